@@ -58,10 +58,29 @@ type ACL struct {
 	// We use an iradix for the purposes of ordered iteration.
 	wildcardHostVolumes *iradix.Tree
 
+	// variables maps a namespace and variable path to a capabilitySet
+	variables *iradix.Tree
+
+	// wildcardVariables maps a glob pattern of a namespace and variable path
+	// to a capabilitySet. We use an iradix for the purposes of ordered
+	// iteration.
+	wildcardVariables *iradix.Tree
+
 	agent    string
 	node     string
 	operator string
 	quota    string
+
+	// workload is set for the ACLs of workload identities, which are scoped
+	// to the job of the workload rather than to namespaces.
+	workload *workloadScope
+}
+
+// workloadScope is the job a workload identity ACL grants capabilities on
+type workloadScope struct {
+	namespace    string
+	jobID        string
+	capabilities capabilitySet
 }
 
 // maxPrivilege returns the policy which grants the most privilege
@@ -92,10 +111,14 @@ func NewACL(management bool, policies []*Policy) (*ACL, error) {
 	wnsTxn := iradix.New().Txn()
 	hvTxn := iradix.New().Txn()
 	whvTxn := iradix.New().Txn()
+	varTxn := iradix.New().Txn()
+	wvarTxn := iradix.New().Txn()
 
 	for _, policy := range policies {
 	NAMESPACES:
 		for _, ns := range policy.Namespaces {
+			addVariablesCapabilities(ns, varTxn, wvarTxn)
+
 			// Should the namespace be matched using a glob?
 			globDefinition := strings.Contains(ns.Name, "*")
 
@@ -200,10 +223,108 @@ func NewACL(management bool, policies []*Policy) (*ACL, error) {
 	acl.wildcardNamespaces = wnsTxn.Commit()
 	acl.hostVolumes = hvTxn.Commit()
 	acl.wildcardHostVolumes = whvTxn.Commit()
+	acl.variables = varTxn.Commit()
+	acl.wildcardVariables = wvarTxn.Commit()
 
 	return acl, nil
 }
 
+// addVariablesCapabilities adds the variables capabilities granted by the
+// namespace policy. The short hand policy grants its capabilities on all the
+// variables of the namespace.
+func addVariablesCapabilities(ns *NamespacePolicy, txn, wildcardTxn *iradix.Txn) {
+	var paths []*VariablesPathPolicy
+	if ns.Policy != "" {
+		paths = append(paths, &VariablesPathPolicy{
+			PathSpec:     "*",
+			Capabilities: expandVariablesPolicy(ns.Policy),
+		})
+	}
+	if ns.Variables != nil {
+		paths = append(paths, ns.Variables.Paths...)
+	}
+
+PATHS:
+	for _, path := range paths {
+		key := variablesKey(ns.Name, path.PathSpec)
+
+		// Should the variables be matched using a glob?
+		tree := txn
+		if strings.Contains(key, "*") {
+			tree = wildcardTxn
+		}
+
+		// Check for existing capabilities
+		var capabilities capabilitySet
+		if raw, ok := tree.Get([]byte(key)); ok {
+			capabilities = raw.(capabilitySet)
+		} else {
+			capabilities = make(capabilitySet)
+			tree.Insert([]byte(key), capabilities)
+		}
+
+		// Deny always takes precedence
+		if capabilities.Check(VariablesCapabilityDeny) {
+			continue
+		}
+
+		// Add in all the capabilities
+		for _, cap := range path.Capabilities {
+			if cap == VariablesCapabilityDeny {
+				// Overwrite any existing capabilities
+				capabilities.Clear()
+				capabilities.Set(VariablesCapabilityDeny)
+				continue PATHS
+			}
+			capabilities.Set(cap)
+		}
+	}
+}
+
+// variablesKey returns the key of the variables capabilities of a namespace
+// and path. Neither namespaces nor paths may contain a NUL byte, so the key
+// is unambiguous for globs on either part.
+func variablesKey(ns, path string) string {
+	return ns + "\x00" + path
+}
+
+// NewWorkloadACL returns the ACL of a workload identity. It grants read access
+// to the workload's own job, and no namespace or cluster wide capabilities.
+func NewWorkloadACL(namespace, jobID string) *ACL {
+	capabilities := make(capabilitySet)
+	capabilities.Set(NamespaceCapabilityReadJob)
+
+	return &ACL{
+		namespaces:          iradix.New(),
+		wildcardNamespaces:  iradix.New(),
+		hostVolumes:         iradix.New(),
+		wildcardHostVolumes: iradix.New(),
+		variables:           iradix.New(),
+		wildcardVariables:   iradix.New(),
+		workload: &workloadScope{
+			namespace:    namespace,
+			jobID:        jobID,
+			capabilities: capabilities,
+		},
+	}
+}
+
+// AllowJobOp checks if a given operation is allowed on a job. Workload
+// identity ACLs are only allowed operations on their own job, other ACLs are
+// checked against the job's namespace.
+func (a *ACL) AllowJobOp(ns, jobID, op string) bool {
+	// Hot path management tokens
+	if a.management {
+		return true
+	}
+
+	if w := a.workload; w != nil && w.namespace == ns && w.jobID == jobID && w.capabilities.Check(op) {
+		return true
+	}
+
+	return a.AllowNamespaceOperation(ns, op)
+}
+
 // AllowNsOp is shorthand for AllowNamespaceOperation
 func (a *ACL) AllowNsOp(ns string, op string) bool {
 	return a.AllowNamespaceOperation(ns, op)
@@ -285,6 +406,59 @@ func (a *ACL) AllowHostVolume(ns string) bool {
 	return !capabilities.Check(PolicyDeny)
 }
 
+// AllowVariableOperation checks if a given operation is allowed on the
+// variable at the path. Workload identity ACLs are allowed to read and list
+// the variables of their own job, at paths under "nomad/jobs/<job ID>".
+func (a *ACL) AllowVariableOperation(ns, path, op string) bool {
+	// Hot path management tokens
+	if a.management {
+		return true
+	}
+
+	if w := a.workload; w != nil && w.allowVariableOperation(ns, path, op) {
+		return true
+	}
+
+	// Check for a matching capability set
+	capabilities, ok := a.matchingVariablesCapabilitySet(ns, path)
+	if !ok {
+		return false
+	}
+
+	// Check if the capability has been granted
+	return capabilities.Check(op)
+}
+
+// allowVariableOperation checks if the operation is allowed on the variables
+// implicitly accessible to the workload.
+func (w *workloadScope) allowVariableOperation(ns, path, op string) bool {
+	if w.namespace != ns {
+		return false
+	}
+	if op != VariablesCapabilityRead && op != VariablesCapabilityList {
+		return false
+	}
+
+	jobPath := "nomad/jobs/" + w.jobID
+	return path == jobPath || strings.HasPrefix(path, jobPath+"/")
+}
+
+// matchingVariablesCapabilitySet looks for a capabilitySet that matches the
+// namespace and variable path, if no concrete definitions are found, then we
+// return the closest matching glob.
+func (a *ACL) matchingVariablesCapabilitySet(ns, path string) (capabilitySet, bool) {
+	key := variablesKey(ns, path)
+
+	// Check for a concrete matching capability set
+	raw, ok := a.variables.Get([]byte(key))
+	if ok {
+		return raw.(capabilitySet), true
+	}
+
+	// We didn't find a concrete match, so lets try and evaluate globs.
+	return a.findClosestMatchingGlob(a.wildcardVariables, key)
+}
+
 // matchingNamespaceCapabilitySet looks for a capabilitySet that matches the namespace,
 // if no concrete definitions are found, then we return the closest matching
 // glob.
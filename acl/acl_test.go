@@ -263,6 +263,91 @@ func TestAllowNamespace(t *testing.T) {
 	}
 }
 
+func TestWorkloadACL(t *testing.T) {
+	assert := assert.New(t)
+
+	acl := NewWorkloadACL("foo", "web")
+	assert.False(acl.IsManagement())
+
+	// Only the workload's own job may be read
+	assert.True(acl.AllowJobOp("foo", "web", NamespaceCapabilityReadJob))
+	assert.False(acl.AllowJobOp("foo", "web", NamespaceCapabilitySubmitJob))
+	assert.False(acl.AllowJobOp("foo", "api", NamespaceCapabilityReadJob))
+	assert.False(acl.AllowJobOp("bar", "web", NamespaceCapabilityReadJob))
+
+	// No namespace or cluster wide capabilities are granted
+	assert.False(acl.AllowNamespace("foo"))
+	assert.False(acl.AllowNsOp("foo", NamespaceCapabilityReadJob))
+	assert.False(acl.AllowNodeRead())
+	assert.False(acl.AllowAgentRead())
+	assert.False(acl.AllowOperatorRead())
+	assert.False(acl.AllowHostVolume("foo"))
+
+	// Other ACLs check job operations against the namespace
+	policy, err := Parse(`namespace "foo" { policy = "read" }`)
+	assert.Nil(err)
+	acl, err = NewACL(false, []*Policy{policy})
+	assert.Nil(err)
+	assert.True(acl.AllowJobOp("foo", "api", NamespaceCapabilityReadJob))
+	assert.False(acl.AllowJobOp("bar", "api", NamespaceCapabilityReadJob))
+}
+
+func TestAllowVariableOperation(t *testing.T) {
+	assert := assert.New(t)
+
+	policy, err := Parse(`
+namespace "default" {
+	policy = "read"
+	variables {
+		path "project/*" {
+			capabilities = ["write", "destroy"]
+		}
+		path "project/secret/*" {
+			capabilities = ["deny"]
+		}
+	}
+}
+namespace "dev-*" {
+	variables {
+		path "*" {
+			capabilities = ["list"]
+		}
+	}
+}
+`)
+	assert.Nil(err)
+	acl, err := NewACL(false, []*Policy{policy})
+	assert.Nil(err)
+
+	// The short hand policy applies to all paths
+	assert.True(acl.AllowVariableOperation("default", "other", VariablesCapabilityRead))
+	assert.True(acl.AllowVariableOperation("default", "other", VariablesCapabilityList))
+	assert.False(acl.AllowVariableOperation("default", "other", VariablesCapabilityWrite))
+
+	// The closest matching path glob takes precedence
+	assert.True(acl.AllowVariableOperation("default", "project/app", VariablesCapabilityWrite))
+	assert.True(acl.AllowVariableOperation("default", "project/app", VariablesCapabilityDestroy))
+	assert.False(acl.AllowVariableOperation("default", "project/app", VariablesCapabilityRead))
+	assert.False(acl.AllowVariableOperation("default", "project/secret/db", VariablesCapabilityRead))
+	assert.False(acl.AllowVariableOperation("default", "project/secret/db", VariablesCapabilityWrite))
+
+	// Namespace globs are matched
+	assert.True(acl.AllowVariableOperation("dev-1", "app", VariablesCapabilityList))
+	assert.False(acl.AllowVariableOperation("dev-1", "app", VariablesCapabilityRead))
+	assert.False(acl.AllowVariableOperation("prod", "app", VariablesCapabilityList))
+
+	// Management tokens may do anything
+	assert.True(ManagementACL.AllowVariableOperation("prod", "app", VariablesCapabilityWrite))
+
+	// Workloads may read the variables of their own job
+	acl = NewWorkloadACL("default", "web")
+	assert.True(acl.AllowVariableOperation("default", "nomad/jobs/web", VariablesCapabilityRead))
+	assert.True(acl.AllowVariableOperation("default", "nomad/jobs/web/group", VariablesCapabilityList))
+	assert.False(acl.AllowVariableOperation("default", "nomad/jobs/web", VariablesCapabilityWrite))
+	assert.False(acl.AllowVariableOperation("default", "nomad/jobs/webapp", VariablesCapabilityRead))
+	assert.False(acl.AllowVariableOperation("other", "nomad/jobs/web", VariablesCapabilityRead))
+}
+
 func TestWildcardNamespaceMatching(t *testing.T) {
 	tests := []struct {
 		Policy string
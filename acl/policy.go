@@ -54,6 +54,23 @@ var (
 	validVolume = regexp.MustCompile("^[a-zA-Z0-9-*]{1,128}$")
 )
 
+const (
+	// The following are the fine-grained capabilities that can be granted on
+	// the variables of a namespace matching a path glob. When capabilities are
+	// combined we take the union of all capabilities. If the deny capability
+	// is present, it takes precedence and overwrites all other capabilities.
+
+	VariablesCapabilityDeny    = "deny"
+	VariablesCapabilityList    = "list"
+	VariablesCapabilityRead    = "read"
+	VariablesCapabilityWrite   = "write"
+	VariablesCapabilityDestroy = "destroy"
+)
+
+var (
+	validVariablesPath = regexp.MustCompile("^[a-zA-Z0-9-_~/*]{1,128}$")
+)
+
 // Policy represents a parsed HCL or JSON policy.
 type Policy struct {
 	Namespaces  []*NamespacePolicy  `hcl:"namespace,expand"`
@@ -81,6 +98,18 @@ type NamespacePolicy struct {
 	Name         string `hcl:",key"`
 	Policy       string
 	Capabilities []string
+	Variables    *VariablesPolicy `hcl:"variables"`
+}
+
+// VariablesPolicy is the policy for the variables of a namespace
+type VariablesPolicy struct {
+	Paths []*VariablesPathPolicy `hcl:"path,expand"`
+}
+
+// VariablesPathPolicy is the policy for the variables matching a path glob
+type VariablesPathPolicy struct {
+	PathSpec     string `hcl:",key"`
+	Capabilities []string
 }
 
 // HostVolumePolicy is the policy for a specific named host volume
@@ -159,6 +188,41 @@ func expandNamespacePolicy(policy string) []string {
 	}
 }
 
+// isVariablesCapabilityValid ensures the given capability is valid for a
+// variables path policy
+func isVariablesCapabilityValid(cap string) bool {
+	switch cap {
+	case VariablesCapabilityDeny, VariablesCapabilityList, VariablesCapabilityRead,
+		VariablesCapabilityWrite, VariablesCapabilityDestroy:
+		return true
+	default:
+		return false
+	}
+}
+
+// expandVariablesPolicy provides the equivalent set of variables capabilities
+// for a namespace policy
+func expandVariablesPolicy(policy string) []string {
+	switch policy {
+	case PolicyDeny:
+		return []string{VariablesCapabilityDeny}
+	case PolicyRead:
+		return []string{
+			VariablesCapabilityList,
+			VariablesCapabilityRead,
+		}
+	case PolicyWrite:
+		return []string{
+			VariablesCapabilityList,
+			VariablesCapabilityRead,
+			VariablesCapabilityWrite,
+			VariablesCapabilityDestroy,
+		}
+	default:
+		return nil
+	}
+}
+
 func isHostVolumeCapabilityValid(cap string) bool {
 	switch cap {
 	case HostVolumeCapabilityDeny, HostVolumeCapabilityMountReadOnly, HostVolumeCapabilityMountReadWrite:
@@ -223,6 +287,19 @@ func Parse(rules string) (*Policy, error) {
 			extraCap := expandNamespacePolicy(ns.Policy)
 			ns.Capabilities = append(ns.Capabilities, extraCap...)
 		}
+
+		if ns.Variables != nil {
+			for _, path := range ns.Variables.Paths {
+				if !validVariablesPath.MatchString(path.PathSpec) {
+					return nil, fmt.Errorf("Invalid variables path: %#v", path)
+				}
+				for _, cap := range path.Capabilities {
+					if !isVariablesCapabilityValid(cap) {
+						return nil, fmt.Errorf("Invalid variables capability '%s': %#v", cap, path)
+					}
+				}
+			}
+		}
 	}
 
 	for _, hv := range p.HostVolumes {
@@ -246,6 +246,71 @@ func TestParse(t *testing.T) {
 			"Invalid host volume name",
 			nil,
 		},
+		{
+			`
+			namespace "default" {
+				variables {
+					path "project/*" {
+						capabilities = ["read", "list"]
+					}
+					path "project/secret" {
+						capabilities = ["deny"]
+					}
+				}
+			}
+			`,
+			"",
+			&Policy{
+				Namespaces: []*NamespacePolicy{
+					{
+						Name: "default",
+						Variables: &VariablesPolicy{
+							Paths: []*VariablesPathPolicy{
+								{
+									PathSpec: "project/*",
+									Capabilities: []string{
+										VariablesCapabilityRead,
+										VariablesCapabilityList,
+									},
+								},
+								{
+									PathSpec: "project/secret",
+									Capabilities: []string{
+										VariablesCapabilityDeny,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			`
+			namespace "default" {
+				variables {
+					path "project/*" {
+						capabilities = ["submit-job"]
+					}
+				}
+			}
+			`,
+			"Invalid variables capability",
+			nil,
+		},
+		{
+			`
+			namespace "default" {
+				variables {
+					path "project secret" {
+						capabilities = ["read"]
+					}
+				}
+			}
+			`,
+			"Invalid variables path",
+			nil,
+		},
 	}
 
 	for idx, tc := range tcases {
@@ -62,6 +62,223 @@ func (a *ACLPolicies) Info(policyName string, q *QueryOptions) (*ACLPolicy, *Que
 	return &resp, wm, nil
 }
 
+// ACLRoles is used to query the ACL Role endpoints.
+type ACLRoles struct {
+	client *Client
+}
+
+// ACLRoles returns a new handle on the ACL roles.
+func (c *Client) ACLRoles() *ACLRoles {
+	return &ACLRoles{client: c}
+}
+
+// List is used to dump all of the roles.
+func (a *ACLRoles) List(q *QueryOptions) ([]*ACLRoleListStub, *QueryMeta, error) {
+	var resp []*ACLRoleListStub
+	qm, err := a.client.query("/v1/acl/roles", &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, qm, nil
+}
+
+// Upsert is used to create or update a role
+func (a *ACLRoles) Upsert(role *ACLRole, q *WriteOptions) (*WriteMeta, error) {
+	if role == nil || role.Name == "" {
+		return nil, fmt.Errorf("missing role name")
+	}
+	wm, err := a.client.write("/v1/acl/role/"+role.Name, role, nil, q)
+	if err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
+
+// Delete is used to delete a role
+func (a *ACLRoles) Delete(roleName string, q *WriteOptions) (*WriteMeta, error) {
+	if roleName == "" {
+		return nil, fmt.Errorf("missing role name")
+	}
+	wm, err := a.client.delete("/v1/acl/role/"+roleName, nil, q)
+	if err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
+
+// Info is used to query a specific role
+func (a *ACLRoles) Info(roleName string, q *QueryOptions) (*ACLRole, *QueryMeta, error) {
+	if roleName == "" {
+		return nil, nil, fmt.Errorf("missing role name")
+	}
+	var resp ACLRole
+	wm, err := a.client.query("/v1/acl/role/"+roleName, &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, wm, nil
+}
+
+// ACLAuthMethods is used to query the ACL auth method endpoints.
+type ACLAuthMethods struct {
+	client *Client
+}
+
+// ACLAuthMethods returns a new handle on the ACL auth methods.
+func (c *Client) ACLAuthMethods() *ACLAuthMethods {
+	return &ACLAuthMethods{client: c}
+}
+
+// List is used to dump all of the auth methods.
+func (a *ACLAuthMethods) List(q *QueryOptions) ([]*ACLAuthMethodListStub, *QueryMeta, error) {
+	var resp []*ACLAuthMethodListStub
+	qm, err := a.client.query("/v1/acl/auth-methods", &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, qm, nil
+}
+
+// Upsert is used to create or update an auth method
+func (a *ACLAuthMethods) Upsert(method *ACLAuthMethod, q *WriteOptions) (*WriteMeta, error) {
+	if method == nil || method.Name == "" {
+		return nil, fmt.Errorf("missing auth method name")
+	}
+	wm, err := a.client.write("/v1/acl/auth-method/"+method.Name, method, nil, q)
+	if err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
+
+// Delete is used to delete an auth method and its binding rules
+func (a *ACLAuthMethods) Delete(methodName string, q *WriteOptions) (*WriteMeta, error) {
+	if methodName == "" {
+		return nil, fmt.Errorf("missing auth method name")
+	}
+	wm, err := a.client.delete("/v1/acl/auth-method/"+methodName, nil, q)
+	if err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
+
+// Info is used to query a specific auth method
+func (a *ACLAuthMethods) Info(methodName string, q *QueryOptions) (*ACLAuthMethod, *QueryMeta, error) {
+	if methodName == "" {
+		return nil, nil, fmt.Errorf("missing auth method name")
+	}
+	var resp ACLAuthMethod
+	wm, err := a.client.query("/v1/acl/auth-method/"+methodName, &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, wm, nil
+}
+
+// ACLBindingRules is used to query the ACL binding rule endpoints.
+type ACLBindingRules struct {
+	client *Client
+}
+
+// ACLBindingRules returns a new handle on the ACL binding rules.
+func (c *Client) ACLBindingRules() *ACLBindingRules {
+	return &ACLBindingRules{client: c}
+}
+
+// List is used to dump all of the binding rules.
+func (a *ACLBindingRules) List(q *QueryOptions) ([]*ACLBindingRuleListStub, *QueryMeta, error) {
+	var resp []*ACLBindingRuleListStub
+	qm, err := a.client.query("/v1/acl/binding-rules", &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, qm, nil
+}
+
+// Create is used to create a binding rule
+func (a *ACLBindingRules) Create(rule *ACLBindingRule, q *WriteOptions) (*ACLBindingRule, *WriteMeta, error) {
+	if rule.ID != "" {
+		return nil, nil, fmt.Errorf("cannot specify ID")
+	}
+	var resp ACLBindingRule
+	wm, err := a.client.write("/v1/acl/binding-rule", rule, &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, wm, nil
+}
+
+// Update is used to update an existing binding rule
+func (a *ACLBindingRules) Update(rule *ACLBindingRule, q *WriteOptions) (*ACLBindingRule, *WriteMeta, error) {
+	if rule.ID == "" {
+		return nil, nil, fmt.Errorf("missing ID")
+	}
+	var resp ACLBindingRule
+	wm, err := a.client.write("/v1/acl/binding-rule/"+rule.ID, rule, &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, wm, nil
+}
+
+// Delete is used to delete a binding rule
+func (a *ACLBindingRules) Delete(ruleID string, q *WriteOptions) (*WriteMeta, error) {
+	if ruleID == "" {
+		return nil, fmt.Errorf("missing ID")
+	}
+	wm, err := a.client.delete("/v1/acl/binding-rule/"+ruleID, nil, q)
+	if err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
+
+// Info is used to query a binding rule
+func (a *ACLBindingRules) Info(ruleID string, q *QueryOptions) (*ACLBindingRule, *QueryMeta, error) {
+	if ruleID == "" {
+		return nil, nil, fmt.Errorf("missing ID")
+	}
+	var resp ACLBindingRule
+	wm, err := a.client.query("/v1/acl/binding-rule/"+ruleID, &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, wm, nil
+}
+
+// ACLOIDC is used to log in with the OIDC auth method endpoints.
+type ACLOIDC struct {
+	client *Client
+}
+
+// ACLOIDC returns a new handle on the OIDC login endpoints.
+func (c *Client) ACLOIDC() *ACLOIDC {
+	return &ACLOIDC{client: c}
+}
+
+// GetAuthURL is used to start an OIDC login, returning the URL of the
+// provider the user logs in at
+func (a *ACLOIDC) GetAuthURL(req *ACLOIDCAuthURLRequest, q *WriteOptions) (*ACLOIDCAuthURLResponse, *WriteMeta, error) {
+	var resp ACLOIDCAuthURLResponse
+	wm, err := a.client.write("/v1/acl/oidc/auth-url", req, &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, wm, nil
+}
+
+// CompleteAuth is used to complete an OIDC login, returning the token
+// created for the user
+func (a *ACLOIDC) CompleteAuth(req *ACLOIDCCompleteAuthRequest, q *WriteOptions) (*ACLToken, *WriteMeta, error) {
+	var resp ACLToken
+	wm, err := a.client.write("/v1/acl/oidc/complete-auth", req, &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, wm, nil
+}
+
 // ACLTokens is used to query the ACL token endpoints.
 type ACLTokens struct {
 	client *Client
@@ -171,26 +388,127 @@ type ACLPolicy struct {
 	ModifyIndex uint64
 }
 
-// ACLToken represents a client token which is used to Authenticate
-type ACLToken struct {
-	AccessorID  string
-	SecretID    string
+// ACLRoleListStub is used to for listing ACL roles
+type ACLRoleListStub struct {
 	Name        string
-	Type        string
+	Description string
 	Policies    []string
-	Global      bool
-	CreateTime  time.Time
 	CreateIndex uint64
 	ModifyIndex uint64
 }
 
-type ACLTokenListStub struct {
-	AccessorID  string
+// ACLRole is used to represent a named set of ACL policies
+type ACLRole struct {
 	Name        string
-	Type        string
+	Description string
 	Policies    []string
-	Global      bool
-	CreateTime  time.Time
 	CreateIndex uint64
 	ModifyIndex uint64
 }
+
+// ACLAuthMethodListStub is used to for listing ACL auth methods
+type ACLAuthMethodListStub struct {
+	Name        string
+	Type        string
+	Default     bool
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// ACLAuthMethod is used to log in users with an external identity provider
+type ACLAuthMethod struct {
+	Name          string
+	Type          string
+	TokenLocality string
+	MaxTokenTTL   time.Duration
+	Default       bool
+	Config        *ACLAuthMethodConfig
+	CreateIndex   uint64
+	ModifyIndex   uint64
+}
+
+// ACLAuthMethodConfig is the configuration of an OIDC auth method
+type ACLAuthMethodConfig struct {
+	OIDCDiscoveryURL    string
+	OIDCClientID        string
+	OIDCClientSecret    string
+	OIDCScopes          []string
+	BoundAudiences      []string
+	AllowedRedirectURIs []string
+	DiscoveryCaPem      []string
+	SigningAlgs         []string
+	ClaimMappings       map[string]string
+	ListClaimMappings   map[string]string
+}
+
+// ACLBindingRuleListStub is used to for listing ACL binding rules
+type ACLBindingRuleListStub struct {
+	ID          string
+	Description string
+	AuthMethod  string
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// ACLBindingRule links the users of an auth method to a role or policy
+type ACLBindingRule struct {
+	ID          string
+	Description string
+	AuthMethod  string
+	Selector    string
+	BindType    string
+	BindName    string
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// ACLOIDCAuthURLRequest is used to start an OIDC login
+type ACLOIDCAuthURLRequest struct {
+	AuthMethodName string
+	RedirectURI    string
+	ClientNonce    string
+}
+
+// ACLOIDCAuthURLResponse is the URL the user logs in at
+type ACLOIDCAuthURLResponse struct {
+	AuthURL string
+}
+
+// ACLOIDCCompleteAuthRequest is used to complete an OIDC login with the
+// parameters the provider redirected the user with
+type ACLOIDCCompleteAuthRequest struct {
+	AuthMethodName string
+	ClientNonce    string
+	State          string
+	Code           string
+	RedirectURI    string
+}
+
+// ACLToken represents a client token which is used to Authenticate
+type ACLToken struct {
+	AccessorID     string
+	SecretID       string
+	Name           string
+	Type           string
+	Policies       []string
+	Roles          []string
+	Global         bool
+	CreateTime     time.Time
+	ExpirationTime *time.Time
+	ExpirationTTL  time.Duration
+	CreateIndex    uint64
+	ModifyIndex    uint64
+}
+
+type ACLTokenListStub struct {
+	AccessorID     string
+	Name           string
+	Type           string
+	Policies       []string
+	Roles          []string
+	Global         bool
+	CreateTime     time.Time
+	ExpirationTime *time.Time
+	CreateIndex    uint64
+	ModifyIndex    uint64
+}
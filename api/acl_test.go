@@ -2,6 +2,7 @@ package api
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -109,6 +110,125 @@ func TestACLPolicies_Info(t *testing.T) {
 	assert.Equal(t, policy.Name, out.Name)
 }
 
+func TestACLRoles_CRUD(t *testing.T) {
+	t.Parallel()
+	c, s, _ := makeACLClient(t, nil, nil)
+	defer s.Stop()
+	ap := c.ACLPolicies()
+	ar := c.ACLRoles()
+
+	// Register a policy for the role
+	policy := &ACLPolicy{
+		Name: "test",
+		Rules: `namespace "default" {
+			policy = "read"
+		}
+		`,
+	}
+	_, err := ap.Upsert(policy, nil)
+	assert.Nil(t, err)
+
+	// Register a role
+	role := &ACLRole{
+		Name:        "ops",
+		Description: "test",
+		Policies:    []string{policy.Name},
+	}
+	wm, err := ar.Upsert(role, nil)
+	assert.Nil(t, err)
+	assertWriteMeta(t, wm)
+
+	// List the roles
+	roles, qm, err := ar.List(nil)
+	assert.Nil(t, err)
+	assertQueryMeta(t, qm)
+	assert.Len(t, roles, 1)
+
+	// Query the role
+	out, qm, err := ar.Info(role.Name, nil)
+	assert.Nil(t, err)
+	assertQueryMeta(t, qm)
+	assert.Equal(t, []string{policy.Name}, out.Policies)
+
+	// Delete the role
+	wm, err = ar.Delete(role.Name, nil)
+	assert.Nil(t, err)
+	assertWriteMeta(t, wm)
+
+	roles, _, err = ar.List(nil)
+	assert.Nil(t, err)
+	assert.Len(t, roles, 0)
+}
+
+func TestACLAuthMethods_BindingRules_CRUD(t *testing.T) {
+	t.Parallel()
+	c, s, _ := makeACLClient(t, nil, nil)
+	defer s.Stop()
+	am := c.ACLAuthMethods()
+	ab := c.ACLBindingRules()
+
+	// Register an auth method
+	method := &ACLAuthMethod{
+		Name:          "okta",
+		Type:          "OIDC",
+		TokenLocality: "local",
+		MaxTokenTTL:   time.Hour,
+		Config: &ACLAuthMethodConfig{
+			OIDCDiscoveryURL:    "https://oidc.example.com",
+			OIDCClientID:        "nomad",
+			OIDCClientSecret:    "secret",
+			AllowedRedirectURIs: []string{"http://localhost:4649/oidc/callback"},
+		},
+	}
+	wm, err := am.Upsert(method, nil)
+	assert.Nil(t, err)
+	assertWriteMeta(t, wm)
+
+	// List the auth methods
+	methods, qm, err := am.List(nil)
+	assert.Nil(t, err)
+	assertQueryMeta(t, qm)
+	assert.Len(t, methods, 1)
+
+	// Query the auth method
+	out, qm, err := am.Info(method.Name, nil)
+	assert.Nil(t, err)
+	assertQueryMeta(t, qm)
+	assert.Equal(t, "nomad", out.Config.OIDCClientID)
+
+	// Register a binding rule
+	rule, wm, err := ab.Create(&ACLBindingRule{
+		AuthMethod: method.Name,
+		Selector:   `"ops" in list.groups`,
+		BindType:   "policy",
+		BindName:   "ops",
+	}, nil)
+	assert.Nil(t, err)
+	assertWriteMeta(t, wm)
+	assert.NotEqual(t, "", rule.ID)
+
+	// Update the binding rule
+	rule.BindName = "readonly"
+	rule, _, err = ab.Update(rule, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "readonly", rule.BindName)
+
+	// List the binding rules
+	rules, qm, err := ab.List(nil)
+	assert.Nil(t, err)
+	assertQueryMeta(t, qm)
+	assert.Len(t, rules, 1)
+
+	// Delete the auth method, deleting its binding rules
+	wm, err = am.Delete(method.Name, nil)
+	assert.Nil(t, err)
+	assertWriteMeta(t, wm)
+
+	rules, _, err = ab.List(nil)
+	assert.Nil(t, err)
+	assert.Len(t, rules, 0)
+}
+
 func TestACLTokens_List(t *testing.T) {
 	t.Parallel()
 	c, s, _ := makeACLClient(t, nil, nil)
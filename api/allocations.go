@@ -365,6 +365,7 @@ type Allocation struct {
 	TaskStates            map[string]*TaskState
 	DeploymentID          string
 	DeploymentStatus      *AllocDeploymentStatus
+	NetworkStatus         *AllocNetworkStatus
 	FollowupEvalID        string
 	PreviousAllocation    string
 	NextAllocation        string
@@ -443,6 +444,13 @@ type AllocDeploymentStatus struct {
 	ModifyIndex uint64
 }
 
+// AllocNetworkStatus captures the address assigned to an allocation's network
+// namespace by the client.
+type AllocNetworkStatus struct {
+	InterfaceName string
+	Address       string
+}
+
 type AllocatedResources struct {
 	Tasks  map[string]*AllocatedTaskResources
 	Shared AllocatedSharedResources
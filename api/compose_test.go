@@ -20,7 +20,7 @@ func TestCompose(t *testing.T) {
 				{
 					CIDR:          "0.0.0.0/0",
 					MBits:         intToPtr(100),
-					ReservedPorts: []Port{{"", 80, 0}, {"", 443, 0}},
+					ReservedPorts: []Port{{"", 80, 0, "", ""}, {"", 443, 0, "", ""}},
 				},
 			},
 		})
@@ -111,8 +111,8 @@ func TestCompose(t *testing.T) {
 									CIDR:  "0.0.0.0/0",
 									MBits: intToPtr(100),
 									ReservedPorts: []Port{
-										{"", 80, 0},
-										{"", 443, 0},
+										{"", 80, 0, "", ""},
+										{"", 443, 0, "", ""},
 									},
 								},
 							},
@@ -75,6 +75,7 @@ type Evaluation struct {
 	ClassEligibility     map[string]bool
 	EscapedComputedClass bool
 	QuotaLimitReached    string
+	WaitingOnJobs        []string
 	AnnotatePlan         bool
 	QueuedAllocations    map[string]int
 	SnapshotIndex        uint64
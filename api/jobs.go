@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gorhill/cronexpr"
@@ -20,9 +21,19 @@ const (
 	// JobTypeSystem indicates a system process that should run on all clients
 	JobTypeSystem = "system"
 
+	// JobTypeSysBatch indicates a short-lived process that should run once
+	// on all clients
+	JobTypeSysBatch = "sysbatch"
+
 	// PeriodicSpecCron is used for a cron spec.
 	PeriodicSpecCron = "cron"
 
+	// PeriodicCatchUpNone, PeriodicCatchUpLatest and PeriodicCatchUpAll are
+	// the policies for launches missed while there was no leader.
+	PeriodicCatchUpNone   = "none"
+	PeriodicCatchUpLatest = "latest"
+	PeriodicCatchUpAll    = "all"
+
 	// DefaultNamespace is the default namespace.
 	DefaultNamespace = "default"
 
@@ -317,13 +328,34 @@ func (j *Jobs) Summary(jobID string, q *QueryOptions) (*JobSummary, *QueryMeta,
 	return &resp, qm, nil
 }
 
+// DispatchOptions is used to pass through job dispatch parameters
+type DispatchOptions struct {
+	Meta    map[string]string
+	Payload []byte
+
+	// IdempotencyToken, if set, returns the job previously dispatched with
+	// the same token instead of dispatching a new one.
+	IdempotencyToken string
+}
+
 func (j *Jobs) Dispatch(jobID string, meta map[string]string,
 	payload []byte, q *WriteOptions) (*JobDispatchResponse, *WriteMeta, error) {
+	opts := DispatchOptions{Meta: meta, Payload: payload}
+	return j.DispatchOpts(jobID, &opts, q)
+}
+
+// DispatchOpts is used to dispatch a parameterized job with the given
+// options.
+func (j *Jobs) DispatchOpts(jobID string, opts *DispatchOptions,
+	q *WriteOptions) (*JobDispatchResponse, *WriteMeta, error) {
 	var resp JobDispatchResponse
 	req := &JobDispatchRequest{
-		JobID:   jobID,
-		Meta:    meta,
-		Payload: payload,
+		JobID: jobID,
+	}
+	if opts != nil {
+		req.Meta = opts.Meta
+		req.Payload = opts.Payload
+		req.IdempotencyToken = opts.IdempotencyToken
 	}
 	wm, err := j.client.write("/v1/job/"+url.PathEscape(jobID)+"/dispatch", req, &resp, q)
 	if err != nil {
@@ -352,6 +384,24 @@ func (j *Jobs) Revert(jobID string, version uint64, enforcePriorVersion *uint64,
 	return &resp, wm, nil
 }
 
+// Pause is used to pause the given job, scaling all its task groups down to
+// zero, or to resume it, restoring the counts it had before being paused.
+func (j *Jobs) Pause(jobID string, pause bool, q *WriteOptions,
+	vaultToken string) (*JobRegisterResponse, *WriteMeta, error) {
+
+	var resp JobRegisterResponse
+	req := &JobPauseRequest{
+		JobID:      jobID,
+		Pause:      pause,
+		VaultToken: vaultToken,
+	}
+	wm, err := j.client.write("/v1/job/"+url.PathEscape(jobID)+"/pause", req, &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, wm, nil
+}
+
 // Stable is used to mark a job version's stability.
 func (j *Jobs) Stable(jobID string, version uint64, stable bool,
 	q *WriteOptions) (*JobStabilityResponse, *WriteMeta, error) {
@@ -385,6 +435,7 @@ type UpdateStrategy struct {
 	Canary           *int           `mapstructure:"canary"`
 	AutoRevert       *bool          `mapstructure:"auto_revert"`
 	AutoPromote      *bool          `mapstructure:"auto_promote"`
+	PauseAfterBatch  *bool          `mapstructure:"pause_after_batch"`
 }
 
 // DefaultUpdateStrategy provides a baseline that can be used to upgrade
@@ -400,6 +451,7 @@ func DefaultUpdateStrategy() *UpdateStrategy {
 		AutoRevert:       boolToPtr(false),
 		Canary:           intToPtr(0),
 		AutoPromote:      boolToPtr(false),
+		PauseAfterBatch:  boolToPtr(false),
 	}
 }
 
@@ -446,6 +498,10 @@ func (u *UpdateStrategy) Copy() *UpdateStrategy {
 		copy.AutoPromote = boolToPtr(*u.AutoPromote)
 	}
 
+	if u.PauseAfterBatch != nil {
+		copy.PauseAfterBatch = boolToPtr(*u.PauseAfterBatch)
+	}
+
 	return copy
 }
 
@@ -489,6 +545,10 @@ func (u *UpdateStrategy) Merge(o *UpdateStrategy) {
 	if o.AutoPromote != nil {
 		u.AutoPromote = boolToPtr(*o.AutoPromote)
 	}
+
+	if o.PauseAfterBatch != nil {
+		u.PauseAfterBatch = boolToPtr(*o.PauseAfterBatch)
+	}
 }
 
 func (u *UpdateStrategy) Canonicalize() {
@@ -529,6 +589,10 @@ func (u *UpdateStrategy) Canonicalize() {
 	if u.AutoPromote == nil {
 		u.AutoPromote = d.AutoPromote
 	}
+
+	if u.PauseAfterBatch == nil {
+		u.PauseAfterBatch = d.PauseAfterBatch
+	}
 }
 
 // Empty returns whether the UpdateStrategy is empty or has user defined values.
@@ -569,6 +633,10 @@ func (u *UpdateStrategy) Empty() bool {
 		return false
 	}
 
+	if u.PauseAfterBatch != nil && *u.PauseAfterBatch {
+		return false
+	}
+
 	if u.Canary != nil && *u.Canary != 0 {
 		return false
 	}
@@ -580,9 +648,13 @@ func (u *UpdateStrategy) Empty() bool {
 type PeriodicConfig struct {
 	Enabled         *bool
 	Spec            *string
+	Specs           []string
 	SpecType        *string
 	ProhibitOverlap *bool   `mapstructure:"prohibit_overlap"`
 	TimeZone        *string `mapstructure:"time_zone"`
+	CatchUp         *string `mapstructure:"catch_up"`
+	MaxCatchUp      *int    `mapstructure:"max_catch_up"`
+	Exclusions      []string
 }
 
 func (p *PeriodicConfig) Canonicalize() {
@@ -601,22 +673,92 @@ func (p *PeriodicConfig) Canonicalize() {
 	if p.TimeZone == nil || *p.TimeZone == "" {
 		p.TimeZone = stringToPtr("UTC")
 	}
+	if p.CatchUp == nil {
+		p.CatchUp = stringToPtr(PeriodicCatchUpLatest)
+	}
+	if p.MaxCatchUp == nil {
+		p.MaxCatchUp = intToPtr(0)
+	}
 }
 
-// Next returns the closest time instant matching the spec that is after the
-// passed time. If no matching instance exists, the zero value of time.Time is
-// returned. The `time.Location` of the returned value matches that of the
-// passed time.
+// Next returns the closest time instant matching any of the specs that is
+// after the passed time and not excluded. If no matching instance exists, the
+// zero value of time.Time is returned. The `time.Location` of the returned
+// value matches that of the passed time.
 func (p *PeriodicConfig) Next(fromTime time.Time) (time.Time, error) {
-	if *p.SpecType == PeriodicSpecCron {
-		if e, err := cronexpr.Parse(*p.Spec); err == nil {
-			return cronParseNext(e, fromTime, *p.Spec)
+	if *p.SpecType != PeriodicSpecCron {
+		return time.Time{}, nil
+	}
+
+	specs := p.Specs
+	if p.Spec != nil && *p.Spec != "" {
+		specs = []string{*p.Spec}
+	}
+
+	location, err := p.GetLocation()
+	if err != nil {
+		location = time.UTC
+	}
+
+	// Each excluded launch moves the search past the end of an exclusion, so
+	// there can't be more excluded launches than exclusions.
+	for i := 0; i <= len(p.Exclusions); i++ {
+		var next time.Time
+		for _, spec := range specs {
+			e, err := cronexpr.Parse(spec)
+			if err != nil {
+				continue
+			}
+
+			t, err := cronParseNext(e, fromTime, spec)
+			if err != nil {
+				return time.Time{}, err
+			}
+			if !t.IsZero() && (next.IsZero() || t.Before(next)) {
+				next = t
+			}
+		}
+		if next.IsZero() {
+			return next, nil
 		}
+
+		end := periodicExcludedUntil(p.Exclusions, next, location)
+		if end.IsZero() {
+			return next, nil
+		}
+		fromTime = end.Add(-time.Nanosecond).In(fromTime.Location())
 	}
 
 	return time.Time{}, nil
 }
 
+// periodicExcludedUntil returns the end of the exclusion the launch time falls
+// in, or the zero time if it isn't excluded.
+func periodicExcludedUntil(exclusions []string, launch time.Time, location *time.Location) time.Time {
+	const layout = "2006-01-02"
+
+	var until time.Time
+	for _, exclusion := range exclusions {
+		split := strings.SplitN(exclusion, "/", 2)
+		start, err := time.ParseInLocation(layout, split[0], location)
+		if err != nil {
+			continue
+		}
+		last := start
+		if len(split) == 2 {
+			if last, err = time.ParseInLocation(layout, split[1], location); err != nil {
+				continue
+			}
+		}
+
+		end := last.AddDate(0, 0, 1)
+		if !launch.Before(start) && launch.Before(end) && end.After(until) {
+			until = end
+		}
+	}
+	return until
+}
+
 // cronParseNext is a helper that parses the next time for the given expression
 // but captures any panic that may occur in the underlying library.
 // ---  THIS FUNCTION IS REPLICATED IN nomad/structs/structs.go
@@ -646,9 +788,36 @@ type ParameterizedJobConfig struct {
 	MetaOptional []string `mapstructure:"meta_optional"`
 }
 
+const (
+	// JobDependencyConditionComplete is met once the job is dead.
+	JobDependencyConditionComplete = "complete"
+
+	// JobDependencyConditionSuccess is met once the job is dead and the latest
+	// allocation of every index completed successfully.
+	JobDependencyConditionSuccess = "success"
+)
+
+// JobDependency is used to hold the evaluation of a job until another job
+// meets a condition.
+type JobDependency struct {
+	JobID     *string `mapstructure:"job"`
+	Condition *string
+}
+
+func (d *JobDependency) Canonicalize() {
+	if d.JobID == nil {
+		d.JobID = stringToPtr("")
+	}
+	if d.Condition == nil {
+		d.Condition = stringToPtr(JobDependencyConditionSuccess)
+	}
+}
+
 // Job is used to serialize a job.
 type Job struct {
 	Stop              *bool
+	Paused            bool
+	PausedCounts      map[string]int
 	Region            *string
 	Namespace         *string
 	ID                *string
@@ -665,6 +834,7 @@ type Job struct {
 	Spreads           []*Spread
 	Periodic          *PeriodicConfig
 	ParameterizedJob  *ParameterizedJobConfig
+	DependsOn         []*JobDependency
 	Dispatched        bool
 	Payload           []byte
 	Reschedule        *ReschedulePolicy
@@ -749,6 +919,9 @@ func (j *Job) Canonicalize() {
 	if j.Periodic != nil {
 		j.Periodic.Canonicalize()
 	}
+	for _, d := range j.DependsOn {
+		d.Canonicalize()
+	}
 	if j.Update != nil {
 		j.Update.Canonicalize()
 	} else if *j.Type == JobTypeService {
@@ -784,6 +957,10 @@ type JobSummary struct {
 	Summary   map[string]TaskGroupSummary
 	Children  *JobChildrenSummary
 
+	// Nodes is the completion of a sysbatch job on each node, keyed by node
+	// ID
+	Nodes map[string]*JobNodeSummary
+
 	// Raft Indexes
 	CreateIndex uint64
 	ModifyIndex uint64
@@ -804,6 +981,20 @@ func (jc *JobChildrenSummary) Sum() int {
 	return int(jc.Pending + jc.Running + jc.Dead)
 }
 
+// JobNodeSummary summarizes the allocations of a sysbatch job on a node
+type JobNodeSummary struct {
+	// Allocs is the most recent allocation of each task group on the node,
+	// keyed by task group
+	Allocs map[string]JobNodeAlloc
+}
+
+// JobNodeAlloc is an allocation of a JobNodeSummary
+type JobNodeAlloc struct {
+	ID           string
+	ClientStatus string
+	CreateIndex  uint64
+}
+
 // TaskGroup summarizes the state of all the allocations of a particular
 // TaskGroup
 type TaskGroupSummary struct {
@@ -865,6 +1056,13 @@ func NewBatchJob(id, name, region string, pri int) *Job {
 	return newJob(id, name, region, JobTypeBatch, pri)
 }
 
+// NewSysBatchJob creates and returns a new sysbatch-style job for
+// short-lived processes that run once on all clients, using the provided
+// name and ID along with the relative job priority.
+func NewSysBatchJob(id, name, region string, pri int) *Job {
+	return newJob(id, name, region, JobTypeSysBatch, pri)
+}
+
 // newJob is used to create a new Job struct.
 func newJob(id, name, region, typ string, pri int) *Job {
 	return &Job{
@@ -975,6 +1173,22 @@ type JobRevertRequest struct {
 	WriteRequest
 }
 
+// JobPauseRequest is used to pause a job or resume a paused job.
+type JobPauseRequest struct {
+	// JobID is the ID of the job being paused or resumed
+	JobID string
+
+	// Pause is set to pause the job and unset to resume it
+	Pause bool
+
+	// VaultToken is the Vault token that proves the submitter of the job resume
+	// has access to any Vault policies specified in the job. This field is only
+	// used to authorize the resume and is not stored after the Job resume.
+	VaultToken string `json:",omitempty"`
+
+	WriteRequest
+}
+
 // JobUpdateRequest is used to update a job
 type JobRegisterRequest struct {
 	Job *Job
@@ -1093,9 +1307,10 @@ type DesiredUpdates struct {
 }
 
 type JobDispatchRequest struct {
-	JobID   string
-	Payload []byte
-	Meta    map[string]string
+	JobID            string
+	Payload          []byte
+	Meta             map[string]string
+	IdempotencyToken string
 }
 
 type JobDispatchResponse struct {
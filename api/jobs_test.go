@@ -118,6 +118,7 @@ func TestJobs_Canonicalize(t *testing.T) {
 					AutoRevert:       boolToPtr(false),
 					Canary:           intToPtr(0),
 					AutoPromote:      boolToPtr(false),
+					PauseAfterBatch:  boolToPtr(false),
 				},
 				TaskGroups: []*TaskGroup{
 					{
@@ -152,6 +153,7 @@ func TestJobs_Canonicalize(t *testing.T) {
 							AutoRevert:       boolToPtr(false),
 							Canary:           intToPtr(0),
 							AutoPromote:      boolToPtr(false),
+							PauseAfterBatch:  boolToPtr(false),
 						},
 						Migrate: DefaultMigrateStrategy(),
 						Tasks: []*Task{
@@ -275,6 +277,7 @@ func TestJobs_Canonicalize(t *testing.T) {
 					AutoRevert:       boolToPtr(false),
 					Canary:           intToPtr(0),
 					AutoPromote:      boolToPtr(false),
+					PauseAfterBatch:  boolToPtr(false),
 				},
 				TaskGroups: []*TaskGroup{
 					{
@@ -309,6 +312,7 @@ func TestJobs_Canonicalize(t *testing.T) {
 							AutoRevert:       boolToPtr(false),
 							Canary:           intToPtr(0),
 							AutoPromote:      boolToPtr(false),
+							PauseAfterBatch:  boolToPtr(false),
 						},
 						Migrate: DefaultMigrateStrategy(),
 						Tasks: []*Task{
@@ -436,6 +440,7 @@ func TestJobs_Canonicalize(t *testing.T) {
 					AutoRevert:       boolToPtr(false),
 					Canary:           intToPtr(0),
 					AutoPromote:      boolToPtr(true),
+					PauseAfterBatch:  boolToPtr(false),
 				},
 				TaskGroups: []*TaskGroup{
 					{
@@ -471,6 +476,7 @@ func TestJobs_Canonicalize(t *testing.T) {
 							AutoRevert:       boolToPtr(true),
 							Canary:           intToPtr(0),
 							AutoPromote:      boolToPtr(true),
+							PauseAfterBatch:  boolToPtr(false),
 						},
 						Migrate: DefaultMigrateStrategy(),
 						Tasks: []*Task{
@@ -585,6 +591,7 @@ func TestJobs_Canonicalize(t *testing.T) {
 					AutoRevert:       boolToPtr(false),
 					Canary:           intToPtr(0),
 					AutoPromote:      boolToPtr(false),
+					PauseAfterBatch:  boolToPtr(false),
 				},
 				Periodic: &PeriodicConfig{
 					Enabled:         boolToPtr(true),
@@ -592,6 +599,8 @@ func TestJobs_Canonicalize(t *testing.T) {
 					SpecType:        stringToPtr(PeriodicSpecCron),
 					ProhibitOverlap: boolToPtr(false),
 					TimeZone:        stringToPtr("UTC"),
+					CatchUp:         stringToPtr(PeriodicCatchUpLatest),
+					MaxCatchUp:      intToPtr(0),
 				},
 			},
 		},
@@ -612,6 +621,7 @@ func TestJobs_Canonicalize(t *testing.T) {
 					AutoRevert:       boolToPtr(false),
 					Canary:           intToPtr(0),
 					AutoPromote:      boolToPtr(false),
+					PauseAfterBatch:  boolToPtr(false),
 				},
 				TaskGroups: []*TaskGroup{
 					{
@@ -669,6 +679,7 @@ func TestJobs_Canonicalize(t *testing.T) {
 					AutoRevert:       boolToPtr(false),
 					Canary:           intToPtr(0),
 					AutoPromote:      boolToPtr(false),
+					PauseAfterBatch:  boolToPtr(false),
 				},
 				TaskGroups: []*TaskGroup{
 					{
@@ -703,6 +714,7 @@ func TestJobs_Canonicalize(t *testing.T) {
 							AutoRevert:       boolToPtr(true),
 							Canary:           intToPtr(1),
 							AutoPromote:      boolToPtr(true),
+							PauseAfterBatch:  boolToPtr(false),
 						},
 						Migrate: DefaultMigrateStrategy(),
 						Tasks: []*Task{
@@ -746,6 +758,7 @@ func TestJobs_Canonicalize(t *testing.T) {
 							AutoRevert:       boolToPtr(false),
 							Canary:           intToPtr(0),
 							AutoPromote:      boolToPtr(false),
+							PauseAfterBatch:  boolToPtr(false),
 						},
 						Migrate: DefaultMigrateStrategy(),
 						Tasks: []*Task{
@@ -868,6 +881,43 @@ func TestJobs_Revert(t *testing.T) {
 	assertWriteMeta(t, wm)
 }
 
+func TestJobs_Pause(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	c, s := makeClient(t, nil, nil)
+	defer s.Stop()
+	jobs := c.Jobs()
+
+	// Register the job
+	job := testJob()
+	job.TaskGroups[0].Count = intToPtr(3)
+	_, _, err := jobs.Register(job, nil)
+	require.NoError(err)
+
+	// Pause the job
+	resp, wm, err := jobs.Pause(*job.ID, true, nil, "")
+	require.NoError(err)
+	require.NotEmpty(resp.EvalID)
+	assertWriteMeta(t, wm)
+
+	out, _, err := jobs.Info(*job.ID, nil)
+	require.NoError(err)
+	require.True(out.Paused)
+	require.Equal(0, *out.TaskGroups[0].Count)
+	require.Equal(map[string]int{*job.TaskGroups[0].Name: 3}, out.PausedCounts)
+
+	// Resume the job
+	resp, wm, err = jobs.Pause(*job.ID, false, nil, "")
+	require.NoError(err)
+	require.NotEmpty(resp.EvalID)
+	assertWriteMeta(t, wm)
+
+	out, _, err = jobs.Info(*job.ID, nil)
+	require.NoError(err)
+	require.False(out.Paused)
+	require.Equal(3, *out.TaskGroups[0].Count)
+}
+
 func TestJobs_Info(t *testing.T) {
 	t.Parallel()
 	c, s := makeClient(t, nil, nil)
@@ -471,11 +471,20 @@ type Node struct {
 }
 
 type NodeResources struct {
-	Cpu      NodeCpuResources
-	Memory   NodeMemoryResources
-	Disk     NodeDiskResources
-	Networks []*NetworkResource
-	Devices  []*NodeDeviceResource
+	Cpu          NodeCpuResources
+	Memory       NodeMemoryResources
+	Disk         NodeDiskResources
+	Networks     []*NetworkResource
+	HostNetworks []*NodeHostNetwork
+	Devices      []*NodeDeviceResource
+}
+
+// NodeHostNetwork is a named host network fingerprinted on a node
+type NodeHostNetwork struct {
+	Name          string
+	Device        string
+	IP            string
+	ReservedPorts string
 }
 
 type NodeCpuResources struct {
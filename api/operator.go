@@ -176,3 +176,21 @@ func (op *Operator) SchedulerCASConfiguration(conf *SchedulerConfiguration, q *W
 
 	return &out, wm, nil
 }
+
+// KeyringRotateResponse is the response object used to return the ID of the
+// new active root key.
+type KeyringRotateResponse struct {
+	KeyID string
+}
+
+// KeyringRotate is used to generate a new active root key. A full rotation
+// re-encrypts all variables with the new key.
+func (op *Operator) KeyringRotate(full bool, q *WriteOptions) (*KeyringRotateResponse, *WriteMeta, error) {
+	var out KeyringRotateResponse
+	wm, err := op.c.write("/v1/operator/keyring/rotate?full="+strconv.FormatBool(full), nil, &out, q)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &out, wm, nil
+}
@@ -84,9 +84,11 @@ func (r *Resources) Merge(other *Resources) {
 }
 
 type Port struct {
-	Label string
-	Value int `mapstructure:"static"`
-	To    int `mapstructure:"to"`
+	Label       string
+	Value       int    `mapstructure:"static"`
+	To          int    `mapstructure:"to"`
+	HostNetwork string `mapstructure:"host_network"`
+	HostIP      string `mapstructure:"-"`
 }
 
 // NetworkResource is used to describe required network
@@ -97,10 +99,18 @@ type NetworkResource struct {
 	CIDR          string
 	IP            string
 	MBits         *int
+	DNS           *DNSConfig
 	ReservedPorts []Port
 	DynamicPorts  []Port
 }
 
+// DNSConfig is the DNS configuration applied to the tasks of a group network
+type DNSConfig struct {
+	Servers  []string `mapstructure:"servers"`
+	Searches []string `mapstructure:"searches"`
+	Options  []string `mapstructure:"options"`
+}
+
 func (n *NetworkResource) Canonicalize() {
 	if n.MBits == nil {
 		n.MBits = intToPtr(10)
@@ -41,11 +41,32 @@ type CpuStats struct {
 	Measured         []string
 }
 
-// ResourceUsage holds information related to cpu and memory stats
+// NetworkStats holds network usage related stats
+type NetworkStats struct {
+	RxBytes   uint64
+	TxBytes   uint64
+	RxPackets uint64
+	TxPackets uint64
+	Measured  []string
+}
+
+// BlockIOStats holds block device io related stats
+type BlockIOStats struct {
+	ReadBytes  uint64
+	WriteBytes uint64
+	ReadOps    uint64
+	WriteOps   uint64
+	Measured   []string
+}
+
+// ResourceUsage holds information related to cpu, memory, network and block
+// io stats
 type ResourceUsage struct {
-	MemoryStats *MemoryStats
-	CpuStats    *CpuStats
-	DeviceStats []*DeviceGroupStats
+	MemoryStats  *MemoryStats
+	CpuStats     *CpuStats
+	NetworkStats *NetworkStats
+	BlockIOStats *BlockIOStats
+	DeviceStats  []*DeviceGroupStats
 }
 
 // TaskResourceUsage holds aggregated resource usage of all processes in a Task
@@ -208,7 +229,7 @@ func NewDefaultReschedulePolicy(jobType string) *ReschedulePolicy {
 			Unlimited: boolToPtr(false),
 		}
 
-	case "system":
+	case "system", "sysbatch":
 		dp = &ReschedulePolicy{
 			Attempts:      intToPtr(0),
 			Interval:      timeToPtr(0),
@@ -362,6 +383,22 @@ func (m *MigrateStrategy) Copy() *MigrateStrategy {
 	return nm
 }
 
+// ArrayConfig is used to run the task group of a batch job as an array of
+// indexed allocations.
+type ArrayConfig struct {
+	Size        *int
+	MaxParallel *int `mapstructure:"max_parallel"`
+}
+
+func (a *ArrayConfig) Canonicalize() {
+	if a.Size == nil {
+		a.Size = intToPtr(1)
+	}
+	if a.MaxParallel == nil {
+		a.MaxParallel = intToPtr(0)
+	}
+}
+
 // VolumeRequest is a representation of a storage volume that a TaskGroup wishes to use.
 type VolumeRequest struct {
 	Name     string
@@ -398,6 +435,7 @@ func (vm *VolumeMount) Canonicalize() {
 type TaskGroup struct {
 	Name             *string
 	Count            *int
+	Array            *ArrayConfig
 	Constraints      []*Constraint
 	Affinities       []*Affinity
 	Tasks            []*Task
@@ -426,6 +464,11 @@ func (g *TaskGroup) Canonicalize(job *Job) {
 	if g.Name == nil {
 		g.Name = stringToPtr("")
 	}
+	if g.Array != nil {
+		// The count of an array is always its size
+		g.Array.Canonicalize()
+		g.Count = intToPtr(*g.Array.Size)
+	}
 	if g.Count == nil {
 		g.Count = intToPtr(1)
 	}
@@ -464,7 +507,7 @@ func (g *TaskGroup) Canonicalize(job *Job) {
 		g.ReschedulePolicy = jobReschedule
 	}
 	// Only use default reschedule policy for non system jobs
-	if g.ReschedulePolicy == nil && *job.Type != "system" {
+	if g.ReschedulePolicy == nil && *job.Type != "system" && *job.Type != "sysbatch" {
 		g.ReschedulePolicy = NewDefaultReschedulePolicy(*job.Type)
 	}
 	if g.ReschedulePolicy != nil {
@@ -611,6 +654,7 @@ type Task struct {
 	LogConfig       *LogConfig     `mapstructure:"logs"`
 	Artifacts       []*TaskArtifact
 	Vault           *Vault
+	Identity        *WorkloadIdentity
 	Templates       []*Template
 	DispatchPayload *DispatchPayloadConfig
 	VolumeMounts    []*VolumeMount
@@ -639,6 +683,9 @@ func (t *Task) Canonicalize(tg *TaskGroup, job *Job) {
 	if t.Vault != nil {
 		t.Vault.Canonicalize()
 	}
+	if t.Identity != nil {
+		t.Identity.Canonicalize()
+	}
 	for _, tmpl := range t.Templates {
 		tmpl.Canonicalize()
 	}
@@ -684,11 +731,34 @@ func (a *TaskArtifact) Canonicalize() {
 	}
 }
 
+type ChangeScript struct {
+	Command     *string        `mapstructure:"command"`
+	Args        []string       `mapstructure:"args"`
+	Timeout     *time.Duration `mapstructure:"timeout"`
+	FailOnError *bool          `mapstructure:"fail_on_error"`
+}
+
+func (ch *ChangeScript) Canonicalize() {
+	if ch.Command == nil {
+		ch.Command = stringToPtr("")
+	}
+	if ch.Args == nil {
+		ch.Args = []string{}
+	}
+	if ch.Timeout == nil {
+		ch.Timeout = timeToPtr(5 * time.Second)
+	}
+	if ch.FailOnError == nil {
+		ch.FailOnError = boolToPtr(false)
+	}
+}
+
 type Template struct {
 	SourcePath   *string        `mapstructure:"source"`
 	DestPath     *string        `mapstructure:"destination"`
 	EmbeddedTmpl *string        `mapstructure:"data"`
 	ChangeMode   *string        `mapstructure:"change_mode"`
+	ChangeScript *ChangeScript  `mapstructure:"change_script"`
 	ChangeSignal *string        `mapstructure:"change_signal"`
 	Splay        *time.Duration `mapstructure:"splay"`
 	Perms        *string        `mapstructure:"perms"`
@@ -721,6 +791,9 @@ func (tmpl *Template) Canonicalize() {
 		sig := *tmpl.ChangeSignal
 		tmpl.ChangeSignal = stringToPtr(strings.ToUpper(sig))
 	}
+	if tmpl.ChangeScript != nil {
+		tmpl.ChangeScript.Canonicalize()
+	}
 	if tmpl.Splay == nil {
 		tmpl.Splay = timeToPtr(5 * time.Second)
 	}
@@ -760,6 +833,22 @@ func (v *Vault) Canonicalize() {
 	}
 }
 
+// WorkloadIdentity configures how a task's workload identity is exposed to
+// it.
+type WorkloadIdentity struct {
+	Env  *bool
+	File *bool
+}
+
+func (w *WorkloadIdentity) Canonicalize() {
+	if w.Env == nil {
+		w.Env = boolToPtr(false)
+	}
+	if w.File == nil {
+		w.File = boolToPtr(true)
+	}
+}
+
 // NewTask creates and initializes a new Task.
 func NewTask(name, driver string) *Task {
 	return &Task{
@@ -843,6 +932,8 @@ const (
 	TaskRestartSignal          = "Restart Signaled"
 	TaskLeaderDead             = "Leader Task Dead"
 	TaskBuildingTaskDir        = "Building Task Directory"
+	TaskDiskExceeded           = "Disk Resources Exceeded"
+	TaskDiskUsageWarning       = "Disk Usage Warning"
 )
 
 // TaskEvent is an event that effects the state of a task and contains meta-data
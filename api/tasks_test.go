@@ -269,7 +269,7 @@ func TestTask_Require(t *testing.T) {
 			{
 				CIDR:          "0.0.0.0/0",
 				MBits:         intToPtr(100),
-				ReservedPorts: []Port{{"", 80, 0}, {"", 443, 0}},
+				ReservedPorts: []Port{{"", 80, 0, "", ""}, {"", 443, 0, "", ""}},
 			},
 		},
 	}
@@ -384,6 +384,7 @@ func TestTaskGroup_Canonicalize_Update(t *testing.T) {
 		Update: &UpdateStrategy{
 			AutoRevert:       boolToPtr(false),
 			AutoPromote:      boolToPtr(false),
+			PauseAfterBatch:  boolToPtr(false),
 			Canary:           intToPtr(0),
 			HealthCheck:      stringToPtr(""),
 			HealthyDeadline:  timeToPtr(0),
@@ -402,6 +403,23 @@ func TestTaskGroup_Canonicalize_Update(t *testing.T) {
 	assert.Nil(t, tg.Update)
 }
 
+// Verifies that the count of an array is set to its size
+func TestTaskGroup_Canonicalize_Array(t *testing.T) {
+	job := &Job{
+		ID:   stringToPtr("test"),
+		Type: stringToPtr(JobTypeBatch),
+	}
+	job.Canonicalize()
+	tg := &TaskGroup{
+		Name:  stringToPtr("foo"),
+		Count: intToPtr(1),
+		Array: &ArrayConfig{Size: intToPtr(50)},
+	}
+	tg.Canonicalize(job)
+	assert.Equal(t, 50, *tg.Count)
+	assert.Equal(t, 0, *tg.Array.MaxParallel)
+}
+
 func TestTaskGroup_Merge_Update(t *testing.T) {
 	job := &Job{
 		ID:     stringToPtr("test"),
@@ -423,6 +441,7 @@ func TestTaskGroup_Merge_Update(t *testing.T) {
 	require.Equal(t, &UpdateStrategy{
 		AutoRevert:       boolToPtr(true),
 		AutoPromote:      boolToPtr(false),
+		PauseAfterBatch:  boolToPtr(false),
 		Canary:           intToPtr(5),
 		HealthCheck:      stringToPtr("foo"),
 		HealthyDeadline:  timeToPtr(5 * time.Minute),
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"fmt"
+)
+
+// Variables is used to query the variables endpoints.
+type Variables struct {
+	client *Client
+}
+
+// Variables returns a new handle on the variables.
+func (c *Client) Variables() *Variables {
+	return &Variables{client: c}
+}
+
+// Variable is a set of key/value items stored at a path of a namespace.
+type Variable struct {
+	Namespace   string
+	Path        string
+	CreateIndex uint64
+	CreateTime  int64
+	ModifyIndex uint64
+	ModifyTime  int64
+	Items       map[string]string
+}
+
+// VariableMetadata is the metadata of a variable returned when listing
+// variables.
+type VariableMetadata struct {
+	Namespace   string
+	Path        string
+	CreateIndex uint64
+	CreateTime  int64
+	ModifyIndex uint64
+	ModifyTime  int64
+}
+
+// List is used to list the variables of the namespace.
+func (v *Variables) List(q *QueryOptions) ([]*VariableMetadata, *QueryMeta, error) {
+	var resp []*VariableMetadata
+	qm, err := v.client.query("/v1/vars", &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, qm, nil
+}
+
+// PrefixList is used to list the variables of the namespace whose path
+// starts with the prefix.
+func (v *Variables) PrefixList(prefix string, q *QueryOptions) ([]*VariableMetadata, *QueryMeta, error) {
+	if q == nil {
+		q = &QueryOptions{}
+	}
+	q.Prefix = prefix
+	return v.List(q)
+}
+
+// Read is used to read the variable at the path.
+func (v *Variables) Read(path string, q *QueryOptions) (*Variable, *QueryMeta, error) {
+	if path == "" {
+		return nil, nil, fmt.Errorf("missing variable path")
+	}
+	var resp Variable
+	qm, err := v.client.query("/v1/var/"+path, &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, qm, nil
+}
+
+// Upsert is used to create or update a variable, and returns the written
+// variable.
+func (v *Variables) Upsert(variable *Variable, q *WriteOptions) (*Variable, *WriteMeta, error) {
+	return v.upsert(variable, nil, q)
+}
+
+// CheckedUpsert is used to create or update a variable only if its current
+// modify index matches the check index. A check index of zero only creates
+// the variable if it doesn't exist.
+func (v *Variables) CheckedUpsert(variable *Variable, checkIndex uint64, q *WriteOptions) (*Variable, *WriteMeta, error) {
+	return v.upsert(variable, &checkIndex, q)
+}
+
+func (v *Variables) upsert(variable *Variable, checkIndex *uint64, q *WriteOptions) (*Variable, *WriteMeta, error) {
+	if variable == nil || variable.Path == "" {
+		return nil, nil, fmt.Errorf("missing variable path")
+	}
+	var resp Variable
+	wm, err := v.client.write(variableEndpoint(variable.Path, checkIndex), variable, &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, wm, nil
+}
+
+// Delete is used to delete the variable at the path.
+func (v *Variables) Delete(path string, q *WriteOptions) (*WriteMeta, error) {
+	return v.delete(path, nil, q)
+}
+
+// CheckedDelete is used to delete the variable at the path only if its
+// current modify index matches the check index.
+func (v *Variables) CheckedDelete(path string, checkIndex uint64, q *WriteOptions) (*WriteMeta, error) {
+	return v.delete(path, &checkIndex, q)
+}
+
+func (v *Variables) delete(path string, checkIndex *uint64, q *WriteOptions) (*WriteMeta, error) {
+	if path == "" {
+		return nil, fmt.Errorf("missing variable path")
+	}
+	wm, err := v.client.delete(variableEndpoint(path, checkIndex), nil, q)
+	if err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
+
+// variableEndpoint returns the endpoint of the variable at the path, with the
+// check-and-set index if set.
+func variableEndpoint(path string, checkIndex *uint64) string {
+	endpoint := "/v1/var/" + path
+	if checkIndex != nil {
+		endpoint += fmt.Sprintf("?cas=%d", *checkIndex)
+	}
+	return endpoint
+}
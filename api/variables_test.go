@@ -0,0 +1,55 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/api/internal/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVariables_CRUD(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	c, s := makeClient(t, nil, nil)
+	defer s.Stop()
+	vars := c.Variables()
+
+	// Write a variable, waiting for the leader to create a root key
+	v := &Variable{
+		Path:  "app/db",
+		Items: map[string]string{"password": "hunter2"},
+	}
+	var out *Variable
+	testutil.WaitForResult(func() (bool, error) {
+		var err error
+		out, _, err = vars.Upsert(v, nil)
+		return err == nil, err
+	}, func(err error) {
+		t.Fatalf("failed to write variable: %v", err)
+	})
+	require.Equal("hunter2", out.Items["password"])
+
+	// A stale check index is rejected
+	_, _, err := vars.CheckedUpsert(v, 0, nil)
+	require.Error(err)
+
+	// Read it back
+	read, qm, err := vars.Read("app/db", nil)
+	require.Nil(err)
+	assertQueryMeta(t, qm)
+	require.Equal(out.ModifyIndex, read.ModifyIndex)
+
+	// List it
+	list, _, err := vars.PrefixList("app", nil)
+	require.Nil(err)
+	require.Len(list, 1)
+	require.Equal("app/db", list[0].Path)
+
+	// Delete it with the current check index
+	wm, err := vars.CheckedDelete("app/db", read.ModifyIndex, nil)
+	require.Nil(err)
+	assertWriteMeta(t, wm)
+
+	_, _, err = vars.Read("app/db", nil)
+	require.Error(err)
+}
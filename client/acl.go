@@ -21,6 +21,10 @@ const (
 	// tokenCacheSize is the number of ACL tokens to keep cached. Tokens have a fetching cost,
 	// so we keep the hot tokens cached to reduce the lookups.
 	tokenCacheSize = 64
+
+	// roleCacheSize is the number of ACL roles to keep cached. Roles have a fetching cost,
+	// so we keep the hot roles cached to reduce the ACL token resolution time.
+	roleCacheSize = 64
 )
 
 // clientACLResolver holds the state required for client resolution
@@ -34,6 +38,9 @@ type clientACLResolver struct {
 
 	// tokenCache is used to maintain the fetched token objects
 	tokenCache *lru.TwoQueueCache
+
+	// roleCache is used to maintain the fetched role objects
+	roleCache *lru.TwoQueueCache
 }
 
 // init is used to setup the client resolver state
@@ -52,6 +59,10 @@ func (c *clientACLResolver) init() error {
 	if err != nil {
 		return err
 	}
+	c.roleCache, err = lru.New2Q(roleCacheSize)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -59,6 +70,7 @@ func (c *clientACLResolver) init() error {
 type cachedACLValue struct {
 	Token     *structs.ACLToken
 	Policy    *structs.ACLPolicy
+	Role      *structs.ACLRole
 	CacheTime time.Time
 }
 
@@ -89,14 +101,21 @@ func (c *Client) resolveTokenAndACL(secretID string) (*acl.ACL, *structs.ACLToke
 	if token == nil {
 		return nil, nil, structs.ErrTokenNotFound
 	}
+	if token.IsExpired(time.Now()) {
+		return nil, nil, structs.ErrTokenExpired
+	}
 
 	// Check if this is a management token
 	if token.Type == structs.ACLManagementToken {
 		return acl.ManagementACL, token, nil
 	}
 
-	// Resolve the policies
-	policies, err := c.resolvePolicies(token.SecretID, token.Policies)
+	// Resolve the policies, including the policies of the token's roles
+	policyNames, err := c.resolveRolePolicies(token)
+	if err != nil {
+		return nil, nil, err
+	}
+	policies, err := c.resolvePolicies(token.SecretID, policyNames)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -109,6 +128,15 @@ func (c *Client) resolveTokenAndACL(secretID string) (*acl.ACL, *structs.ACLToke
 	return aclObj, token, nil
 }
 
+// ResolveSecretToken is used to translate an ACL Token Secret ID into the
+// ACL token, nil if ACLs are disabled or the token doesn't exist.
+func (c *Client) ResolveSecretToken(secretID string) (*structs.ACLToken, error) {
+	if !c.config.ACLEnabled {
+		return nil, nil
+	}
+	return c.resolveTokenValue(secretID)
+}
+
 // resolveTokenValue is used to translate a secret ID into an ACL token with caching
 // We use a local cache up to the TTL limit, and then resolve via a server. If we cannot
 // reach a server, but have a cached value we extend the TTL to gracefully handle outages.
@@ -222,3 +250,101 @@ func (c *Client) resolvePolicies(secretID string, policies []string) ([]*structs
 	// Return the valid policies
 	return out, nil
 }
+
+// resolveRolePolicies returns the names of the policies of a token, including
+// the policies of its roles.
+func (c *Client) resolveRolePolicies(token *structs.ACLToken) ([]string, error) {
+	if len(token.Roles) == 0 {
+		return token.Policies, nil
+	}
+
+	roles, err := c.resolveRoles(token.SecretID, token.Roles)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]struct{}, len(token.Policies))
+	names := make([]string, 0, len(token.Policies))
+	for _, name := range token.Policies {
+		seen[name] = struct{}{}
+		names = append(names, name)
+	}
+	for _, role := range roles {
+		for _, name := range role.Policies {
+			if _, ok := seen[name]; !ok {
+				seen[name] = struct{}{}
+				names = append(names, name)
+			}
+		}
+	}
+	return names, nil
+}
+
+// resolveRoles is used to translate a set of named ACL roles into the objects.
+// We cache the roles locally, and fault them from a server as necessary. Roles
+// are cached for the policy TTL, and then refreshed. If a server cannot be
+// reached, the cache TTL will be ignored to gracefully handle outages.
+func (c *Client) resolveRoles(secretID string, roles []string) ([]*structs.ACLRole, error) {
+	var out []*structs.ACLRole
+	var expired []*structs.ACLRole
+	var missing []string
+
+	// Scan the cache for each role
+	for _, roleName := range roles {
+		// Lookup the role in the cache
+		raw, ok := c.roleCache.Get(roleName)
+		if !ok {
+			missing = append(missing, roleName)
+			continue
+		}
+
+		// Check if the cached value is valid or expired
+		cached := raw.(*cachedACLValue)
+		if cached.Age() <= c.config.ACLPolicyTTL {
+			out = append(out, cached.Role)
+		} else {
+			expired = append(expired, cached.Role)
+		}
+	}
+
+	// Hot-path if we have no missing or expired roles
+	if len(missing)+len(expired) == 0 {
+		return out, nil
+	}
+
+	// Lookup the missing and expired roles
+	fetch := missing
+	for _, r := range expired {
+		fetch = append(fetch, r.Name)
+	}
+	req := structs.ACLRoleSetRequest{
+		Names: fetch,
+		QueryOptions: structs.QueryOptions{
+			Region:     c.Region(),
+			AuthToken:  secretID,
+			AllowStale: true,
+		},
+	}
+	var resp structs.ACLRoleSetResponse
+	if err := c.RPC("ACL.GetRoles", &req, &resp); err != nil {
+		// If we encounter an error but have cached roles, mask the error and extend the cache
+		if len(missing) == 0 {
+			c.logger.Warn("failed to resolve roles, using expired cached value", "error", err)
+			out = append(out, expired...)
+			return out, nil
+		}
+		return nil, err
+	}
+
+	// Handle each output
+	for _, role := range resp.Roles {
+		c.roleCache.Add(role.Name, &cachedACLValue{
+			Role:      role,
+			CacheTime: time.Now(),
+		})
+		out = append(out, role)
+	}
+
+	// Return the valid roles
+	return out, nil
+}
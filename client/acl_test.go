@@ -2,6 +2,7 @@ package client
 
 import (
 	"testing"
+	"time"
 
 	"github.com/hashicorp/nomad/acl"
 	"github.com/hashicorp/nomad/client/config"
@@ -101,6 +102,67 @@ func TestClient_ACL_resolvePolicies(t *testing.T) {
 	}
 }
 
+func TestClient_ACL_resolveRoles(t *testing.T) {
+	s1, _, _ := testACLServer(t, nil)
+	defer s1.Shutdown()
+	testutil.WaitForLeader(t, s1.RPC)
+
+	c1, cleanup := TestClient(t, func(c *config.Config) {
+		c.RPCHandler = s1
+		c.ACLEnabled = true
+	})
+	defer cleanup()
+
+	// Create a policy / role / token
+	policy := mock.ACLPolicy()
+	role := mock.ACLRole()
+	role.Policies = []string{policy.Name}
+	token := mock.ACLToken()
+	token.Roles = []string{role.Name}
+	assert.Nil(t, s1.State().UpsertACLPolicies(100, []*structs.ACLPolicy{policy}))
+	assert.Nil(t, s1.State().UpsertACLRoles(110, []*structs.ACLRole{role}))
+	assert.Nil(t, s1.State().UpsertACLTokens(120, []*structs.ACLToken{token}))
+
+	// Test the client resolution
+	out, err := c1.resolveRoles(token.SecretID, []string{role.Name})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(out))
+
+	// Test caching
+	out2, err := c1.resolveRoles(token.SecretID, []string{role.Name})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(out2))
+	if out[0] != out2[0] {
+		t.Fatalf("bad caching")
+	}
+
+	// The role policies are added to the token policies
+	names, err := c1.resolveRolePolicies(token)
+	assert.Nil(t, err)
+	assert.Equal(t, append(token.Policies, policy.Name), names)
+}
+
+func TestClient_ACL_ResolveToken_Expired(t *testing.T) {
+	s1, _, _ := testACLServer(t, nil)
+	defer s1.Shutdown()
+	testutil.WaitForLeader(t, s1.RPC)
+
+	c1, cleanup := TestClient(t, func(c *config.Config) {
+		c.RPCHandler = s1
+		c.ACLEnabled = true
+	})
+	defer cleanup()
+
+	token := mock.ACLToken()
+	expiry := time.Now().Add(-time.Minute)
+	token.ExpirationTime = &expiry
+	assert.Nil(t, s1.State().UpsertACLTokens(110, []*structs.ACLToken{token}))
+
+	aclObj, err := c1.ResolveToken(token.SecretID)
+	assert.Equal(t, structs.ErrTokenExpired, err)
+	assert.Nil(t, aclObj)
+}
+
 func TestClient_ACL_ResolveToken_Disabled(t *testing.T) {
 	s1, _ := testServer(t, nil)
 	defer s1.Shutdown()
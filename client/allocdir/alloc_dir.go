@@ -359,6 +359,31 @@ func (d *AllocDir) Build() error {
 	return nil
 }
 
+// DiskUsage returns the number of bytes used by the writable portions of the
+// allocation directory: the shared alloc dir and each task's local and tmp
+// directories. Chroot files and the secrets tmpfs are not counted as they do
+// not consume the allocation's ephemeral disk.
+func (d *AllocDir) DiskUsage() (int64, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	roots := []string{d.SharedDir}
+	for _, taskdir := range d.TaskDirs {
+		roots = append(roots, taskdir.LocalDir, filepath.Join(taskdir.Dir, TmpDirName))
+	}
+
+	var total int64
+	for _, root := range roots {
+		size, err := DirSize(root)
+		if err != nil {
+			return 0, err
+		}
+		total += size
+	}
+
+	return total, nil
+}
+
 // List returns the list of files at a path relative to the alloc dir
 func (d *AllocDir) List(path string) ([]*cstructs.AllocFileInfo, error) {
 	if escapes, err := structs.PathEscapesAllocDir("", path); err != nil {
@@ -545,6 +570,31 @@ func fileCopy(src, dst string, uid, gid int, perm os.FileMode) error {
 	return nil
 }
 
+// DirSize returns the total size of the regular files beneath path. Symlinks
+// are not followed and files removed while walking are ignored. A path that
+// does not exist has a size of zero.
+func DirSize(path string) (int64, error) {
+	var size int64
+	walkFn := func(path string, fileInfo os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		if fileInfo.Mode().IsRegular() {
+			size += fileInfo.Size()
+		}
+		return nil
+	}
+
+	if err := filepath.Walk(path, walkFn); err != nil {
+		return 0, fmt.Errorf("failed to compute size of %q: %v", path, err)
+	}
+	return size, nil
+}
+
 // pathExists is a helper function to check if the path exists.
 func pathExists(path string) bool {
 	if _, err := os.Stat(path); err != nil {
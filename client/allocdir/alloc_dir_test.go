@@ -438,6 +438,37 @@ func TestAllocDir_Copy(t *testing.T) {
 	}
 }
 
+// TestAllocDir_DiskUsage asserts that DiskUsage sums the writable portions of
+// the alloc dir and ignores the secrets directory.
+func TestAllocDir_DiskUsage(t *testing.T) {
+	require := require.New(t)
+
+	tmp, err := ioutil.TempDir("", "AllocDir")
+	require.NoError(err)
+	defer os.RemoveAll(tmp)
+
+	d := NewAllocDir(testlog.HCLogger(t), tmp)
+	defer d.Destroy()
+	require.NoError(d.Build())
+
+	td := d.NewTaskDir(t1.Name)
+	require.NoError(td.Build(false, nil))
+
+	usage, err := d.DiskUsage()
+	require.NoError(err)
+	require.Zero(usage)
+
+	data := make([]byte, 1024)
+	require.NoError(ioutil.WriteFile(filepath.Join(d.SharedDir, SharedDataDir, "data"), data, 0666))
+	require.NoError(ioutil.WriteFile(filepath.Join(td.LocalDir, "local"), data, 0666))
+	require.NoError(ioutil.WriteFile(filepath.Join(td.Dir, TmpDirName, "tmp"), data, 0666))
+	require.NoError(ioutil.WriteFile(filepath.Join(td.SecretsDir, "secret"), data, 0666))
+
+	usage, err = d.DiskUsage()
+	require.NoError(err)
+	require.EqualValues(3*len(data), usage)
+}
+
 func TestPathFuncs(t *testing.T) {
 	dir, err := ioutil.TempDir("", "nomadtest-pathfuncs")
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
 	"github.com/hashicorp/nomad/client/allocrunner/state"
 	"github.com/hashicorp/nomad/client/allocrunner/taskrunner"
+	"github.com/hashicorp/nomad/client/allocrunner/taskrunner/getter"
 	"github.com/hashicorp/nomad/client/allocwatcher"
 	"github.com/hashicorp/nomad/client/config"
 	"github.com/hashicorp/nomad/client/consul"
@@ -138,6 +139,12 @@ type allocRunner struct {
 	// event handlers
 	driverManager drivermanager.Manager
 
+	// artifactCache is the client's shared artifact cache; nil if disabled
+	artifactCache *getter.Cache
+
+	// rpcClient is used by tasks to read data from the servers
+	rpcClient config.RPCHandler
+
 	// serversContactedCh is passed to TaskRunners so they can detect when
 	// servers have been contacted for the first time in case of a failed
 	// restore.
@@ -173,6 +180,8 @@ func NewAllocRunner(config *Config) (*allocRunner, error) {
 		prevAllocMigrator:        config.PrevAllocMigrator,
 		devicemanager:            config.DeviceManager,
 		driverManager:            config.DriverManager,
+		artifactCache:            config.ArtifactCache,
+		rpcClient:                config.RPCClient,
 		serversContactedCh:       config.ServersContactedCh,
 	}
 
@@ -214,6 +223,8 @@ func (ar *allocRunner) initTaskRunners(tasks []*structs.Task) error {
 			DeviceStatsReporter: ar.deviceStatsReporter,
 			DeviceManager:       ar.devicemanager,
 			DriverManager:       ar.driverManager,
+			ArtifactCache:       ar.artifactCache,
+			RPCClient:           ar.rpcClient,
 			ServersContactedCh:  ar.serversContactedCh,
 		}
 
@@ -347,8 +358,16 @@ func (ar *allocRunner) Restore() error {
 		return err
 	}
 
+	// Retrieve the network status as the network hook only sets it when
+	// the alloc network is created, not when an existing one is reused.
+	ns, err := ar.stateDB.GetNetworkStatus(ar.id)
+	if err != nil {
+		return err
+	}
+
 	ar.stateLock.Lock()
 	ar.state.DeploymentStatus = ds
+	ar.state.NetworkStatus = ns
 	ar.stateLock.Unlock()
 
 	// Restore task runners
@@ -374,6 +393,15 @@ func (ar *allocRunner) persistDeploymentStatus(ds *structs.AllocDeploymentStatus
 	}
 }
 
+// persistNetworkStatus stores AllocNetworkStatus.
+func (ar *allocRunner) persistNetworkStatus(ns *structs.AllocNetworkStatus) {
+	if err := ar.stateDB.PutNetworkStatus(ar.id, ns); err != nil {
+		// Failing to persist the network status only loses the alloc's
+		// address if the agent is restarted, so don't fail the alloc.
+		ar.logger.Error("error storing network status", "error", err)
+	}
+}
+
 // TaskStateUpdated is called by TaskRunner when a task's state has been
 // updated. It does not process the update synchronously but instead notifies a
 // goroutine the state has change. Since processing the state change may cause
@@ -564,6 +592,10 @@ func (ar *allocRunner) clientAlloc(taskStates map[string]*structs.TaskState) *st
 		a.DeploymentStatus = d.Copy()
 	}
 
+	if n := ar.state.NetworkStatus; n != nil {
+		a.NetworkStatus = n.Copy()
+	}
+
 	// Compute the ClientStatus
 	if ar.state.ClientStatus != "" {
 		// The client status is being forced
@@ -960,6 +992,12 @@ func (ar *allocRunner) LatestAllocStats(taskFilter string) (*cstructs.AllocResou
 		},
 	}
 
+	// Tasks sharing the network namespace of the alloc all report the
+	// counters of the namespace, so only the latest of them is used instead
+	// of summing them
+	sharedNetwork := allocSharesNetwork(ar.Alloc())
+	var networkTimestamp int64
+
 	for name, tr := range ar.tasks {
 		if taskFilter != "" && taskFilter != name {
 			// Getting stats for a particular task and its not this one!
@@ -968,7 +1006,20 @@ func (ar *allocRunner) LatestAllocStats(taskFilter string) (*cstructs.AllocResou
 
 		if usage := tr.LatestResourceUsage(); usage != nil {
 			astat.Tasks[name] = usage
-			astat.ResourceUsage.Add(usage.ResourceUsage)
+
+			ru := usage.ResourceUsage
+			if sharedNetwork && ru.NetworkStats != nil {
+				if astat.ResourceUsage.NetworkStats == nil || usage.Timestamp > networkTimestamp {
+					ns := *ru.NetworkStats
+					astat.ResourceUsage.NetworkStats = &ns
+					networkTimestamp = usage.Timestamp
+				}
+				withoutNetwork := *ru
+				withoutNetwork.NetworkStats = nil
+				ru = &withoutNetwork
+			}
+			astat.ResourceUsage.Add(ru)
+
 			if usage.Timestamp > astat.Timestamp {
 				astat.Timestamp = usage.Timestamp
 			}
@@ -978,6 +1029,17 @@ func (ar *allocRunner) LatestAllocStats(taskFilter string) (*cstructs.AllocResou
 	return astat, nil
 }
 
+// allocSharesNetwork returns whether the tasks of the alloc run in a network
+// namespace shared by the task group.
+func allocSharesNetwork(alloc *structs.Allocation) bool {
+	tg := alloc.Job.LookupTaskGroup(alloc.TaskGroup)
+	if tg == nil || len(tg.Networks) == 0 {
+		return false
+	}
+	mode := tg.Networks[0].Mode
+	return mode != "" && mode != "host"
+}
+
 func (ar *allocRunner) GetTaskEventHandler(taskName string) drivermanager.EventHandler {
 	if tr, ok := ar.tasks[taskName]; ok {
 		return func(ev *drivers.TaskEvent) {
@@ -993,13 +1055,19 @@ func (ar *allocRunner) GetTaskEventHandler(taskName string) drivermanager.EventH
 }
 
 // RestartTask signalls the task runner for the  provided task to restart.
+// Once restarted, the restart hooks are run so the health of the allocation
+// is watched again.
 func (ar *allocRunner) RestartTask(taskName string, taskEvent *structs.TaskEvent) error {
 	tr, ok := ar.tasks[taskName]
 	if !ok {
 		return fmt.Errorf("Could not find task runner for task: %s", taskName)
 	}
 
-	return tr.Restart(context.TODO(), taskEvent, false)
+	if err := tr.Restart(context.TODO(), taskEvent, false); err != nil {
+		return err
+	}
+
+	return ar.restarted()
 }
 
 // Restart satisfies the WorkloadRestarter interface restarts all task runners
@@ -1036,19 +1104,24 @@ func (ar *allocRunner) Restart(ctx context.Context, event *structs.TaskEvent, fa
 }
 
 // RestartAll signalls all task runners in the allocation to restart and passes
-// a copy of the task event to each restart event.
+// a copy of the task event to each restart event. Once restarted, the restart
+// hooks are run so the health of the allocation is watched again.
 // Returns any errors in a concatenated form.
 func (ar *allocRunner) RestartAll(taskEvent *structs.TaskEvent) error {
 	var err *multierror.Error
 
-	for tn := range ar.tasks {
-		rerr := ar.RestartTask(tn, taskEvent.Copy())
+	for _, tr := range ar.tasks {
+		rerr := tr.Restart(context.TODO(), taskEvent.Copy(), false)
 		if rerr != nil {
 			err = multierror.Append(err, rerr)
 		}
 	}
 
-	return err.ErrorOrNil()
+	if err != nil {
+		return err.ErrorOrNil()
+	}
+
+	return ar.restarted()
 }
 
 // Signal sends a signal request to task runners inside an allocation. If the
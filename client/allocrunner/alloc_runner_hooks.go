@@ -29,6 +29,38 @@ func (a *allocNetworkIsolationSetter) SetNetworkIsolation(n *drivers.NetworkIsol
 	}
 }
 
+type networkStatusSetter interface {
+	SetNetworkStatus(*structs.AllocNetworkStatus)
+}
+
+type networkStatusGetter interface {
+	NetworkStatus() *structs.AllocNetworkStatus
+}
+
+// allocNetworkStatus is a shim to allow the alloc network hook to record the
+// network status and the group service hook to read it without full access to
+// the alloc runner state
+type allocNetworkStatus struct {
+	ar *allocRunner
+}
+
+// SetNetworkStatus stores and persists the network status of the allocation.
+func (a *allocNetworkStatus) SetNetworkStatus(s *structs.AllocNetworkStatus) {
+	a.ar.stateLock.Lock()
+	a.ar.state.NetworkStatus = s.Copy()
+	a.ar.stateLock.Unlock()
+
+	a.ar.persistNetworkStatus(s)
+}
+
+// NetworkStatus returns a copy of the network status of the allocation, or
+// nil if it has not been set.
+func (a *allocNetworkStatus) NetworkStatus() *structs.AllocNetworkStatus {
+	a.ar.stateLock.RLock()
+	defer a.ar.stateLock.RUnlock()
+	return a.ar.state.NetworkStatus.Copy()
+}
+
 // allocHealthSetter is a shim to allow the alloc health watcher hook to set
 // and clear the alloc health without full access to the alloc runner state
 type allocHealthSetter struct {
@@ -95,6 +127,27 @@ func (a *allocHealthSetter) SetHealth(healthy, isDeploy bool, trackerTaskEvents
 	a.ar.allocBroadcaster.Send(calloc)
 }
 
+// allocDiskUsageEnforcer is a shim to allow the disk usage hook to emit task
+// events and kill tasks without full access to the alloc runner
+type allocDiskUsageEnforcer struct {
+	ar *allocRunner
+}
+
+// EmitTaskEvent emits the event to all live tasks.
+func (a *allocDiskUsageEnforcer) EmitTaskEvent(event *structs.TaskEvent) {
+	for _, tr := range a.ar.tasks {
+		if tr.TaskState().State != structs.TaskStateDead {
+			tr.EmitEvent(event)
+		}
+	}
+}
+
+// KillTasks emits the event to all live tasks and then kills them.
+func (a *allocDiskUsageEnforcer) KillTasks(event *structs.TaskEvent) {
+	a.EmitTaskEvent(event)
+	a.ar.killTasks()
+}
+
 // initRunnerHooks intializes the runners hooks.
 func (ar *allocRunner) initRunnerHooks(config *clientconfig.Config) error {
 	hookLogger := ar.logger.Named("runner_hook")
@@ -105,6 +158,9 @@ func (ar *allocRunner) initRunnerHooks(config *clientconfig.Config) error {
 	// create network isolation setting shim
 	ns := &allocNetworkIsolationSetter{ar: ar}
 
+	// create network status shim
+	nst := &allocNetworkStatus{ar: ar}
+
 	// build the network manager
 	nm, err := newNetworkManager(ar.Alloc(), ar.driverManager)
 	if err != nil {
@@ -125,17 +181,26 @@ func (ar *allocRunner) initRunnerHooks(config *clientconfig.Config) error {
 		newUpstreamAllocsHook(hookLogger, ar.prevAllocWatcher),
 		newDiskMigrationHook(hookLogger, ar.prevAllocMigrator, ar.allocDir),
 		newAllocHealthWatcherHook(hookLogger, alloc, hs, ar.Listener(), ar.consulClient),
-		newNetworkHook(hookLogger, ns, alloc, nm, nc),
+		newNetworkHook(hookLogger, ns, nst, alloc, nm, nc),
 		newGroupServiceHook(groupServiceHookConfig{
 			alloc:          alloc,
 			consul:         ar.consulClient,
 			restarter:      ar,
 			taskEnvBuilder: taskenv.NewBuilder(config.Node, ar.Alloc(), nil, config.Region).SetAllocDir(ar.allocDir.AllocDir),
+			networkStatus:  nst,
 			logger:         hookLogger,
 		}),
 		newConsulSockHook(hookLogger, alloc, ar.allocDir, config.ConsulConfig),
 	}
 
+	// Only watch the alloc dir's disk usage if the client enforces
+	// ephemeral disk limits
+	if config.EnforceEphemeralDisk {
+		ds := &allocDiskUsageEnforcer{ar: ar}
+		ar.runnerHooks = append(ar.runnerHooks,
+			newDiskUsageHook(hookLogger, alloc, ar.allocDir, ds, config.EphemeralDiskCheckInterval))
+	}
+
 	return nil
 }
 
@@ -219,6 +284,45 @@ func (ar *allocRunner) update(update *structs.Allocation) error {
 	return merr.ErrorOrNil()
 }
 
+// restarted runs the alloc runner restart hooks after the tasks of the
+// allocation were restarted in place by an operator.
+func (ar *allocRunner) restarted() error {
+	states := make(map[string]*structs.TaskState, len(ar.tasks))
+	for name, tr := range ar.tasks {
+		states[name] = tr.TaskState()
+	}
+
+	req := &interfaces.RunnerRestartRequest{
+		Alloc: ar.clientAlloc(states),
+	}
+
+	var merr multierror.Error
+	for _, hook := range ar.runnerHooks {
+		h, ok := hook.(interfaces.RunnerRestartHook)
+		if !ok {
+			continue
+		}
+
+		name := h.Name()
+		var start time.Time
+		if ar.logger.IsTrace() {
+			start = time.Now()
+			ar.logger.Trace("running restart hook", "name", name, "start", start)
+		}
+
+		if err := h.Restarted(req); err != nil {
+			merr.Errors = append(merr.Errors, fmt.Errorf("restart hook %q failed: %v", name, err))
+		}
+
+		if ar.logger.IsTrace() {
+			end := time.Now()
+			ar.logger.Trace("finished restart hook", "name", name, "end", end, "duration", end.Sub(start))
+		}
+	}
+
+	return merr.ErrorOrNil()
+}
+
 // postrun is used to run the runners postrun hooks.
 func (ar *allocRunner) postrun() error {
 	if ar.logger.IsTrace() {
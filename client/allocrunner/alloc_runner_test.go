@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/nomad/client/allocwatcher"
 	cconsul "github.com/hashicorp/nomad/client/consul"
 	"github.com/hashicorp/nomad/client/state"
+	cstructs "github.com/hashicorp/nomad/client/structs"
 	"github.com/hashicorp/nomad/command/agent/consul"
 	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/hashicorp/nomad/nomad/mock"
@@ -237,6 +238,85 @@ func TestAllocRunner_TaskLeader_StopTG(t *testing.T) {
 	})
 }
 
+// TestAllocRunner_Restore_NetworkStatus asserts that the network status set
+// by the network hook is restored as the hook doesn't set it again when the
+// alloc network already exists.
+func TestAllocRunner_Restore_NetworkStatus(t *testing.T) {
+	t.Parallel()
+
+	alloc := mock.Alloc()
+	conf, cleanup := testAllocRunnerConfig(t, alloc)
+	defer cleanup()
+
+	// Use a memory backed statedb
+	conf.StateDB = state.NewMemDB(conf.Logger)
+
+	ar, err := NewAllocRunner(conf)
+	require.NoError(t, err)
+
+	status := &structs.AllocNetworkStatus{
+		InterfaceName: "eth0",
+		Address:       "10.0.0.2",
+	}
+	(&allocNetworkStatus{ar: ar}).SetNetworkStatus(status)
+
+	ar2, err := NewAllocRunner(conf)
+	require.NoError(t, err)
+	require.NoError(t, ar2.Restore())
+
+	ar2.stateLock.RLock()
+	restored := ar2.state.NetworkStatus
+	ar2.stateLock.RUnlock()
+	require.Equal(t, status, restored)
+}
+
+// TestAllocRunner_LatestAllocStats_SharedNetwork asserts that the network
+// stats of tasks sharing the network namespace of the alloc are not summed.
+func TestAllocRunner_LatestAllocStats_SharedNetwork(t *testing.T) {
+	t.Parallel()
+
+	alloc := mock.Alloc()
+	alloc.Job.TaskGroups[0].Networks = []*structs.NetworkResource{{Mode: "bridge"}}
+	task := alloc.Job.TaskGroups[0].Tasks[0]
+	task2 := task.Copy()
+	task2.Name = "task2"
+	alloc.Job.TaskGroups[0].Tasks = append(alloc.Job.TaskGroups[0].Tasks, task2)
+	alloc.AllocatedResources.Tasks[task2.Name] = alloc.AllocatedResources.Tasks[task.Name]
+
+	conf, cleanup := testAllocRunnerConfig(t, alloc)
+	defer cleanup()
+	ar, err := NewAllocRunner(conf)
+	require.NoError(t, err)
+
+	// Both tasks report the counters of the namespace, the second one more
+	// recently
+	usage := func(ts int64, rx uint64) *cstructs.TaskResourceUsage {
+		return &cstructs.TaskResourceUsage{
+			ResourceUsage: &cstructs.ResourceUsage{
+				MemoryStats:  &cstructs.MemoryStats{RSS: 100},
+				CpuStats:     &cstructs.CpuStats{},
+				NetworkStats: &cstructs.NetworkStats{RxBytes: rx, TxBytes: 10},
+				BlockIOStats: &cstructs.BlockIOStats{ReadBytes: 5},
+			},
+			Timestamp: ts,
+		}
+	}
+	ar.tasks[task.Name].UpdateStats(usage(1, 1000))
+	ar.tasks[task2.Name].UpdateStats(usage(2, 1024))
+
+	stats, err := ar.LatestAllocStats("")
+	require.NoError(t, err)
+	require.Len(t, stats.Tasks, 2)
+	require.EqualValues(t, 1024, stats.ResourceUsage.NetworkStats.RxBytes)
+	require.EqualValues(t, 10, stats.ResourceUsage.NetworkStats.TxBytes)
+	require.EqualValues(t, 10, stats.ResourceUsage.BlockIOStats.ReadBytes)
+	require.EqualValues(t, 200, stats.ResourceUsage.MemoryStats.RSS)
+
+	// The stats of the tasks are left untouched
+	require.EqualValues(t, 1000, stats.Tasks[task.Name].ResourceUsage.NetworkStats.RxBytes)
+	require.EqualValues(t, 1024, stats.Tasks[task2.Name].ResourceUsage.NetworkStats.RxBytes)
+}
+
 // TestAllocRunner_TaskLeader_StopRestoredTG asserts that when stopping a
 // restored task group with a leader that failed before restoring the leader is
 // not stopped as it does not exist.
@@ -2,6 +2,7 @@ package allocrunner
 
 import (
 	log "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/client/allocrunner/taskrunner/getter"
 	"github.com/hashicorp/nomad/client/allocwatcher"
 	clientconfig "github.com/hashicorp/nomad/client/config"
 	"github.com/hashicorp/nomad/client/consul"
@@ -52,6 +53,12 @@ type Config struct {
 	// DriverManager handles dispensing of driver plugins
 	DriverManager drivermanager.Manager
 
+	// ArtifactCache is the client's shared artifact cache and may be nil
+	ArtifactCache *getter.Cache
+
+	// RPCClient is used by tasks to read data from the servers
+	RPCClient clientconfig.RPCHandler
+
 	// ServersContactedCh is closed when the first GetClientAllocs call to
 	// servers succeeds and allocs are synced.
 	ServersContactedCh chan struct{}
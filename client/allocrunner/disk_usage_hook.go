@@ -0,0 +1,149 @@
+package allocrunner
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	hclog "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+const (
+	// diskUsageWarnPercent is the percentage of the ephemeral disk limit at
+	// which a warning task event is emitted.
+	diskUsageWarnPercent = 90
+
+	// diskUsageResetPercent is the percentage of the ephemeral disk limit
+	// usage must fall below before another warning is emitted.
+	diskUsageResetPercent = 80
+)
+
+// diskUsageMeasurer returns the number of bytes used by an allocation
+// directory.
+type diskUsageMeasurer interface {
+	DiskUsage() (int64, error)
+}
+
+// diskUsageEnforcer is used by the disk usage hook to notify and kill tasks
+// without full access to the alloc runner.
+type diskUsageEnforcer interface {
+	// EmitTaskEvent emits the event to all live tasks.
+	EmitTaskEvent(event *structs.TaskEvent)
+
+	// KillTasks emits the event to all live tasks and then kills them.
+	KillTasks(event *structs.TaskEvent)
+}
+
+// diskUsageHook is an alloc lifecycle hook that periodically measures the
+// disk usage of the allocation directory and kills the allocation when it
+// exceeds the task group's ephemeral disk size.
+type diskUsageHook struct {
+	alloc    *structs.Allocation
+	allocDir diskUsageMeasurer
+	enforcer diskUsageEnforcer
+
+	// interval is the period between disk usage measurements
+	interval time.Duration
+
+	// stopCh is closed to stop the watcher goroutine
+	stopCh   chan struct{}
+	stopOnce sync.Once
+
+	logger hclog.Logger
+}
+
+func newDiskUsageHook(logger hclog.Logger, alloc *structs.Allocation,
+	allocDir diskUsageMeasurer, enforcer diskUsageEnforcer, interval time.Duration) *diskUsageHook {
+	h := &diskUsageHook{
+		alloc:    alloc,
+		allocDir: allocDir,
+		enforcer: enforcer,
+		interval: interval,
+		stopCh:   make(chan struct{}),
+	}
+	h.logger = logger.Named(h.Name())
+	return h
+}
+
+func (h *diskUsageHook) Name() string {
+	return "disk_usage"
+}
+
+func (h *diskUsageHook) Prerun() error {
+	tg := h.alloc.Job.LookupTaskGroup(h.alloc.TaskGroup)
+	if tg == nil || tg.EphemeralDisk == nil || tg.EphemeralDisk.SizeMB <= 0 {
+		return nil
+	}
+
+	go h.watch(tg.EphemeralDisk.SizeMB)
+	return nil
+}
+
+func (h *diskUsageHook) Postrun() error {
+	h.stop()
+	return nil
+}
+
+func (h *diskUsageHook) Destroy() error {
+	h.stop()
+	return nil
+}
+
+func (h *diskUsageHook) Shutdown() {
+	h.stop()
+}
+
+func (h *diskUsageHook) stop() {
+	h.stopOnce.Do(func() {
+		close(h.stopCh)
+	})
+}
+
+// watch measures the disk usage of the allocation directory every interval
+// until the hook is stopped or the limit is exceeded.
+func (h *diskUsageHook) watch(limitMB int) {
+	limit := int64(limitMB) * 1024 * 1024
+	warned := false
+
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.stopCh:
+			return
+		case <-ticker.C:
+		}
+
+		usage, err := h.allocDir.DiskUsage()
+		if err != nil {
+			h.logger.Warn("failed to measure alloc dir disk usage", "error", err)
+			continue
+		}
+
+		usedMB := usage / 1024 / 1024
+		switch {
+		case usage > limit:
+			h.logger.Info("alloc dir exceeded ephemeral disk limit; killing tasks",
+				"usage_mb", usedMB, "limit_mb", limitMB)
+			event := structs.NewTaskEvent(structs.TaskDiskExceeded).
+				SetDiskLimit(int64(limitMB)).
+				SetMessage(fmt.Sprintf("Allocation used %d MB of its %d MB ephemeral disk", usedMB, limitMB)).
+				SetFailsTask()
+			h.enforcer.KillTasks(event)
+			return
+		case usage*100 >= limit*diskUsageWarnPercent:
+			if warned {
+				continue
+			}
+			warned = true
+			event := structs.NewTaskEvent(structs.TaskDiskUsageWarning).
+				SetDiskLimit(int64(limitMB)).
+				SetMessage(fmt.Sprintf("Allocation is using %d MB of its %d MB ephemeral disk", usedMB, limitMB))
+			h.enforcer.EmitTaskEvent(event)
+		case usage*100 < limit*diskUsageResetPercent:
+			warned = false
+		}
+	}
+}
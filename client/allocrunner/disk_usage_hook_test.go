@@ -0,0 +1,129 @@
+package allocrunner
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// statically assert disk usage hook implements the expected interfaces
+var _ interfaces.RunnerPrerunHook = (*diskUsageHook)(nil)
+var _ interfaces.RunnerPostrunHook = (*diskUsageHook)(nil)
+var _ interfaces.RunnerDestroyHook = (*diskUsageHook)(nil)
+var _ interfaces.ShutdownHook = (*diskUsageHook)(nil)
+
+type mockDiskUsageMeasurer struct {
+	mu    sync.Mutex
+	usage int64
+}
+
+func (m *mockDiskUsageMeasurer) setUsage(usage int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.usage = usage
+}
+
+func (m *mockDiskUsageMeasurer) DiskUsage() (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.usage, nil
+}
+
+type mockDiskUsageEnforcer struct {
+	mu     sync.Mutex
+	events []*structs.TaskEvent
+	killed *structs.TaskEvent
+}
+
+func (m *mockDiskUsageEnforcer) EmitTaskEvent(event *structs.TaskEvent) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.events = append(m.events, event)
+}
+
+func (m *mockDiskUsageEnforcer) KillTasks(event *structs.TaskEvent) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.killed = event
+}
+
+func (m *mockDiskUsageEnforcer) state() ([]*structs.TaskEvent, *structs.TaskEvent) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*structs.TaskEvent{}, m.events...), m.killed
+}
+
+// TestDiskUsageHook_WarnAndKill asserts that the hook emits a warning when
+// usage approaches the limit and kills the tasks once it is exceeded.
+func TestDiskUsageHook_WarnAndKill(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	alloc := mock.Alloc()
+	alloc.Job.TaskGroups[0].EphemeralDisk.SizeMB = 10
+	limit := int64(10 * 1024 * 1024)
+
+	measurer := &mockDiskUsageMeasurer{}
+	enforcer := &mockDiskUsageEnforcer{}
+	hook := newDiskUsageHook(testlog.HCLogger(t), alloc, measurer, enforcer, 10*time.Millisecond)
+	require.NoError(hook.Prerun())
+	defer hook.Postrun()
+
+	// Approaching the limit emits a single warning
+	measurer.setUsage(limit * 95 / 100)
+	testutil.WaitForResult(func() (bool, error) {
+		events, _ := enforcer.state()
+		return len(events) == 1, nil
+	}, func(err error) {
+		t.Fatalf("expected warning event")
+	})
+	time.Sleep(50 * time.Millisecond)
+	events, killed := enforcer.state()
+	require.Len(events, 1)
+	require.Equal(structs.TaskDiskUsageWarning, events[0].Type)
+	require.Nil(killed)
+
+	// Exceeding the limit kills the tasks with a failing event
+	measurer.setUsage(limit + 1)
+	testutil.WaitForResult(func() (bool, error) {
+		_, killed := enforcer.state()
+		return killed != nil, nil
+	}, func(err error) {
+		t.Fatalf("expected tasks to be killed")
+	})
+	_, killed = enforcer.state()
+	require.Equal(structs.TaskDiskExceeded, killed.Type)
+	require.True(killed.FailsTask)
+	require.Equal("10", killed.Details["disk_limit"])
+}
+
+// TestDiskUsageHook_Stop asserts that usage is not enforced once the hook is
+// stopped.
+func TestDiskUsageHook_Stop(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	alloc := mock.Alloc()
+	alloc.Job.TaskGroups[0].EphemeralDisk.SizeMB = 10
+
+	measurer := &mockDiskUsageMeasurer{}
+	enforcer := &mockDiskUsageEnforcer{}
+	hook := newDiskUsageHook(testlog.HCLogger(t), alloc, measurer, enforcer, 10*time.Millisecond)
+	require.NoError(hook.Prerun())
+	require.NoError(hook.Postrun())
+	require.NoError(hook.Destroy())
+
+	measurer.setUsage(100 * 1024 * 1024)
+	time.Sleep(50 * time.Millisecond)
+
+	events, killed := enforcer.state()
+	require.Empty(events)
+	require.Nil(killed)
+}
@@ -1,6 +1,7 @@
 package allocrunner
 
 import (
+	"strings"
 	"sync"
 
 	log "github.com/hashicorp/go-hclog"
@@ -21,6 +22,10 @@ type groupServiceHook struct {
 	consulClient consul.ConsulServiceAPI
 	prerun       bool
 
+	// networkStatus is used to retrieve the address assigned to the alloc's
+	// network and may be nil
+	networkStatus networkStatusGetter
+
 	logger log.Logger
 
 	// The following fields may be updated
@@ -39,6 +44,7 @@ type groupServiceHookConfig struct {
 	consul         consul.ConsulServiceAPI
 	restarter      agentconsul.WorkloadRestarter
 	taskEnvBuilder *taskenv.Builder
+	networkStatus  networkStatusGetter
 	logger         log.Logger
 }
 
@@ -49,6 +55,7 @@ func newGroupServiceHook(cfg groupServiceHookConfig) *groupServiceHook {
 		restarter:      cfg.restarter,
 		consulClient:   cfg.consul,
 		taskEnvBuilder: cfg.taskEnvBuilder,
+		networkStatus:  cfg.networkStatus,
 	}
 	h.logger = cfg.logger.Named(h.Name())
 	h.services = cfg.alloc.Job.LookupTaskGroup(h.group).Services
@@ -131,15 +138,60 @@ func (h *groupServiceHook) driverNet() *drivers.DriverNetwork {
 
 	//TODO(schmichael) only support one network for now
 	net := h.networks[0]
+
+	// Allocs on a CNI network are reachable at the address assigned by the
+	// CNI plugins, on the ports the tasks listen on inside the namespace
+	if strings.HasPrefix(strings.ToLower(net.Mode), cniNetworkModePrefix) && h.networkStatus != nil {
+		if status := h.networkStatus.NetworkStatus(); status != nil && status.Address != "" {
+			return &drivers.DriverNetwork{
+				AutoAdvertise: true,
+				IP:            status.Address,
+				PortMap:       cniPortMap(net),
+			}
+		}
+	}
+
 	//TODO(schmichael) there's probably a better way than hacking driver network
 	return &drivers.DriverNetwork{
-		AutoAdvertise: true,
+		// Ports bound on a host network must be advertised on its IP, which
+		// only the host address mode resolves per port
+		AutoAdvertise: !hasHostNetworkPorts(net),
 		IP:            net.IP,
 		// Copy PortLabels from group network
 		PortMap: net.PortLabels(),
 	}
 }
 
+// hasHostNetworkPorts returns whether any port of the network was allocated
+// on a host network.
+func hasHostNetworkPorts(net *structs.NetworkResource) bool {
+	for _, portSet := range [][]structs.Port{net.ReservedPorts, net.DynamicPorts} {
+		for _, port := range portSet {
+			if port.HostIP != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// cniPortMap returns the port labels of the network mapped to the port the
+// task listens on inside the network namespace, which is the port's mapped
+// To value if set.
+func cniPortMap(net *structs.NetworkResource) map[string]int {
+	ports := make(map[string]int, len(net.ReservedPorts)+len(net.DynamicPorts))
+	for _, portSet := range [][]structs.Port{net.ReservedPorts, net.DynamicPorts} {
+		for _, port := range portSet {
+			if port.To > 0 {
+				ports[port.Label] = port.To
+			} else {
+				ports[port.Label] = port.Value
+			}
+		}
+	}
+	return ports
+}
+
 // deregister services from Consul.
 func (h *groupServiceHook) deregister() {
 	if len(h.services) > 0 {
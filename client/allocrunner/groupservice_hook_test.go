@@ -158,6 +158,92 @@ func TestGroupServiceHook_getWorkloadServices(t *testing.T) {
 	require.Len(t, services.Services, 1)
 }
 
+type mockNetworkStatusGetter struct {
+	status *structs.AllocNetworkStatus
+}
+
+func (m *mockNetworkStatusGetter) NetworkStatus() *structs.AllocNetworkStatus {
+	return m.status
+}
+
+// TestGroupServiceHook_CNINetworkAddress asserts that services of allocs on a
+// CNI network advertise the address assigned by CNI and the mapped ports.
+func TestGroupServiceHook_CNINetworkAddress(t *testing.T) {
+	t.Parallel()
+
+	alloc := mock.Alloc()
+	alloc.AllocatedResources.Shared.Networks = []*structs.NetworkResource{
+		{
+			Mode:          "cni/mynet",
+			IP:            "192.168.0.10",
+			ReservedPorts: []structs.Port{{Label: "admin", Value: 5000}},
+			DynamicPorts:  []structs.Port{{Label: "http", Value: 23456, To: 8080}},
+		},
+	}
+	logger := testlog.HCLogger(t)
+
+	h := newGroupServiceHook(groupServiceHookConfig{
+		alloc:          alloc,
+		consul:         consul.NewMockConsulServiceClient(t, logger),
+		restarter:      agentconsul.NoopRestarter(),
+		taskEnvBuilder: taskenv.NewBuilder(mock.Node(), alloc, nil, alloc.Job.Region),
+		networkStatus: &mockNetworkStatusGetter{
+			status: &structs.AllocNetworkStatus{InterfaceName: "eth0", Address: "10.1.2.3"},
+		},
+		logger: logger,
+	})
+
+	net := h.getWorkloadServices().DriverNetwork
+	require.NotNil(t, net)
+	require.True(t, net.AutoAdvertise)
+	require.Equal(t, "10.1.2.3", net.IP)
+	require.Equal(t, map[string]int{"admin": 5000, "http": 8080}, net.PortMap)
+
+	// Without a network status the host address is used
+	h.networkStatus = &mockNetworkStatusGetter{}
+	net = h.getWorkloadServices().DriverNetwork
+	require.Equal(t, "192.168.0.10", net.IP)
+	require.Equal(t, map[string]int{"admin": 5000, "http": 23456}, net.PortMap)
+}
+
+// TestGroupServiceHook_HostNetworkAddress asserts that services of allocs
+// with ports on a host network advertise the host network's IP.
+func TestGroupServiceHook_HostNetworkAddress(t *testing.T) {
+	t.Parallel()
+
+	alloc := mock.Alloc()
+	networks := []*structs.NetworkResource{
+		{
+			Mode:          "bridge",
+			IP:            "192.168.0.10",
+			ReservedPorts: []structs.Port{{Label: "admin", Value: 5000}},
+			DynamicPorts:  []structs.Port{{Label: "http", Value: 23456, To: 8080, HostNetwork: "public", HostIP: "10.0.0.5"}},
+		},
+	}
+	alloc.AllocatedResources.Shared.Networks = networks
+	logger := testlog.HCLogger(t)
+
+	h := newGroupServiceHook(groupServiceHookConfig{
+		alloc:          alloc,
+		consul:         consul.NewMockConsulServiceClient(t, logger),
+		restarter:      agentconsul.NoopRestarter(),
+		taskEnvBuilder: taskenv.NewBuilder(mock.Node(), alloc, nil, alloc.Job.Region),
+		logger:         logger,
+	})
+
+	// The services fall back to the host address mode
+	ws := h.getWorkloadServices()
+	require.False(t, ws.DriverNetwork.Advertise())
+
+	ip, port := structs.Networks(networks).Port("http")
+	require.Equal(t, "10.0.0.5", ip)
+	require.Equal(t, 23456, port)
+
+	ip, port = structs.Networks(networks).Port("admin")
+	require.Equal(t, "192.168.0.10", ip)
+	require.Equal(t, 5000, port)
+}
+
 // TestGroupServiceHook_Update08Alloc asserts that adding group services to a previously
 // 0.8 alloc works.
 //
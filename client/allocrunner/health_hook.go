@@ -71,8 +71,8 @@ func newAllocHealthWatcherHook(logger log.Logger, alloc *structs.Allocation, hs
 	listener *cstructs.AllocListener, consul consul.ConsulServiceAPI) interfaces.RunnerHook {
 
 	// Neither deployments nor migrations care about the health of
-	// non-service jobs so never watch their health
-	if alloc.Job.Type != structs.JobTypeService {
+	// non-service and non-system jobs so never watch their health
+	if alloc.Job.Type != structs.JobTypeService && alloc.Job.Type != structs.JobTypeSystem {
 		return noopAllocHealthWatcherHook{}
 	}
 
@@ -97,8 +97,9 @@ func (h *allocHealthWatcherHook) Name() string {
 	return "alloc_health_watcher"
 }
 
-// init starts the allochealth.Tracker and watchHealth goroutine on either
-// Prerun or Update. Caller must set/update alloc and logger fields.
+// init starts watching the health of the allocation on either Prerun or
+// Update unless it is already set. Caller must set/update alloc and logger
+// fields.
 //
 // Not threadsafe so the caller should lock since Updates occur concurrently.
 func (h *allocHealthWatcherHook) init() error {
@@ -108,6 +109,14 @@ func (h *allocHealthWatcherHook) init() error {
 		return nil
 	}
 
+	return h.watch(h.alloc)
+}
+
+// watch starts the allochealth.Tracker and watchHealth goroutine for the
+// given allocation regardless of whether health is already set.
+//
+// Not threadsafe so the caller should lock since Updates occur concurrently.
+func (h *allocHealthWatcherHook) watch(alloc *structs.Allocation) error {
 	tg := h.alloc.Job.LookupTaskGroup(h.alloc.TaskGroup)
 	if tg == nil {
 		return fmt.Errorf("task group %q does not exist in job %q", h.alloc.TaskGroup, h.alloc.Job.ID)
@@ -115,6 +124,12 @@ func (h *allocHealthWatcherHook) init() error {
 
 	h.isDeploy = h.alloc.DeploymentID != ""
 
+	// System allocations are never migrated so their health only matters
+	// to deployments
+	if !h.isDeploy && h.alloc.Job.Type == structs.JobTypeSystem {
+		return nil
+	}
+
 	// No need to watch allocs for deployments that rely on operators
 	// manually setting health
 	if h.isDeploy && (tg.Update.IsEmpty() || tg.Update.HealthCheck == structs.UpdateStrategyHealthCheck_Manual) {
@@ -132,7 +147,7 @@ func (h *allocHealthWatcherHook) init() error {
 
 	h.logger.Trace("watching", "deadline", deadline, "checks", useChecks, "min_healthy_time", minHealthyTime)
 	// Create a new tracker, start it, and watch for health results.
-	tracker := allochealth.NewTracker(ctx, h.logger, h.alloc,
+	tracker := allochealth.NewTracker(ctx, h.logger, alloc,
 		h.listener, h.consul, minHealthyTime, useChecks)
 	tracker.Start()
 
@@ -179,6 +194,32 @@ func (h *allocHealthWatcherHook) Update(req *interfaces.RunnerUpdateRequest) err
 	return h.init()
 }
 
+// Restarted watches the health of the allocation again after its tasks were
+// restarted in place, so the health reported to the server reflects the
+// restarted tasks. The previous health is kept until the watcher sets a new
+// one, which also updates its timestamp.
+func (h *allocHealthWatcherHook) Restarted(req *interfaces.RunnerRestartRequest) error {
+	h.hookLock.Lock()
+	defer h.hookLock.Unlock()
+
+	// Nothing to watch again before Prerun or an Update
+	if !h.ranOnce {
+		return nil
+	}
+
+	// Cancel the old watcher and wait until it exits
+	h.cancelFn()
+	<-h.watchDone
+
+	// Track the restarted task states while keeping the server's view of the
+	// allocation for its desired status
+	alloc := h.alloc.CopySkipJob()
+	alloc.Job = h.alloc.Job
+	alloc.TaskStates = req.Alloc.TaskStates
+	alloc.ClientStatus = req.Alloc.ClientStatus
+	return h.watch(alloc)
+}
+
 func (h *allocHealthWatcherHook) Postrun() error {
 	h.hookLock.Lock()
 	defer h.hookLock.Unlock()
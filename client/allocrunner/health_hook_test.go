@@ -22,6 +22,7 @@ import (
 var _ interfaces.RunnerPrerunHook = (*allocHealthWatcherHook)(nil)
 var _ interfaces.RunnerUpdateHook = (*allocHealthWatcherHook)(nil)
 var _ interfaces.RunnerPostrunHook = (*allocHealthWatcherHook)(nil)
+var _ interfaces.RunnerRestartHook = (*allocHealthWatcherHook)(nil)
 var _ interfaces.ShutdownHook = (*allocHealthWatcherHook)(nil)
 
 // allocHealth is emitted to a chan whenever SetHealth is called
@@ -300,11 +301,124 @@ func TestHealthHook_SetHealth(t *testing.T) {
 	require.NoError(h.Postrun())
 }
 
-// TestHealthHook_SystemNoop asserts that system jobs return the noop tracker.
-func TestHealthHook_SystemNoop(t *testing.T) {
+// TestHealthHook_Restarted asserts that health is watched and set again after
+// the tasks are restarted in place, even though it was already set.
+func TestHealthHook_Restarted(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	alloc := mock.Alloc()
+	alloc.Job.TaskGroups[0].Migrate.MinHealthyTime = 1 // let's speed things up
+	task := alloc.Job.TaskGroups[0].Tasks[0]
+
+	// Synthesize running alloc and tasks
+	alloc.ClientStatus = structs.AllocClientStatusRunning
+	alloc.TaskStates = map[string]*structs.TaskState{
+		task.Name: {
+			State:     structs.TaskStateRunning,
+			StartedAt: time.Now(),
+		},
+	}
+
+	// Make Consul response
+	check := &consulapi.AgentCheck{
+		Name:   task.Services[0].Checks[0].Name,
+		Status: consulapi.HealthPassing,
+	}
+	reg := &agentconsul.AllocRegistration{
+		Tasks: map[string]*agentconsul.ServiceRegistrations{
+			task.Name: {
+				Services: map[string]*agentconsul.ServiceRegistration{
+					task.Services[0].Name: {
+						Service: &consulapi.AgentService{
+							ID:      "foo",
+							Service: task.Services[0].Name,
+						},
+						Checks: []*consulapi.AgentCheck{check},
+					},
+				},
+			},
+		},
+	}
+
+	logger := testlog.HCLogger(t)
+	b := cstructs.NewAllocBroadcaster(logger)
+	defer b.Close()
+
+	consul := consul.NewMockConsulServiceClient(t, logger)
+	consul.AllocRegistrationsFn = func(string) (*agentconsul.AllocRegistration, error) {
+		return reg, nil
+	}
+
+	hs := newMockHealthSetter()
+
+	h := newAllocHealthWatcherHook(logger, alloc.Copy(), hs, b.Listen(), consul).(*allocHealthWatcherHook)
+
+	// Restarting before Prerun is a noop
+	require.NoError(h.Restarted(&interfaces.RunnerRestartRequest{Alloc: alloc.Copy()}))
+	require.False(hs.HasHealth())
+
+	// Prerun and wait for health to be set
+	require.NoError(h.Prerun())
+	select {
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timeout waiting for health to be set")
+	case health := <-hs.healthCh:
+		require.True(health.healthy)
+	}
+
+	// Restart the task and assert health is set again
+	restarted := alloc.Copy()
+	restarted.TaskStates[task.Name].StartedAt = time.Now()
+	require.NoError(h.Restarted(&interfaces.RunnerRestartRequest{Alloc: restarted}))
+	select {
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timeout waiting for health to be set again")
+	case health := <-hs.healthCh:
+		require.True(health.healthy)
+	}
+
+	hs.mu.Lock()
+	require.Equal(2, hs.setCalls)
+	hs.mu.Unlock()
+
+	// Postrun
+	require.NoError(h.Postrun())
+}
+
+// TestHealthHook_System asserts that system jobs only watch the health of
+// allocations that are part of a deployment.
+func TestHealthHook_System(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	alloc := mock.SystemAlloc()
+	h := newAllocHealthWatcherHook(testlog.HCLogger(t), alloc, nil, nil, nil)
+
+	// Assert that it's the real impl
+	hook, ok := h.(*allocHealthWatcherHook)
+	require.True(ok)
+
+	// Allocations outside of a deployment are not watched
+	hs := newMockHealthSetter()
+	hook.healthSetter = hs
+	require.NoError(hook.init())
+	require.Nil(hs.healthy)
+	select {
+	case <-hook.watchDone:
+	default:
+		require.Fail("expected no health watcher to be running")
+	}
+}
+
+// TestHealthHook_SysBatchNoop asserts that sysbatch jobs return the noop
+// tracker.
+func TestHealthHook_SysBatchNoop(t *testing.T) {
 	t.Parallel()
 
-	h := newAllocHealthWatcherHook(testlog.HCLogger(t), mock.SystemAlloc(), nil, nil, nil)
+	alloc := mock.SystemAlloc()
+	alloc.Job.Type = structs.JobTypeSysBatch
+	h := newAllocHealthWatcherHook(testlog.HCLogger(t), alloc, nil, nil, nil)
 
 	// Assert that it's the noop impl
 	_, ok := h.(noopAllocHealthWatcherHook)
@@ -46,6 +46,19 @@ type RunnerUpdateRequest struct {
 	Alloc *structs.Allocation
 }
 
+// RunnerRestartHooks are executed after the tasks of an allocation have been
+// restarted in place at the request of an operator. They are not executed for
+// restarts caused by task failures.
+type RunnerRestartHook interface {
+	RunnerHook
+	Restarted(*RunnerRestartRequest) error
+}
+
+type RunnerRestartRequest struct {
+	// Alloc is the allocation with the task states as of the restart.
+	Alloc *structs.Allocation
+}
+
 // ShutdownHook may be implemented by AllocRunner or TaskRunner hooks and will
 // be called when the agent process is being shutdown gracefully.
 type ShutdownHook interface {
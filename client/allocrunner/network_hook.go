@@ -16,6 +16,10 @@ type networkHook struct {
 	// network is created
 	setter networkIsolationSetter
 
+	// statusSetter is a callback to record the network status once the
+	// network has been configured
+	statusSetter networkStatusSetter
+
 	// manager is used when creating the network namespace. This defaults to
 	// bind mounting a network namespace descritor under /var/run/netns but
 	// can be created by a driver if nessicary
@@ -35,10 +39,12 @@ type networkHook struct {
 }
 
 func newNetworkHook(logger hclog.Logger, ns networkIsolationSetter,
-	alloc *structs.Allocation, netManager drivers.DriverNetworkManager,
+	statusSetter networkStatusSetter, alloc *structs.Allocation,
+	netManager drivers.DriverNetworkManager,
 	netConfigurator NetworkConfigurator) *networkHook {
 	return &networkHook{
 		setter:              ns,
+		statusSetter:        statusSetter,
 		alloc:               alloc,
 		manager:             netManager,
 		networkConfigurator: netConfigurator,
@@ -73,9 +79,13 @@ func (h *networkHook) Prerun() error {
 	}
 
 	if created {
-		if err := h.networkConfigurator.Setup(context.TODO(), h.alloc, spec); err != nil {
+		status, err := h.networkConfigurator.Setup(context.TODO(), h.alloc, spec)
+		if err != nil {
 			return fmt.Errorf("failed to configure networking for alloc: %v", err)
 		}
+		if status != nil {
+			h.statusSetter.SetNetworkStatus(status)
+		}
 	}
 	return nil
 }
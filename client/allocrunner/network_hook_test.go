@@ -1,6 +1,7 @@
 package allocrunner
 
 import (
+	"context"
 	"testing"
 
 	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
@@ -27,6 +28,30 @@ func (m *mockNetworkIsolationSetter) SetNetworkIsolation(spec *drivers.NetworkIs
 	require.Exactly(m.t, m.expectedSpec, spec)
 }
 
+type mockNetworkStatusSetter struct {
+	status *structs.AllocNetworkStatus
+}
+
+func (m *mockNetworkStatusSetter) SetNetworkStatus(status *structs.AllocNetworkStatus) {
+	m.status = status
+}
+
+type mockNetworkConfigurator struct {
+	status        *structs.AllocNetworkStatus
+	setupCalls    int
+	teardownCalls int
+}
+
+func (m *mockNetworkConfigurator) Setup(context.Context, *structs.Allocation, *drivers.NetworkIsolationSpec) (*structs.AllocNetworkStatus, error) {
+	m.setupCalls++
+	return m.status, nil
+}
+
+func (m *mockNetworkConfigurator) Teardown(context.Context, *structs.Allocation, *drivers.NetworkIsolationSpec) error {
+	m.teardownCalls++
+	return nil
+}
+
 // Test that the prerun and postrun hooks call the setter with the expected spec when
 // the network mode is not host
 func TestNetworkHook_Prerun_Postrun(t *testing.T) {
@@ -65,7 +90,7 @@ func TestNetworkHook_Prerun_Postrun(t *testing.T) {
 	require := require.New(t)
 
 	logger := testlog.HCLogger(t)
-	hook := newNetworkHook(logger, setter, alloc, nm, &hostNetworkConfigurator{})
+	hook := newNetworkHook(logger, setter, &mockNetworkStatusSetter{}, alloc, nm, &hostNetworkConfigurator{})
 	require.NoError(hook.Prerun())
 	require.True(setter.called)
 	require.False(destroyCalled)
@@ -76,7 +101,7 @@ func TestNetworkHook_Prerun_Postrun(t *testing.T) {
 	setter.called = false
 	destroyCalled = false
 	alloc.Job.TaskGroups[0].Networks[0].Mode = "host"
-	hook = newNetworkHook(logger, setter, alloc, nm, &hostNetworkConfigurator{})
+	hook = newNetworkHook(logger, setter, &mockNetworkStatusSetter{}, alloc, nm, &hostNetworkConfigurator{})
 	require.NoError(hook.Prerun())
 	require.False(setter.called)
 	require.False(destroyCalled)
@@ -84,3 +109,47 @@ func TestNetworkHook_Prerun_Postrun(t *testing.T) {
 	require.False(destroyCalled)
 
 }
+
+// Test that the network status returned by the configurator is recorded when
+// the hook creates the network
+func TestNetworkHook_Prerun_NetworkStatus(t *testing.T) {
+	require := require.New(t)
+
+	alloc := mock.Alloc()
+	alloc.Job.TaskGroups[0].Networks = []*structs.NetworkResource{
+		{
+			Mode: "cni/mynet",
+		},
+	}
+	spec := &drivers.NetworkIsolationSpec{
+		Mode: drivers.NetIsolationModeGroup,
+		Path: "test",
+	}
+
+	nm := &testutils.MockDriver{
+		MockNetworkManager: testutils.MockNetworkManager{
+			CreateNetworkF: func(allocID string) (*drivers.NetworkIsolationSpec, bool, error) {
+				return spec, true, nil
+			},
+			DestroyNetworkF: func(allocID string, netSpec *drivers.NetworkIsolationSpec) error {
+				return nil
+			},
+		},
+	}
+	nc := &mockNetworkConfigurator{
+		status: &structs.AllocNetworkStatus{
+			InterfaceName: "eth0",
+			Address:       "10.1.2.3",
+		},
+	}
+	statusSetter := &mockNetworkStatusSetter{}
+
+	hook := newNetworkHook(testlog.HCLogger(t), &mockNetworkIsolationSetter{t: t, expectedSpec: spec},
+		statusSetter, alloc, nm, nc)
+	require.NoError(hook.Prerun())
+	require.Equal(1, nc.setupCalls)
+	require.Equal(nc.status, statusSetter.status)
+
+	require.NoError(hook.Postrun())
+	require.Equal(1, nc.teardownCalls)
+}
@@ -117,6 +117,9 @@ func netModeToIsolationMode(netMode string) drivers.NetIsolationMode {
 	case "driver":
 		return drivers.NetIsolationModeTask
 	default:
+		if strings.HasPrefix(strings.ToLower(netMode), cniNetworkModePrefix) {
+			return drivers.NetIsolationModeGroup
+		}
 		return drivers.NetIsolationModeHost
 	}
 }
@@ -129,9 +132,14 @@ func newNetworkConfigurator(log hclog.Logger, alloc *structs.Allocation, config
 		return &hostNetworkConfigurator{}, nil
 	}
 
-	switch strings.ToLower(tg.Networks[0].Mode) {
-	case "bridge":
+	netMode := strings.ToLower(tg.Networks[0].Mode)
+	switch {
+	case netMode == "bridge":
 		return newBridgeNetworkConfigurator(log, config.BridgeNetworkName, config.BridgeNetworkAllocSubnet, config.CNIPath)
+	case strings.HasPrefix(netMode, cniNetworkModePrefix):
+		// network names are case sensitive so use the mode as written
+		name := tg.Networks[0].Mode[len(cniNetworkModePrefix):]
+		return newCNINetworkConfigurator(log, config.CNIPath, config.CNIConfigDir, name)
 	default:
 		return &hostNetworkConfigurator{}, nil
 	}
@@ -4,8 +4,6 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
-	"os"
-	"path/filepath"
 	"time"
 
 	cni "github.com/containerd/go-cni"
@@ -16,14 +14,6 @@ import (
 )
 
 const (
-	// envCNIPath is the environment variable name to use to derive the CNI path
-	// when it is not explicitly set by the client
-	envCNIPath = "CNI_PATH"
-
-	// defaultCNIPath is the CNI path to use when it is not set by the client
-	// and is not set by environment variable
-	defaultCNIPath = "/opt/cni/bin"
-
 	// defaultNomadBridgeName is the name of the bridge to use when not set by
 	// the client
 	defaultNomadBridgeName = "nomad"
@@ -60,14 +50,7 @@ func newBridgeNetworkConfigurator(log hclog.Logger, bridgeName, ipRange, cniPath
 		rand:        rand.New(rand.NewSource(time.Now().Unix())),
 		logger:      log,
 	}
-	if cniPath == "" {
-		if cniPath = os.Getenv(envCNIPath); cniPath == "" {
-			cniPath = defaultCNIPath
-		}
-	}
-
-	c, err := cni.New(cni.WithPluginDir(filepath.SplitList(cniPath)),
-		cni.WithInterfacePrefix(bridgeNetworkAllocIfPrefix))
+	c, err := newCNI(cniPath, bridgeNetworkAllocIfPrefix)
 	if err != nil {
 		return nil, err
 	}
@@ -145,13 +128,13 @@ func (b *bridgeNetworkConfigurator) generateAdminChainRule() []string {
 }
 
 // Setup calls the CNI plugins with the add action
-func (b *bridgeNetworkConfigurator) Setup(ctx context.Context, alloc *structs.Allocation, spec *drivers.NetworkIsolationSpec) error {
+func (b *bridgeNetworkConfigurator) Setup(ctx context.Context, alloc *structs.Allocation, spec *drivers.NetworkIsolationSpec) (*structs.AllocNetworkStatus, error) {
 	if err := b.ensureForwardingRules(); err != nil {
-		return fmt.Errorf("failed to initialize table forwarding rules: %v", err)
+		return nil, fmt.Errorf("failed to initialize table forwarding rules: %v", err)
 	}
 
 	if err := b.cni.Load(cni.WithConfListBytes(b.buildNomadNetConfig())); err != nil {
-		return err
+		return nil, err
 	}
 
 	// Depending on the version of bridge cni plugin used, a known race could occure
@@ -159,21 +142,18 @@ func (b *bridgeNetworkConfigurator) Setup(ctx context.Context, alloc *structs.Al
 	// in one of them to fail. This rety attempts to overcome any
 	const retry = 3
 	for attempt := 1; ; attempt++ {
-		//TODO eventually returning the IP from the result would be nice to have in the alloc
-		if _, err := b.cni.Setup(ctx, alloc.ID, spec.Path, cni.WithCapabilityPortMap(getPortMapping(alloc))); err != nil {
+		res, err := b.cni.Setup(ctx, alloc.ID, spec.Path, cni.WithCapabilityPortMap(getPortMapping(alloc)))
+		if err != nil {
 			b.logger.Warn("failed to configure bridge network", "err", err, "attempt", attempt)
 			if attempt == retry {
-				return fmt.Errorf("failed to configure bridge network: %v", err)
+				return nil, fmt.Errorf("failed to configure bridge network: %v", err)
 			}
 			// Sleep for 1 second + jitter
 			time.Sleep(time.Second + (time.Duration(b.rand.Int63n(1000)) * time.Millisecond))
 			continue
 		}
-		break
+		return cniToAllocNetworkStatus(res), nil
 	}
-
-	return nil
-
 }
 
 // Teardown calls the CNI plugins with the delete action
@@ -181,27 +161,6 @@ func (b *bridgeNetworkConfigurator) Teardown(ctx context.Context, alloc *structs
 	return b.cni.Remove(ctx, alloc.ID, spec.Path, cni.WithCapabilityPortMap(getPortMapping(alloc)))
 }
 
-// getPortMapping builds a list of portMapping structs that are used as the
-// portmapping capability arguments for the portmap CNI plugin
-func getPortMapping(alloc *structs.Allocation) []cni.PortMapping {
-	ports := []cni.PortMapping{}
-	for _, network := range alloc.AllocatedResources.Shared.Networks {
-		for _, port := range append(network.DynamicPorts, network.ReservedPorts...) {
-			if port.To < 1 {
-				continue
-			}
-			for _, proto := range []string{"tcp", "udp"} {
-				ports = append(ports, cni.PortMapping{
-					HostPort:      int32(port.Value),
-					ContainerPort: int32(port.To),
-					Protocol:      proto,
-				})
-			}
-		}
-	}
-	return ports
-}
-
 func (b *bridgeNetworkConfigurator) buildNomadNetConfig() []byte {
 	return []byte(fmt.Sprintf(nomadCNIConfigTemplate, b.bridgeName, b.allocSubnet, cniAdminChainName))
 }
@@ -0,0 +1,178 @@
+package allocrunner
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	cni "github.com/containerd/go-cni"
+	"github.com/containernetworking/cni/libcni"
+	hclog "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/drivers"
+)
+
+const (
+	// envCNIPath is the environment variable name to use to derive the CNI path
+	// when it is not explicitly set by the client
+	envCNIPath = "CNI_PATH"
+
+	// defaultCNIPath is the CNI path to use when it is not set by the client
+	// and is not set by environment variable
+	defaultCNIPath = "/opt/cni/bin"
+
+	// cniNetworkModePrefix is the prefix of the network mode which attaches
+	// the alloc to a named CNI network, ie cni/<name>
+	cniNetworkModePrefix = "cni/"
+
+	// defaultCNIConfigDir is the directory searched for CNI network
+	// configurations when it is not set by the client
+	defaultCNIConfigDir = "/opt/cni/config"
+
+	// cniNetworkAllocIfPrefix is the prefix that is used for the interface
+	// names created inside of the alloc network by CNI plugins
+	cniNetworkAllocIfPrefix = "eth"
+)
+
+// cniNetworkConfigurator is a NetworkConfigurator which attaches the alloc to
+// a user defined CNI network loaded from the client's CNI config directory
+type cniNetworkConfigurator struct {
+	cni     cni.CNI
+	cniConf []byte
+
+	rand   *rand.Rand
+	logger hclog.Logger
+}
+
+func newCNINetworkConfigurator(logger hclog.Logger, cniPath, cniConfigDir, networkName string) (*cniNetworkConfigurator, error) {
+	if cniConfigDir == "" {
+		cniConfigDir = defaultCNIConfigDir
+	}
+
+	confList, err := libcni.LoadConfList(cniConfigDir, networkName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load CNI config for network %q from %q: %v", networkName, cniConfigDir, err)
+	}
+
+	c, err := newCNI(cniPath, cniNetworkAllocIfPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cniNetworkConfigurator{
+		cni:     c,
+		cniConf: confList.Bytes,
+		rand:    rand.New(rand.NewSource(time.Now().Unix())),
+		logger:  logger,
+	}, nil
+}
+
+// newCNI creates a CNI library instance searching cniPath for plugins. The
+// CNI_PATH environment variable and then the default path are used if
+// cniPath is empty.
+func newCNI(cniPath, ifPrefix string) (cni.CNI, error) {
+	if cniPath == "" {
+		if cniPath = os.Getenv(envCNIPath); cniPath == "" {
+			cniPath = defaultCNIPath
+		}
+	}
+
+	return cni.New(cni.WithPluginDir(filepath.SplitList(cniPath)),
+		cni.WithInterfacePrefix(ifPrefix))
+}
+
+// Setup calls the CNI plugins with the add action
+func (c *cniNetworkConfigurator) Setup(ctx context.Context, alloc *structs.Allocation, spec *drivers.NetworkIsolationSpec) (*structs.AllocNetworkStatus, error) {
+	if err := c.cni.Load(cni.WithConfListBytes(c.cniConf)); err != nil {
+		return nil, err
+	}
+
+	// Plugins may fail transiently, for example when two allocs race to
+	// create the same bridge, so retry like the bridge network does
+	const retry = 3
+	for attempt := 1; ; attempt++ {
+		res, err := c.cni.Setup(ctx, alloc.ID, spec.Path, cni.WithCapabilityPortMap(getPortMapping(alloc)))
+		if err != nil {
+			c.logger.Warn("failed to configure CNI network", "err", err, "attempt", attempt)
+			if attempt == retry {
+				return nil, fmt.Errorf("failed to configure CNI network: %v", err)
+			}
+			// Sleep for 1 second + jitter
+			time.Sleep(time.Second + (time.Duration(c.rand.Int63n(1000)) * time.Millisecond))
+			continue
+		}
+		return cniToAllocNetworkStatus(res), nil
+	}
+}
+
+// Teardown calls the CNI plugins with the delete action
+func (c *cniNetworkConfigurator) Teardown(ctx context.Context, alloc *structs.Allocation, spec *drivers.NetworkIsolationSpec) error {
+	if err := c.cni.Load(cni.WithConfListBytes(c.cniConf)); err != nil {
+		return err
+	}
+	return c.cni.Remove(ctx, alloc.ID, spec.Path, cni.WithCapabilityPortMap(getPortMapping(alloc)))
+}
+
+// getPortMapping builds a list of portMapping structs that are used as the
+// portmapping capability arguments for the portmap CNI plugin. Ports
+// allocated on a host network are only mapped on its IP.
+func getPortMapping(alloc *structs.Allocation) []cni.PortMapping {
+	ports := []cni.PortMapping{}
+	for _, network := range alloc.AllocatedResources.Shared.Networks {
+		for _, port := range append(network.DynamicPorts, network.ReservedPorts...) {
+			if port.To < 1 {
+				continue
+			}
+			for _, proto := range []string{"tcp", "udp"} {
+				ports = append(ports, cni.PortMapping{
+					HostPort:      int32(port.Value),
+					ContainerPort: int32(port.To),
+					Protocol:      proto,
+					HostIP:        port.HostIP,
+				})
+			}
+		}
+	}
+	return ports
+}
+
+// cniToAllocNetworkStatus returns the address assigned to the alloc by the
+// CNI plugins. Interfaces inside the alloc's namespace are preferred over
+// host side interfaces such as bridges. Nil is returned if no address was
+// assigned.
+func cniToAllocNetworkStatus(res *cni.CNIResult) *structs.AllocNetworkStatus {
+	if res == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(res.Interfaces))
+	for name := range res.Interfaces {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var fallback *structs.AllocNetworkStatus
+	for _, name := range names {
+		iface := res.Interfaces[name]
+		if iface == nil || len(iface.IPConfigs) == 0 || iface.IPConfigs[0].IP == nil {
+			continue
+		}
+
+		status := &structs.AllocNetworkStatus{
+			InterfaceName: name,
+			Address:       iface.IPConfigs[0].IP.String(),
+		}
+		if iface.Sandbox != "" {
+			return status
+		}
+		if fallback == nil {
+			fallback = status
+		}
+	}
+
+	return fallback
+}
@@ -0,0 +1,164 @@
+package allocrunner
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	cni "github.com/containerd/go-cni"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCNINetworkConfigurator_LoadConfig asserts that the named network is
+// loaded from the CNI config directory.
+func TestCNINetworkConfigurator_LoadConfig(t *testing.T) {
+	require := require.New(t)
+
+	dir, err := ioutil.TempDir("", "nomad-cni")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+
+	conflist := `{
+	"cniVersion": "0.4.0",
+	"name": "mynet",
+	"plugins": [{"type": "macvlan", "master": "eth0", "ipam": {"type": "dhcp"}}]
+}`
+	conf := `{"cniVersion": "0.4.0", "name": "othernet", "type": "bridge"}`
+	require.NoError(ioutil.WriteFile(filepath.Join(dir, "10-mynet.conflist"), []byte(conflist), 0644))
+	require.NoError(ioutil.WriteFile(filepath.Join(dir, "20-othernet.conf"), []byte(conf), 0644))
+
+	c, err := newCNINetworkConfigurator(testlog.HCLogger(t), "", dir, "mynet")
+	require.NoError(err)
+	require.JSONEq(conflist, string(c.cniConf))
+
+	// Single network configs are converted to a config list
+	c, err = newCNINetworkConfigurator(testlog.HCLogger(t), "", dir, "othernet")
+	require.NoError(err)
+	require.Contains(string(c.cniConf), `"name":"othernet"`)
+
+	_, err = newCNINetworkConfigurator(testlog.HCLogger(t), "", dir, "missing")
+	require.Error(err)
+	require.Contains(err.Error(), `network "missing"`)
+}
+
+func TestCNIToAllocNetworkStatus(t *testing.T) {
+	require := require.New(t)
+
+	require.Nil(cniToAllocNetworkStatus(nil))
+	require.Nil(cniToAllocNetworkStatus(&cni.CNIResult{
+		Interfaces: map[string]*cni.Config{"eth0": {}},
+	}))
+
+	// Interfaces inside the namespace are preferred
+	res := &cni.CNIResult{
+		Interfaces: map[string]*cni.Config{
+			"bridge0": {
+				IPConfigs: []*cni.IPConfig{{IP: net.ParseIP("172.26.64.1")}},
+			},
+			"eth0": {
+				IPConfigs: []*cni.IPConfig{{IP: net.ParseIP("172.26.64.2")}},
+				Sandbox:   "/var/run/netns/abc",
+			},
+			"veth1234": {},
+		},
+	}
+	require.Equal(&structs.AllocNetworkStatus{
+		InterfaceName: "eth0",
+		Address:       "172.26.64.2",
+	}, cniToAllocNetworkStatus(res))
+
+	// Otherwise the first interface with an address is used
+	res.Interfaces["eth0"].Sandbox = ""
+	require.Equal(&structs.AllocNetworkStatus{
+		InterfaceName: "bridge0",
+		Address:       "172.26.64.1",
+	}, cniToAllocNetworkStatus(res))
+}
+
+// failingCNI is a cni.CNI whose Setup fails a number of times before
+// succeeding.
+type failingCNI struct {
+	cni.CNI
+	failures int
+	calls    int
+}
+
+func (f *failingCNI) Load(...cni.CNIOpt) error { return nil }
+
+func (f *failingCNI) Setup(context.Context, string, string, ...cni.NamespaceOpts) (*cni.CNIResult, error) {
+	f.calls++
+	if f.calls <= f.failures {
+		return nil, fmt.Errorf("failed attempt %d", f.calls)
+	}
+	return &cni.CNIResult{
+		Interfaces: map[string]*cni.Config{
+			"eth0": {
+				IPConfigs: []*cni.IPConfig{{IP: net.ParseIP("10.0.0.2")}},
+				Sandbox:   "/var/run/netns/alloc",
+			},
+		},
+	}, nil
+}
+
+// TestCNINetworkConfigurator_Setup_Retry asserts that a failing CNI setup is
+// retried.
+func TestCNINetworkConfigurator_Setup_Retry(t *testing.T) {
+	require := require.New(t)
+
+	alloc := mock.Alloc()
+	spec := &drivers.NetworkIsolationSpec{Path: "/var/run/netns/alloc"}
+
+	fake := &failingCNI{failures: 1}
+	c := &cniNetworkConfigurator{
+		cni:    fake,
+		rand:   rand.New(rand.NewSource(0)),
+		logger: testlog.HCLogger(t),
+	}
+
+	status, err := c.Setup(context.Background(), alloc, spec)
+	require.NoError(err)
+	require.Equal(2, fake.calls)
+	require.Equal("10.0.0.2", status.Address)
+
+	// Setup gives up after three attempts
+	fake = &failingCNI{failures: 3}
+	c.cni = fake
+	_, err = c.Setup(context.Background(), alloc, spec)
+	require.Error(err)
+	require.Equal(3, fake.calls)
+}
+
+// TestGetPortMapping asserts that ports allocated on a host network are only
+// mapped on the host network's IP.
+func TestGetPortMapping(t *testing.T) {
+	require := require.New(t)
+
+	alloc := mock.Alloc()
+	alloc.AllocatedResources.Shared.Networks = []*structs.NetworkResource{
+		{
+			Mode:          "bridge",
+			IP:            "192.168.0.100",
+			ReservedPorts: []structs.Port{{Label: "admin", Value: 9000, To: 9000}},
+			DynamicPorts: []structs.Port{
+				{Label: "http", Value: 25000, To: 8080, HostNetwork: "public", HostIP: "10.0.0.5"},
+				{Label: "unmapped", Value: 25001},
+			},
+		},
+	}
+
+	require.Equal([]cni.PortMapping{
+		{HostPort: 25000, ContainerPort: 8080, Protocol: "tcp", HostIP: "10.0.0.5"},
+		{HostPort: 25000, ContainerPort: 8080, Protocol: "udp", HostIP: "10.0.0.5"},
+		{HostPort: 9000, ContainerPort: 9000, Protocol: "tcp"},
+		{HostPort: 9000, ContainerPort: 9000, Protocol: "udp"},
+	}, getPortMapping(alloc))
+}
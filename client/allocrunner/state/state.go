@@ -20,6 +20,10 @@ type State struct {
 
 	// TaskStates is a snapshot of task states.
 	TaskStates map[string]*structs.TaskState
+
+	// NetworkStatus captures the network details of the allocation once the
+	// network hook has configured it
+	NetworkStatus *structs.AllocNetworkStatus
 }
 
 // SetDeploymentStatus is a helper for updating the client-controlled
@@ -57,6 +61,7 @@ func (s *State) Copy() *State {
 		ClientDescription: s.ClientDescription,
 		DeploymentStatus:  s.DeploymentStatus.Copy(),
 		TaskStates:        taskStates,
+		NetworkStatus:     s.NetworkStatus.Copy(),
 	}
 }
 
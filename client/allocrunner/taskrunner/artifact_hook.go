@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
 	"github.com/hashicorp/nomad/client/allocrunner/taskrunner/getter"
 	ti "github.com/hashicorp/nomad/client/allocrunner/taskrunner/interfaces"
+	"github.com/hashicorp/nomad/client/config"
 	"github.com/hashicorp/nomad/nomad/structs"
 )
 
@@ -15,11 +16,20 @@ import (
 type artifactHook struct {
 	eventEmitter ti.EventEmitter
 	logger       log.Logger
+
+	// config limits and sandboxes artifact downloads
+	config *config.ArtifactConfig
+
+	// cache is the client's shared artifact cache and may be nil if
+	// caching is disabled
+	cache *getter.Cache
 }
 
-func newArtifactHook(e ti.EventEmitter, logger log.Logger) *artifactHook {
+func newArtifactHook(e ti.EventEmitter, config *config.ArtifactConfig, cache *getter.Cache, logger log.Logger) *artifactHook {
 	h := &artifactHook{
 		eventEmitter: e,
+		config:       config,
+		cache:        cache,
 	}
 	h.logger = logger.Named(h.Name())
 	return h
@@ -52,7 +62,13 @@ func (h *artifactHook) Prestart(ctx context.Context, req *interfaces.TaskPrestar
 
 		h.logger.Debug("downloading artifact", "artifact", artifact.GetterSource)
 		//XXX add ctx to GetArtifact to allow cancelling long downloads
-		if err := getter.GetArtifact(req.TaskEnv, artifact, req.TaskDir.Dir); err != nil {
+		var err error
+		if h.cache != nil {
+			err = h.cache.GetArtifact(req.TaskEnv, artifact, req.TaskDir.Dir)
+		} else {
+			err = getter.GetArtifact(h.config, req.TaskEnv, artifact, req.TaskDir.Dir)
+		}
+		if err != nil {
 			wrapped := structs.NewRecoverableError(
 				fmt.Errorf("failed to download artifact %q: %v", artifact.GetterSource, err),
 				true,
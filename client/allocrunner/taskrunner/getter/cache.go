@@ -0,0 +1,447 @@
+package getter
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	gg "github.com/hashicorp/go-getter"
+	hclog "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/client/allocdir"
+	"github.com/hashicorp/nomad/client/config"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+const (
+	// cacheTmpPrefix is the prefix of directories artifacts are downloaded
+	// into before being moved into the cache.
+	cacheTmpPrefix = ".tmp-"
+
+	// cacheArtifactName is the name of the downloaded artifact within a
+	// cache entry's directory.
+	cacheArtifactName = "artifact"
+)
+
+// Cache is a content-addressed cache of artifacts shared by all allocations
+// on a client. Entries are keyed by the artifact's getter URL, which includes
+// its checksum, and only artifacts with a checksum are cached so a changed
+// source is never served stale. The least recently used entries are evicted
+// once the cache grows beyond its size limit.
+type Cache struct {
+	config   *config.ArtifactConfig
+	dir      string
+	maxBytes int64
+	logger   hclog.Logger
+
+	// lock guards the fields below
+	lock    sync.Mutex
+	entries map[string]*cacheEntry
+	lru     *list.List
+	size    int64
+}
+
+// cacheEntry is a single cached artifact.
+type cacheEntry struct {
+	key  string
+	size int64
+	elem *list.Element
+
+	// done is true once the download has completed and ready is closed.
+	// Waiters must check err after ready is closed.
+	done  bool
+	ready chan struct{}
+	err   error
+
+	// refs is the number of callers copying out of the entry. Entries with
+	// references are never evicted.
+	refs int
+}
+
+// NewCache returns an artifact cache rooted at dir which holds at most
+// maxBytes of artifacts. Entries left in dir by a previous run are reused.
+func NewCache(logger hclog.Logger, cfg *config.ArtifactConfig, dir string, maxBytes int64) (*Cache, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create artifact cache dir: %v", err)
+	}
+
+	if cfg == nil {
+		cfg = config.DefaultArtifactConfig()
+	}
+
+	c := &Cache{
+		config:   cfg,
+		dir:      dir,
+		maxBytes: maxBytes,
+		logger:   logger.Named("artifact_cache"),
+		entries:  make(map[string]*cacheEntry),
+		lru:      list.New(),
+	}
+
+	if err := c.restore(); err != nil {
+		return nil, err
+	}
+	c.prune()
+	return c, nil
+}
+
+// restore loads the entries found in the cache directory, ordering them by
+// their last use and removing any partial downloads.
+func (c *Cache) restore() error {
+	files, err := ioutil.ReadDir(c.dir)
+	if err != nil {
+		return fmt.Errorf("failed to read artifact cache dir: %v", err)
+	}
+
+	// Oldest first so the most recently used entry ends at the front
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].ModTime().Before(files[j].ModTime())
+	})
+
+	for _, fi := range files {
+		path := filepath.Join(c.dir, fi.Name())
+		if !fi.IsDir() || strings.HasPrefix(fi.Name(), cacheTmpPrefix) {
+			os.RemoveAll(path)
+			continue
+		}
+
+		size, err := allocdir.DirSize(path)
+		if err != nil {
+			c.logger.Warn("removing unreadable cached artifact", "key", fi.Name(), "error", err)
+			os.RemoveAll(path)
+			continue
+		}
+
+		e := &cacheEntry{
+			key:   fi.Name(),
+			size:  size,
+			done:  true,
+			ready: make(chan struct{}),
+		}
+		close(e.ready)
+		e.elem = c.lru.PushFront(e)
+		c.entries[e.key] = e
+		c.size += size
+	}
+
+	c.emitSize()
+	return nil
+}
+
+// GetArtifact downloads an artifact into the specified task directory,
+// serving it from the cache when possible. Artifacts without a checksum
+// bypass the cache.
+func (c *Cache) GetArtifact(taskEnv EnvReplacer, artifact *structs.TaskArtifact, taskDir string) error {
+	if artifact.GetterOptions["checksum"] == "" {
+		return GetArtifact(c.config, taskEnv, artifact, taskDir)
+	}
+
+	url, err := getGetterUrl(taskEnv, artifact)
+	if err != nil {
+		return newGetError(artifact.GetterSource, err, false)
+	}
+
+	if err := checkProtocol(url, allowedProtocols(c.config)); err != nil {
+		return newGetError(url, err, false)
+	}
+
+	mode := getterMode(artifact)
+	key := cacheKey(url, mode)
+
+	e, err := c.acquire(key, url, mode)
+	if err != nil {
+		return newGetError(url, err, true)
+	}
+	defer c.release(e)
+
+	src := filepath.Join(c.dir, key, cacheArtifactName)
+	dest := filepath.Join(taskDir, artifact.RelativeDest)
+	if err := copyArtifact(src, dest, mode); err != nil {
+		return newGetError(url, fmt.Errorf("failed to copy cached artifact: %v", err), true)
+	}
+
+	return nil
+}
+
+// acquire returns a referenced entry for the key, downloading the artifact
+// if it is not already cached. Concurrent callers for the same key wait on a
+// single download.
+func (c *Cache) acquire(key, url string, mode gg.ClientMode) (*cacheEntry, error) {
+	c.lock.Lock()
+	if e, ok := c.entries[key]; ok {
+		e.refs++
+		c.lru.MoveToFront(e.elem)
+		c.lock.Unlock()
+
+		<-e.ready
+		if e.err != nil {
+			return nil, e.err
+		}
+
+		// Persist the use so the LRU order survives restarts
+		now := time.Now()
+		os.Chtimes(filepath.Join(c.dir, key), now, now)
+
+		metrics.IncrCounter([]string{"client", "artifact_cache", "hit"}, 1)
+		return e, nil
+	}
+
+	e := &cacheEntry{
+		key:   key,
+		ready: make(chan struct{}),
+		refs:  1,
+	}
+	e.elem = c.lru.PushFront(e)
+	c.entries[key] = e
+	c.lock.Unlock()
+
+	metrics.IncrCounter([]string{"client", "artifact_cache", "miss"}, 1)
+	size, err := c.download(key, url, mode)
+
+	c.lock.Lock()
+	e.done = true
+	if err != nil {
+		e.err = err
+		delete(c.entries, key)
+		c.lru.Remove(e.elem)
+	} else {
+		e.size = size
+		c.size += size
+	}
+	close(e.ready)
+	c.lock.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.prune()
+	return e, nil
+}
+
+// release drops a reference acquired by acquire.
+func (c *Cache) release(e *cacheEntry) {
+	c.lock.Lock()
+	e.refs--
+	c.lock.Unlock()
+}
+
+// download fetches the artifact into the entry's directory and returns its
+// size on disk.
+func (c *Cache) download(key, url string, mode gg.ClientMode) (int64, error) {
+	tmp, err := ioutil.TempDir(c.dir, cacheTmpPrefix+key)
+	if err != nil {
+		return 0, err
+	}
+	defer os.RemoveAll(tmp)
+
+	p := newParameters(c.config, url, mode, filepath.Join(tmp, cacheArtifactName), tmp)
+	if err := fetch(p); err != nil {
+		return 0, err
+	}
+
+	size, err := allocdir.DirSize(tmp)
+	if err != nil {
+		return 0, err
+	}
+
+	dst := filepath.Join(c.dir, key)
+	if err := os.RemoveAll(dst); err != nil {
+		return 0, err
+	}
+	if err := os.Rename(tmp, dst); err != nil {
+		return 0, err
+	}
+
+	c.logger.Debug("cached artifact", "key", key, "size", size)
+	return size, nil
+}
+
+// prune evicts the least recently used entries until the cache is within its
+// size limit.
+func (c *Cache) prune() {
+	for {
+		c.lock.Lock()
+		over := c.size > c.maxBytes
+		c.lock.Unlock()
+
+		if !over || !c.EvictOldest() {
+			return
+		}
+	}
+}
+
+// EvictOldest removes the least recently used artifact that is not in use
+// and returns true if an artifact was removed.
+func (c *Cache) EvictOldest() bool {
+	c.lock.Lock()
+
+	var victim *cacheEntry
+	for elem := c.lru.Back(); elem != nil; elem = elem.Prev() {
+		e := elem.Value.(*cacheEntry)
+		if e.done && e.refs == 0 {
+			victim = e
+			break
+		}
+	}
+
+	if victim == nil {
+		c.lock.Unlock()
+		return false
+	}
+
+	delete(c.entries, victim.key)
+	c.lru.Remove(victim.elem)
+	c.size -= victim.size
+
+	// Move the entry aside while holding the lock so a new download of the
+	// same key cannot race with its removal.
+	path := filepath.Join(c.dir, victim.key)
+	trash := filepath.Join(c.dir, fmt.Sprintf("%s%s-evicted-%d", cacheTmpPrefix, victim.key, time.Now().UnixNano()))
+	if err := os.Rename(path, trash); err != nil {
+		trash = path
+	}
+	c.emitSize()
+	c.lock.Unlock()
+
+	if err := os.RemoveAll(trash); err != nil {
+		c.logger.Warn("failed to remove evicted artifact", "key", victim.key, "error", err)
+	}
+
+	metrics.IncrCounter([]string{"client", "artifact_cache", "evict"}, 1)
+	c.logger.Debug("evicted cached artifact", "key", victim.key, "size", victim.size)
+	return true
+}
+
+// Size returns the number of bytes used by cached artifacts.
+func (c *Cache) Size() int64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.size
+}
+
+// emitSize emits the size of the cache. Must be called with the lock held.
+func (c *Cache) emitSize() {
+	metrics.SetGauge([]string{"client", "artifact_cache", "size"}, float32(c.size))
+}
+
+// getterMode converts from the artifact's getter mode to the go-getter const.
+func getterMode(artifact *structs.TaskArtifact) gg.ClientMode {
+	switch artifact.GetterMode {
+	case structs.GetterModeFile:
+		return gg.ClientModeFile
+	case structs.GetterModeDir:
+		return gg.ClientModeDir
+	default:
+		return gg.ClientModeAny
+	}
+}
+
+// cacheKey returns the cache key for an artifact's getter URL and mode. The
+// URL includes the artifact's checksum.
+func cacheKey(url string, mode gg.ClientMode) string {
+	h := sha256.Sum256([]byte(fmt.Sprintf("%d:%s", mode, url)))
+	return hex.EncodeToString(h[:])
+}
+
+// copyArtifact copies a cached artifact to dest. Files are copied to dest
+// itself while directories have their contents copied into dest. Symlinks
+// must stay within the artifact so a download can't plant links out of the
+// task directory.
+func copyArtifact(src, dest string, mode gg.ClientMode) error {
+	if mode == gg.ClientModeFile {
+		fi, err := os.Lstat(src)
+		if err != nil {
+			return err
+		}
+		if !fi.Mode().IsRegular() {
+			return fmt.Errorf("artifact is not a regular file")
+		}
+		if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+			return err
+		}
+		return copyFile(src, dest, fi.Mode())
+	}
+
+	return filepath.Walk(src, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dest, rel)
+
+		switch {
+		case fi.IsDir():
+			return os.MkdirAll(target, fi.Mode().Perm()|0700)
+		case fi.Mode()&os.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			if err := checkSymlink(src, path, link); err != nil {
+				return err
+			}
+			os.Remove(target)
+			return os.Symlink(link, target)
+		case fi.Mode().IsRegular():
+			return copyFile(path, target, fi.Mode())
+		default:
+			return nil
+		}
+	})
+}
+
+// checkSymlink returns an error if the symlink at path, beneath root, has an
+// absolute target or a relative target that resolves outside of root.
+func checkSymlink(root, path, link string) error {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return err
+	}
+
+	if filepath.IsAbs(link) {
+		return fmt.Errorf("artifact symlink %q has absolute target %q", rel, link)
+	}
+
+	resolved, err := filepath.Rel(root, filepath.Join(filepath.Dir(path), link))
+	if err != nil {
+		return err
+	}
+	if resolved == ".." || strings.HasPrefix(resolved, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("artifact symlink %q escapes the artifact: %q", rel, link)
+	}
+	return nil
+}
+
+// copyFile copies the file at src to dst with the given mode.
+func copyFile(src, dst string, mode os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode.Perm())
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
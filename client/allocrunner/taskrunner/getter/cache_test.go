@@ -0,0 +1,214 @@
+package getter
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	gg "github.com/hashicorp/go-getter"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/stretchr/testify/require"
+)
+
+// countingServer returns a test server hosting the test fixtures and a
+// pointer to the number of GET requests it has served.
+func countingServer() (*httptest.Server, *int64) {
+	var requests int64
+	fs := http.FileServer(http.Dir(filepath.Dir("./test-fixtures/")))
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			atomic.AddInt64(&requests, 1)
+		}
+		fs.ServeHTTP(w, r)
+	}))
+	return ts, &requests
+}
+
+func testArtifact(ts *httptest.Server) *structs.TaskArtifact {
+	return &structs.TaskArtifact{
+		GetterSource: fmt.Sprintf("%s/%s", ts.URL, "test.sh"),
+		GetterOptions: map[string]string{
+			"checksum": "md5:bce963762aa2dbfed13caf492a45fb72",
+		},
+		RelativeDest: "local/",
+	}
+}
+
+func TestCache_GetArtifact_Hit(t *testing.T) {
+	require := require.New(t)
+	ts, requests := countingServer()
+	defer ts.Close()
+
+	cacheDir, err := ioutil.TempDir("", "nomad-test")
+	require.NoError(err)
+	defer os.RemoveAll(cacheDir)
+
+	cache, err := NewCache(testlog.HCLogger(t), nil, cacheDir, 1024*1024)
+	require.NoError(err)
+
+	artifact := testArtifact(ts)
+	for i := 0; i < 3; i++ {
+		taskDir, err := ioutil.TempDir("", "nomad-test")
+		require.NoError(err)
+		defer os.RemoveAll(taskDir)
+
+		require.NoError(cache.GetArtifact(taskEnv, artifact, taskDir))
+		_, err = os.Stat(filepath.Join(taskDir, "local", "test.sh"))
+		require.NoError(err)
+	}
+
+	// Only the first download should reach the server
+	require.EqualValues(1, atomic.LoadInt64(requests))
+	require.NotZero(cache.Size())
+
+	// A new cache in the same dir should reuse the cached artifact
+	cache, err = NewCache(testlog.HCLogger(t), nil, cacheDir, 1024*1024)
+	require.NoError(err)
+	require.NotZero(cache.Size())
+
+	taskDir, err := ioutil.TempDir("", "nomad-test")
+	require.NoError(err)
+	defer os.RemoveAll(taskDir)
+	require.NoError(cache.GetArtifact(taskEnv, artifact, taskDir))
+	require.EqualValues(1, atomic.LoadInt64(requests))
+}
+
+func TestCache_GetArtifact_NoChecksum(t *testing.T) {
+	require := require.New(t)
+	ts, requests := countingServer()
+	defer ts.Close()
+
+	cacheDir, err := ioutil.TempDir("", "nomad-test")
+	require.NoError(err)
+	defer os.RemoveAll(cacheDir)
+
+	cache, err := NewCache(testlog.HCLogger(t), nil, cacheDir, 1024*1024)
+	require.NoError(err)
+
+	artifact := testArtifact(ts)
+	artifact.GetterOptions = nil
+	for i := 0; i < 2; i++ {
+		taskDir, err := ioutil.TempDir("", "nomad-test")
+		require.NoError(err)
+		defer os.RemoveAll(taskDir)
+
+		require.NoError(cache.GetArtifact(taskEnv, artifact, taskDir))
+	}
+
+	// Artifacts without a checksum are never cached
+	require.EqualValues(2, atomic.LoadInt64(requests))
+	require.Zero(cache.Size())
+}
+
+func TestCache_Evict(t *testing.T) {
+	require := require.New(t)
+	ts, requests := countingServer()
+	defer ts.Close()
+
+	cacheDir, err := ioutil.TempDir("", "nomad-test")
+	require.NoError(err)
+	defer os.RemoveAll(cacheDir)
+
+	// A cache too small to hold the artifact evicts it once it is unused
+	cache, err := NewCache(testlog.HCLogger(t), nil, cacheDir, 1)
+	require.NoError(err)
+
+	artifact := testArtifact(ts)
+	for i := 0; i < 2; i++ {
+		taskDir, err := ioutil.TempDir("", "nomad-test")
+		require.NoError(err)
+		defer os.RemoveAll(taskDir)
+
+		require.NoError(cache.GetArtifact(taskEnv, artifact, taskDir))
+		_, err = os.Stat(filepath.Join(taskDir, "local", "test.sh"))
+		require.NoError(err)
+		cache.prune()
+	}
+
+	require.EqualValues(2, atomic.LoadInt64(requests))
+	require.Zero(cache.Size())
+	require.False(cache.EvictOldest())
+
+	files, err := ioutil.ReadDir(cacheDir)
+	require.NoError(err)
+	require.Empty(files)
+}
+
+func TestCache_CopyArtifact_Symlinks(t *testing.T) {
+	cases := []struct {
+		Name string
+		Link string
+		Fail bool
+	}{
+		{
+			Name: "within artifact",
+			Link: "dir/file",
+		},
+		{
+			Name: "absolute",
+			Link: "/etc/passwd",
+			Fail: true,
+		},
+		{
+			Name: "escaping",
+			Link: "dir/../../secrets",
+			Fail: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			src, err := ioutil.TempDir("", "nomad-test")
+			require.NoError(err)
+			defer os.RemoveAll(src)
+
+			dest, err := ioutil.TempDir("", "nomad-test")
+			require.NoError(err)
+			defer os.RemoveAll(dest)
+
+			require.NoError(os.MkdirAll(filepath.Join(src, "dir"), 0755))
+			require.NoError(ioutil.WriteFile(filepath.Join(src, "dir", "file"), []byte("foo"), 0644))
+			require.NoError(os.Symlink(c.Link, filepath.Join(src, "link")))
+
+			err = copyArtifact(src, dest, gg.ClientModeDir)
+			if c.Fail {
+				require.Error(err)
+				_, err = os.Lstat(filepath.Join(dest, "link"))
+				require.True(os.IsNotExist(err))
+				return
+			}
+
+			require.NoError(err)
+			link, err := os.Readlink(filepath.Join(dest, "link"))
+			require.NoError(err)
+			require.Equal(c.Link, link)
+		})
+	}
+}
+
+func TestCache_CopyArtifact_FileSymlink(t *testing.T) {
+	require := require.New(t)
+
+	src, err := ioutil.TempDir("", "nomad-test")
+	require.NoError(err)
+	defer os.RemoveAll(src)
+
+	dest, err := ioutil.TempDir("", "nomad-test")
+	require.NoError(err)
+	defer os.RemoveAll(dest)
+
+	// A file artifact that is a link must not copy the file it points to
+	require.NoError(os.Symlink("/etc/passwd", filepath.Join(src, "artifact")))
+	require.Error(copyArtifact(filepath.Join(src, "artifact"), filepath.Join(dest, "file"), gg.ClientModeFile))
+
+	_, err = os.Stat(filepath.Join(dest, "file"))
+	require.True(os.IsNotExist(err))
+}
@@ -0,0 +1,156 @@
+package getter
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/bzip2"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	gg "github.com/hashicorp/go-getter"
+	"github.com/ulikunitz/xz"
+)
+
+// decompressors returns go-getter's decompressors wrapped to enforce the
+// decompression limits.
+func (p *parameters) decompressors() map[string]gg.Decompressor {
+	decompressors := make(map[string]gg.Decompressor, len(gg.Decompressors))
+	for format, d := range gg.Decompressors {
+		decompressors[format] = &limitedDecompressor{
+			Decompressor:   d,
+			format:         format,
+			fileCountLimit: p.DecompressionFileCountLimit,
+			sizeLimit:      p.DecompressionSizeLimit,
+		}
+	}
+	return decompressors
+}
+
+// limitedDecompressor checks an archive against the decompression limits
+// before unpacking it, so oversized archives are rejected without writing
+// their contents to disk.
+type limitedDecompressor struct {
+	gg.Decompressor
+	format         string
+	fileCountLimit int
+	sizeLimit      int64
+}
+
+func (d *limitedDecompressor) Decompress(dst, src string, dir bool, umask os.FileMode) error {
+	if err := d.check(src); err != nil {
+		return err
+	}
+	return d.Decompressor.Decompress(dst, src, dir, umask)
+}
+
+// check returns an error if the archive at src exceeds the limits.
+func (d *limitedDecompressor) check(src string) error {
+	if d.format == "zip" {
+		return d.checkZip(src)
+	}
+
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	switch d.format {
+	case "tar.gz", "tgz":
+		r, err := gzip.NewReader(f)
+		if err != nil {
+			return err
+		}
+		return d.checkTar(r)
+	case "tar.bz2", "tbz2":
+		return d.checkTar(bzip2.NewReader(f))
+	case "tar.xz", "txz":
+		r, err := xz.NewReader(f)
+		if err != nil {
+			return err
+		}
+		return d.checkTar(r)
+	case "gz":
+		r, err := gzip.NewReader(f)
+		if err != nil {
+			return err
+		}
+		return d.checkSize(r)
+	case "bz2":
+		return d.checkSize(bzip2.NewReader(f))
+	case "xz":
+		r, err := xz.NewReader(f)
+		if err != nil {
+			return err
+		}
+		return d.checkSize(r)
+	default:
+		return nil
+	}
+}
+
+// checkTar counts the files and sizes recorded in a tar stream.
+func (d *limitedDecompressor) checkTar(r io.Reader) error {
+	tr := tar.NewReader(r)
+	files := 0
+	var size int64
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		files++
+		size += hdr.Size
+		if err := d.checkLimits(files, size); err != nil {
+			return err
+		}
+	}
+}
+
+// checkZip counts the files and sizes recorded in a zip's central directory.
+func (d *limitedDecompressor) checkZip(src string) error {
+	zr, err := zip.OpenReader(src)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+
+	var size int64
+	for i, f := range zr.File {
+		size += int64(f.UncompressedSize64)
+		if err := d.checkLimits(i+1, size); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkSize measures the decompressed size of a single compressed file.
+func (d *limitedDecompressor) checkSize(r io.Reader) error {
+	if d.sizeLimit <= 0 {
+		return nil
+	}
+
+	n, err := io.Copy(ioutil.Discard, io.LimitReader(r, d.sizeLimit+1))
+	if err != nil {
+		return err
+	}
+	return d.checkLimits(1, n)
+}
+
+func (d *limitedDecompressor) checkLimits(files int, size int64) error {
+	if d.fileCountLimit > 0 && files > d.fileCountLimit {
+		return fmt.Errorf("archive contains more than %d files", d.fileCountLimit)
+	}
+	if d.sizeLimit > 0 && size > d.sizeLimit {
+		return fmt.Errorf("archive decompresses to more than %d bytes", d.sizeLimit)
+	}
+	return nil
+}
@@ -4,21 +4,22 @@ import (
 	"fmt"
 	"net/url"
 	"path/filepath"
+	"regexp"
 	"strings"
-	"sync"
 
 	gg "github.com/hashicorp/go-getter"
+	"github.com/hashicorp/nomad/client/config"
+	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/nomad/structs"
 )
 
 var (
-	// getters is the map of getters suitable for Nomad. It is initialized once
-	// and the lock is used to guard access to it.
-	getters map[string]gg.Getter
-	lock    sync.Mutex
-
 	// supported is the set of download schemes supported by Nomad
 	supported = []string{"http", "https", "s3", "hg", "git", "gcs"}
+
+	// forcedRegexp matches go-getter sources that force a getter, such as
+	// git::https://example.com/repo.git
+	forcedRegexp = regexp.MustCompile(`^([A-Za-z0-9]+)::(.+)$`)
 )
 
 const (
@@ -32,28 +33,45 @@ type EnvReplacer interface {
 	ReplaceEnv(string) string
 }
 
-// getClient returns a client that is suitable for Nomad downloading artifacts.
-func getClient(src string, mode gg.ClientMode, dst string) *gg.Client {
-	lock.Lock()
-	defer lock.Unlock()
-
-	// Return the pre-initialized client
-	if getters == nil {
-		getters = make(map[string]gg.Getter, len(supported))
-		for _, getter := range supported {
-			if impl, ok := gg.Getters[getter]; ok {
-				getters[getter] = impl
-			}
+// allowedProtocols returns the supported protocols permitted by the
+// configuration's allowlist and denylist.
+func allowedProtocols(cfg *config.ArtifactConfig) []string {
+	allowed := make([]string, 0, len(supported))
+	for _, p := range supported {
+		if len(cfg.ProtocolAllowlist) != 0 && !helper.SliceStringContains(cfg.ProtocolAllowlist, p) {
+			continue
 		}
+		if helper.SliceStringContains(cfg.ProtocolDenylist, p) {
+			continue
+		}
+		allowed = append(allowed, p)
+	}
+	return allowed
+}
+
+// checkProtocol returns an error if the getter protocol used to download the
+// source is not in the allowed protocols.
+func checkProtocol(src string, allowed []string) error {
+	detected, err := gg.Detect(src, "", gg.Detectors)
+	if err != nil {
+		return err
 	}
 
-	return &gg.Client{
-		Src:     src,
-		Dst:     dst,
-		Mode:    mode,
-		Getters: getters,
-		Umask:   060000000,
+	var protocol string
+	if m := forcedRegexp.FindStringSubmatch(detected); m != nil {
+		protocol = m[1]
+	} else {
+		u, err := url.Parse(detected)
+		if err != nil {
+			return err
+		}
+		protocol = u.Scheme
 	}
+
+	if !helper.SliceStringContains(allowed, protocol) {
+		return fmt.Errorf("artifact protocol %q is not allowed", protocol)
+	}
+	return nil
 }
 
 // getGetterUrl returns the go-getter URL to download the artifact.
@@ -89,26 +107,25 @@ func getGetterUrl(taskEnv EnvReplacer, artifact *structs.TaskArtifact) (string,
 	return url, nil
 }
 
-// GetArtifact downloads an artifact into the specified task directory.
-func GetArtifact(taskEnv EnvReplacer, artifact *structs.TaskArtifact, taskDir string) error {
+// GetArtifact downloads an artifact into the specified task directory
+// according to the client's artifact configuration.
+func GetArtifact(cfg *config.ArtifactConfig, taskEnv EnvReplacer, artifact *structs.TaskArtifact, taskDir string) error {
+	if cfg == nil {
+		cfg = config.DefaultArtifactConfig()
+	}
+
 	url, err := getGetterUrl(taskEnv, artifact)
 	if err != nil {
 		return newGetError(artifact.GetterSource, err, false)
 	}
 
-	// Download the artifact
-	dest := filepath.Join(taskDir, artifact.RelativeDest)
-
-	// Convert from string getter mode to go-getter const
-	mode := gg.ClientModeAny
-	switch artifact.GetterMode {
-	case structs.GetterModeFile:
-		mode = gg.ClientModeFile
-	case structs.GetterModeDir:
-		mode = gg.ClientModeDir
+	p := newParameters(cfg, url, getterMode(artifact), filepath.Join(taskDir, artifact.RelativeDest), taskDir)
+	if err := checkProtocol(url, p.Protocols); err != nil {
+		return newGetError(url, err, false)
 	}
 
-	if err := getClient(url, mode, dest).Get(); err != nil {
+	// Download the artifact
+	if err := fetch(p); err != nil {
 		return newGetError(url, err, true)
 	}
 
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/nomad/client/config"
 	"github.com/hashicorp/nomad/client/taskenv"
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/structs"
@@ -49,7 +50,7 @@ func TestGetArtifact_FileAndChecksum(t *testing.T) {
 	}
 
 	// Download the artifact
-	if err := GetArtifact(taskEnv, artifact, taskDir); err != nil {
+	if err := GetArtifact(config.DefaultArtifactConfig(), taskEnv, artifact, taskDir); err != nil {
 		t.Fatalf("GetArtifact failed: %v", err)
 	}
 
@@ -83,7 +84,7 @@ func TestGetArtifact_File_RelativeDest(t *testing.T) {
 	}
 
 	// Download the artifact
-	if err := GetArtifact(taskEnv, artifact, taskDir); err != nil {
+	if err := GetArtifact(config.DefaultArtifactConfig(), taskEnv, artifact, taskDir); err != nil {
 		t.Fatalf("GetArtifact failed: %v", err)
 	}
 
@@ -137,7 +138,7 @@ func TestGetArtifact_InvalidChecksum(t *testing.T) {
 	}
 
 	// Download the artifact and expect an error
-	if err := GetArtifact(taskEnv, artifact, taskDir); err == nil {
+	if err := GetArtifact(config.DefaultArtifactConfig(), taskEnv, artifact, taskDir); err == nil {
 		t.Fatalf("GetArtifact should have failed")
 	}
 }
@@ -202,7 +203,7 @@ func TestGetArtifact_Archive(t *testing.T) {
 		},
 	}
 
-	if err := GetArtifact(taskEnv, artifact, taskDir); err != nil {
+	if err := GetArtifact(config.DefaultArtifactConfig(), taskEnv, artifact, taskDir); err != nil {
 		t.Fatalf("GetArtifact failed: %v", err)
 	}
 
@@ -235,7 +236,7 @@ func TestGetArtifact_Setuid(t *testing.T) {
 		},
 	}
 
-	require.NoError(t, GetArtifact(taskEnv, artifact, taskDir))
+	require.NoError(t, GetArtifact(config.DefaultArtifactConfig(), taskEnv, artifact, taskDir))
 
 	var expected map[string]int
 
@@ -0,0 +1,278 @@
+package getter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	gg "github.com/hashicorp/go-getter"
+	"github.com/hashicorp/nomad/client/config"
+)
+
+const (
+	// getterCommand is the nomad subcommand that runs a sandboxed artifact
+	// download.
+	getterCommand = "artifact-getter"
+
+	// maxStderrBytes bounds the error output read from the sandboxed
+	// process.
+	maxStderrBytes = 16 * 1024
+)
+
+// parameters describe a single artifact download. They are passed to the
+// sandboxed getter process encoded as JSON on stdin.
+type parameters struct {
+	Source string
+	Mode   gg.ClientMode
+	Dest   string
+
+	// WritableDir is the only path the sandboxed process may write to. It
+	// must contain Dest.
+	WritableDir string
+
+	// Protocols are the getter protocols that may be used.
+	Protocols []string
+
+	Timeout                     time.Duration
+	HTTPMaxBytes                int64
+	DecompressionFileCountLimit int
+	DecompressionSizeLimit      int64
+
+	// Isolate is set when the sandboxed process was started in its own
+	// mount namespace and should make WritableDir its only writable path.
+	Isolate bool
+
+	// DisableSandbox downloads in the current process.
+	DisableSandbox bool `json:"-"`
+}
+
+func newParameters(cfg *config.ArtifactConfig, src string, mode gg.ClientMode, dest, writableDir string) *parameters {
+	return &parameters{
+		Source:                      src,
+		Mode:                        mode,
+		Dest:                        dest,
+		WritableDir:                 writableDir,
+		Protocols:                   allowedProtocols(cfg),
+		Timeout:                     cfg.DownloadTimeout,
+		HTTPMaxBytes:                cfg.HTTPMaxBytes,
+		DecompressionFileCountLimit: cfg.DecompressionFileCountLimit,
+		DecompressionSizeLimit:      cfg.DecompressionSizeLimit,
+		DisableSandbox:              cfg.DisableSandbox,
+	}
+}
+
+// context returns a context which is cancelled after the download timeout.
+func (p *parameters) context() (context.Context, context.CancelFunc) {
+	if p.Timeout <= 0 {
+		return context.WithCancel(context.Background())
+	}
+	return context.WithTimeout(context.Background(), p.Timeout)
+}
+
+// fetch downloads the artifact, in a sandboxed child process unless the
+// sandbox is disabled.
+func fetch(p *parameters) error {
+	ctx, cancel := p.context()
+	defer cancel()
+
+	var err error
+	if p.DisableSandbox {
+		err = p.get(ctx)
+	} else {
+		err = p.getSandboxed(ctx)
+	}
+
+	if ctx.Err() == context.DeadlineExceeded {
+		return fmt.Errorf("artifact download timed out after %s", p.Timeout)
+	}
+	return err
+}
+
+// getSandboxed runs the download in a child process which may only write to
+// the writable dir.
+func (p *parameters) getSandboxed(ctx context.Context) error {
+	bin, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to find nomad binary: %v", err)
+	}
+
+	// go-getter stages archives in the temp dir so it must be writable
+	tmp, err := ioutil.TempDir(p.WritableDir, ".nomad-artifact-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	attr, isolate := sandboxAttr()
+	p.Isolate = isolate
+
+	input, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, bin, getterCommand)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stderr = &limitedBuffer{buf: &stderr, remaining: maxStderrBytes}
+	cmd.SysProcAttr = attr
+	cmd.Env = append(os.Environ(),
+		"TMPDIR="+tmp,
+		"GIT_TERMINAL_PROMPT=0",
+
+		// Never run hooks from repositories or templates
+		"GIT_CONFIG_COUNT=1",
+		"GIT_CONFIG_KEY_0=core.hooksPath",
+		"GIT_CONFIG_VALUE_0="+os.DevNull,
+	)
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return errors.New(msg)
+		}
+		return err
+	}
+	return nil
+}
+
+// runGetterCommand is the entrypoint of the sandboxed getter process. It
+// reads the parameters from in and writes any error to errOut, returning the
+// process exit code.
+func runGetterCommand(in io.Reader, errOut io.Writer) int {
+	var p parameters
+	if err := json.NewDecoder(in).Decode(&p); err != nil {
+		fmt.Fprintf(errOut, "failed to decode artifact parameters: %v", err)
+		return 1
+	}
+
+	if p.Isolate {
+		if err := isolate(p.WritableDir); err != nil {
+			fmt.Fprintf(errOut, "failed to isolate artifact download: %v", err)
+			return 1
+		}
+	}
+
+	ctx, cancel := p.context()
+	defer cancel()
+
+	if err := p.get(ctx); err != nil {
+		fmt.Fprint(errOut, err.Error())
+		return 1
+	}
+	return 0
+}
+
+// get downloads the artifact in the current process.
+func (p *parameters) get(ctx context.Context) error {
+	client := &gg.Client{
+		Ctx:           ctx,
+		Src:           p.Source,
+		Dst:           p.Dest,
+		Mode:          p.Mode,
+		Getters:       p.getters(ctx),
+		Decompressors: p.decompressors(),
+		Umask:         060000000,
+	}
+	return client.Get()
+}
+
+// getters returns new instances of the allowed getters. HTTP downloads are
+// limited to the maximum download size and cancelled with the context.
+func (p *parameters) getters(ctx context.Context) map[string]gg.Getter {
+	getters := make(map[string]gg.Getter, len(p.Protocols))
+	for _, protocol := range p.Protocols {
+		switch protocol {
+		case "http", "https":
+			getters[protocol] = &gg.HttpGetter{
+				Netrc:  true,
+				Client: p.httpClient(ctx),
+			}
+		case "s3":
+			getters[protocol] = new(gg.S3Getter)
+		case "hg":
+			getters[protocol] = new(gg.HgGetter)
+		case "git":
+			getters[protocol] = new(gg.GitGetter)
+		case "gcs":
+			getters[protocol] = new(gg.GCSGetter)
+		}
+	}
+	return getters
+}
+
+// httpClient returns an HTTP client whose requests are bound to the context
+// and whose responses are limited to the maximum download size.
+func (p *parameters) httpClient(ctx context.Context) *http.Client {
+	client := cleanhttp.DefaultClient()
+	client.Transport = &limitedTransport{
+		ctx:      ctx,
+		rt:       client.Transport,
+		maxBytes: p.HTTPMaxBytes,
+	}
+	return client
+}
+
+// limitedTransport cancels requests with its context and fails responses
+// with bodies larger than maxBytes. go-getter does not pass its context to
+// every request it makes.
+type limitedTransport struct {
+	ctx      context.Context
+	rt       http.RoundTripper
+	maxBytes int64
+}
+
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.rt.RoundTrip(req.WithContext(t.ctx))
+	if err != nil || t.maxBytes <= 0 {
+		return resp, err
+	}
+
+	if resp.ContentLength > t.maxBytes {
+		resp.Body.Close()
+		return nil, fmt.Errorf("artifact size of %d bytes exceeds limit of %d bytes", resp.ContentLength, t.maxBytes)
+	}
+
+	resp.Body = &limitedBody{ReadCloser: resp.Body, remaining: t.maxBytes}
+	return resp, nil
+}
+
+// limitedBody returns an error once more than remaining bytes are read.
+type limitedBody struct {
+	io.ReadCloser
+	remaining int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.remaining -= int64(n)
+	if b.remaining < 0 {
+		return n, fmt.Errorf("artifact exceeds size limit")
+	}
+	return n, err
+}
+
+// limitedBuffer discards writes beyond remaining bytes.
+type limitedBuffer struct {
+	buf       *bytes.Buffer
+	remaining int
+}
+
+func (l *limitedBuffer) Write(p []byte) (int, error) {
+	n := len(p)
+	if len(p) > l.remaining {
+		p = p[:l.remaining]
+	}
+	l.remaining -= len(p)
+	l.buf.Write(p)
+	return n, nil
+}
@@ -0,0 +1,16 @@
+// +build !linux
+
+package getter
+
+import "syscall"
+
+// sandboxAttr returns the attributes of the sandboxed getter process. The
+// process can only be isolated on Linux.
+func sandboxAttr() (*syscall.SysProcAttr, bool) {
+	return nil, false
+}
+
+// isolate is not supported on this platform.
+func isolate(string) error {
+	return nil
+}
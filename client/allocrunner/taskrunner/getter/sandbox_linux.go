@@ -0,0 +1,152 @@
+// +build linux
+
+package getter
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// sandboxAttr returns the attributes of the sandboxed getter process and
+// whether it should isolate itself. Root clients start the process in its
+// own mount namespace so every path but the writable dir can be made
+// read-only.
+func sandboxAttr() (*syscall.SysProcAttr, bool) {
+	if os.Geteuid() != 0 {
+		return nil, false
+	}
+	return &syscall.SysProcAttr{Cloneflags: syscall.CLONE_NEWNS}, true
+}
+
+// isolate remounts every mount in the process's private mount namespace
+// read-only and bind mounts writableDir over itself so it is the only
+// writable path.
+func isolate(writableDir string) error {
+	// Guard against ever remounting the mounts of the client itself
+	self, err := os.Readlink("/proc/self/ns/mnt")
+	if err != nil {
+		return err
+	}
+	parent, err := os.Readlink(fmt.Sprintf("/proc/%d/ns/mnt", os.Getppid()))
+	if err != nil {
+		return err
+	}
+	if self == parent {
+		return fmt.Errorf("not running in a private mount namespace")
+	}
+
+	// Stop mount events from propagating back to the host
+	if err := unix.Mount("", "/", "", unix.MS_REC|unix.MS_PRIVATE, ""); err != nil {
+		return fmt.Errorf("failed to make mounts private: %v", err)
+	}
+
+	mounts, err := readMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, m := range mounts {
+		flags := uintptr(unix.MS_REMOUNT | unix.MS_BIND | unix.MS_RDONLY | m.flags)
+		if err := unix.Mount("", m.path, "", flags, ""); err != nil {
+			// Kernel pseudo filesystems may refuse to be remounted
+			if isPseudoMount(m.path) {
+				continue
+			}
+			return fmt.Errorf("failed to remount %q read-only: %v", m.path, err)
+		}
+	}
+
+	// Bind mounts inherit the read-only flag of their source, so the bind
+	// must be remounted writable
+	if err := unix.Mount(writableDir, writableDir, "", unix.MS_BIND, ""); err != nil {
+		return fmt.Errorf("failed to bind mount %q: %v", writableDir, err)
+	}
+	flags := uintptr(unix.MS_REMOUNT | unix.MS_BIND | unix.MS_NOSUID | unix.MS_NODEV)
+	if err := unix.Mount("", writableDir, "", flags, ""); err != nil {
+		return fmt.Errorf("failed to mount %q writable: %v", writableDir, err)
+	}
+	return nil
+}
+
+// mount is a mount point and the per-mount flags that must be preserved
+// when remounting it.
+type mount struct {
+	path  string
+	flags uintptr
+}
+
+// readMounts returns the mounts of the current mount namespace.
+func readMounts() ([]mount, error) {
+	f, err := os.Open("/proc/self/mountinfo")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var mounts []mount
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// Fields are: id parent major:minor root mount_point options ...
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 6 {
+			continue
+		}
+
+		m := mount{path: unescapeMountPath(fields[4])}
+		for _, opt := range strings.Split(fields[5], ",") {
+			switch opt {
+			case "nosuid":
+				m.flags |= unix.MS_NOSUID
+			case "nodev":
+				m.flags |= unix.MS_NODEV
+			case "noexec":
+				m.flags |= unix.MS_NOEXEC
+			case "noatime":
+				m.flags |= unix.MS_NOATIME
+			case "nodiratime":
+				m.flags |= unix.MS_NODIRATIME
+			case "relatime":
+				m.flags |= unix.MS_RELATIME
+			}
+		}
+		mounts = append(mounts, m)
+	}
+	return mounts, scanner.Err()
+}
+
+// unescapeMountPath decodes the octal escapes used for whitespace and
+// backslashes in mountinfo paths.
+func unescapeMountPath(path string) string {
+	if !strings.Contains(path, `\`) {
+		return path
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(path); i++ {
+		if path[i] == '\\' && i+3 < len(path) {
+			if c, err := strconv.ParseUint(path[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(c))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(path[i])
+	}
+	return b.String()
+}
+
+// isPseudoMount returns true for mounts of kernel pseudo filesystems.
+func isPseudoMount(path string) bool {
+	for _, prefix := range []string{"/proc", "/sys"} {
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+package getter
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/client/config"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetArtifact_ProtocolDenylist(t *testing.T) {
+	require := require.New(t)
+	ts := httptest.NewServer(http.FileServer(http.Dir(filepath.Dir("./test-fixtures/"))))
+	defer ts.Close()
+
+	taskDir, err := ioutil.TempDir("", "nomad-test")
+	require.NoError(err)
+	defer os.RemoveAll(taskDir)
+
+	artifact := &structs.TaskArtifact{
+		GetterSource: fmt.Sprintf("%s/%s", ts.URL, "test.sh"),
+	}
+
+	cfg := config.DefaultArtifactConfig()
+	cfg.ProtocolDenylist = []string{"http"}
+
+	err = GetArtifact(cfg, taskEnv, artifact, taskDir)
+	require.Error(err)
+	require.Contains(err.Error(), `"http" is not allowed`)
+	require.False(err.(*GetError).IsRecoverable())
+
+	cfg = config.DefaultArtifactConfig()
+	cfg.ProtocolAllowlist = []string{"git"}
+	require.Error(GetArtifact(cfg, taskEnv, artifact, taskDir))
+
+	cfg.ProtocolAllowlist = []string{"http"}
+	require.NoError(GetArtifact(cfg, taskEnv, artifact, taskDir))
+}
+
+func TestGetArtifact_HTTPMaxBytes(t *testing.T) {
+	require := require.New(t)
+	ts := httptest.NewServer(http.FileServer(http.Dir(filepath.Dir("./test-fixtures/"))))
+	defer ts.Close()
+
+	taskDir, err := ioutil.TempDir("", "nomad-test")
+	require.NoError(err)
+	defer os.RemoveAll(taskDir)
+
+	artifact := &structs.TaskArtifact{
+		GetterSource: fmt.Sprintf("%s/%s", ts.URL, "test.sh"),
+	}
+
+	cfg := config.DefaultArtifactConfig()
+	cfg.HTTPMaxBytes = 4
+
+	err = GetArtifact(cfg, taskEnv, artifact, taskDir)
+	require.Error(err)
+	require.Contains(err.Error(), "exceeds limit")
+}
+
+func TestGetArtifact_DecompressionLimits(t *testing.T) {
+	ts := httptest.NewServer(http.FileServer(http.Dir(filepath.Dir("./test-fixtures/"))))
+	defer ts.Close()
+
+	cases := []struct {
+		name  string
+		setFn func(*config.ArtifactConfig)
+		err   string
+	}{
+		{
+			name:  "file count",
+			setFn: func(c *config.ArtifactConfig) { c.DecompressionFileCountLimit = 1 },
+			err:   "more than 1 files",
+		},
+		{
+			name:  "size",
+			setFn: func(c *config.ArtifactConfig) { c.DecompressionSizeLimit = 8 },
+			err:   "more than 8 bytes",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			taskDir, err := ioutil.TempDir("", "nomad-test")
+			require.NoError(err)
+			defer os.RemoveAll(taskDir)
+
+			artifact := &structs.TaskArtifact{
+				GetterSource: fmt.Sprintf("%s/%s", ts.URL, "archive.tar.gz"),
+			}
+
+			cfg := config.DefaultArtifactConfig()
+			tc.setFn(cfg)
+
+			err = GetArtifact(cfg, taskEnv, artifact, taskDir)
+			require.Error(err)
+			require.Contains(err.Error(), tc.err)
+
+			// Nothing should have been unpacked
+			_, err = os.Stat(filepath.Join(taskDir, "new"))
+			require.True(os.IsNotExist(err))
+		})
+	}
+}
+
+func TestGetArtifact_Timeout(t *testing.T) {
+	require := require.New(t)
+	done := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-time.After(10 * time.Second):
+		}
+	}))
+	defer ts.Close()
+	defer close(done)
+
+	taskDir, err := ioutil.TempDir("", "nomad-test")
+	require.NoError(err)
+	defer os.RemoveAll(taskDir)
+
+	artifact := &structs.TaskArtifact{
+		GetterSource: fmt.Sprintf("%s/%s", ts.URL, "test.sh"),
+	}
+
+	for _, disableSandbox := range []bool{false, true} {
+		cfg := config.DefaultArtifactConfig()
+		cfg.DownloadTimeout = 200 * time.Millisecond
+		cfg.DisableSandbox = disableSandbox
+
+		err = GetArtifact(cfg, taskEnv, artifact, taskDir)
+		require.Error(err)
+		require.Contains(err.Error(), "timed out")
+	}
+}
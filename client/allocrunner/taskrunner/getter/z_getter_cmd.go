@@ -0,0 +1,15 @@
+package getter
+
+import (
+	"os"
+)
+
+// Install a cli handler for the sandboxed artifact getter so downloads can
+// run as a child of the nomad binary or a test binary importing this package.
+// This init() must be initialized last in package required by the child
+// process.
+func init() {
+	if len(os.Args) > 1 && os.Args[1] == getterCommand {
+		os.Exit(runGetterCommand(os.Stdin, os.Stderr))
+	}
+}
@@ -0,0 +1,199 @@
+package taskrunner
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"time"
+
+	log "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
+	"github.com/hashicorp/nomad/client/config"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+const (
+	// identityRenewRetry is how long to wait before retrying a failed renewal
+	// of the workload identity
+	identityRenewRetry = 10 * time.Second
+)
+
+type identityHookConfig struct {
+	alloc        *structs.Allocation
+	task         *structs.Task
+	clientConfig *config.Config
+	rpcClient    config.RPCHandler
+	logger       log.Logger
+}
+
+// identityHook fetches the workload identity of a task from the servers and
+// exposes it to the task in its secrets directory and environment. The
+// identity written to the secrets directory is renewed before it expires; the
+// environment keeps the identity the task was started with.
+type identityHook struct {
+	alloc        *structs.Allocation
+	taskName     string
+	identity     *structs.WorkloadIdentity
+	clientConfig *config.Config
+	rpc          config.RPCHandler
+	logger       log.Logger
+
+	// tokenPath is the path the identity is written to. It is set by the
+	// first prestart before the renewal loop starts.
+	tokenPath string
+
+	// writeLock serializes writes of the identity file
+	writeLock sync.Mutex
+
+	// renewing is set once the renewal loop has been started
+	renewing bool
+
+	// ctx and cancel are used to stop the renewal loop
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func newIdentityHook(config *identityHookConfig) *identityHook {
+	identity := config.task.Identity
+	if identity == nil {
+		identity = structs.DefaultWorkloadIdentity()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	h := &identityHook{
+		alloc:        config.alloc,
+		taskName:     config.task.Name,
+		identity:     identity,
+		clientConfig: config.clientConfig,
+		rpc:          config.rpcClient,
+		ctx:          ctx,
+		cancel:       cancel,
+	}
+	h.logger = config.logger.Named(h.Name())
+	return h
+}
+
+func (*identityHook) Name() string {
+	return "identity"
+}
+
+func (h *identityHook) Prestart(ctx context.Context, req *interfaces.TaskPrestartRequest, resp *interfaces.TaskPrestartResponse) error {
+	if h.rpc == nil || h.clientConfig.Node == nil || (!h.identity.Env && !h.identity.File) {
+		resp.Done = true
+		return nil
+	}
+
+	if h.identity.File && !h.renewing {
+		h.tokenPath = filepath.Join(req.TaskDir.SecretsDir, structs.WorkloadIdentityFile)
+	}
+
+	token, expiration, err := h.sign()
+	if err != nil {
+		// Tasks keep running while the servers are unreachable, so start the
+		// task without a new identity and retry in the background. A restored
+		// task keeps its previous identity in the meantime.
+		h.logger.Warn("failed to sign workload identity, retrying in background", "error", err)
+		if h.identity.File && !h.renewing {
+			h.renewing = true
+			go h.renew(time.Now())
+		}
+		return nil
+	}
+
+	if h.identity.Env {
+		resp.Env = map[string]string{structs.WorkloadIdentityEnv: token}
+	}
+
+	if h.identity.File {
+		if err := h.writeToken(token); err != nil {
+			return err
+		}
+
+		// Renew the identity for as long as the task runs
+		if !h.renewing {
+			h.renewing = true
+			go h.renew(expiration)
+		}
+	}
+
+	return nil
+}
+
+func (h *identityHook) Stop(ctx context.Context, req *interfaces.TaskStopRequest, resp *interfaces.TaskStopResponse) error {
+	h.cancel()
+	return nil
+}
+
+func (h *identityHook) Shutdown() {
+	h.cancel()
+}
+
+// renew rewrites the identity file with a new identity once half of the
+// lifetime of the current identity has passed.
+func (h *identityHook) renew(expiration time.Time) {
+	next := renewalTime(expiration)
+	for {
+		select {
+		case <-h.ctx.Done():
+			return
+		case <-time.After(time.Until(next)):
+		}
+
+		token, exp, err := h.sign()
+		if err != nil {
+			h.logger.Warn("failed to renew workload identity", "error", err)
+			next = time.Now().Add(identityRenewRetry)
+			continue
+		}
+
+		if err := h.writeToken(token); err != nil {
+			h.logger.Error("failed to write workload identity", "error", err)
+		}
+		next = renewalTime(exp)
+	}
+}
+
+// renewalTime returns the time an identity expiring at the given time should
+// be renewed.
+func renewalTime(expiration time.Time) time.Time {
+	return time.Now().Add(time.Until(expiration) / 2)
+}
+
+// sign requests the workload identity of the task from the servers.
+func (h *identityHook) sign() (string, time.Time, error) {
+	node := h.clientConfig.Node
+	args := &structs.SignIdentitiesRequest{
+		NodeID:   node.ID,
+		SecretID: node.SecretID,
+		AllocID:  h.alloc.ID,
+		Tasks:    []string{h.taskName},
+		QueryOptions: structs.QueryOptions{
+			Region:     h.clientConfig.Region,
+			AllowStale: true,
+		},
+	}
+
+	var reply structs.SignIdentitiesResponse
+	if err := h.rpc.RPC("Node.SignIdentities", args, &reply); err != nil {
+		return "", time.Time{}, err
+	}
+
+	token, ok := reply.Identities[h.taskName]
+	if !ok {
+		return "", time.Time{}, fmt.Errorf("no identity returned for task")
+	}
+	return token, reply.Expiration, nil
+}
+
+// writeToken writes the identity to the secrets directory.
+func (h *identityHook) writeToken(token string) error {
+	h.writeLock.Lock()
+	defer h.writeLock.Unlock()
+
+	if err := ioutil.WriteFile(h.tokenPath, []byte(token), 0666); err != nil {
+		return fmt.Errorf("failed to write workload identity: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package taskrunner
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/client/allocdir"
+	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
+	"github.com/hashicorp/nomad/client/config"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// Statically assert the identity hook implements the expected interfaces
+var _ interfaces.TaskPrestartHook = (*identityHook)(nil)
+var _ interfaces.TaskStopHook = (*identityHook)(nil)
+var _ interfaces.ShutdownHook = (*identityHook)(nil)
+
+// mockIdentityRPC signs numbered identities valid for ttl.
+type mockIdentityRPC struct {
+	ttl time.Duration
+	err error
+
+	lock  sync.Mutex
+	count int
+}
+
+func (m *mockIdentityRPC) RPC(method string, args interface{}, reply interface{}) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.err != nil {
+		return m.err
+	}
+
+	m.count++
+	req := args.(*structs.SignIdentitiesRequest)
+	resp := reply.(*structs.SignIdentitiesResponse)
+	resp.Identities = map[string]string{req.Tasks[0]: fmt.Sprintf("token-%d", m.count)}
+	resp.Expiration = time.Now().Add(m.ttl)
+	return nil
+}
+
+func testIdentityHook(t *testing.T, task *structs.Task, rpc config.RPCHandler) (*identityHook, *allocdir.TaskDir, func()) {
+	logger := testlog.HCLogger(t)
+	allocDir := allocdir.NewAllocDir(logger, "nomadtest_identity")
+	taskDir := allocDir.NewTaskDir(task.Name)
+	require.NoError(t, taskDir.Build(false, nil))
+
+	clientConfig := config.DefaultConfig()
+	clientConfig.Node = mock.Node()
+
+	h := newIdentityHook(&identityHookConfig{
+		alloc:        mock.Alloc(),
+		task:         task,
+		clientConfig: clientConfig,
+		rpcClient:    rpc,
+		logger:       logger,
+	})
+	return h, taskDir, func() {
+		h.Shutdown()
+		allocDir.Destroy()
+	}
+}
+
+// TestTaskRunner_IdentityHook_File asserts the identity is written to the
+// secrets dir and renewed before it expires.
+func TestTaskRunner_IdentityHook_File(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	task := mock.Job().TaskGroups[0].Tasks[0]
+	rpc := &mockIdentityRPC{ttl: 200 * time.Millisecond}
+	h, taskDir, cleanup := testIdentityHook(t, task, rpc)
+	defer cleanup()
+
+	req := interfaces.TaskPrestartRequest{Task: task, TaskDir: taskDir}
+	var resp interfaces.TaskPrestartResponse
+	require.NoError(h.Prestart(context.Background(), &req, &resp))
+	require.False(resp.Done)
+	require.Empty(resp.Env)
+
+	path := filepath.Join(taskDir.SecretsDir, structs.WorkloadIdentityFile)
+	data, err := ioutil.ReadFile(path)
+	require.NoError(err)
+	require.Equal("token-1", string(data))
+
+	testutil.WaitForResult(func() (bool, error) {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return false, err
+		}
+		return string(data) != "token-1", fmt.Errorf("identity not renewed: %s", data)
+	}, func(err error) {
+		t.Fatal(err)
+	})
+}
+
+// TestTaskRunner_IdentityHook_Env asserts the identity is exposed in the
+// environment when configured.
+func TestTaskRunner_IdentityHook_Env(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	task := mock.Job().TaskGroups[0].Tasks[0]
+	task.Identity = &structs.WorkloadIdentity{Env: true}
+	rpc := &mockIdentityRPC{ttl: time.Hour}
+	h, taskDir, cleanup := testIdentityHook(t, task, rpc)
+	defer cleanup()
+
+	req := interfaces.TaskPrestartRequest{Task: task, TaskDir: taskDir}
+	var resp interfaces.TaskPrestartResponse
+	require.NoError(h.Prestart(context.Background(), &req, &resp))
+	require.Equal("token-1", resp.Env[structs.WorkloadIdentityEnv])
+
+	_, err := ioutil.ReadFile(filepath.Join(taskDir.SecretsDir, structs.WorkloadIdentityFile))
+	require.Error(err)
+}
+
+// TestTaskRunner_IdentityHook_Error asserts that failing to sign an identity
+// doesn't fail the task, and that a restored task keeps its previous identity.
+func TestTaskRunner_IdentityHook_Error(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	task := mock.Job().TaskGroups[0].Tasks[0]
+	rpc := &mockIdentityRPC{ttl: time.Hour, err: fmt.Errorf("no servers")}
+	h, taskDir, cleanup := testIdentityHook(t, task, rpc)
+	defer cleanup()
+
+	req := interfaces.TaskPrestartRequest{Task: task, TaskDir: taskDir}
+	var resp interfaces.TaskPrestartResponse
+	path := filepath.Join(taskDir.SecretsDir, structs.WorkloadIdentityFile)
+	require.NoError(ioutil.WriteFile(path, []byte("previous"), 0666))
+	require.NoError(h.Prestart(context.Background(), &req, &resp))
+	require.Empty(resp.Env)
+
+	data, err := ioutil.ReadFile(path)
+	require.NoError(err)
+	require.Equal("previous", string(data))
+}
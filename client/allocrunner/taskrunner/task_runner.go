@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/hcl2/hcldec"
 	"github.com/hashicorp/nomad/client/allocdir"
 	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
+	"github.com/hashicorp/nomad/client/allocrunner/taskrunner/getter"
 	"github.com/hashicorp/nomad/client/allocrunner/taskrunner/restarts"
 	"github.com/hashicorp/nomad/client/allocrunner/taskrunner/state"
 	"github.com/hashicorp/nomad/client/config"
@@ -25,6 +26,7 @@ import (
 	cstructs "github.com/hashicorp/nomad/client/structs"
 	"github.com/hashicorp/nomad/client/taskenv"
 	"github.com/hashicorp/nomad/client/vaultclient"
+	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/helper/pluginutils/hclspecutils"
 	"github.com/hashicorp/nomad/helper/pluginutils/hclutils"
 	"github.com/hashicorp/nomad/helper/uuid"
@@ -190,6 +192,12 @@ type TaskRunner struct {
 	// handlers
 	driverManager drivermanager.Manager
 
+	// artifactCache is the client's shared artifact cache; nil if disabled
+	artifactCache *getter.Cache
+
+	// rpcClient is used to read data from the servers
+	rpcClient config.RPCHandler
+
 	// maxEvents is the capacity of the TaskEvents on the TaskState.
 	// Defaults to defaultMaxEvents but overrideable for testing.
 	maxEvents int
@@ -235,6 +243,12 @@ type Config struct {
 	// handlers
 	DriverManager drivermanager.Manager
 
+	// ArtifactCache is the client's shared artifact cache and may be nil
+	ArtifactCache *getter.Cache
+
+	// RPCClient is used to read data from the servers
+	RPCClient config.RPCHandler
+
 	// ServersContactedCh is closed when the first GetClientAllocs call to
 	// servers succeeds and allocs are synced.
 	ServersContactedCh chan struct{}
@@ -285,6 +299,8 @@ func NewTaskRunner(config *Config) (*TaskRunner, error) {
 		waitCh:              make(chan struct{}),
 		devicemanager:       config.DeviceManager,
 		driverManager:       config.DriverManager,
+		artifactCache:       config.ArtifactCache,
+		rpcClient:           config.RPCClient,
 		maxEvents:           defaultMaxEvents,
 		serversContactedCh:  config.ServersContactedCh,
 	}
@@ -901,6 +917,18 @@ func (tr *TaskRunner) buildTaskConfig() *drivers.TaskConfig {
 	tr.networkIsolationLock.Lock()
 	defer tr.networkIsolationLock.Unlock()
 
+	// Tasks use the DNS configuration of the group network
+	var dns *drivers.DNSConfig
+	if tg := alloc.Job.LookupTaskGroup(alloc.TaskGroup); tg != nil && len(tg.Networks) > 0 {
+		if groupDNS := tg.Networks[0].DNS; groupDNS != nil {
+			dns = &drivers.DNSConfig{
+				Servers:  helper.CopySliceString(groupDNS.Servers),
+				Searches: helper.CopySliceString(groupDNS.Searches),
+				Options:  helper.CopySliceString(groupDNS.Options),
+			}
+		}
+	}
+
 	return &drivers.TaskConfig{
 		ID:            fmt.Sprintf("%s/%s/%s", alloc.ID, task.Name, invocationid),
 		Name:          task.Name,
@@ -924,6 +952,7 @@ func (tr *TaskRunner) buildTaskConfig() *drivers.TaskConfig {
 		StderrPath:       tr.logmonHookConfig.stderrFifo,
 		AllocID:          tr.allocID,
 		NetworkIsolation: tr.networkIsolationSpec,
+		DNS:              dns,
 	}
 }
 
@@ -1326,6 +1355,36 @@ func (tr *TaskRunner) setGaugeForCPU(ru *cstructs.TaskResourceUsage) {
 	}
 }
 
+func (tr *TaskRunner) setGaugeForNetwork(ru *cstructs.TaskResourceUsage) {
+	if tr.clientConfig.DisableTaggedMetrics {
+		return
+	}
+
+	metrics.SetGaugeWithLabels([]string{"client", "allocs", "network", "rx_bytes"},
+		float32(ru.ResourceUsage.NetworkStats.RxBytes), tr.baseLabels)
+	metrics.SetGaugeWithLabels([]string{"client", "allocs", "network", "tx_bytes"},
+		float32(ru.ResourceUsage.NetworkStats.TxBytes), tr.baseLabels)
+	metrics.SetGaugeWithLabels([]string{"client", "allocs", "network", "rx_packets"},
+		float32(ru.ResourceUsage.NetworkStats.RxPackets), tr.baseLabels)
+	metrics.SetGaugeWithLabels([]string{"client", "allocs", "network", "tx_packets"},
+		float32(ru.ResourceUsage.NetworkStats.TxPackets), tr.baseLabels)
+}
+
+func (tr *TaskRunner) setGaugeForBlockIO(ru *cstructs.TaskResourceUsage) {
+	if tr.clientConfig.DisableTaggedMetrics {
+		return
+	}
+
+	metrics.SetGaugeWithLabels([]string{"client", "allocs", "blkio", "read_bytes"},
+		float32(ru.ResourceUsage.BlockIOStats.ReadBytes), tr.baseLabels)
+	metrics.SetGaugeWithLabels([]string{"client", "allocs", "blkio", "write_bytes"},
+		float32(ru.ResourceUsage.BlockIOStats.WriteBytes), tr.baseLabels)
+	metrics.SetGaugeWithLabels([]string{"client", "allocs", "blkio", "read_ops"},
+		float32(ru.ResourceUsage.BlockIOStats.ReadOps), tr.baseLabels)
+	metrics.SetGaugeWithLabels([]string{"client", "allocs", "blkio", "write_ops"},
+		float32(ru.ResourceUsage.BlockIOStats.WriteOps), tr.baseLabels)
+}
+
 // emitStats emits resource usage stats of tasks to remote metrics collector
 // sinks
 func (tr *TaskRunner) emitStats(ru *cstructs.TaskResourceUsage) {
@@ -1344,6 +1403,15 @@ func (tr *TaskRunner) emitStats(ru *cstructs.TaskResourceUsage) {
 	} else {
 		tr.logger.Debug("Skipping cpu stats for allocation", "reason", "CpuStats is nil")
 	}
+
+	// Network and block io stats are not measured by every driver
+	if ru.ResourceUsage.NetworkStats != nil {
+		tr.setGaugeForNetwork(ru)
+	}
+
+	if ru.ResourceUsage.BlockIOStats != nil {
+		tr.setGaugeForBlockIO(ru)
+	}
 }
 
 // appendTaskEvent updates the task status by appending the new event.
@@ -63,8 +63,15 @@ func (tr *TaskRunner) initHooks() {
 		newTaskDirHook(tr, hookLogger),
 		newLogMonHook(tr.logmonHookConfig, hookLogger),
 		newDispatchHook(alloc, hookLogger),
+		newIdentityHook(&identityHookConfig{
+			alloc:        alloc,
+			task:         task,
+			clientConfig: tr.clientConfig,
+			rpcClient:    tr.rpcClient,
+			logger:       hookLogger,
+		}),
 		newVolumeHook(tr, hookLogger),
-		newArtifactHook(tr, hookLogger),
+		newArtifactHook(tr, tr.clientConfig.Artifact, tr.artifactCache, hookLogger),
 		newStatsHook(tr, tr.clientConfig.StatsCollectionInterval, hookLogger),
 		newDeviceHook(tr.devicemanager, hookLogger),
 		newEnvoyBootstrapHook(alloc, tr.clientConfig.ConsulConfig.Addr, hookLogger),
@@ -93,6 +100,8 @@ func (tr *TaskRunner) initHooks() {
 			templates:    task.Templates,
 			clientConfig: tr.clientConfig,
 			envBuilder:   tr.envBuilder,
+			allocID:      tr.allocID,
+			rpcClient:    tr.rpcClient,
 		}))
 	}
 
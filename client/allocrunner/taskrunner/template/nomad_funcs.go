@@ -0,0 +1,214 @@
+package template
+
+import (
+	"fmt"
+
+	dep "github.com/hashicorp/consul-template/dependency"
+	cttemplate "github.com/hashicorp/consul-template/template"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// nomadFuncs returns the Nomad template functions. They read cluster data
+// through the client's server connection and are watched with blocking
+// queries, so templates re-render when the data changes:
+//
+//	nomadAllocations "job" ["group"] - the running allocations of a job
+//	nomadJobMeta "job" ["group"]     - the meta of a job, merged with its group's
+//	nomadNodeMeta                    - the meta of the allocation's node
+func nomadFuncs(config *TaskTemplateManagerConfig) map[string]interface{} {
+	q := &nomadQuerier{config: config}
+	return map[string]interface{}{
+		"nomadAllocations": cttemplate.ExtFuncFactory(q.allocationsFunc),
+		"nomadJobMeta":     cttemplate.ExtFuncFactory(q.jobMetaFunc),
+		"nomadNodeMeta":    cttemplate.ExtFuncFactory(q.nodeMetaFunc),
+	}
+}
+
+// nomadQuerier builds the dependencies of the Nomad template functions.
+type nomadQuerier struct {
+	config *TaskTemplateManagerConfig
+}
+
+func (q *nomadQuerier) allocationsFunc(b *cttemplate.Brain, used, missing *dep.Set) interface{} {
+	return func(jobID string, group ...string) ([]*structs.TemplateAllocation, error) {
+		d, err := q.newDependency(nomadAllocationsQuery, jobID, group, false)
+		if err != nil {
+			return nil, err
+		}
+
+		used.Add(d)
+		if value, ok := b.Recall(d); ok {
+			return value.([]*structs.TemplateAllocation), nil
+		}
+		missing.Add(d)
+
+		return []*structs.TemplateAllocation{}, nil
+	}
+}
+
+func (q *nomadQuerier) jobMetaFunc(b *cttemplate.Brain, used, missing *dep.Set) interface{} {
+	return func(jobID string, group ...string) (map[string]string, error) {
+		d, err := q.newDependency(nomadMetaQuery, jobID, group, false)
+		if err != nil {
+			return nil, err
+		}
+		return recallMeta(b, used, missing, d), nil
+	}
+}
+
+func (q *nomadQuerier) nodeMetaFunc(b *cttemplate.Brain, used, missing *dep.Set) interface{} {
+	return func() (map[string]string, error) {
+		d, err := q.newDependency(nomadMetaQuery, "", nil, true)
+		if err != nil {
+			return nil, err
+		}
+		return recallMeta(b, used, missing, d), nil
+	}
+}
+
+func recallMeta(b *cttemplate.Brain, used, missing *dep.Set, d dep.Dependency) map[string]string {
+	used.Add(d)
+	if value, ok := b.Recall(d); ok {
+		return value.(map[string]string)
+	}
+	missing.Add(d)
+
+	return map[string]string{}
+}
+
+func (q *nomadQuerier) newDependency(method, jobID string, group []string, nodeMeta bool) (*nomadDependency, error) {
+	if !nodeMeta && jobID == "" {
+		return nil, fmt.Errorf("missing job ID")
+	}
+	if len(group) > 1 {
+		return nil, fmt.Errorf("at most one group may be given")
+	}
+
+	d := &nomadDependency{
+		config:   q.config,
+		method:   method,
+		jobID:    jobID,
+		nodeMeta: nodeMeta,
+		stopCh:   make(chan struct{}),
+	}
+	if len(group) == 1 {
+		d.group = group[0]
+	}
+	return d, nil
+}
+
+const (
+	// nomadAllocationsQuery and nomadMetaQuery are the server RPCs used by
+	// the Nomad template functions
+	nomadAllocationsQuery = "Node.TemplateAllocations"
+	nomadMetaQuery        = "Node.TemplateMeta"
+)
+
+// nomadDependency is a consul-template dependency reading cluster data from
+// the Nomad servers with blocking queries.
+type nomadDependency struct {
+	config   *TaskTemplateManagerConfig
+	method   string
+	jobID    string
+	group    string
+	nodeMeta bool
+	stopCh   chan struct{}
+}
+
+// nomadResult is the result of a Nomad template dependency query.
+type nomadResult struct {
+	data interface{}
+	meta structs.QueryMeta
+	err  error
+}
+
+func (d *nomadDependency) Fetch(_ *dep.ClientSet, opts *dep.QueryOptions) (interface{}, *dep.ResponseMetadata, error) {
+	select {
+	case <-d.stopCh:
+		return nil, nil, dep.ErrStopped
+	default:
+	}
+
+	if d.config.RPC == nil || d.config.ClientConfig.Node == nil {
+		return nil, nil, fmt.Errorf("%s: Nomad template functions are unavailable", d)
+	}
+
+	node := d.config.ClientConfig.Node
+	args := &structs.TemplateDataRequest{
+		NodeID:    node.ID,
+		SecretID:  node.SecretID,
+		AllocID:   d.config.AllocID,
+		JobID:     d.jobID,
+		TaskGroup: d.group,
+		NodeMeta:  d.nodeMeta,
+		QueryOptions: structs.QueryOptions{
+			Region:        d.config.ClientConfig.Region,
+			AllowStale:    true,
+			MinQueryIndex: opts.WaitIndex,
+			MaxQueryTime:  opts.WaitTime,
+		},
+	}
+
+	resultCh := make(chan *nomadResult, 1)
+	go func() {
+		var result nomadResult
+		switch d.method {
+		case nomadAllocationsQuery:
+			var reply structs.TemplateAllocationsResponse
+			result.err = d.config.RPC.RPC(d.method, args, &reply)
+			result.data, result.meta = reply.Allocations, reply.QueryMeta
+		default:
+			var reply structs.TemplateMetaResponse
+			result.err = d.config.RPC.RPC(d.method, args, &reply)
+			result.data, result.meta = reply.Meta, reply.QueryMeta
+		}
+		resultCh <- &result
+	}()
+
+	select {
+	case <-d.stopCh:
+		return nil, nil, dep.ErrStopped
+	case result := <-resultCh:
+		if result.err != nil {
+			return nil, nil, fmt.Errorf("%s: %v", d, result.err)
+		}
+
+		return result.data, &dep.ResponseMetadata{
+			LastIndex:   result.meta.Index,
+			LastContact: result.meta.LastContact,
+		}, nil
+	}
+}
+
+func (d *nomadDependency) CanShare() bool {
+	return true
+}
+
+func (d *nomadDependency) Stop() {
+	close(d.stopCh)
+}
+
+// Type returns TypeLocal as consul-template only uses the type to pick the
+// retry function of a dependency, and Nomad dependencies use the default one
+// like local files do.
+func (d *nomadDependency) Type() dep.Type {
+	return dep.TypeLocal
+}
+
+func (d *nomadDependency) String() string {
+	switch {
+	case d.method == nomadAllocationsQuery:
+		return fmt.Sprintf("nomad.allocations(%s%s)", d.jobID, d.groupSuffix())
+	case d.nodeMeta:
+		return "nomad.node.meta"
+	default:
+		return fmt.Sprintf("nomad.job.meta(%s%s)", d.jobID, d.groupSuffix())
+	}
+}
+
+func (d *nomadDependency) groupSuffix() string {
+	if d.group == "" {
+		return ""
+	}
+	return "." + d.group
+}
@@ -0,0 +1,169 @@
+package template
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// mockTemplateRPC serves the Nomad template function RPCs, blocking until
+// the data changes past the query's minimum index.
+type mockTemplateRPC struct {
+	lock   sync.Mutex
+	index  uint64
+	allocs []*structs.TemplateAllocation
+	meta   map[string]string
+
+	requests []*structs.TemplateDataRequest
+}
+
+func (m *mockTemplateRPC) update(allocs []*structs.TemplateAllocation, meta map[string]string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.index++
+	m.allocs = allocs
+	m.meta = meta
+}
+
+func (m *mockTemplateRPC) RPC(method string, args interface{}, reply interface{}) error {
+	req := args.(*structs.TemplateDataRequest)
+	deadline := time.Now().Add(req.MaxQueryTime)
+	for {
+		m.lock.Lock()
+		if m.index > req.MinQueryIndex || time.Now().After(deadline) {
+			break
+		}
+		m.lock.Unlock()
+		time.Sleep(10 * time.Millisecond)
+	}
+	defer m.lock.Unlock()
+	m.requests = append(m.requests, req)
+
+	switch method {
+	case nomadAllocationsQuery:
+		resp := reply.(*structs.TemplateAllocationsResponse)
+		resp.Allocations = m.allocs
+		resp.Index = m.index
+	case nomadMetaQuery:
+		resp := reply.(*structs.TemplateMetaResponse)
+		resp.Meta = m.meta
+		resp.Index = m.index
+	default:
+		return fmt.Errorf("unexpected method %q", method)
+	}
+	return nil
+}
+
+func templateAlloc(name, addr string, port int) *structs.TemplateAllocation {
+	return &structs.TemplateAllocation{
+		Name: name,
+		Ports: map[string]*structs.TemplatePort{
+			"http": {IP: addr, Port: port, Address: fmt.Sprintf("%s:%d", addr, port)},
+		},
+	}
+}
+
+func TestTaskTemplateManager_NomadFuncs(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	file := "peers.txt"
+	template := &structs.Template{
+		EmbeddedTmpl: `{{ range nomadAllocations "web" "frontend" }}{{ .Name }}={{ (index .Ports "http").Address }}
+{{ end }}owner={{ with nomadJobMeta "web" }}{{ .owner }}{{ end }} node={{ with nomadNodeMeta }}{{ .owner }}{{ end }}`,
+		DestPath:   file,
+		ChangeMode: structs.TemplateChangeModeNoop,
+	}
+
+	rpc := &mockTemplateRPC{}
+	rpc.update([]*structs.TemplateAllocation{
+		templateAlloc("web.frontend[0]", "10.0.0.1", 8080),
+	}, map[string]string{"owner": "armon"})
+
+	harness := newTestHarness(t, []*structs.Template{template}, false, false)
+	harness.config.Node = harness.node
+	harness.rpc = rpc
+	harness.start(t)
+	defer harness.stop()
+
+	// Wait for the unblock
+	select {
+	case <-harness.mockHooks.UnblockCh:
+	case <-time.After(time.Duration(5*testutil.TestMultiplier()) * time.Second):
+		t.Fatalf("Task unblock should have been called")
+	}
+
+	path := filepath.Join(harness.taskDir, file)
+	raw, err := ioutil.ReadFile(path)
+	require.NoError(err)
+	require.Equal("web.frontend[0]=10.0.0.1:8080\nowner=armon node=armon", string(raw))
+
+	// The requests identify the node and allocation, and node meta is only
+	// requested for the allocation's node
+	rpc.lock.Lock()
+	requests := rpc.requests
+	rpc.lock.Unlock()
+	nodeMeta := false
+	for _, req := range requests {
+		require.Equal(harness.node.ID, req.NodeID)
+		require.Equal(harness.node.SecretID, req.SecretID)
+		require.Equal(harness.allocID, req.AllocID)
+		if req.NodeMeta {
+			nodeMeta = true
+			require.Empty(req.JobID)
+		} else {
+			require.Equal("web", req.JobID)
+		}
+	}
+	require.True(nodeMeta)
+
+	// Re-render when the allocations change
+	rpc.update([]*structs.TemplateAllocation{
+		templateAlloc("web.frontend[0]", "10.0.0.1", 8080),
+		templateAlloc("web.frontend[1]", "10.0.0.2", 9090),
+	}, map[string]string{"owner": "armon"})
+
+	expected := "web.frontend[0]=10.0.0.1:8080\nweb.frontend[1]=10.0.0.2:9090\nowner=armon node=armon"
+	testutil.WaitForResult(func() (bool, error) {
+		raw, err := ioutil.ReadFile(path)
+		if err != nil {
+			return false, err
+		}
+		if s := string(raw); s != expected {
+			return false, fmt.Errorf("got %q, want %q", s, expected)
+		}
+		return true, nil
+	}, func(err error) {
+		t.Fatal(err)
+	})
+}
+
+func TestNomadDependency_String(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	q := &nomadQuerier{}
+	d, err := q.newDependency(nomadAllocationsQuery, "web", []string{"frontend"}, false)
+	require.NoError(err)
+	require.Equal("nomad.allocations(web.frontend)", d.String())
+
+	d, err = q.newDependency(nomadMetaQuery, "web", nil, false)
+	require.NoError(err)
+	require.Equal("nomad.job.meta(web)", d.String())
+
+	d, err = q.newDependency(nomadMetaQuery, "", nil, true)
+	require.NoError(err)
+	require.Equal("nomad.node.meta", d.String())
+
+	_, err = q.newDependency(nomadAllocationsQuery, "", nil, false)
+	require.Error(err)
+	_, err = q.newDependency(nomadAllocationsQuery, "web", []string{"a", "b"}, false)
+	require.Error(err)
+}
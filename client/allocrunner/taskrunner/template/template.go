@@ -63,6 +63,11 @@ type TaskTemplateManager struct {
 	// shutdown marks whether the manager has been shutdown
 	shutdown     bool
 	shutdownLock sync.Mutex
+
+	// handle is used to execute change scripts in the task. It is nil until
+	// the task has started.
+	handle     interfaces.ScriptExecutor
+	handleLock sync.Mutex
 }
 
 // TaskTemplateManagerConfig is used to configure an instance of the
@@ -87,6 +92,13 @@ type TaskTemplateManagerConfig struct {
 	// VaultToken is the Vault token for the task.
 	VaultToken string
 
+	// AllocID is the ID of the allocation the task belongs to.
+	AllocID string
+
+	// RPC is used by the Nomad template functions to read data from the
+	// servers.
+	RPC config.RPCHandler
+
 	// TaskDir is the task's directory
 	TaskDir string
 
@@ -340,6 +352,13 @@ WAIT:
 	}
 }
 
+// SetDriverHandle sets the executor used to run change scripts in the task.
+func (tm *TaskTemplateManager) SetDriverHandle(executor interfaces.ScriptExecutor) {
+	tm.handleLock.Lock()
+	defer tm.handleLock.Unlock()
+	tm.handle = executor
+}
+
 // handleTemplateRerenders is used to handle template render events after they
 // have all rendered. It takes action based on which set of templates re-render.
 // The passed allRenderedTime is the time at which all templates have rendered.
@@ -365,6 +384,7 @@ func (tm *TaskTemplateManager) handleTemplateRerenders(allRenderedTime time.Time
 			// A template has been rendered, figure out what to do
 			var handling []string
 			signals := make(map[string]struct{})
+			var scripts []*structs.ChangeScript
 			restart := false
 			var splay time.Duration
 
@@ -409,6 +429,8 @@ func (tm *TaskTemplateManager) handleTemplateRerenders(allRenderedTime time.Time
 						signals[tmpl.ChangeSignal] = struct{}{}
 					case structs.TemplateChangeModeRestart:
 						restart = true
+					case structs.TemplateChangeModeScript:
+						scripts = append(scripts, tmpl.ChangeScript)
 					case structs.TemplateChangeModeNoop:
 						continue
 					}
@@ -421,7 +443,7 @@ func (tm *TaskTemplateManager) handleTemplateRerenders(allRenderedTime time.Time
 				handling = append(handling, id)
 			}
 
-			if restart || len(signals) != 0 {
+			if restart || len(signals) != 0 || len(scripts) != 0 {
 				if splay != 0 {
 					ns := splay.Nanoseconds()
 					offset := rand.Int63n(ns)
@@ -465,11 +487,55 @@ func (tm *TaskTemplateManager) handleTemplateRerenders(allRenderedTime time.Time
 								SetDisplayMessage(fmt.Sprintf("Template failed to send signals %v: %v", flat, err)))
 					}
 				}
+
+				// Scripts are skipped when restarting since the task is
+				// starting again with the new templates
+				if !restart {
+					for _, script := range scripts {
+						tm.processScript(script)
+					}
+				}
 			}
 		}
 	}
 }
 
+// processScript executes a change script in the task and emits the result as
+// a task event. The task is killed if the script fails and fail_on_error is
+// set.
+func (tm *TaskTemplateManager) processScript(script *structs.ChangeScript) {
+	tm.handleLock.Lock()
+	handle := tm.handle
+	tm.handleLock.Unlock()
+
+	var failure string
+	if handle == nil {
+		failure = fmt.Sprintf("Template failed to run script %v: task is not running", script.Command)
+	} else {
+		_, exitCode, err := handle.Exec(script.Timeout, script.Command, script.Args)
+		switch {
+		case err != nil:
+			failure = fmt.Sprintf("Template failed to run script %v with arguments %v: %v", script.Command, script.Args, err)
+		case exitCode != 0:
+			failure = fmt.Sprintf("Template ran script %v with arguments %v on change but it exited with code %d", script.Command, script.Args, exitCode)
+		default:
+			tm.config.Events.EmitEvent(structs.NewTaskEvent(structs.TaskHookMessage).
+				SetDisplayMessage(fmt.Sprintf("Template successfully ran script %v with arguments %v", script.Command, script.Args)))
+			return
+		}
+	}
+
+	if script.FailOnError {
+		tm.config.Lifecycle.Kill(context.Background(),
+			structs.NewTaskEvent(structs.TaskKilling).
+				SetFailsTask().
+				SetDisplayMessage(failure))
+		return
+	}
+
+	tm.config.Events.EmitEvent(structs.NewTaskEvent(structs.TaskHookFailed).SetDisplayMessage(failure))
+}
+
 // allTemplatesNoop returns whether all the managed templates have change mode noop.
 func (tm *TaskTemplateManager) allTemplatesNoop() bool {
 	for _, tmpl := range tm.config.Templates {
@@ -549,6 +615,8 @@ func parseTemplateConfigs(config *TaskTemplateManagerConfig) (map[*ctconf.Templa
 	allowAbs := config.ClientConfig.ReadBoolDefault(hostSrcOption, true)
 	taskEnv := config.EnvBuilder.Build()
 
+	extFuncs := nomadFuncs(config)
+
 	ctmpls := make(map[*ctconf.TemplateConfig]*structs.Template, len(config.Templates))
 	for _, tmpl := range config.Templates {
 		var src, dest string
@@ -574,6 +642,7 @@ func parseTemplateConfigs(config *TaskTemplateManagerConfig) (map[*ctconf.Templa
 		ct.LeftDelim = &tmpl.LeftDelim
 		ct.RightDelim = &tmpl.RightDelim
 		ct.FunctionBlacklist = config.ClientConfig.TemplateConfig.FunctionBlacklist
+		ct.ExtFuncMap = extFuncs
 		if !config.ClientConfig.TemplateConfig.DisableSandbox {
 			ct.SandboxPath = &config.TaskDir
 		}
@@ -101,6 +101,22 @@ func (m *MockTaskHooks) EmitEvent(event *structs.TaskEvent) {
 
 func (m *MockTaskHooks) SetState(state string, event *structs.TaskEvent) {}
 
+// mockExecutor implements script executor interface
+type mockExecutor struct {
+	DesiredExit int
+	DesiredErr  error
+
+	lock  sync.Mutex
+	Execs []string
+}
+
+func (m *mockExecutor) Exec(timeout time.Duration, cmd string, args []string) ([]byte, int, error) {
+	m.lock.Lock()
+	m.Execs = append(m.Execs, cmd)
+	m.lock.Unlock()
+	return []byte{}, m.DesiredExit, m.DesiredErr
+}
+
 // testHarness is used to test the TaskTemplateManager by spinning up
 // Consul/Vault as needed
 type testHarness struct {
@@ -114,6 +130,8 @@ type testHarness struct {
 	taskDir    string
 	vault      *testutil.TestVault
 	consul     *ctestutil.TestServer
+	rpc        config.RPCHandler
+	allocID    string
 	emitRate   time.Duration
 }
 
@@ -139,6 +157,7 @@ func newTestHarness(t *testing.T, templates []*structs.Template, consul, vault b
 	task := a.Job.TaskGroups[0].Tasks[0]
 	task.Name = TestTaskName
 	harness.envBuilder = taskenv.NewBuilder(harness.node, a, task, region)
+	harness.allocID = a.ID
 
 	// Make a tempdir
 	d, err := ioutil.TempDir("", "ct_test")
@@ -181,6 +200,8 @@ func (h *testHarness) startWithErr() error {
 		Templates:            h.templates,
 		ClientConfig:         h.config,
 		VaultToken:           h.vaultToken,
+		AllocID:              h.allocID,
+		RPC:                  h.rpc,
 		TaskDir:              h.taskDir,
 		EnvBuilder:           h.envBuilder,
 		MaxTemplateEventRate: h.emitRate,
@@ -881,6 +902,133 @@ OUTER:
 	}
 }
 
+func TestTaskTemplateManager_Rerender_Script(t *testing.T) {
+	t.Parallel()
+	// Make a template that renders based on a key in Consul and runs a script
+	key1 := "bam"
+	content1_1 := "cat"
+	content1_2 := "dog"
+	embedded1 := fmt.Sprintf(`{{key "%s"}}`, key1)
+	file1 := "my.tmpl"
+	template := &structs.Template{
+		EmbeddedTmpl: embedded1,
+		DestPath:     file1,
+		ChangeMode:   structs.TemplateChangeModeScript,
+		ChangeScript: &structs.ChangeScript{
+			Command: "/bin/foo",
+			Args:    []string{},
+			Timeout: 5 * time.Second,
+		},
+	}
+
+	me := &mockExecutor{}
+	harness := newTestHarness(t, []*structs.Template{template}, true, false)
+	harness.start(t)
+	harness.manager.SetDriverHandle(me)
+	defer harness.stop()
+
+	// Ensure no unblock
+	select {
+	case <-harness.mockHooks.UnblockCh:
+		t.Fatalf("Task unblock should have not have been called")
+	case <-time.After(time.Duration(1*testutil.TestMultiplier()) * time.Second):
+	}
+
+	// Write the key to Consul
+	harness.consul.SetKV(t, key1, []byte(content1_1))
+
+	// Wait for the unblock
+	select {
+	case <-harness.mockHooks.UnblockCh:
+	case <-time.After(time.Duration(5*testutil.TestMultiplier()) * time.Second):
+		t.Fatalf("Task unblock should have been called")
+	}
+
+	// Update the key in Consul
+	harness.consul.SetKV(t, key1, []byte(content1_2))
+
+	// Wait for the script to run
+	timeout := time.After(time.Duration(5*testutil.TestMultiplier()) * time.Second)
+OUTER:
+	for {
+		select {
+		case <-harness.mockHooks.RestartCh:
+			t.Fatalf("Restart with script policy: %+v", harness.mockHooks)
+		case <-harness.mockHooks.KillCh:
+			t.Fatalf("Kill with successful script: %+v", harness.mockHooks)
+		case <-harness.mockHooks.EmitEventCh:
+			me.lock.Lock()
+			n := len(me.Execs)
+			me.lock.Unlock()
+			if n == 0 {
+				continue
+			}
+			break OUTER
+		case <-timeout:
+			t.Fatalf("Should have run the change script: %+v", harness.mockHooks)
+		}
+	}
+}
+
+func TestTaskTemplateManager_ProcessScript(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	newManager := func(exec *mockExecutor) (*TaskTemplateManager, *MockTaskHooks) {
+		hooks := NewMockTaskHooks()
+		tm := &TaskTemplateManager{
+			config: &TaskTemplateManagerConfig{
+				Lifecycle: hooks,
+				Events:    hooks,
+			},
+		}
+		if exec != nil {
+			tm.SetDriverHandle(exec)
+		}
+		return tm, hooks
+	}
+
+	script := &structs.ChangeScript{
+		Command: "/bin/foo",
+		Args:    []string{"-h"},
+		Timeout: 5 * time.Second,
+	}
+
+	// Successful scripts emit an informational event
+	exec := &mockExecutor{}
+	tm, hooks := newManager(exec)
+	tm.processScript(script)
+	require.Equal([]string{"/bin/foo"}, exec.Execs)
+	require.Len(hooks.Events, 1)
+	require.Equal(structs.TaskHookMessage, hooks.Events[0].Type)
+	require.Nil(hooks.KillEvent)
+
+	// Failed scripts emit a hook failure event
+	tm, hooks = newManager(&mockExecutor{DesiredExit: 1})
+	tm.processScript(script)
+	require.Len(hooks.Events, 1)
+	require.Equal(structs.TaskHookFailed, hooks.Events[0].Type)
+	require.Contains(hooks.Events[0].DisplayMessage, "exited with code 1")
+	require.Nil(hooks.KillEvent)
+
+	// Scripts can't run before the task has started
+	tm, hooks = newManager(nil)
+	tm.processScript(script)
+	require.Len(hooks.Events, 1)
+	require.Equal(structs.TaskHookFailed, hooks.Events[0].Type)
+	require.Contains(hooks.Events[0].DisplayMessage, "task is not running")
+
+	// Failed scripts kill the task with fail_on_error
+	failing := script.Copy()
+	failing.FailOnError = true
+	tm, hooks = newManager(&mockExecutor{DesiredErr: fmt.Errorf("exec not supported")})
+	tm.processScript(failing)
+	require.Empty(hooks.Events)
+	require.NotNil(hooks.KillEvent)
+	require.True(hooks.KillEvent.FailsTask)
+	require.Contains(hooks.KillEvent.DisplayMessage, "exec not supported")
+}
+
 func TestTaskTemplateManager_Rerender_Restart(t *testing.T) {
 	t.Parallel()
 	// Make a template that renders based on a key in Consul and sends restart
@@ -32,6 +32,13 @@ type templateHookConfig struct {
 
 	// envBuilder is the environment variable builder for the task.
 	envBuilder *taskenv.Builder
+
+	// allocID is the ID of the task's allocation
+	allocID string
+
+	// rpcClient is used by the Nomad template functions to read data from
+	// the servers
+	rpcClient config.RPCHandler
 }
 
 type templateHook struct {
@@ -49,6 +56,9 @@ type templateHook struct {
 
 	// taskDir is the task directory
 	taskDir string
+
+	// driverHandle is used by the template manager to run change scripts
+	driverHandle ti.ScriptExecutor
 }
 
 func newTemplateHook(config *templateHookConfig) *templateHook {
@@ -98,6 +108,8 @@ func (h *templateHook) newManager() (unblock chan struct{}, err error) {
 		Templates:            h.config.templates,
 		ClientConfig:         h.config.clientConfig,
 		VaultToken:           h.vaultToken,
+		AllocID:              h.config.allocID,
+		RPC:                  h.config.rpcClient,
 		TaskDir:              h.taskDir,
 		EnvBuilder:           h.config.envBuilder,
 		MaxTemplateEventRate: template.DefaultMaxTemplateEventRate,
@@ -107,10 +119,28 @@ func (h *templateHook) newManager() (unblock chan struct{}, err error) {
 		return nil, err
 	}
 
+	if h.driverHandle != nil {
+		m.SetDriverHandle(h.driverHandle)
+	}
+
 	h.templateManager = m
 	return unblock, nil
 }
 
+// Poststart passes the task's driver handle to the template manager so it can
+// run change scripts.
+func (h *templateHook) Poststart(ctx context.Context, req *interfaces.TaskPoststartRequest, resp *interfaces.TaskPoststartResponse) error {
+	h.managerLock.Lock()
+	defer h.managerLock.Unlock()
+
+	h.driverHandle = req.DriverExec
+	if h.templateManager != nil {
+		h.templateManager.SetDriverHandle(req.DriverExec)
+	}
+
+	return nil
+}
+
 func (h *templateHook) Stop(ctx context.Context, req *interfaces.TaskStopRequest, resp *interfaces.TaskStopResponse) error {
 	h.managerLock.Lock()
 	defer h.managerLock.Unlock()
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/nomad/client/allocrunner"
 	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
 	arstate "github.com/hashicorp/nomad/client/allocrunner/state"
+	"github.com/hashicorp/nomad/client/allocrunner/taskrunner/getter"
 	"github.com/hashicorp/nomad/client/allocwatcher"
 	"github.com/hashicorp/nomad/client/config"
 	consulApi "github.com/hashicorp/nomad/client/consul"
@@ -243,6 +244,10 @@ type Client struct {
 	// in the node automatically
 	garbageCollector *AllocGarbageCollector
 
+	// artifactCache is shared by all allocations to avoid downloading the
+	// same artifact repeatedly. It is nil if the cache is disabled.
+	artifactCache *getter.Cache
+
 	// clientACLResolver holds the ACL resolution state
 	clientACLResolver
 
@@ -415,6 +420,16 @@ func NewClient(cfg *config.Config, consulCatalog consul.CatalogAPI, consulServic
 	statsCollector := stats.NewHostStatsCollector(c.logger, c.config.AllocDir, c.devicemanager.AllStats)
 	c.hostStatsCollector = statsCollector
 
+	// Add the artifact cache
+	if !cfg.DisableArtifactCache && cfg.ArtifactCacheMaxMB > 0 {
+		cacheDir := filepath.Join(cfg.StateDir, "artifacts")
+		cache, err := getter.NewCache(c.logger, cfg.Artifact, cacheDir, int64(cfg.ArtifactCacheMaxMB)*MB)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create artifact cache: %v", err)
+		}
+		c.artifactCache = cache
+	}
+
 	// Add the garbage collector
 	gcConfig := &GCConfig{
 		MaxAllocs:           cfg.GCMaxAllocs,
@@ -424,6 +439,9 @@ func NewClient(cfg *config.Config, consulCatalog consul.CatalogAPI, consulServic
 		ParallelDestroys:    cfg.GCParallelDestroys,
 		ReservedDiskMB:      cfg.Node.Reserved.DiskMB,
 	}
+	if c.artifactCache != nil {
+		gcConfig.ArtifactCache = c.artifactCache
+	}
 	c.garbageCollector = NewAllocGarbageCollector(c.logger, statsCollector, c, gcConfig)
 	go c.garbageCollector.Run()
 
@@ -1047,6 +1065,8 @@ func (c *Client) restoreState() error {
 			PrevAllocMigrator:   prevAllocMigrator,
 			DeviceManager:       c.devicemanager,
 			DriverManager:       c.drivermanager,
+			ArtifactCache:       c.artifactCache,
+			RPCClient:           c,
 			ServersContactedCh:  c.serversContactedCh,
 		}
 		c.configLock.RUnlock()
@@ -2298,6 +2318,8 @@ func (c *Client) addAlloc(alloc *structs.Allocation, migrateToken string) error
 		PrevAllocMigrator:   prevAllocMigrator,
 		DeviceManager:       c.devicemanager,
 		DriverManager:       c.drivermanager,
+		ArtifactCache:       c.artifactCache,
+		RPCClient:           c,
 	}
 	c.configLock.RUnlock()
 
@@ -0,0 +1,58 @@
+package config
+
+import (
+	"time"
+
+	"github.com/hashicorp/nomad/helper"
+)
+
+// ArtifactConfig is the configuration specific to downloading artifacts.
+type ArtifactConfig struct {
+	// DownloadTimeout is the maximum time a single artifact may take to
+	// download and unpack.
+	DownloadTimeout time.Duration
+
+	// HTTPMaxBytes is the maximum size of an artifact downloaded over HTTP.
+	HTTPMaxBytes int64
+
+	// DecompressionFileCountLimit is the maximum number of files an archive
+	// may contain.
+	DecompressionFileCountLimit int
+
+	// DecompressionSizeLimit is the maximum total size of the files
+	// unpacked from an archive.
+	DecompressionSizeLimit int64
+
+	// ProtocolAllowlist restricts artifacts to the given getter protocols.
+	// All supported protocols are allowed when empty.
+	ProtocolAllowlist []string
+
+	// ProtocolDenylist disallows artifacts using the given getter protocols.
+	ProtocolDenylist []string
+
+	// DisableSandbox downloads artifacts in the client process instead of
+	// an isolated child process.
+	DisableSandbox bool
+}
+
+// DefaultArtifactConfig returns the default artifact configuration.
+func DefaultArtifactConfig() *ArtifactConfig {
+	return &ArtifactConfig{
+		DownloadTimeout:             30 * time.Minute,
+		HTTPMaxBytes:                100 << 30,
+		DecompressionFileCountLimit: 4096,
+		DecompressionSizeLimit:      100 << 30,
+	}
+}
+
+func (c *ArtifactConfig) Copy() *ArtifactConfig {
+	if c == nil {
+		return nil
+	}
+
+	nc := new(ArtifactConfig)
+	*nc = *c
+	nc.ProtocolAllowlist = helper.CopySliceString(nc.ProtocolAllowlist)
+	nc.ProtocolDenylist = helper.CopySliceString(nc.ProtocolDenylist)
+	return nc
+}
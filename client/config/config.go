@@ -201,6 +201,25 @@ type Config struct {
 	// DisableRemoteExec disables remote exec targeting tasks on this client
 	DisableRemoteExec bool
 
+	// ArtifactCacheMaxMB is the maximum size of the artifact cache shared
+	// by allocations on this client
+	ArtifactCacheMaxMB int
+
+	// DisableArtifactCache disables caching artifacts across allocations
+	DisableArtifactCache bool
+
+	// Artifact configures the limits and sandboxing of artifact downloads
+	Artifact *ArtifactConfig
+
+	// EnforceEphemeralDisk enables killing allocations whose alloc directory
+	// grows beyond their requested ephemeral disk size.
+	EnforceEphemeralDisk bool
+
+	// EphemeralDiskCheckInterval is the interval at which the disk usage of
+	// each allocation directory is measured when enforcing ephemeral disk
+	// limits.
+	EphemeralDiskCheckInterval time.Duration
+
 	// TemplateConfig includes configuration for template rendering
 	TemplateConfig *ClientTemplateConfig
 
@@ -229,6 +248,10 @@ type Config struct {
 	// be specified with colon delimited
 	CNIPath string
 
+	// CNIConfigDir is the directory searched for the CNI network
+	// configurations used by the cni/<name> network mode
+	CNIConfigDir string
+
 	// BridgeNetworkName is the name to use for the bridge created in bridge
 	// networking mode. This defaults to 'nomad' if not set
 	BridgeNetworkName string
@@ -240,6 +263,9 @@ type Config struct {
 
 	// HostVolumes is a map of the configured host volumes by name.
 	HostVolumes map[string]*structs.ClientHostVolumeConfig
+
+	// HostNetworks are the configured named host networks
+	HostNetworks []*structs.ClientHostNetworkConfig
 }
 
 type ClientTemplateConfig struct {
@@ -265,31 +291,36 @@ func (c *Config) Copy() *Config {
 	nc.Servers = helper.CopySliceString(nc.Servers)
 	nc.Options = helper.CopyMapStringString(nc.Options)
 	nc.HostVolumes = structs.CopyMapStringClientHostVolumeConfig(nc.HostVolumes)
+	nc.HostNetworks = structs.CopySliceClientHostNetworkConfig(nc.HostNetworks)
 	nc.ConsulConfig = c.ConsulConfig.Copy()
 	nc.VaultConfig = c.VaultConfig.Copy()
 	nc.TemplateConfig = c.TemplateConfig.Copy()
+	nc.Artifact = c.Artifact.Copy()
 	return nc
 }
 
 // DefaultConfig returns the default configuration
 func DefaultConfig() *Config {
 	return &Config{
-		Version:                 version.GetVersion(),
-		VaultConfig:             config.DefaultVaultConfig(),
-		ConsulConfig:            config.DefaultConsulConfig(),
-		LogOutput:               os.Stderr,
-		Region:                  "global",
-		StatsCollectionInterval: 1 * time.Second,
-		TLSConfig:               &config.TLSConfig{},
-		LogLevel:                "DEBUG",
-		GCInterval:              1 * time.Minute,
-		GCParallelDestroys:      2,
-		GCDiskUsageThreshold:    80,
-		GCInodeUsageThreshold:   70,
-		GCMaxAllocs:             50,
-		NoHostUUID:              true,
-		DisableTaggedMetrics:    false,
-		DisableRemoteExec:       false,
+		Version:                    version.GetVersion(),
+		VaultConfig:                config.DefaultVaultConfig(),
+		ConsulConfig:               config.DefaultConsulConfig(),
+		LogOutput:                  os.Stderr,
+		Region:                     "global",
+		StatsCollectionInterval:    1 * time.Second,
+		TLSConfig:                  &config.TLSConfig{},
+		LogLevel:                   "DEBUG",
+		GCInterval:                 1 * time.Minute,
+		GCParallelDestroys:         2,
+		GCDiskUsageThreshold:       80,
+		GCInodeUsageThreshold:      70,
+		GCMaxAllocs:                50,
+		NoHostUUID:                 true,
+		DisableTaggedMetrics:       false,
+		DisableRemoteExec:          false,
+		ArtifactCacheMaxMB:         1024,
+		Artifact:                   DefaultArtifactConfig(),
+		EphemeralDiskCheckInterval: 30 * time.Second,
 		TemplateConfig: &ClientTemplateConfig{
 			FunctionBlacklist: []string{"plugin"},
 			DisableSandbox:    false,
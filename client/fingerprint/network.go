@@ -101,8 +101,15 @@ func (f *NetworkFingerprint) Fingerprint(req *FingerprintRequest, resp *Fingerpr
 		Networks: nwResources,
 	}
 
+	// Find the addresses of the named host networks
+	hostNetworks, err := f.createHostNetworks(cfg.HostNetworks, disallowLinkLocal)
+	if err != nil {
+		return err
+	}
+
 	resp.NodeResources = &structs.NodeResources{
-		Networks: nwResources,
+		Networks:     nwResources,
+		HostNetworks: hostNetworks,
 	}
 
 	for _, nwResource := range nwResources {
@@ -174,6 +181,108 @@ func (f *NetworkFingerprint) createNetworkResources(throughput int, intf *net.In
 	return nwResources, nil
 }
 
+// createHostNetworks finds the address of each configured host network. The
+// address is the first on the configured interface, or on any interface if
+// none is given, which is within the configured CIDR. Host networks without a
+// matching address are skipped so that the scheduler avoids the node.
+func (f *NetworkFingerprint) createHostNetworks(configs []*structs.ClientHostNetworkConfig, disallowLinkLocal bool) ([]*structs.NodeHostNetwork, error) {
+	var hostNetworks []*structs.NodeHostNetwork
+	for _, cfg := range configs {
+		if cfg.CIDR == "" && cfg.Interface == "" {
+			return nil, fmt.Errorf("host network %q must set a cidr or interface", cfg.Name)
+		}
+
+		var cidr *net.IPNet
+		if cfg.CIDR != "" {
+			var err error
+			if _, cidr, err = net.ParseCIDR(cfg.CIDR); err != nil {
+				return nil, fmt.Errorf("invalid cidr for host network %q: %v", cfg.Name, err)
+			}
+		}
+
+		if cfg.ReservedPorts != "" {
+			if _, err := structs.ParsePortRanges(cfg.ReservedPorts); err != nil {
+				return nil, fmt.Errorf("invalid reserved_ports for host network %q: %v", cfg.Name, err)
+			}
+		}
+
+		var intfs []net.Interface
+		if cfg.Interface != "" {
+			intf, err := f.interfaceDetector.InterfaceByName(cfg.Interface)
+			if err != nil {
+				return nil, fmt.Errorf("Error while detecting interface %s of host network %q: %v", cfg.Interface, cfg.Name, err)
+			}
+			intfs = []net.Interface{*intf}
+		} else {
+			var err error
+			if intfs, err = f.interfaceDetector.Interfaces(); err != nil {
+				return nil, err
+			}
+		}
+
+		hn, err := f.findHostNetworkAddress(cfg, intfs, cidr, disallowLinkLocal)
+		if err != nil {
+			return nil, err
+		}
+		if hn == nil {
+			f.logger.Warn("no address found for host network", "name", cfg.Name,
+				"interface", cfg.Interface, "cidr", cfg.CIDR)
+			continue
+		}
+
+		f.logger.Debug("detected host network", "name", hn.Name, "interface", hn.Device, "IP", hn.IP)
+		hostNetworks = append(hostNetworks, hn)
+	}
+
+	return hostNetworks, nil
+}
+
+// findHostNetworkAddress returns the host network built from the first
+// address of the interfaces which is within cidr, if cidr is set. As with the
+// node's network, link-local addresses are only used if nothing else matches.
+func (f *NetworkFingerprint) findHostNetworkAddress(cfg *structs.ClientHostNetworkConfig, intfs []net.Interface,
+	cidr *net.IPNet, disallowLinkLocal bool) (*structs.NodeHostNetwork, error) {
+
+	var linkLocal *structs.NodeHostNetwork
+	for i := range intfs {
+		intf := &intfs[i]
+		addrs, err := f.interfaceDetector.Addrs(intf)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, addr := range addrs {
+			var ip net.IP
+			switch v := (addr).(type) {
+			case *net.IPNet:
+				ip = v.IP
+			case *net.IPAddr:
+				ip = v.IP
+			}
+
+			if ip == nil || (cidr != nil && !cidr.Contains(ip)) {
+				continue
+			}
+
+			hn := &structs.NodeHostNetwork{
+				Name:          cfg.Name,
+				Device:        intf.Name,
+				IP:            ip.String(),
+				ReservedPorts: cfg.ReservedPorts,
+			}
+			if ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() {
+				if linkLocal == nil && !disallowLinkLocal {
+					linkLocal = hn
+				}
+				continue
+			}
+			return hn, nil
+		}
+	}
+
+	return linkLocal, nil
+}
+
 // Returns the interface with the name passed by user. If the name is blank, we
 // use the interface attached to the default route.
 func (f *NetworkFingerprint) findInterface(deviceName string) (*net.Interface, error) {
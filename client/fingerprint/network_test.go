@@ -437,3 +437,40 @@ func TestNetworkFingerPrint_LinkLocal_Disallowed(t *testing.T) {
 		t.Fatalf("should not apply attributes")
 	}
 }
+
+func TestNetworkFingerPrint_HostNetworks(t *testing.T) {
+	f := &NetworkFingerprint{logger: testlog.HCLogger(t), interfaceDetector: &NetworkInterfaceDetectorMultipleInterfaces{}}
+	node := &structs.Node{
+		Attributes: make(map[string]string),
+	}
+	cfg := &config.Config{
+		NetworkSpeed:     100,
+		NetworkInterface: "eth0",
+		HostNetworks: []*structs.ClientHostNetworkConfig{
+			{Name: "public", CIDR: "2003:DB8::/48", ReservedPorts: "22,80"},
+			{Name: "internal", Interface: "eth4"},
+			{Name: "missing", CIDR: "192.168.0.0/16"},
+		},
+	}
+
+	request := &FingerprintRequest{Config: cfg, Node: node}
+	var response FingerprintResponse
+	if err := f.Fingerprint(request, &response); err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	expected := structs.HostNetworks{
+		{Name: "public", Device: "eth1", IP: "2003:db8::", ReservedPorts: "22,80"},
+		{Name: "internal", Device: "eth4", IP: "100.64.0.0"},
+	}
+	if !response.NodeResources.HostNetworks.Equals(expected) {
+		t.Fatalf("bad host networks: %#v", response.NodeResources.HostNetworks)
+	}
+
+	// A host network must be identified by a cidr or interface
+	cfg.HostNetworks = []*structs.ClientHostNetworkConfig{{Name: "bad"}}
+	response = FingerprintResponse{}
+	if err := f.Fingerprint(request, &response); err == nil {
+		t.Fatalf("expected error")
+	}
+}
@@ -26,6 +26,10 @@ type GCConfig struct {
 	Interval            time.Duration
 	ReservedDiskMB      int
 	ParallelDestroys    int
+
+	// ArtifactCache is evicted from before terminal allocations are
+	// destroyed when disk usage is over the threshold. May be nil.
+	ArtifactCache ArtifactCache
 }
 
 // AllocCounter is used by AllocGarbageCollector to discover how many un-GC'd
@@ -34,6 +38,14 @@ type AllocCounter interface {
 	NumAllocs() int
 }
 
+// ArtifactCache is used by AllocGarbageCollector to free disk space held by
+// cached artifacts and is generally fulfilled by the client's artifact cache.
+type ArtifactCache interface {
+	// EvictOldest removes the least recently used artifact and returns
+	// false if nothing could be evicted.
+	EvictOldest() bool
+}
+
 // AllocGarbageCollector garbage collects terminated allocations on a node
 type AllocGarbageCollector struct {
 	config *GCConfig
@@ -130,6 +142,7 @@ func (a *AllocGarbageCollector) keepUsageBelowThreshold() error {
 		// See if we are below thresholds for used disk space and inode usage
 		diskStats := a.statsCollector.Stats().AllocDirStats
 		reason := ""
+		diskPressure := false
 		logf := a.logger.Warn
 
 		liveAllocs := a.allocCounter.NumAllocs()
@@ -138,9 +151,11 @@ func (a *AllocGarbageCollector) keepUsageBelowThreshold() error {
 		case diskStats.UsedPercent > a.config.DiskUsageThreshold:
 			reason = fmt.Sprintf("disk usage of %.0f is over gc threshold of %.0f",
 				diskStats.UsedPercent, a.config.DiskUsageThreshold)
+			diskPressure = true
 		case diskStats.InodesUsedPercent > a.config.InodeUsageThreshold:
 			reason = fmt.Sprintf("inode usage of %.0f is over gc threshold of %.0f",
 				diskStats.InodesUsedPercent, a.config.InodeUsageThreshold)
+			diskPressure = true
 		case liveAllocs > a.config.MaxAllocs:
 			// if we're unable to gc, don't WARN until at least 2x over limit
 			if liveAllocs < (a.config.MaxAllocs * 2) {
@@ -154,6 +169,13 @@ func (a *AllocGarbageCollector) keepUsageBelowThreshold() error {
 			break
 		}
 
+		// Cached artifacts can be downloaded again, so evict them before
+		// destroying allocations
+		if diskPressure && a.config.ArtifactCache != nil && a.config.ArtifactCache.EvictOldest() {
+			a.logger.Debug("evicted cached artifact", "reason", reason)
+			continue
+		}
+
 		// Collect an allocation
 		gcAlloc := a.allocRunners.Pop()
 		if gcAlloc == nil {
@@ -89,6 +89,21 @@ func (m *MockAllocCounter) NumAllocs() int {
 	return m.allocs
 }
 
+// MockArtifactCache implements the ArtifactCache interface.
+type MockArtifactCache struct {
+	entries int
+	evicted int
+}
+
+func (m *MockArtifactCache) EvictOldest() bool {
+	if m.entries == 0 {
+		return false
+	}
+	m.entries--
+	m.evicted++
+	return true
+}
+
 type MockStatsCollector struct {
 	availableValues []uint64
 	usedPercents    []float64
@@ -563,3 +578,34 @@ func TestAllocGarbageCollector_UsedPercentThreshold(t *testing.T) {
 		t.Fatalf("gcAlloc: %v", gcAlloc)
 	}
 }
+
+func TestAllocGarbageCollector_UsedPercentThreshold_ArtifactCache(t *testing.T) {
+	t.Parallel()
+	logger := testlog.HCLogger(t)
+	statsCollector := &MockStatsCollector{}
+	cache := &MockArtifactCache{entries: 1}
+	conf := gcConfig()
+	conf.ReservedDiskMB = 20
+	conf.ArtifactCache = cache
+	gc := NewAllocGarbageCollector(logger, statsCollector, &MockAllocCounter{}, conf)
+
+	ar1, cleanup1 := allocrunner.TestAllocRunnerFromAlloc(t, mock.Alloc())
+	defer cleanup1()
+
+	go ar1.Run()
+
+	gc.MarkForCollection(ar1.Alloc().ID, ar1)
+
+	// Exit the alloc runners
+	exitAllocRunner(ar1)
+
+	statsCollector.availableValues = []uint64{1000, 800}
+	statsCollector.usedPercents = []float64{85, 60}
+	statsCollector.inodePercents = []float64{50, 30}
+
+	require.NoError(t, gc.keepUsageBelowThreshold())
+
+	// The cached artifact should be evicted instead of the alloc runner
+	require.Equal(t, 1, cache.evicted)
+	require.NotNil(t, gc.allocRunners.Pop())
+}
@@ -194,6 +194,38 @@ func TestStateDB_TaskState(t *testing.T) {
 	})
 }
 
+// TestStateDB_NetworkStatus asserts the behavior of the alloc network status
+// StateDB methods.
+func TestStateDB_NetworkStatus(t *testing.T) {
+	t.Parallel()
+
+	testDB(t, func(t *testing.T, db StateDB) {
+		require := require.New(t)
+
+		// Getting nonexistent state should return nil
+		ns, err := db.GetNetworkStatus("allocid")
+		require.NoError(err)
+		require.Nil(ns)
+
+		// Putting NetworkStatus without first putting the allocation should work
+		status := &structs.AllocNetworkStatus{
+			InterfaceName: "eth0",
+			Address:       "10.0.0.2",
+		}
+		require.NoError(db.PutNetworkStatus("allocid", status))
+
+		ns, err = db.GetNetworkStatus("allocid")
+		require.NoError(err)
+		require.Equal(status, ns)
+
+		// Deleting the allocation should remove the state
+		require.NoError(db.DeleteAllocationBucket("allocid"))
+		ns, err = db.GetNetworkStatus("allocid")
+		require.NoError(err)
+		require.Nil(ns)
+	})
+}
+
 // TestStateDB_DeviceManager asserts the behavior of device manager state related StateDB
 // methods.
 func TestStateDB_DeviceManager(t *testing.T) {
@@ -19,6 +19,9 @@ type MemDB struct {
 	// alloc_id -> value
 	deployStatus map[string]*structs.AllocDeploymentStatus
 
+	// alloc_id -> value
+	networkStatus map[string]*structs.AllocNetworkStatus
+
 	// alloc_id -> task_name -> value
 	localTaskState map[string]map[string]*state.LocalState
 	taskState      map[string]map[string]*structs.TaskState
@@ -39,6 +42,7 @@ func NewMemDB(logger hclog.Logger) *MemDB {
 	return &MemDB{
 		allocs:         make(map[string]*structs.Allocation),
 		deployStatus:   make(map[string]*structs.AllocDeploymentStatus),
+		networkStatus:  make(map[string]*structs.AllocNetworkStatus),
 		localTaskState: make(map[string]map[string]*state.LocalState),
 		taskState:      make(map[string]map[string]*structs.TaskState),
 		logger:         logger,
@@ -85,6 +89,19 @@ func (m *MemDB) PutDeploymentStatus(allocID string, ds *structs.AllocDeploymentS
 	return nil
 }
 
+func (m *MemDB) GetNetworkStatus(allocID string) (*structs.AllocNetworkStatus, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.networkStatus[allocID], nil
+}
+
+func (m *MemDB) PutNetworkStatus(allocID string, ns *structs.AllocNetworkStatus) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.networkStatus[allocID] = ns
+	return nil
+}
+
 func (m *MemDB) GetTaskRunnerState(allocID string, taskName string) (*state.LocalState, *structs.TaskState, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
@@ -159,6 +176,8 @@ func (m *MemDB) DeleteAllocationBucket(allocID string) error {
 	defer m.mu.Unlock()
 
 	delete(m.allocs, allocID)
+	delete(m.deployStatus, allocID)
+	delete(m.networkStatus, allocID)
 	delete(m.taskState, allocID)
 	delete(m.localTaskState, allocID)
 
@@ -256,16 +256,80 @@ func (cs *CpuStats) Add(other *CpuStats) {
 	cs.Measured = joinStringSet(cs.Measured, other.Measured)
 }
 
-// ResourceUsage holds information related to cpu and memory stats
+// NetworkStats holds network usage related stats
+type NetworkStats struct {
+	RxBytes   uint64
+	TxBytes   uint64
+	RxPackets uint64
+	TxPackets uint64
+
+	// A list of fields whose values were actually sampled
+	Measured []string
+}
+
+func (ns *NetworkStats) Add(other *NetworkStats) {
+	if other == nil {
+		return
+	}
+
+	ns.RxBytes += other.RxBytes
+	ns.TxBytes += other.TxBytes
+	ns.RxPackets += other.RxPackets
+	ns.TxPackets += other.TxPackets
+	ns.Measured = joinStringSet(ns.Measured, other.Measured)
+}
+
+// BlockIOStats holds block device io related stats
+type BlockIOStats struct {
+	ReadBytes  uint64
+	WriteBytes uint64
+	ReadOps    uint64
+	WriteOps   uint64
+
+	// A list of fields whose values were actually sampled
+	Measured []string
+}
+
+func (bs *BlockIOStats) Add(other *BlockIOStats) {
+	if other == nil {
+		return
+	}
+
+	bs.ReadBytes += other.ReadBytes
+	bs.WriteBytes += other.WriteBytes
+	bs.ReadOps += other.ReadOps
+	bs.WriteOps += other.WriteOps
+	bs.Measured = joinStringSet(bs.Measured, other.Measured)
+}
+
+// ResourceUsage holds information related to cpu, memory, network and block
+// io stats
 type ResourceUsage struct {
-	MemoryStats *MemoryStats
-	CpuStats    *CpuStats
-	DeviceStats []*device.DeviceGroupStats
+	MemoryStats  *MemoryStats
+	CpuStats     *CpuStats
+	NetworkStats *NetworkStats
+	BlockIOStats *BlockIOStats
+	DeviceStats  []*device.DeviceGroupStats
 }
 
 func (ru *ResourceUsage) Add(other *ResourceUsage) {
 	ru.MemoryStats.Add(other.MemoryStats)
 	ru.CpuStats.Add(other.CpuStats)
+
+	// Network and block io stats are optional so only aggregate them when
+	// they have been measured
+	if other.NetworkStats != nil {
+		if ru.NetworkStats == nil {
+			ru.NetworkStats = &NetworkStats{}
+		}
+		ru.NetworkStats.Add(other.NetworkStats)
+	}
+	if other.BlockIOStats != nil {
+		if ru.BlockIOStats == nil {
+			ru.BlockIOStats = &BlockIOStats{}
+		}
+		ru.BlockIOStats.Add(other.BlockIOStats)
+	}
 	ru.DeviceStats = append(ru.DeviceStats, other.DeviceStats...)
 }
 
@@ -54,6 +54,13 @@ const (
 	// AllocIndex is the environment variable for passing the allocation index.
 	AllocIndex = "NOMAD_ALLOC_INDEX"
 
+	// ArrayIndex is the environment variable for passing the index of the
+	// allocation within an array.
+	ArrayIndex = "NOMAD_ARRAY_INDEX"
+
+	// ArraySize is the environment variable for passing the size of an array.
+	ArraySize = "NOMAD_ARRAY_SIZE"
+
 	// Datacenter is the environment variable for passing the datacenter in which the alloc is running.
 	Datacenter = "NOMAD_DC"
 
@@ -308,6 +315,7 @@ type Builder struct {
 	memLimit         int64
 	taskName         string
 	allocIndex       int
+	arraySize        int
 	datacenter       string
 	namespace        string
 	region           string
@@ -331,6 +339,10 @@ type Builder struct {
 	// and affect network env vars.
 	networks []*structs.NetworkResource
 
+	// hostNetworks are the node's named host networks used to resolve the
+	// IP of ports allocated on a host network.
+	hostNetworks structs.HostNetworks
+
 	// hookEnvs are env vars set by hooks and stored by hook name to
 	// support adding/removing vars from multiple hooks (eg HookA adds A:1,
 	// HookB adds A:2, HookA removes A, A should equal 2)
@@ -408,6 +420,10 @@ func (b *Builder) Build() *TaskEnv {
 	if b.allocIndex != -1 {
 		envMap[AllocIndex] = strconv.Itoa(b.allocIndex)
 	}
+	if b.arraySize != 0 {
+		envMap[ArrayIndex] = strconv.Itoa(b.allocIndex)
+		envMap[ArraySize] = strconv.Itoa(b.arraySize)
+	}
 	if b.taskName != "" {
 		envMap[TaskName] = b.taskName
 	}
@@ -428,7 +444,7 @@ func (b *Builder) Build() *TaskEnv {
 	}
 
 	// Build the network related env vars
-	buildNetworkEnv(envMap, b.networks, b.driverNetwork)
+	buildNetworkEnv(envMap, b.networks, b.hostNetworks, b.driverNetwork)
 
 	// Build the addr of the other tasks
 	for k, v := range b.otherPorts {
@@ -602,6 +618,9 @@ func (b *Builder) setAlloc(alloc *structs.Allocation) *Builder {
 	}
 
 	tg := alloc.Job.LookupTaskGroup(alloc.TaskGroup)
+	if tg.Array != nil {
+		b.arraySize = tg.Array.Size
+	}
 
 	// COMPAT(0.11): Remove in 0.11
 	b.otherPorts = make(map[string]string, len(tg.Tasks)*2)
@@ -692,6 +711,9 @@ func (b *Builder) setNode(n *structs.Node) *Builder {
 	b.nodeAttrs[nodeClassKey] = n.NodeClass
 	b.nodeAttrs[nodeDcKey] = n.Datacenter
 	b.datacenter = n.Datacenter
+	if n.NodeResources != nil {
+		b.hostNetworks = n.NodeResources.HostNetworks.Copy()
+	}
 
 	// Set up the attributes.
 	for k, v := range n.Attributes {
@@ -744,17 +766,33 @@ func (b *Builder) SetDriverNetwork(n *drivers.DriverNetwork) *Builder {
 //
 //	Task:   NOMAD_TASK_{IP,PORT,ADDR}_<task>_<label> # Always host values
 //
-func buildNetworkEnv(envMap map[string]string, nets structs.Networks, driverNet *drivers.DriverNetwork) {
+func buildNetworkEnv(envMap map[string]string, nets structs.Networks, hostNets structs.HostNetworks, driverNet *drivers.DriverNetwork) {
 	for _, n := range nets {
 		for _, p := range n.ReservedPorts {
-			buildPortEnv(envMap, p, n.IP, driverNet)
+			buildPortEnv(envMap, p, portIP(n.IP, p, hostNets), driverNet)
 		}
 		for _, p := range n.DynamicPorts {
-			buildPortEnv(envMap, p, n.IP, driverNet)
+			buildPortEnv(envMap, p, portIP(n.IP, p, hostNets), driverNet)
 		}
 	}
 }
 
+// portIP returns the IP of the host network the port was allocated on,
+// falling back to the network's IP. Allocs placed before the scheduler
+// recorded the port's IP have it looked up on the node.
+func portIP(ip string, p structs.Port, hostNets structs.HostNetworks) string {
+	if p.HostIP != "" {
+		return p.HostIP
+	}
+	if p.HostNetwork == "" {
+		return ip
+	}
+	if hn := hostNets.Lookup(p.HostNetwork); hn != nil {
+		return hn.IP
+	}
+	return ip
+}
+
 func buildPortEnv(envMap map[string]string, p structs.Port, ip string, driverNet *drivers.DriverNetwork) {
 	// Host IP, port, and address
 	portStr := strconv.Itoa(p.Value)
@@ -761,6 +761,24 @@ func TestEnvironment_InterpolateEmptyOptionalMeta(t *testing.T) {
 	require.Empty(env.ReplaceEnv("${NOMAD_META_metaopt2}"))
 }
 
+// TestEnvironment_Array asserts that the array index and size are only added
+// to the environment of array allocations.
+func TestEnvironment_Array(t *testing.T) {
+	require := require.New(t)
+	a := mock.Alloc()
+	a.Name = structs.AllocName(a.JobID, a.TaskGroup, 7)
+	task := a.Job.TaskGroups[0].Tasks[0]
+
+	envMap := NewBuilder(mock.Node(), a, task, "global").Build().Map()
+	require.NotContains(envMap, ArrayIndex)
+	require.NotContains(envMap, ArraySize)
+
+	a.Job.TaskGroups[0].Array = &structs.ArrayConfig{Size: 10}
+	envMap = NewBuilder(mock.Node(), a, task, "global").Build().Map()
+	require.Equal("7", envMap[ArrayIndex])
+	require.Equal("10", envMap[ArraySize])
+}
+
 // TestEnvironment_Upsteams asserts that group.service.upstreams entries are
 // added to the environment.
 func TestEnvironment_Upstreams(t *testing.T) {
@@ -833,6 +851,26 @@ func TestEnvironment_SetPortMapEnvs(t *testing.T) {
 	require.Equal(t, expected, envs)
 }
 
+func TestEnvironment_HostNetworkPorts(t *testing.T) {
+	n := mock.Node()
+	n.NodeResources.HostNetworks = structs.HostNetworks{
+		{Name: "public", Device: "eth1", IP: "10.0.0.5"},
+	}
+	a := mock.Alloc()
+	a.AllocatedResources.Tasks["web"].Networks[0] = &structs.NetworkResource{
+		Device:        "eth0",
+		IP:            "127.0.0.1",
+		ReservedPorts: []structs.Port{{Label: "https", Value: 8080, HostNetwork: "public"}},
+		DynamicPorts:  []structs.Port{{Label: "http", Value: 80}},
+	}
+	task := a.Job.TaskGroups[0].Tasks[0]
+
+	env := NewBuilder(n, a, task, "global").Build().Map()
+	require.Equal(t, "10.0.0.5", env["NOMAD_IP_https"])
+	require.Equal(t, "10.0.0.5:8080", env["NOMAD_ADDR_https"])
+	require.Equal(t, "127.0.0.1", env["NOMAD_IP_http"])
+}
+
 func TestEnvironment_TasklessBuilder(t *testing.T) {
 	node := mock.Node()
 	alloc := mock.Alloc()
@@ -0,0 +1,145 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/posener/complete"
+)
+
+type ACLAuthMethodApplyCommand struct {
+	Meta
+}
+
+func (c *ACLAuthMethodApplyCommand) Help() string {
+	helpText := `
+Usage: nomad acl auth-method apply [options] <name>
+
+  Apply is used to create or update an ACL auth method. The auth method
+  replaces any existing auth method of the same name. Requires a management
+  token.
+
+General Options:
+
+  ` + generalOptionsUsage() + `
+
+Apply Options:
+
+  -type="OIDC"
+    Specifies the type of the auth method. Only "OIDC" is supported.
+
+  -token-locality="local"
+    Specifies whether the tokens created by the auth method are "local" to
+    the region of the login or "global" and replicated to all regions.
+
+  -max-token-ttl=""
+    Specifies the time-to-live of the tokens created by the auth method, such
+    as "1h".
+
+  -default
+    Sets the auth method as the default of the login command.
+
+  -config=""
+    Specifies the path to a JSON file with the configuration of the auth
+    method, such as its OIDCDiscoveryURL, OIDCClientID, OIDCClientSecret and
+    AllowedRedirectURIs.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ACLAuthMethodApplyCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"type":           complete.PredictSet("OIDC"),
+			"token-locality": complete.PredictSet("local", "global"),
+			"max-token-ttl":  complete.PredictAnything,
+			"default":        complete.PredictNothing,
+			"config":         complete.PredictFiles("*.json"),
+		})
+}
+
+func (c *ACLAuthMethodApplyCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ACLAuthMethodApplyCommand) Synopsis() string {
+	return "Create or update an ACL auth method"
+}
+
+func (c *ACLAuthMethodApplyCommand) Name() string { return "acl auth-method apply" }
+
+func (c *ACLAuthMethodApplyCommand) Run(args []string) int {
+	var methodType, locality, configPath string
+	var maxTTL time.Duration
+	var isDefault bool
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.StringVar(&methodType, "type", "OIDC", "")
+	flags.StringVar(&locality, "token-locality", "local", "")
+	flags.DurationVar(&maxTTL, "max-token-ttl", 0, "")
+	flags.BoolVar(&isDefault, "default", false, "")
+	flags.StringVar(&configPath, "config", "", "")
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got exactly one argument
+	args = flags.Args()
+	if l := len(args); l != 1 {
+		c.Ui.Error("This command takes one argument: <name>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	if configPath == "" {
+		c.Ui.Error("The auth method config must be specified")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	// Read the config
+	raw, err := ioutil.ReadFile(configPath)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error reading auth method config: %s", err))
+		return 1
+	}
+	var config api.ACLAuthMethodConfig
+	if err := json.Unmarshal(raw, &config); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error parsing auth method config: %s", err))
+		return 1
+	}
+
+	// Get the auth method name
+	methodName := args[0]
+
+	// Construct the auth method
+	method := &api.ACLAuthMethod{
+		Name:          methodName,
+		Type:          methodType,
+		TokenLocality: locality,
+		MaxTokenTTL:   maxTTL,
+		Default:       isDefault,
+		Config:        &config,
+	}
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	// Upsert the auth method
+	_, err = client.ACLAuthMethods().Upsert(method, nil)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error writing ACL auth method: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(fmt.Sprintf("Successfully wrote %q ACL auth method!", methodName))
+	return 0
+}
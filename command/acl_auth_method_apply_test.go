@@ -0,0 +1,76 @@
+package command
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/command/agent"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestACLAuthMethodApplyCommand(t *testing.T) {
+	assert := assert.New(t)
+	t.Parallel()
+	config := func(c *agent.Config) {
+		c.ACL.Enabled = true
+	}
+
+	srv, _, url := testServer(t, true, config)
+	state := srv.Agent.Server().State()
+	defer srv.Shutdown()
+
+	// Bootstrap an initial ACL token
+	token := srv.RootToken
+	assert.NotNil(token, "failed to bootstrap ACL token")
+
+	// Write the auth method config
+	dir, err := ioutil.TempDir("", "nomad-acl-auth-method")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+	configPath := filepath.Join(dir, "config.json")
+	assert.Nil(ioutil.WriteFile(configPath, []byte(`{
+  "OIDCDiscoveryURL": "https://oidc.example.com",
+  "OIDCClientID": "nomad",
+  "OIDCClientSecret": "very-secret",
+  "AllowedRedirectURIs": ["http://localhost:4649/oidc/callback"],
+  "ListClaimMappings": {"groups": "groups"}
+}`), 0600))
+
+	ui := new(cli.MockUi)
+	cmd := &ACLAuthMethodApplyCommand{Meta: Meta{Ui: ui, flagAddress: url}}
+
+	// Applying an auth method without a config fails
+	code := cmd.Run([]string{"-address=" + url, "-token=" + token.SecretID,
+		"-max-token-ttl=1h", "okta"})
+	assert.Equal(1, code)
+
+	// Attempt to apply an auth method without a valid management token
+	invalidToken := mock.ACLToken()
+	code = cmd.Run([]string{"-address=" + url, "-token=" + invalidToken.SecretID,
+		"-max-token-ttl=1h", "-config=" + configPath, "okta"})
+	assert.Equal(1, code)
+
+	// Apply an auth method with a valid management token
+	code = cmd.Run([]string{"-address=" + url, "-token=" + token.SecretID,
+		"-max-token-ttl=1h", "-default", "-config=" + configPath, "okta"})
+	assert.Equal(0, code)
+
+	// Check the output
+	out := ui.OutputWriter.String()
+	if !strings.Contains(out, "Successfully wrote") {
+		t.Fatalf("bad: %v", out)
+	}
+
+	method, err := state.ACLAuthMethodByName(nil, "okta")
+	assert.Nil(err)
+	assert.Equal(time.Hour, method.MaxTokenTTL)
+	assert.True(method.Default)
+	assert.Equal("nomad", method.Config.OIDCClientID)
+	assert.Equal(map[string]string{"groups": "groups"}, method.Config.ListClaimMappings)
+}
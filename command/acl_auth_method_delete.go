@@ -0,0 +1,77 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/posener/complete"
+)
+
+type ACLAuthMethodDeleteCommand struct {
+	Meta
+}
+
+func (c *ACLAuthMethodDeleteCommand) Help() string {
+	helpText := `
+Usage: nomad acl auth-method delete <name>
+
+  Delete is used to delete an existing ACL auth method and its binding rules.
+  Requires a management token.
+
+General Options:
+
+  ` + generalOptionsUsage()
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ACLAuthMethodDeleteCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{})
+}
+
+func (c *ACLAuthMethodDeleteCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ACLAuthMethodDeleteCommand) Synopsis() string {
+	return "Delete an existing ACL auth method"
+}
+
+func (c *ACLAuthMethodDeleteCommand) Name() string { return "acl auth-method delete" }
+
+func (c *ACLAuthMethodDeleteCommand) Run(args []string) int {
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got exactly one argument
+	args = flags.Args()
+	if l := len(args); l != 1 {
+		c.Ui.Error("This command takes one argument: <name>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	// Get the auth method name
+	methodName := args[0]
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	// Delete the auth method
+	_, err = client.ACLAuthMethods().Delete(methodName, nil)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error deleting ACL auth method: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(fmt.Sprintf("Successfully deleted %s auth method!", methodName))
+	return 0
+}
@@ -0,0 +1,54 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/nomad/command/agent"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestACLAuthMethodDeleteCommand(t *testing.T) {
+	assert := assert.New(t)
+	t.Parallel()
+	config := func(c *agent.Config) {
+		c.ACL.Enabled = true
+	}
+
+	srv, _, url := testServer(t, true, config)
+	state := srv.Agent.Server().State()
+	defer srv.Shutdown()
+
+	// Bootstrap an initial ACL token
+	token := srv.RootToken
+	assert.NotNil(token, "failed to bootstrap ACL token")
+
+	// Create a test auth method
+	method := mock.ACLAuthMethod()
+	assert.Nil(state.UpsertACLAuthMethods(1000, []*structs.ACLAuthMethod{method}))
+
+	ui := new(cli.MockUi)
+	cmd := &ACLAuthMethodDeleteCommand{Meta: Meta{Ui: ui, flagAddress: url}}
+
+	// Attempt to delete the auth method without a valid management token
+	invalidToken := mock.ACLToken()
+	code := cmd.Run([]string{"-address=" + url, "-token=" + invalidToken.SecretID, method.Name})
+	assert.Equal(1, code)
+
+	// Delete the auth method with a valid management token
+	code = cmd.Run([]string{"-address=" + url, "-token=" + token.SecretID, method.Name})
+	assert.Equal(0, code)
+
+	// Check the output
+	out := ui.OutputWriter.String()
+	if !strings.Contains(out, "Successfully deleted") {
+		t.Fatalf("bad: %v", out)
+	}
+
+	out2, err := state.ACLAuthMethodByName(nil, method.Name)
+	assert.Nil(err)
+	assert.Nil(out2)
+}
@@ -0,0 +1,49 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/nomad/command/agent"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestACLAuthMethodInfoCommand(t *testing.T) {
+	assert := assert.New(t)
+	t.Parallel()
+	config := func(c *agent.Config) {
+		c.ACL.Enabled = true
+	}
+
+	srv, _, url := testServer(t, true, config)
+	state := srv.Agent.Server().State()
+	defer srv.Shutdown()
+
+	// Bootstrap an initial ACL token
+	token := srv.RootToken
+	assert.NotNil(token, "failed to bootstrap ACL token")
+
+	// Create a test auth method
+	method := mock.ACLAuthMethod()
+	assert.Nil(state.UpsertACLAuthMethods(1000, []*structs.ACLAuthMethod{method}))
+
+	ui := new(cli.MockUi)
+	cmd := &ACLAuthMethodInfoCommand{Meta: Meta{Ui: ui, flagAddress: url}}
+
+	// Attempt to read the auth method without a management token
+	code := cmd.Run([]string{"-address=" + url, method.Name})
+	assert.Equal(1, code)
+
+	// Read the auth method with a valid management token
+	code = cmd.Run([]string{"-address=" + url, "-token=" + token.SecretID, method.Name})
+	assert.Equal(0, code)
+
+	// Check the output
+	out := ui.OutputWriter.String()
+	if !strings.Contains(out, method.Config.OIDCDiscoveryURL) {
+		t.Fatalf("bad: %v", out)
+	}
+}
@@ -0,0 +1,117 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/posener/complete"
+)
+
+type ACLAuthMethodListCommand struct {
+	Meta
+}
+
+func (c *ACLAuthMethodListCommand) Help() string {
+	helpText := `
+Usage: nomad acl auth-method list
+
+  List is used to list available ACL auth methods.
+
+General Options:
+
+  ` + generalOptionsUsage() + `
+
+List Options:
+
+  -json
+    Output the ACL auth methods in a JSON format.
+
+  -t
+    Format and display the ACL auth methods using a Go template.
+`
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ACLAuthMethodListCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-json": complete.PredictNothing,
+			"-t":    complete.PredictAnything,
+		})
+}
+
+func (c *ACLAuthMethodListCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ACLAuthMethodListCommand) Synopsis() string {
+	return "List ACL auth methods"
+}
+
+func (c *ACLAuthMethodListCommand) Name() string { return "acl auth-method list" }
+
+func (c *ACLAuthMethodListCommand) Run(args []string) int {
+	var json bool
+	var tmpl string
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.BoolVar(&json, "json", false, "")
+	flags.StringVar(&tmpl, "t", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got no arguments
+	args = flags.Args()
+	if l := len(args); l != 0 {
+		c.Ui.Error("This command takes no arguments")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	// Fetch the auth methods
+	methods, _, err := client.ACLAuthMethods().List(nil)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error listing ACL auth methods: %s", err))
+		return 1
+	}
+
+	if json || len(tmpl) > 0 {
+		out, err := Format(json, tmpl, methods)
+		if err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+
+		c.Ui.Output(out)
+		return 0
+	}
+
+	c.Ui.Output(formatAuthMethods(methods))
+	return 0
+}
+
+func formatAuthMethods(methods []*api.ACLAuthMethodListStub) string {
+	if len(methods) == 0 {
+		return "No auth methods found"
+	}
+
+	output := make([]string, 0, len(methods)+1)
+	output = append(output, fmt.Sprintf("Name|Type|Default"))
+	for _, m := range methods {
+		output = append(output, fmt.Sprintf("%s|%s|%v", m.Name, m.Type, m.Default))
+	}
+
+	return formatList(output)
+}
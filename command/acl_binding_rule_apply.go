@@ -0,0 +1,118 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/posener/complete"
+)
+
+type ACLBindingRuleApplyCommand struct {
+	Meta
+}
+
+func (c *ACLBindingRuleApplyCommand) Help() string {
+	helpText := `
+Usage: nomad acl binding-rule apply [options]
+
+  Apply is used to create or update an ACL binding rule. The binding rule is
+  created unless an existing ID is given. Requires a management token.
+
+General Options:
+
+  ` + generalOptionsUsage() + `
+
+Apply Options:
+
+  -id=""
+    Specifies the ID of an existing binding rule to update.
+
+  -description=""
+    Specifies a human readable description for the binding rule.
+
+  -auth-method=""
+    Specifies the name of the auth method of the binding rule.
+
+  -selector=""
+    Specifies an expression over the claims of the users the binding rule
+    applies to, such as '"ops" in list.groups and value.team == "infra"'.
+    The binding rule applies to all users if empty.
+
+  -bind-type=""
+    Specifies the type of ACL object, "role" or "policy", the binding rule
+    links users to.
+
+  -bind-name=""
+    Specifies the name of the role or policy the binding rule links users to.
+    Claims may be interpolated, such as "team-${value.team}".
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ACLBindingRuleApplyCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"id":          complete.PredictAnything,
+			"description": complete.PredictAnything,
+			"auth-method": complete.PredictAnything,
+			"selector":    complete.PredictAnything,
+			"bind-type":   complete.PredictSet("role", "policy"),
+			"bind-name":   complete.PredictAnything,
+		})
+}
+
+func (c *ACLBindingRuleApplyCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ACLBindingRuleApplyCommand) Synopsis() string {
+	return "Create or update an ACL binding rule"
+}
+
+func (c *ACLBindingRuleApplyCommand) Name() string { return "acl binding-rule apply" }
+
+func (c *ACLBindingRuleApplyCommand) Run(args []string) int {
+	var rule api.ACLBindingRule
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.StringVar(&rule.ID, "id", "", "")
+	flags.StringVar(&rule.Description, "description", "", "")
+	flags.StringVar(&rule.AuthMethod, "auth-method", "", "")
+	flags.StringVar(&rule.Selector, "selector", "", "")
+	flags.StringVar(&rule.BindType, "bind-type", "", "")
+	flags.StringVar(&rule.BindName, "bind-name", "", "")
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got no arguments
+	args = flags.Args()
+	if l := len(args); l != 0 {
+		c.Ui.Error("This command takes no arguments")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	// Create or update the binding rule
+	var out *api.ACLBindingRule
+	if rule.ID == "" {
+		out, _, err = client.ACLBindingRules().Create(&rule, nil)
+	} else {
+		out, _, err = client.ACLBindingRules().Update(&rule, nil)
+	}
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error writing ACL binding rule: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(formatKVBindingRule(out))
+	return 0
+}
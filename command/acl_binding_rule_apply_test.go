@@ -0,0 +1,76 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/nomad/command/agent"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestACLBindingRuleApplyCommand(t *testing.T) {
+	assert := assert.New(t)
+	t.Parallel()
+	config := func(c *agent.Config) {
+		c.ACL.Enabled = true
+	}
+
+	srv, _, url := testServer(t, true, config)
+	state := srv.Agent.Server().State()
+	defer srv.Shutdown()
+
+	// Bootstrap an initial ACL token
+	token := srv.RootToken
+	assert.NotNil(token, "failed to bootstrap ACL token")
+
+	// Create a test auth method
+	method := mock.ACLAuthMethod()
+	assert.Nil(state.UpsertACLAuthMethods(1000, []*structs.ACLAuthMethod{method}))
+
+	ui := new(cli.MockUi)
+	cmd := &ACLBindingRuleApplyCommand{Meta: Meta{Ui: ui, flagAddress: url}}
+
+	args := []string{"-address=" + url, "-auth-method=" + method.Name,
+		"-selector=\"ops\" in list.groups", "-bind-type=role", "-bind-name=ops"}
+
+	// Attempt to apply a binding rule without a valid management token
+	invalidToken := mock.ACLToken()
+	code := cmd.Run(append([]string{"-token=" + invalidToken.SecretID}, args...))
+	assert.Equal(1, code)
+
+	// Applying an invalid binding rule fails
+	code = cmd.Run([]string{"-address=" + url, "-token=" + token.SecretID,
+		"-auth-method=" + method.Name, "-bind-type=user", "-bind-name=ops"})
+	assert.Equal(1, code)
+
+	// Apply a binding rule with a valid management token
+	code = cmd.Run(append([]string{"-token=" + token.SecretID}, args...))
+	assert.Equal(0, code)
+
+	// Check the output
+	out := ui.OutputWriter.String()
+	if !strings.Contains(out, method.Name) {
+		t.Fatalf("bad: %v", out)
+	}
+
+	iter, err := state.ACLBindingRulesByAuthMethod(nil, method.Name)
+	assert.Nil(err)
+	raw := iter.Next()
+	assert.NotNil(raw)
+	rule := raw.(*structs.ACLBindingRule)
+	assert.Equal(`"ops" in list.groups`, rule.Selector)
+	assert.Equal("ops", rule.BindName)
+
+	// Update the binding rule
+	code = cmd.Run([]string{"-address=" + url, "-token=" + token.SecretID, "-id=" + rule.ID,
+		"-auth-method=" + method.Name, "-bind-type=policy", "-bind-name=readonly"})
+	assert.Equal(0, code)
+
+	rule, err = state.ACLBindingRuleByID(nil, rule.ID)
+	assert.Nil(err)
+	assert.Equal(structs.ACLBindingRuleBindTypePolicy, rule.BindType)
+	assert.Equal("readonly", rule.BindName)
+}
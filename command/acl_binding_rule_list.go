@@ -0,0 +1,117 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/posener/complete"
+)
+
+type ACLBindingRuleListCommand struct {
+	Meta
+}
+
+func (c *ACLBindingRuleListCommand) Help() string {
+	helpText := `
+Usage: nomad acl binding-rule list
+
+  List is used to list available ACL binding rules.
+
+General Options:
+
+  ` + generalOptionsUsage() + `
+
+List Options:
+
+  -json
+    Output the ACL binding rules in a JSON format.
+
+  -t
+    Format and display the ACL binding rules using a Go template.
+`
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ACLBindingRuleListCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-json": complete.PredictNothing,
+			"-t":    complete.PredictAnything,
+		})
+}
+
+func (c *ACLBindingRuleListCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ACLBindingRuleListCommand) Synopsis() string {
+	return "List ACL binding rules"
+}
+
+func (c *ACLBindingRuleListCommand) Name() string { return "acl binding-rule list" }
+
+func (c *ACLBindingRuleListCommand) Run(args []string) int {
+	var json bool
+	var tmpl string
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.BoolVar(&json, "json", false, "")
+	flags.StringVar(&tmpl, "t", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got no arguments
+	args = flags.Args()
+	if l := len(args); l != 0 {
+		c.Ui.Error("This command takes no arguments")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	// Fetch the binding rules
+	rules, _, err := client.ACLBindingRules().List(nil)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error listing ACL binding rules: %s", err))
+		return 1
+	}
+
+	if json || len(tmpl) > 0 {
+		out, err := Format(json, tmpl, rules)
+		if err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+
+		c.Ui.Output(out)
+		return 0
+	}
+
+	c.Ui.Output(formatBindingRules(rules))
+	return 0
+}
+
+func formatBindingRules(rules []*api.ACLBindingRuleListStub) string {
+	if len(rules) == 0 {
+		return "No binding rules found"
+	}
+
+	output := make([]string, 0, len(rules)+1)
+	output = append(output, fmt.Sprintf("ID|Description|Auth Method"))
+	for _, r := range rules {
+		output = append(output, fmt.Sprintf("%s|%s|%s", r.ID, r.Description, r.AuthMethod))
+	}
+
+	return formatList(output)
+}
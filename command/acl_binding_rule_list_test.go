@@ -0,0 +1,50 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/nomad/command/agent"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestACLBindingRuleListCommand(t *testing.T) {
+	assert := assert.New(t)
+	t.Parallel()
+	config := func(c *agent.Config) {
+		c.ACL.Enabled = true
+	}
+
+	srv, _, url := testServer(t, true, config)
+	state := srv.Agent.Server().State()
+	defer srv.Shutdown()
+
+	// Bootstrap an initial ACL token
+	token := srv.RootToken
+	assert.NotNil(token, "failed to bootstrap ACL token")
+
+	// Create a test binding rule
+	rule := mock.ACLBindingRule("okta")
+	assert.Nil(state.UpsertACLBindingRules(1000, []*structs.ACLBindingRule{rule}))
+
+	ui := new(cli.MockUi)
+	cmd := &ACLBindingRuleListCommand{Meta: Meta{Ui: ui, flagAddress: url}}
+
+	// Attempt to list binding rules without a valid token
+	invalidToken := mock.ACLToken()
+	code := cmd.Run([]string{"-address=" + url, "-token=" + invalidToken.SecretID})
+	assert.Equal(1, code)
+
+	// List the binding rules with a valid management token
+	code = cmd.Run([]string{"-address=" + url, "-token=" + token.SecretID})
+	assert.Equal(0, code)
+
+	// Check the output
+	out := ui.OutputWriter.String()
+	if !strings.Contains(out, rule.ID) {
+		t.Fatalf("bad: %v", out)
+	}
+}
@@ -0,0 +1,47 @@
+package command
+
+import (
+	"strings"
+
+	"github.com/mitchellh/cli"
+)
+
+type ACLRoleCommand struct {
+	Meta
+}
+
+func (f *ACLRoleCommand) Help() string {
+	helpText := `
+Usage: nomad acl role <subcommand> [options] [args]
+
+  This command groups subcommands for interacting with ACL roles. An ACL role
+  is a named set of ACL policies. Tokens that are linked to a role are granted
+  the capabilities of all of the role's policies. For a full guide
+  see: https://www.nomadproject.io/guides/acl.html
+
+  Create an ACL role:
+
+      $ nomad acl role apply -policy=<policy> <name>
+
+  List ACL roles:
+
+      $ nomad acl role list
+
+  Inspect an ACL role:
+
+      $ nomad acl role info <role>
+
+  Please see the individual subcommand help for detailed usage information.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (f *ACLRoleCommand) Synopsis() string {
+	return "Interact with ACL roles"
+}
+
+func (f *ACLRoleCommand) Name() string { return "acl role" }
+
+func (f *ACLRoleCommand) Run(args []string) int {
+	return cli.RunResultHelp
+}
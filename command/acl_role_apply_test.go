@@ -0,0 +1,60 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/nomad/command/agent"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestACLRoleApplyCommand(t *testing.T) {
+	assert := assert.New(t)
+	t.Parallel()
+	config := func(c *agent.Config) {
+		c.ACL.Enabled = true
+	}
+
+	srv, _, url := testServer(t, true, config)
+	state := srv.Agent.Server().State()
+	defer srv.Shutdown()
+
+	// Bootstrap an initial ACL token
+	token := srv.RootToken
+	assert.NotNil(token, "failed to bootstrap ACL token")
+
+	// Create a test policy
+	policy := mock.ACLPolicy()
+	assert.Nil(state.UpsertACLPolicies(1000, []*structs.ACLPolicy{policy}))
+
+	ui := new(cli.MockUi)
+	cmd := &ACLRoleApplyCommand{Meta: Meta{Ui: ui, flagAddress: url}}
+
+	// Applying a role without a policy fails
+	code := cmd.Run([]string{"-address=" + url, "-token=" + token.SecretID, "test-role"})
+	assert.Equal(1, code)
+
+	// Attempt to apply a role without a valid management token
+	invalidToken := mock.ACLToken()
+	code = cmd.Run([]string{"-address=" + url, "-token=" + invalidToken.SecretID,
+		"-policy=" + policy.Name, "test-role"})
+	assert.Equal(1, code)
+
+	// Apply a role with a valid management token
+	code = cmd.Run([]string{"-address=" + url, "-token=" + token.SecretID,
+		"-policy=" + policy.Name, "test-role"})
+	assert.Equal(0, code)
+
+	// Check the output
+	out := ui.OutputWriter.String()
+	if !strings.Contains(out, "Successfully wrote") {
+		t.Fatalf("bad: %v", out)
+	}
+
+	role, err := state.ACLRoleByName(nil, "test-role")
+	assert.Nil(err)
+	assert.Equal([]string{policy.Name}, role.Policies)
+}
@@ -0,0 +1,76 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/posener/complete"
+)
+
+type ACLRoleDeleteCommand struct {
+	Meta
+}
+
+func (c *ACLRoleDeleteCommand) Help() string {
+	helpText := `
+Usage: nomad acl role delete <name>
+
+  Delete is used to delete an existing ACL role. Requires a management token.
+
+General Options:
+
+  ` + generalOptionsUsage()
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ACLRoleDeleteCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{})
+}
+
+func (c *ACLRoleDeleteCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ACLRoleDeleteCommand) Synopsis() string {
+	return "Delete an existing ACL role"
+}
+
+func (c *ACLRoleDeleteCommand) Name() string { return "acl role delete" }
+
+func (c *ACLRoleDeleteCommand) Run(args []string) int {
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got exactly one argument
+	args = flags.Args()
+	if l := len(args); l != 1 {
+		c.Ui.Error("This command takes one argument: <name>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	// Get the role name
+	roleName := args[0]
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	// Delete the role
+	_, err = client.ACLRoles().Delete(roleName, nil)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error deleting ACL role: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(fmt.Sprintf("Successfully deleted %s role!", roleName))
+	return 0
+}
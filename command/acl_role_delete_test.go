@@ -0,0 +1,51 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/nomad/command/agent"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestACLRoleDeleteCommand(t *testing.T) {
+	assert := assert.New(t)
+	t.Parallel()
+	config := func(c *agent.Config) {
+		c.ACL.Enabled = true
+	}
+
+	srv, _, url := testServer(t, true, config)
+	state := srv.Agent.Server().State()
+	defer srv.Shutdown()
+
+	// Bootstrap an initial ACL token
+	token := srv.RootToken
+	assert.NotNil(token, "failed to bootstrap ACL token")
+
+	// Create a test ACLRole
+	role := mock.ACLRole()
+	assert.Nil(state.UpsertACLRoles(1000, []*structs.ACLRole{role}))
+
+	ui := new(cli.MockUi)
+	cmd := &ACLRoleDeleteCommand{Meta: Meta{Ui: ui, flagAddress: url}}
+
+	// Delete the role without a valid token fails
+	invalidToken := mock.ACLToken()
+	code := cmd.Run([]string{"-address=" + url, "-token=" + invalidToken.SecretID, role.Name})
+	assert.Equal(1, code)
+
+	// Delete the role with a valid management token
+	code = cmd.Run([]string{"-address=" + url, "-token=" + token.SecretID, role.Name})
+	assert.Equal(0, code)
+
+	// Check the output
+	out := ui.OutputWriter.String()
+	if !strings.Contains(out, fmt.Sprintf("Successfully deleted %s role", role.Name)) {
+		t.Fatalf("bad: %v", out)
+	}
+}
@@ -0,0 +1,51 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/nomad/command/agent"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestACLRoleInfoCommand(t *testing.T) {
+	assert := assert.New(t)
+	t.Parallel()
+	config := func(c *agent.Config) {
+		c.ACL.Enabled = true
+	}
+
+	srv, _, url := testServer(t, true, config)
+	state := srv.Agent.Server().State()
+	defer srv.Shutdown()
+
+	// Bootstrap an initial ACL token
+	token := srv.RootToken
+	assert.NotNil(token, "failed to bootstrap ACL token")
+
+	// Create a test ACLRole
+	role := mock.ACLRole()
+	assert.Nil(state.UpsertACLRoles(1000, []*structs.ACLRole{role}))
+
+	ui := new(cli.MockUi)
+	cmd := &ACLRoleInfoCommand{Meta: Meta{Ui: ui, flagAddress: url}}
+
+	// Attempt to read a role the token isn't linked to
+	invalidToken := mock.ACLToken()
+	assert.Nil(state.UpsertACLTokens(1001, []*structs.ACLToken{invalidToken}))
+	code := cmd.Run([]string{"-address=" + url, "-token=" + invalidToken.SecretID, role.Name})
+	assert.Equal(1, code)
+
+	// Read the role with a valid management token
+	code = cmd.Run([]string{"-address=" + url, "-token=" + token.SecretID, role.Name})
+	assert.Equal(0, code)
+
+	// Check the output
+	out := ui.OutputWriter.String()
+	if !strings.Contains(out, role.Name) || !strings.Contains(out, "[foo bar]") {
+		t.Fatalf("bad: %v", out)
+	}
+}
@@ -0,0 +1,117 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/posener/complete"
+)
+
+type ACLRoleListCommand struct {
+	Meta
+}
+
+func (c *ACLRoleListCommand) Help() string {
+	helpText := `
+Usage: nomad acl role list
+
+  List is used to list available ACL roles.
+
+General Options:
+
+  ` + generalOptionsUsage() + `
+
+List Options:
+
+  -json
+    Output the ACL roles in a JSON format.
+
+  -t
+    Format and display the ACL roles using a Go template.
+`
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ACLRoleListCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-json": complete.PredictNothing,
+			"-t":    complete.PredictAnything,
+		})
+}
+
+func (c *ACLRoleListCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ACLRoleListCommand) Synopsis() string {
+	return "List ACL roles"
+}
+
+func (c *ACLRoleListCommand) Name() string { return "acl role list" }
+
+func (c *ACLRoleListCommand) Run(args []string) int {
+	var json bool
+	var tmpl string
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.BoolVar(&json, "json", false, "")
+	flags.StringVar(&tmpl, "t", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got no arguments
+	args = flags.Args()
+	if l := len(args); l != 0 {
+		c.Ui.Error("This command takes no arguments")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	// Fetch the roles
+	roles, _, err := client.ACLRoles().List(nil)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error listing ACL roles: %s", err))
+		return 1
+	}
+
+	if json || len(tmpl) > 0 {
+		out, err := Format(json, tmpl, roles)
+		if err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+
+		c.Ui.Output(out)
+		return 0
+	}
+
+	c.Ui.Output(formatRoles(roles))
+	return 0
+}
+
+func formatRoles(roles []*api.ACLRoleListStub) string {
+	if len(roles) == 0 {
+		return "No roles found"
+	}
+
+	output := make([]string, 0, len(roles)+1)
+	output = append(output, fmt.Sprintf("Name|Description|Policies"))
+	for _, r := range roles {
+		output = append(output, fmt.Sprintf("%s|%s|%v", r.Name, r.Description, r.Policies))
+	}
+
+	return formatList(output)
+}
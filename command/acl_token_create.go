@@ -3,6 +3,7 @@ package command
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/nomad/api"
 	"github.com/posener/complete"
@@ -36,6 +37,15 @@ Create Options:
   -policy=""
     Specifies a policy to associate with the token. Can be specified multiple times,
     but only with client type tokens.
+
+  -role=""
+    Specifies a role to associate with the token. Can be specified multiple times,
+    but only with client type tokens.
+
+  -ttl=""
+    Specifies the time-to-live of the token, such as "8h". Once expired, the
+    token is no longer valid and is eventually deleted. By default, tokens do
+    not expire.
 `
 	return strings.TrimSpace(helpText)
 }
@@ -47,6 +57,8 @@ func (c *ACLTokenCreateCommand) AutocompleteFlags() complete.Flags {
 			"type":   complete.PredictAnything,
 			"global": complete.PredictNothing,
 			"policy": complete.PredictAnything,
+			"role":   complete.PredictAnything,
+			"ttl":    complete.PredictAnything,
 		})
 }
 
@@ -63,7 +75,8 @@ func (c *ACLTokenCreateCommand) Name() string { return "acl token create" }
 func (c *ACLTokenCreateCommand) Run(args []string) int {
 	var name, tokenType string
 	var global bool
-	var policies []string
+	var policies, roles []string
+	var ttl time.Duration
 	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
 	flags.Usage = func() { c.Ui.Output(c.Help()) }
 	flags.StringVar(&name, "name", "", "")
@@ -73,6 +86,11 @@ func (c *ACLTokenCreateCommand) Run(args []string) int {
 		policies = append(policies, s)
 		return nil
 	}), "policy", "")
+	flags.Var((funcVar)(func(s string) error {
+		roles = append(roles, s)
+		return nil
+	}), "role", "")
+	flags.DurationVar(&ttl, "ttl", 0, "")
 	if err := flags.Parse(args); err != nil {
 		return 1
 	}
@@ -87,10 +105,12 @@ func (c *ACLTokenCreateCommand) Run(args []string) int {
 
 	// Setup the token
 	tk := &api.ACLToken{
-		Name:     name,
-		Type:     tokenType,
-		Policies: policies,
-		Global:   global,
+		Name:          name,
+		Type:          tokenType,
+		Policies:      policies,
+		Roles:         roles,
+		Global:        global,
+		ExpirationTTL: ttl,
 	}
 
 	// Get the HTTP client
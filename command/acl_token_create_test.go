@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/nomad/command/agent"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/mitchellh/cli"
 	"github.com/stretchr/testify/assert"
 )
@@ -42,4 +44,18 @@ func TestACLTokenCreateCommand(t *testing.T) {
 	if !strings.Contains(out, "[foo]") {
 		t.Fatalf("bad: %v", out)
 	}
+
+	// Request to create a new token with a role and a TTL
+	role := mock.ACLRole()
+	state := srv.Agent.Server().State()
+	assert.Nil(state.UpsertACLRoles(1000, []*structs.ACLRole{role}))
+
+	ui.OutputWriter.Reset()
+	code = cmd.Run([]string{"-address=" + url, "-role=" + role.Name, "-ttl=1h"})
+	assert.Equal(0, code)
+
+	out = ui.OutputWriter.String()
+	if !strings.Contains(out, role.Name) || strings.Contains(out, "Expiry Time  = <none>") {
+		t.Fatalf("bad: %v", out)
+	}
 }
@@ -35,6 +35,10 @@ Update Options:
   -policy=""
     Specifies a policy to associate with the token. Can be specified multiple times,
     but only with client type tokens.
+
+  -role=""
+    Specifies a role to associate with the token. Can be specified multiple times,
+    but only with client type tokens.
 `
 
 	return strings.TrimSpace(helpText)
@@ -47,6 +51,7 @@ func (c *ACLTokenUpdateCommand) AutocompleteFlags() complete.Flags {
 			"type":   complete.PredictAnything,
 			"global": complete.PredictNothing,
 			"policy": complete.PredictAnything,
+			"role":   complete.PredictAnything,
 		})
 }
 
@@ -63,7 +68,7 @@ func (*ACLTokenUpdateCommand) Name() string { return "acl token update" }
 func (c *ACLTokenUpdateCommand) Run(args []string) int {
 	var name, tokenType string
 	var global bool
-	var policies []string
+	var policies, roles []string
 	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
 	flags.Usage = func() { c.Ui.Output(c.Help()) }
 	flags.StringVar(&name, "name", "", "")
@@ -73,6 +78,10 @@ func (c *ACLTokenUpdateCommand) Run(args []string) int {
 		policies = append(policies, s)
 		return nil
 	}), "policy", "")
+	flags.Var((funcVar)(func(s string) error {
+		roles = append(roles, s)
+		return nil
+	}), "role", "")
 	if err := flags.Parse(args); err != nil {
 		return 1
 	}
@@ -119,6 +128,10 @@ func (c *ACLTokenUpdateCommand) Run(args []string) int {
 		token.Policies = policies
 	}
 
+	if len(roles) != 0 {
+		token.Roles = roles
+	}
+
 	// Update the token
 	updatedToken, _, err := client.ACLTokens().Update(token, nil)
 	if err != nil {
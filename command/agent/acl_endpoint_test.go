@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestHTTP_ACLPolicyList(t *testing.T) {
@@ -187,6 +188,162 @@ func TestHTTP_ACLPolicyDelete(t *testing.T) {
 	})
 }
 
+func TestHTTP_ACLRoleCRUD(t *testing.T) {
+	t.Parallel()
+	httpACLTest(t, nil, func(s *TestAgent) {
+		require := require.New(t)
+
+		p1 := mock.ACLPolicy()
+		args := structs.ACLPolicyUpsertRequest{
+			Policies: []*structs.ACLPolicy{p1},
+			WriteRequest: structs.WriteRequest{
+				Region:    "global",
+				AuthToken: s.RootToken.SecretID,
+			},
+		}
+		var resp structs.GenericResponse
+		require.NoError(s.Agent.RPC("ACL.UpsertPolicies", &args, &resp))
+
+		// Create the role
+		r1 := mock.ACLRole()
+		r1.Policies = []string{p1.Name}
+		req, err := http.NewRequest("PUT", "/v1/acl/role/"+r1.Name, encodeReq(r1))
+		require.NoError(err)
+		respW := httptest.NewRecorder()
+		setToken(req, s.RootToken)
+		_, err = s.Server.ACLRoleSpecificRequest(respW, req)
+		require.NoError(err)
+		require.NotEmpty(respW.HeaderMap.Get("X-Nomad-Index"))
+
+		// A mismatched name is rejected
+		req, err = http.NewRequest("PUT", "/v1/acl/role/other", encodeReq(r1))
+		require.NoError(err)
+		respW = httptest.NewRecorder()
+		setToken(req, s.RootToken)
+		_, err = s.Server.ACLRoleSpecificRequest(respW, req)
+		require.Error(err)
+
+		// List the roles
+		req, err = http.NewRequest("GET", "/v1/acl/roles", nil)
+		require.NoError(err)
+		respW = httptest.NewRecorder()
+		setToken(req, s.RootToken)
+		obj, err := s.Server.ACLRolesRequest(respW, req)
+		require.NoError(err)
+		require.Len(obj.([]*structs.ACLRoleListStub), 1)
+
+		// Read the role
+		req, err = http.NewRequest("GET", "/v1/acl/role/"+r1.Name, nil)
+		require.NoError(err)
+		respW = httptest.NewRecorder()
+		setToken(req, s.RootToken)
+		obj, err = s.Server.ACLRoleSpecificRequest(respW, req)
+		require.NoError(err)
+		require.Equal([]string{p1.Name}, obj.(*structs.ACLRole).Policies)
+
+		// Delete the role
+		req, err = http.NewRequest("DELETE", "/v1/acl/role/"+r1.Name, nil)
+		require.NoError(err)
+		respW = httptest.NewRecorder()
+		setToken(req, s.RootToken)
+		_, err = s.Server.ACLRoleSpecificRequest(respW, req)
+		require.NoError(err)
+
+		// Reading a missing role is a 404
+		req, err = http.NewRequest("GET", "/v1/acl/role/"+r1.Name, nil)
+		require.NoError(err)
+		respW = httptest.NewRecorder()
+		setToken(req, s.RootToken)
+		_, err = s.Server.ACLRoleSpecificRequest(respW, req)
+		require.Error(err)
+		codedErr, ok := err.(HTTPCodedError)
+		require.True(ok)
+		require.Equal(404, codedErr.Code())
+	})
+}
+
+func TestHTTP_ACLAuthMethodAndBindingRuleCRUD(t *testing.T) {
+	t.Parallel()
+	httpACLTest(t, nil, func(s *TestAgent) {
+		require := require.New(t)
+
+		// Create the auth method
+		m1 := mock.ACLAuthMethod()
+		req, err := http.NewRequest("PUT", "/v1/acl/auth-method/"+m1.Name, encodeReq(m1))
+		require.NoError(err)
+		respW := httptest.NewRecorder()
+		setToken(req, s.RootToken)
+		_, err = s.Server.ACLAuthMethodSpecificRequest(respW, req)
+		require.NoError(err)
+		require.NotEmpty(respW.HeaderMap.Get("X-Nomad-Index"))
+
+		// List the auth methods without a token
+		req, err = http.NewRequest("GET", "/v1/acl/auth-methods", nil)
+		require.NoError(err)
+		respW = httptest.NewRecorder()
+		obj, err := s.Server.ACLAuthMethodsRequest(respW, req)
+		require.NoError(err)
+		require.Len(obj.([]*structs.ACLAuthMethodListStub), 1)
+
+		// Read the auth method
+		req, err = http.NewRequest("GET", "/v1/acl/auth-method/"+m1.Name, nil)
+		require.NoError(err)
+		respW = httptest.NewRecorder()
+		setToken(req, s.RootToken)
+		obj, err = s.Server.ACLAuthMethodSpecificRequest(respW, req)
+		require.NoError(err)
+		require.Equal(m1.Config.OIDCClientID, obj.(*structs.ACLAuthMethod).Config.OIDCClientID)
+
+		// Create a binding rule
+		r1 := mock.ACLBindingRule(m1.Name)
+		r1.ID = ""
+		req, err = http.NewRequest("PUT", "/v1/acl/binding-rule", encodeReq(r1))
+		require.NoError(err)
+		respW = httptest.NewRecorder()
+		setToken(req, s.RootToken)
+		obj, err = s.Server.ACLBindingRuleSpecificRequest(respW, req)
+		require.NoError(err)
+		created := obj.(*structs.ACLBindingRule)
+		require.NotEmpty(created.ID)
+
+		// List the binding rules
+		req, err = http.NewRequest("GET", "/v1/acl/binding-rules", nil)
+		require.NoError(err)
+		respW = httptest.NewRecorder()
+		setToken(req, s.RootToken)
+		obj, err = s.Server.ACLBindingRulesRequest(respW, req)
+		require.NoError(err)
+		require.Len(obj.([]*structs.ACLBindingRuleListStub), 1)
+
+		// Read the binding rule
+		req, err = http.NewRequest("GET", "/v1/acl/binding-rule/"+created.ID, nil)
+		require.NoError(err)
+		respW = httptest.NewRecorder()
+		setToken(req, s.RootToken)
+		obj, err = s.Server.ACLBindingRuleSpecificRequest(respW, req)
+		require.NoError(err)
+		require.Equal(r1.Selector, obj.(*structs.ACLBindingRule).Selector)
+
+		// Deleting the auth method deletes its binding rules
+		req, err = http.NewRequest("DELETE", "/v1/acl/auth-method/"+m1.Name, nil)
+		require.NoError(err)
+		respW = httptest.NewRecorder()
+		setToken(req, s.RootToken)
+		_, err = s.Server.ACLAuthMethodSpecificRequest(respW, req)
+		require.NoError(err)
+
+		req, err = http.NewRequest("GET", "/v1/acl/binding-rule/"+created.ID, nil)
+		require.NoError(err)
+		respW = httptest.NewRecorder()
+		setToken(req, s.RootToken)
+		_, err = s.Server.ACLBindingRuleSpecificRequest(respW, req)
+		require.Error(err)
+		codedErr, ok := err.(HTTPCodedError)
+		require.True(ok)
+		require.Equal(404, codedErr.Code())
+	})
+}
+
 func TestHTTP_ACLTokenBootstrap(t *testing.T) {
 	t.Parallel()
 	conf := func(c *Config) {
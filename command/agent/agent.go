@@ -291,6 +291,15 @@ func convertServerConfig(agentConfig *Config) (*nomad.Config, error) {
 		}
 		conf.JobGCThreshold = dur
 	}
+	if window := agentConfig.Server.DispatchIdempotencyWindow; window != "" {
+		dur, err := time.ParseDuration(window)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse dispatch_idempotency_window: %v", err)
+		} else if dur < time.Duration(0) {
+			return nil, fmt.Errorf("dispatch_idempotency_window can't be negative")
+		}
+		conf.DispatchIdempotencyWindow = dur
+	}
 	if gcThreshold := agentConfig.Server.EvalGCThreshold; gcThreshold != "" {
 		dur, err := time.ParseDuration(gcThreshold)
 		if err != nil {
@@ -305,6 +314,27 @@ func convertServerConfig(agentConfig *Config) (*nomad.Config, error) {
 		}
 		conf.DeploymentGCThreshold = dur
 	}
+	if threshold := agentConfig.Server.RootKeyRotationThreshold; threshold != "" {
+		dur, err := time.ParseDuration(threshold)
+		if err != nil {
+			return nil, err
+		}
+		conf.RootKeyRotationThreshold = dur
+	}
+	if agentConfig.Server.KeyringEncryptionKey != "" {
+		kek, err := agentConfig.Server.KeyringEncryptionKeyBytes()
+		if err != nil {
+			return nil, fmt.Errorf("invalid keyring_encryption_key: %v", err)
+		}
+		conf.KeyEncryptionKey = kek
+	}
+	if ttl := agentConfig.Server.WorkloadIdentityTTL; ttl != "" {
+		dur, err := time.ParseDuration(ttl)
+		if err != nil {
+			return nil, err
+		}
+		conf.WorkloadIdentityTTL = dur
+	}
 
 	if heartbeatGrace := agentConfig.Server.HeartbeatGrace; heartbeatGrace != 0 {
 		conf.HeartbeatGrace = heartbeatGrace
@@ -470,14 +500,26 @@ func convertClientConfig(agentConfig *Config) (*clientconfig.Config, error) {
 	conf.ClientMaxPort = uint(agentConfig.Client.ClientMaxPort)
 	conf.ClientMinPort = uint(agentConfig.Client.ClientMinPort)
 	conf.DisableRemoteExec = agentConfig.Client.DisableRemoteExec
+	if agentConfig.Client.ArtifactCacheMaxMB != 0 {
+		conf.ArtifactCacheMaxMB = agentConfig.Client.ArtifactCacheMaxMB
+	}
+	conf.DisableArtifactCache = agentConfig.Client.DisableArtifactCache
+	conf.EnforceEphemeralDisk = agentConfig.Client.EnforceEphemeralDisk
 	conf.TemplateConfig.FunctionBlacklist = agentConfig.Client.TemplateConfig.FunctionBlacklist
 	conf.TemplateConfig.DisableSandbox = agentConfig.Client.TemplateConfig.DisableSandbox
 
+	artifactConfig, err := agentConfig.Client.Artifact.ToClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("invalid artifact config: %v", err)
+	}
+	conf.Artifact = artifactConfig
+
 	hvMap := make(map[string]*structs.ClientHostVolumeConfig, len(agentConfig.Client.HostVolumes))
 	for _, v := range agentConfig.Client.HostVolumes {
 		hvMap[v.Name] = v
 	}
 	conf.HostVolumes = hvMap
+	conf.HostNetworks = structs.CopySliceClientHostNetworkConfig(agentConfig.Client.HostNetworks)
 
 	// Setup the node
 	conf.Node = new(structs.Node)
@@ -550,6 +592,7 @@ func convertClientConfig(agentConfig *Config) (*clientconfig.Config, error) {
 
 	// Setup networking configration
 	conf.CNIPath = agentConfig.Client.CNIPath
+	conf.CNIConfigDir = agentConfig.Client.CNIConfigDir
 	conf.BridgeNetworkName = agentConfig.Client.BridgeNetworkName
 	conf.BridgeNetworkAllocSubnet = agentConfig.Client.BridgeNetworkSubnet
 
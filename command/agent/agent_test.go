@@ -272,6 +272,17 @@ func TestAgent_ServerConfig(t *testing.T) {
 	if out.BootstrapExpect != 3 {
 		t.Fatalf("should have bootstrap-expect = 3")
 	}
+
+	// The keyring encryption key must be a base64 encoded 32 byte key
+	conf.Server.KeyringEncryptionKey = "sHck3WL6cxuhuY7Mso9BHA=="
+	_, err = a.serverConfig()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "keyring_encryption_key")
+
+	conf.Server.KeyringEncryptionKey = "bm9tYWQtdGVzdC1rZXktZW5jcnlwdGlvbi1rZXktMzI="
+	out, err = a.serverConfig()
+	require.NoError(t, err)
+	require.Equal(t, []byte("nomad-test-key-encryption-key-32"), out.KeyEncryptionKey)
 }
 
 func TestAgent_ClientConfig(t *testing.T) {
@@ -0,0 +1,318 @@
+package agent
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	log "github.com/hashicorp/go-hclog"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+const (
+	// AuditStageOperationReceived is the stage of the audit event written
+	// when a request is received, before it is handled
+	AuditStageOperationReceived = "OperationReceived"
+
+	// AuditStageOperationComplete is the stage of the audit event written
+	// once a request has been handled, before the response is written
+	AuditStageOperationComplete = "OperationComplete"
+
+	// AuditDeliveryEnforced fails requests whose audit events cannot be
+	// written to the sink
+	AuditDeliveryEnforced = "enforced"
+
+	// AuditDeliveryBestEffort logs failures to write audit events to the
+	// sink without failing the request
+	AuditDeliveryBestEffort = "best-effort"
+
+	// AuditSinkTypeFile writes audit events to a rotated file
+	AuditSinkTypeFile = "file"
+
+	// AuditFormatJSON writes audit events as one JSON object per line
+	AuditFormatJSON = "json"
+
+	// AuditFilterTypeHTTPEvent filters the audit events of HTTP requests
+	AuditFilterTypeHTTPEvent = "HTTPEvent"
+
+	// auditEventType is the type of all audit events
+	auditEventType = "audit"
+
+	// auditEventVersion is the version of the audit event format
+	auditEventVersion = 1
+)
+
+// auditEvent is the structured record of a stage of an HTTP request
+type auditEvent struct {
+	ID        string         `json:"id"`
+	Type      string         `json:"type"`
+	Stage     string         `json:"stage"`
+	Timestamp time.Time      `json:"timestamp"`
+	Version   int            `json:"version"`
+	Auth      *auditAuth     `json:"auth,omitempty"`
+	Request   *auditRequest  `json:"request"`
+	Response  *auditResponse `json:"response,omitempty"`
+}
+
+// auditAuth describes the ACL token a request was made with. It is omitted
+// if ACLs are disabled or the token cannot be resolved.
+type auditAuth struct {
+	AccessorID string    `json:"accessor_id"`
+	Name       string    `json:"name"`
+	Type       string    `json:"type"`
+	Global     bool      `json:"global"`
+	Policies   []string  `json:"policies"`
+	Roles      []string  `json:"roles"`
+	CreateTime time.Time `json:"create_time"`
+}
+
+// auditRequest describes an HTTP request. Its ID is shared by the events of
+// all stages of the request.
+type auditRequest struct {
+	ID         string `json:"id"`
+	Operation  string `json:"operation"`
+	Endpoint   string `json:"endpoint"`
+	Namespace  string `json:"namespace"`
+	RemoteAddr string `json:"remote_address"`
+	UserAgent  string `json:"user_agent"`
+}
+
+// auditResponse describes the outcome of an HTTP request
+type auditResponse struct {
+	StatusCode int    `json:"status_code"`
+	Error      string `json:"error,omitempty"`
+}
+
+// auditSink is a destination audit events are written to
+type auditSink struct {
+	name     string
+	enforced bool
+	file     *logFile
+}
+
+// auditor writes the audit events of HTTP requests to the configured sinks,
+// excluding the events matching any of its filters.
+type auditor struct {
+	logger  log.Logger
+	sinks   []*auditSink
+	filters []*AuditFilter
+}
+
+// newAuditor returns an auditor for the given config, or nil if audit
+// logging is disabled.
+func newAuditor(config *AuditConfig, logger log.Logger) (*auditor, error) {
+	if config == nil || !config.Enabled {
+		return nil, nil
+	}
+	if len(config.Sinks) == 0 {
+		return nil, fmt.Errorf("audit logging requires at least one sink")
+	}
+
+	a := &auditor{
+		logger: logger.Named("audit"),
+	}
+	for _, sc := range config.Sinks {
+		sink, err := newAuditSink(sc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid audit sink %q: %v", sc.Name, err)
+		}
+		a.sinks = append(a.sinks, sink)
+	}
+	for _, f := range config.Filters {
+		if f.Type != "" && f.Type != AuditFilterTypeHTTPEvent {
+			return nil, fmt.Errorf("invalid audit filter %q: unsupported type %q", f.Name, f.Type)
+		}
+		for _, stage := range f.Stages {
+			switch stage {
+			case "*", AuditStageOperationReceived, AuditStageOperationComplete:
+			default:
+				return nil, fmt.Errorf("invalid audit filter %q: unknown stage %q", f.Name, stage)
+			}
+		}
+		a.filters = append(a.filters, f)
+	}
+	return a, nil
+}
+
+func newAuditSink(config *AuditSink) (*auditSink, error) {
+	sink := &auditSink{
+		name: config.Name,
+	}
+
+	switch config.DeliveryGuarantee {
+	case "", AuditDeliveryBestEffort:
+	case AuditDeliveryEnforced:
+		sink.enforced = true
+	default:
+		return nil, fmt.Errorf("unknown delivery guarantee %q", config.DeliveryGuarantee)
+	}
+	if config.Type != "" && config.Type != AuditSinkTypeFile {
+		return nil, fmt.Errorf("unsupported type %q", config.Type)
+	}
+	if config.Format != "" && config.Format != AuditFormatJSON {
+		return nil, fmt.Errorf("unsupported format %q", config.Format)
+	}
+	if config.Path == "" {
+		return nil, fmt.Errorf("missing path")
+	}
+
+	dir, fileName := filepath.Split(config.Path)
+	if fileName == "" {
+		fileName = "audit.json"
+	}
+	if dir != "" {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return nil, err
+		}
+	}
+
+	duration := config.RotateDuration
+	if duration == 0 {
+		duration = 24 * time.Hour
+	}
+	sink.file = &logFile{
+		fileName: fileName,
+		logPath:  dir,
+		duration: duration,
+		MaxBytes: config.RotateBytes,
+		MaxFiles: config.RotateMaxFiles,
+	}
+	return sink, nil
+}
+
+// Event writes the audit event to all sinks unless it is filtered. An error
+// is returned if the event could not be written to an enforced sink.
+func (a *auditor) Event(event *auditEvent) error {
+	for _, f := range a.filters {
+		if auditFilterMatches(f, event) {
+			return nil
+		}
+	}
+
+	buf, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	buf = append(buf, '\n')
+
+	var mErr multierror.Error
+	for _, sink := range a.sinks {
+		if _, err := sink.file.Write(buf); err != nil {
+			if sink.enforced {
+				multierror.Append(&mErr, fmt.Errorf("failed to write audit event to sink %q: %v", sink.name, err))
+				continue
+			}
+			a.logger.Warn("failed to write audit event", "sink", sink.name, "error", err)
+		}
+	}
+	return mErr.ErrorOrNil()
+}
+
+// Close closes the files of all sinks
+func (a *auditor) Close() {
+	for _, sink := range a.sinks {
+		sink.file.Close()
+	}
+}
+
+// auditFilterMatches returns whether the event matches all criteria of the
+// filter.
+func auditFilterMatches(f *AuditFilter, event *auditEvent) bool {
+	return auditFilterValueMatches(f.Endpoints, event.Request.Endpoint, true) &&
+		auditFilterValueMatches(f.Stages, event.Stage, false) &&
+		auditFilterValueMatches(f.Operations, event.Request.Operation, false)
+}
+
+// auditFilterValueMatches returns whether a criteria of a filter matches the
+// value. Empty criteria match all values.
+func auditFilterValueMatches(criteria []string, value string, prefix bool) bool {
+	if len(criteria) == 0 {
+		return true
+	}
+	for _, c := range criteria {
+		switch {
+		case c == "*":
+			return true
+		case prefix && strings.HasSuffix(c, "*"):
+			if strings.HasPrefix(value, strings.TrimSuffix(c, "*")) {
+				return true
+			}
+		case strings.EqualFold(c, value):
+			return true
+		}
+	}
+	return false
+}
+
+// auditReceived writes the audit event of a received request, returning the
+// event to complete once the request has been handled.
+func (s *HTTPServer) auditReceived(req *http.Request) (*auditEvent, error) {
+	var secret, namespace string
+	s.parseToken(req, &secret)
+	parseNamespace(req, &namespace)
+
+	event := &auditEvent{
+		ID:        uuid.Generate(),
+		Type:      auditEventType,
+		Stage:     AuditStageOperationReceived,
+		Timestamp: time.Now().UTC(),
+		Version:   auditEventVersion,
+		Auth:      s.auditAuth(secret),
+		Request: &auditRequest{
+			ID:         uuid.Generate(),
+			Operation:  req.Method,
+			Endpoint:   req.URL.Path,
+			Namespace:  namespace,
+			RemoteAddr: req.RemoteAddr,
+			UserAgent:  req.UserAgent(),
+		},
+	}
+	return event, s.auditor.Event(event)
+}
+
+// auditComplete writes the audit event of a handled request
+func (s *HTTPServer) auditComplete(received *auditEvent, code int, errMsg string) error {
+	event := *received
+	event.ID = uuid.Generate()
+	event.Stage = AuditStageOperationComplete
+	event.Timestamp = time.Now().UTC()
+	event.Response = &auditResponse{
+		StatusCode: code,
+		Error:      errMsg,
+	}
+	return s.auditor.Event(&event)
+}
+
+// auditAuth resolves the ACL token of a request
+func (s *HTTPServer) auditAuth(secret string) *auditAuth {
+	var token *structs.ACLToken
+	var err error
+	if srv := s.agent.Server(); srv != nil {
+		token, err = srv.ResolveSecretToken(secret)
+	} else if client := s.agent.Client(); client != nil {
+		token, err = client.ResolveSecretToken(secret)
+	}
+	if err != nil {
+		s.logger.Debug("failed to resolve token for audit event", "error", err)
+		return nil
+	}
+	if token == nil {
+		return nil
+	}
+
+	return &auditAuth{
+		AccessorID: token.AccessorID,
+		Name:       token.Name,
+		Type:       token.Type,
+		Global:     token.Global,
+		Policies:   token.Policies,
+		Roles:      token.Roles,
+		CreateTime: token.CreateTime,
+	}
+}
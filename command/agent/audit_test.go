@@ -0,0 +1,296 @@
+package agent
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/stretchr/testify/require"
+)
+
+// readAuditEvents reads the audit events written to the files of a sink
+func readAuditEvents(t *testing.T, dir string) []*auditEvent {
+	matches, err := filepath.Glob(filepath.Join(dir, "audit-*.json"))
+	require.NoError(t, err)
+
+	var events []*auditEvent
+	for _, path := range matches {
+		f, err := os.Open(path)
+		require.NoError(t, err)
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			var event auditEvent
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+			events = append(events, &event)
+		}
+		f.Close()
+		require.NoError(t, scanner.Err())
+	}
+	return events
+}
+
+func TestAuditor_Config(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	logger := testlog.HCLogger(t)
+
+	a, err := newAuditor(nil, logger)
+	require.NoError(err)
+	require.Nil(a)
+
+	a, err = newAuditor(&AuditConfig{Enabled: false}, logger)
+	require.NoError(err)
+	require.Nil(a)
+
+	_, err = newAuditor(&AuditConfig{Enabled: true}, logger)
+	require.Error(err)
+	require.Contains(err.Error(), "at least one sink")
+
+	dir, err := ioutil.TempDir("", "nomad-audit")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+
+	cases := []struct {
+		Name   string
+		Sink   *AuditSink
+		Filter *AuditFilter
+		Err    string
+	}{
+		{
+			Name: "delivery guarantee",
+			Sink: &AuditSink{Name: "s", DeliveryGuarantee: "maybe", Path: filepath.Join(dir, "audit.json")},
+			Err:  "delivery guarantee",
+		},
+		{
+			Name: "sink type",
+			Sink: &AuditSink{Name: "s", Type: "syslog", Path: filepath.Join(dir, "audit.json")},
+			Err:  "unsupported type",
+		},
+		{
+			Name: "format",
+			Sink: &AuditSink{Name: "s", Format: "xml", Path: filepath.Join(dir, "audit.json")},
+			Err:  "unsupported format",
+		},
+		{
+			Name: "path",
+			Sink: &AuditSink{Name: "s"},
+			Err:  "missing path",
+		},
+		{
+			Name:   "filter type",
+			Sink:   &AuditSink{Name: "s", Path: filepath.Join(dir, "audit.json")},
+			Filter: &AuditFilter{Name: "f", Type: "RPCEvent"},
+			Err:    "unsupported type",
+		},
+		{
+			Name:   "filter stage",
+			Sink:   &AuditSink{Name: "s", Path: filepath.Join(dir, "audit.json")},
+			Filter: &AuditFilter{Name: "f", Stages: []string{"OperationStarted"}},
+			Err:    "unknown stage",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			config := &AuditConfig{
+				Enabled: true,
+				Sinks:   []*AuditSink{c.Sink},
+			}
+			if c.Filter != nil {
+				config.Filters = []*AuditFilter{c.Filter}
+			}
+			_, err := newAuditor(config, logger)
+			require.Error(err)
+			require.Contains(err.Error(), c.Err)
+		})
+	}
+}
+
+func TestAuditor_FilterMatches(t *testing.T) {
+	t.Parallel()
+	event := &auditEvent{
+		Stage: AuditStageOperationReceived,
+		Request: &auditRequest{
+			Operation: "GET",
+			Endpoint:  "/v1/job/example/allocations",
+		},
+	}
+
+	cases := []struct {
+		Name    string
+		Filter  *AuditFilter
+		Matches bool
+	}{
+		{
+			Name:    "empty",
+			Filter:  &AuditFilter{},
+			Matches: true,
+		},
+		{
+			Name: "exact",
+			Filter: &AuditFilter{
+				Endpoints:  []string{"/v1/job/example/allocations"},
+				Stages:     []string{AuditStageOperationReceived},
+				Operations: []string{"get"},
+			},
+			Matches: true,
+		},
+		{
+			Name: "wildcards",
+			Filter: &AuditFilter{
+				Endpoints:  []string{"/v1/jobs", "/v1/job/*"},
+				Stages:     []string{"*"},
+				Operations: []string{"*"},
+			},
+			Matches: true,
+		},
+		{
+			Name: "other endpoint",
+			Filter: &AuditFilter{
+				Endpoints: []string{"/v1/job/other"},
+			},
+		},
+		{
+			Name: "other stage",
+			Filter: &AuditFilter{
+				Endpoints: []string{"*"},
+				Stages:    []string{AuditStageOperationComplete},
+			},
+		},
+		{
+			Name: "other operation",
+			Filter: &AuditFilter{
+				Operations: []string{"PUT", "POST", "DELETE"},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			require.Equal(t, c.Matches, auditFilterMatches(c.Filter, event))
+		})
+	}
+}
+
+func TestHTTP_Audit(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "nomad-audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	httpACLTest(t, func(c *Config) {
+		c.Audit = &AuditConfig{
+			Enabled: true,
+			Sinks: []*AuditSink{{
+				Name: "audit",
+				Path: filepath.Join(dir, "audit.json"),
+			}},
+			Filters: []*AuditFilter{{
+				Name:      "health",
+				Endpoints: []string{"/v1/agent/health"},
+			}},
+		}
+	}, func(s *TestAgent) {
+		require := require.New(t)
+		state := s.Agent.server.State()
+		token := mock.ACLManagementToken()
+		require.NoError(state.UpsertACLTokens(1000, []*structs.ACLToken{token}))
+
+		// Make an authenticated request and a denied request
+		req, err := http.NewRequest("GET", "/v1/jobs?namespace=web", nil)
+		require.NoError(err)
+		setToken(req, token)
+		respW := httptest.NewRecorder()
+		s.Server.wrap(s.Server.JobsRequest)(respW, req)
+		require.Equal(http.StatusOK, respW.Code)
+
+		req, err = http.NewRequest("DELETE", "/v1/job/example", nil)
+		require.NoError(err)
+		respW = httptest.NewRecorder()
+		s.Server.wrap(s.Server.JobSpecificRequest)(respW, req)
+		require.Equal(http.StatusForbidden, respW.Code)
+
+		// Filtered requests are not audited
+		req, err = http.NewRequest("GET", "/v1/agent/health", nil)
+		require.NoError(err)
+		respW = httptest.NewRecorder()
+		s.Server.wrap(s.Server.HealthRequest)(respW, req)
+
+		events := readAuditEvents(t, dir)
+		require.Len(events, 4)
+
+		received, complete := events[0], events[1]
+		require.Equal(AuditStageOperationReceived, received.Stage)
+		require.Equal(AuditStageOperationComplete, complete.Stage)
+		require.Equal(received.Request.ID, complete.Request.ID)
+		require.NotEqual(received.ID, complete.ID)
+		require.Equal("GET", received.Request.Operation)
+		require.Equal("/v1/jobs", received.Request.Endpoint)
+		require.Equal("web", received.Request.Namespace)
+		require.NotNil(received.Auth)
+		require.Equal(token.AccessorID, received.Auth.AccessorID)
+		require.Nil(received.Response)
+		require.Equal(http.StatusOK, complete.Response.StatusCode)
+
+		denied := events[3]
+		require.Equal("DELETE", denied.Request.Operation)
+		require.Equal(structs.DefaultNamespace, denied.Request.Namespace)
+		require.Equal(structs.AnonymousACLToken.AccessorID, denied.Auth.AccessorID)
+		require.Equal(http.StatusForbidden, denied.Response.StatusCode)
+		require.Equal(structs.ErrPermissionDenied.Error(), denied.Response.Error)
+	})
+}
+
+func TestHTTP_Audit_Enforced(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "nomad-audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	httpTest(t, func(c *Config) {
+		c.Audit = &AuditConfig{
+			Enabled: true,
+			Sinks: []*AuditSink{
+				{
+					Name:              "enforced",
+					DeliveryGuarantee: AuditDeliveryEnforced,
+					Path:              filepath.Join(dir, "enforced", "audit.json"),
+				},
+				{
+					Name: "best-effort",
+					Path: filepath.Join(dir, "best-effort", "audit.json"),
+				},
+			},
+		}
+	}, func(s *TestAgent) {
+		require := require.New(t)
+
+		// Requests are handled while both sinks can be written to
+		req, err := http.NewRequest("GET", "/v1/jobs", nil)
+		require.NoError(err)
+		respW := httptest.NewRecorder()
+		s.Server.wrap(s.Server.JobsRequest)(respW, req)
+		require.Equal(http.StatusOK, respW.Code)
+
+		// Failing to write to the best-effort sink doesn't fail requests
+		require.NoError(os.RemoveAll(filepath.Join(dir, "best-effort")))
+		s.Server.auditor.Close()
+		respW = httptest.NewRecorder()
+		s.Server.wrap(s.Server.JobsRequest)(respW, req)
+		require.Equal(http.StatusOK, respW.Code)
+
+		// Failing to write to the enforced sink fails requests
+		require.NoError(os.RemoveAll(filepath.Join(dir, "enforced")))
+		s.Server.auditor.Close()
+		respW = httptest.NewRecorder()
+		s.Server.wrap(s.Server.JobsRequest)(respW, req)
+		require.Equal(http.StatusInternalServerError, respW.Code)
+		require.Contains(respW.Body.String(), `sink "enforced"`)
+	})
+}
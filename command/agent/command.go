@@ -314,6 +314,13 @@ func (c *Command) isValidConfig(config, cmdConfig *Config) bool {
 		}
 	}
 
+	if config.Server.KeyringEncryptionKey != "" {
+		if _, err := config.Server.KeyringEncryptionKeyBytes(); err != nil {
+			c.Ui.Error(fmt.Sprintf("Invalid keyring encryption key: %s", err))
+			return false
+		}
+	}
+
 	// Verify the paths are absolute.
 	dirs := map[string]string{
 		"data-dir":   config.DataDir,
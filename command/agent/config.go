@@ -1,6 +1,7 @@
 package agent
 
 import (
+	"crypto/rand"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -16,6 +17,7 @@ import (
 	"strings"
 	"time"
 
+	humanize "github.com/dustin/go-humanize"
 	sockaddr "github.com/hashicorp/go-sockaddr"
 	"github.com/hashicorp/go-sockaddr/template"
 	client "github.com/hashicorp/nomad/client/config"
@@ -99,6 +101,9 @@ type Config struct {
 	// ACL has our acl related settings
 	ACL *ACLConfig `hcl:"acl"`
 
+	// Audit has our audit logging related settings
+	Audit *AuditConfig `hcl:"audit"`
+
 	// Telemetry is used to configure sending telemetry
 	Telemetry *Telemetry `hcl:"telemetry"`
 
@@ -257,9 +262,23 @@ type ClientConfig struct {
 	// DisableRemoteExec disables remote exec targeting tasks on this client
 	DisableRemoteExec bool `hcl:"disable_remote_exec"`
 
+	// ArtifactCacheMaxMB is the maximum size of the artifact cache shared
+	// by allocations on this client
+	ArtifactCacheMaxMB int `hcl:"artifact_cache_max_mb"`
+
+	// DisableArtifactCache disables caching artifacts across allocations
+	DisableArtifactCache bool `hcl:"disable_artifact_cache"`
+
+	// EnforceEphemeralDisk enables killing allocations that use more disk
+	// than their requested ephemeral disk size
+	EnforceEphemeralDisk bool `hcl:"enforce_ephemeral_disk"`
+
 	// TemplateConfig includes configuration for template rendering
 	TemplateConfig *ClientTemplateConfig `hcl:"template"`
 
+	// Artifact configures the limits and sandboxing of artifact downloads
+	Artifact *ClientArtifactConfig `hcl:"artifact"`
+
 	// ServerJoin contains information that is used to attempt to join servers
 	ServerJoin *ServerJoin `hcl:"server_join"`
 
@@ -267,6 +286,10 @@ type ClientConfig struct {
 	// available to jobs running on this node.
 	HostVolumes []*structs.ClientHostVolumeConfig `hcl:"host_volume"`
 
+	// HostNetworks describes the named host networks ports may be bound on
+	// in addition to the network_interface
+	HostNetworks []*structs.ClientHostNetworkConfig `hcl:"host_network"`
+
 	// ExtraKeysHCL is used by hcl to surface unexpected keys
 	ExtraKeysHCL []string `hcl:",unusedKeys" json:"-"`
 
@@ -274,6 +297,10 @@ type ClientConfig struct {
 	// specified colon delimited
 	CNIPath string `hcl:"cni_path"`
 
+	// CNIConfigDir is the directory containing the CNI network configurations
+	// that allocations can join using the cni/<name> network mode
+	CNIConfigDir string `hcl:"cni_config_dir"`
+
 	// BridgeNetworkName is the name of the bridge to create when using the
 	// bridge network mode
 	BridgeNetworkName string `hcl:"bridge_network_name"`
@@ -298,6 +325,111 @@ type ClientTemplateConfig struct {
 	DisableSandbox bool `hcl:"disable_file_sandbox"`
 }
 
+// ClientArtifactConfig is configuration on the client specific to
+// downloading artifacts
+type ClientArtifactConfig struct {
+	// DownloadTimeout is the maximum time a single artifact may take to
+	// download and unpack.
+	DownloadTimeout string `hcl:"download_timeout"`
+
+	// HTTPMaxSize is the maximum size of an artifact downloaded over HTTP,
+	// such as "100GB".
+	HTTPMaxSize string `hcl:"http_max_size"`
+
+	// DecompressionFileCountLimit is the maximum number of files an archive
+	// may contain.
+	DecompressionFileCountLimit int `hcl:"decompression_file_count_limit"`
+
+	// DecompressionSizeLimit is the maximum total size of the files
+	// unpacked from an archive, such as "100GB".
+	DecompressionSizeLimit string `hcl:"decompression_size_limit"`
+
+	// ProtocolAllowlist restricts artifacts to the given getter protocols.
+	ProtocolAllowlist []string `hcl:"protocol_allowlist"`
+
+	// ProtocolDenylist disallows artifacts using the given getter protocols.
+	ProtocolDenylist []string `hcl:"protocol_denylist"`
+
+	// DisableSandbox downloads artifacts in the client process instead of
+	// an isolated child process.
+	DisableSandbox bool `hcl:"disable_sandbox"`
+}
+
+// Merge merges two artifact configurations, preferring the values of b.
+func (a *ClientArtifactConfig) Merge(b *ClientArtifactConfig) *ClientArtifactConfig {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+
+	result := *a
+	if b.DownloadTimeout != "" {
+		result.DownloadTimeout = b.DownloadTimeout
+	}
+	if b.HTTPMaxSize != "" {
+		result.HTTPMaxSize = b.HTTPMaxSize
+	}
+	if b.DecompressionFileCountLimit != 0 {
+		result.DecompressionFileCountLimit = b.DecompressionFileCountLimit
+	}
+	if b.DecompressionSizeLimit != "" {
+		result.DecompressionSizeLimit = b.DecompressionSizeLimit
+	}
+	if len(b.ProtocolAllowlist) != 0 {
+		result.ProtocolAllowlist = b.ProtocolAllowlist
+	}
+	if len(b.ProtocolDenylist) != 0 {
+		result.ProtocolDenylist = b.ProtocolDenylist
+	}
+	if b.DisableSandbox {
+		result.DisableSandbox = b.DisableSandbox
+	}
+	return &result
+}
+
+// ToClientConfig returns the client's artifact configuration, using the
+// defaults for unset values.
+func (a *ClientArtifactConfig) ToClientConfig() (*client.ArtifactConfig, error) {
+	c := client.DefaultArtifactConfig()
+	if a == nil {
+		return c, nil
+	}
+
+	if a.DownloadTimeout != "" {
+		d, err := time.ParseDuration(a.DownloadTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse download_timeout: %v", err)
+		}
+		c.DownloadTimeout = d
+	}
+	if a.HTTPMaxSize != "" {
+		size, err := humanize.ParseBytes(a.HTTPMaxSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse http_max_size: %v", err)
+		}
+		c.HTTPMaxBytes = int64(size)
+	}
+	if a.DecompressionFileCountLimit < 0 {
+		return nil, fmt.Errorf("decompression_file_count_limit must not be negative")
+	}
+	if a.DecompressionFileCountLimit != 0 {
+		c.DecompressionFileCountLimit = a.DecompressionFileCountLimit
+	}
+	if a.DecompressionSizeLimit != "" {
+		size, err := humanize.ParseBytes(a.DecompressionSizeLimit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse decompression_size_limit: %v", err)
+		}
+		c.DecompressionSizeLimit = int64(size)
+	}
+	c.ProtocolAllowlist = helper.CopySliceString(a.ProtocolAllowlist)
+	c.ProtocolDenylist = helper.CopySliceString(a.ProtocolDenylist)
+	c.DisableSandbox = a.DisableSandbox
+	return c, nil
+}
+
 // ACLConfig is configuration specific to the ACL system
 type ACLConfig struct {
 	// Enabled controls if we are enforce and manage ACLs
@@ -326,6 +458,79 @@ type ACLConfig struct {
 	ExtraKeysHCL []string `hcl:",unusedKeys" json:"-"`
 }
 
+// AuditConfig is configuration specific to audit logging of the HTTP API
+type AuditConfig struct {
+	// Enabled controls if the agent writes audit events
+	Enabled bool `hcl:"enabled"`
+
+	// Sinks are the destinations audit events are written to
+	Sinks []*AuditSink `hcl:"sink"`
+
+	// Filters exclude matching audit events from being written
+	Filters []*AuditFilter `hcl:"filter"`
+
+	// ExtraKeysHCL is used by hcl to surface unexpected keys
+	ExtraKeysHCL []string `hcl:",unusedKeys" json:"-"`
+}
+
+// AuditSink is a destination audit events are written to
+type AuditSink struct {
+	// Name is the unique name of the sink
+	Name string `hcl:",key"`
+
+	// DeliveryGuarantee is either "enforced", in which case requests fail
+	// when their events cannot be written, or "best-effort"
+	DeliveryGuarantee string `hcl:"delivery_guarantee"`
+
+	// Type is the type of the sink. Only "file" is supported.
+	Type string `hcl:"type"`
+
+	// Format is the format of the events. Only "json" is supported.
+	Format string `hcl:"format"`
+
+	// Path is the path of the audit log file
+	Path string `hcl:"path"`
+
+	// RotateDuration is the time period after which the file is rotated.
+	// Defaults to 24h.
+	RotateDuration    time.Duration
+	RotateDurationHCL string `hcl:"rotate_duration" json:"-"`
+
+	// RotateBytes is the max number of bytes written to a file before it
+	// is rotated
+	RotateBytes int `hcl:"rotate_bytes"`
+
+	// RotateMaxFiles is the max number of rotated files to keep
+	RotateMaxFiles int `hcl:"rotate_max_files"`
+
+	// ExtraKeysHCL is used by hcl to surface unexpected keys
+	ExtraKeysHCL []string `hcl:",unusedKeys" json:"-"`
+}
+
+// AuditFilter excludes the audit events matching all of its criteria. Each
+// criteria matches if it is empty, contains "*" or contains the value of the
+// event. Endpoints may end with "*" to match a prefix.
+type AuditFilter struct {
+	// Name is the unique name of the filter
+	Name string `hcl:",key"`
+
+	// Type is the type of the events filtered. Only "HTTPEvent" is
+	// supported.
+	Type string `hcl:"type"`
+
+	// Endpoints are the request paths filtered
+	Endpoints []string `hcl:"endpoints"`
+
+	// Stages are the request stages filtered
+	Stages []string `hcl:"stages"`
+
+	// Operations are the HTTP methods filtered
+	Operations []string `hcl:"operations"`
+
+	// ExtraKeysHCL is used by hcl to surface unexpected keys
+	ExtraKeysHCL []string `hcl:",unusedKeys" json:"-"`
+}
+
 // ServerConfig is configuration specific to the server mode
 type ServerConfig struct {
 	// Enabled controls if we are a server
@@ -373,6 +578,10 @@ type ServerConfig struct {
 	// can be used to filter by age.
 	JobGCThreshold string `hcl:"job_gc_threshold"`
 
+	// DispatchIdempotencyWindow controls how long the idempotency token of a
+	// dispatched job is honored after the job was dispatched.
+	DispatchIdempotencyWindow string `hcl:"dispatch_idempotency_window"`
+
 	// EvalGCThreshold controls how "old" an eval must be to be collected by GC.
 	// Age is not the only requirement for a eval to be GCed but the threshold
 	// can be used to filter by age.
@@ -383,6 +592,14 @@ type ServerConfig struct {
 	// GCed but the threshold can be used to filter by age.
 	DeploymentGCThreshold string `hcl:"deployment_gc_threshold"`
 
+	// RootKeyRotationThreshold controls how "old" the key signing workload
+	// identities must be to be rotated.
+	RootKeyRotationThreshold string `hcl:"root_key_rotation_threshold"`
+
+	// WorkloadIdentityTTL controls how long the workload identities of tasks
+	// are valid for before they must be renewed.
+	WorkloadIdentityTTL string `hcl:"workload_identity_ttl"`
+
 	// HeartbeatGrace is the grace period beyond the TTL to account for network,
 	// processing delays and clock skew before marking a node as "down".
 	HeartbeatGrace    time.Duration
@@ -441,6 +658,10 @@ type ServerConfig struct {
 	// Encryption key to use for the Serf communication
 	EncryptKey string `hcl:"encrypt" json:"-"`
 
+	// KeyringEncryptionKey is the base64 encoded key wrapping the keys that
+	// encrypt variables. It must be the same on all servers.
+	KeyringEncryptionKey string `hcl:"keyring_encryption_key" json:"-"`
+
 	// ServerJoin contains information that is used to attempt to join servers
 	ServerJoin *ServerJoin `hcl:"server_join"`
 
@@ -507,6 +728,18 @@ func (s *ServerConfig) EncryptBytes() ([]byte, error) {
 	return base64.StdEncoding.DecodeString(s.EncryptKey)
 }
 
+// KeyringEncryptionKeyBytes returns the keyring encryption key configured.
+func (s *ServerConfig) KeyringEncryptionKeyBytes() ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(s.KeyringEncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("key must be 32 bytes, got %d", len(key))
+	}
+	return key, nil
+}
+
 // Telemetry is the telemetry configuration for the server
 type Telemetry struct {
 	StatsiteAddr             string        `hcl:"statsite_address"`
@@ -791,6 +1024,13 @@ func DevConfig(mode *devModeConfig) *Config {
 	conf.Telemetry.PublishAllocationMetrics = true
 	conf.Telemetry.PublishNodeMetrics = true
 
+	// The state of a dev agent is kept in memory, so a random keyring
+	// encryption key doesn't need to survive restarts
+	kek := make([]byte, 32)
+	if _, err := rand.Read(kek); err == nil {
+		conf.Server.KeyringEncryptionKey = base64.StdEncoding.EncodeToString(kek)
+	}
+
 	return conf
 }
 
@@ -823,6 +1063,7 @@ func DefaultConfig() *Config {
 			GCMaxAllocs:           50,
 			NoHostUUID:            helper.BoolToPtr(true),
 			DisableRemoteExec:     false,
+			ArtifactCacheMaxMB:    1024,
 			ServerJoin: &ServerJoin{
 				RetryJoin:        []string{},
 				RetryInterval:    30 * time.Second,
@@ -986,6 +1227,14 @@ func (c *Config) Merge(b *Config) *Config {
 		result.ACL = result.ACL.Merge(b.ACL)
 	}
 
+	// Apply the audit config
+	if result.Audit == nil && b.Audit != nil {
+		audit := *b.Audit
+		result.Audit = &audit
+	} else if b.Audit != nil {
+		result.Audit = result.Audit.Merge(b.Audit)
+	}
+
 	// Apply the ports config
 	if result.Ports == nil && b.Ports != nil {
 		ports := *b.Ports
@@ -1254,6 +1503,46 @@ func (a *ACLConfig) Merge(b *ACLConfig) *ACLConfig {
 	return &result
 }
 
+// Merge is used to merge two audit configs together. Sinks and filters are
+// merged by name.
+func (a *AuditConfig) Merge(b *AuditConfig) *AuditConfig {
+	result := *a
+
+	if b.Enabled {
+		result.Enabled = true
+	}
+
+	result.Sinks = make([]*AuditSink, 0, len(a.Sinks)+len(b.Sinks))
+	seenSinks := make(map[string]int, len(a.Sinks))
+	for _, sink := range a.Sinks {
+		seenSinks[sink.Name] = len(result.Sinks)
+		result.Sinks = append(result.Sinks, sink)
+	}
+	for _, sink := range b.Sinks {
+		if i, ok := seenSinks[sink.Name]; ok {
+			result.Sinks[i] = sink
+			continue
+		}
+		result.Sinks = append(result.Sinks, sink)
+	}
+
+	result.Filters = make([]*AuditFilter, 0, len(a.Filters)+len(b.Filters))
+	seenFilters := make(map[string]int, len(a.Filters))
+	for _, filter := range a.Filters {
+		seenFilters[filter.Name] = len(result.Filters)
+		result.Filters = append(result.Filters, filter)
+	}
+	for _, filter := range b.Filters {
+		if i, ok := seenFilters[filter.Name]; ok {
+			result.Filters[i] = filter
+			continue
+		}
+		result.Filters = append(result.Filters, filter)
+	}
+
+	return &result
+}
+
 // Merge is used to merge two server configs together
 func (a *ServerConfig) Merge(b *ServerConfig) *ServerConfig {
 	result := *a
@@ -1288,12 +1577,21 @@ func (a *ServerConfig) Merge(b *ServerConfig) *ServerConfig {
 	if b.JobGCThreshold != "" {
 		result.JobGCThreshold = b.JobGCThreshold
 	}
+	if b.DispatchIdempotencyWindow != "" {
+		result.DispatchIdempotencyWindow = b.DispatchIdempotencyWindow
+	}
 	if b.EvalGCThreshold != "" {
 		result.EvalGCThreshold = b.EvalGCThreshold
 	}
 	if b.DeploymentGCThreshold != "" {
 		result.DeploymentGCThreshold = b.DeploymentGCThreshold
 	}
+	if b.RootKeyRotationThreshold != "" {
+		result.RootKeyRotationThreshold = b.RootKeyRotationThreshold
+	}
+	if b.WorkloadIdentityTTL != "" {
+		result.WorkloadIdentityTTL = b.WorkloadIdentityTTL
+	}
 	if b.HeartbeatGrace != 0 {
 		result.HeartbeatGrace = b.HeartbeatGrace
 	}
@@ -1333,6 +1631,9 @@ func (a *ServerConfig) Merge(b *ServerConfig) *ServerConfig {
 	if b.EncryptKey != "" {
 		result.EncryptKey = b.EncryptKey
 	}
+	if b.KeyringEncryptionKey != "" {
+		result.KeyringEncryptionKey = b.KeyringEncryptionKey
+	}
 	if b.ServerJoin != nil {
 		result.ServerJoin = result.ServerJoin.Merge(b.ServerJoin)
 	}
@@ -1423,10 +1724,28 @@ func (a *ClientConfig) Merge(b *ClientConfig) *ClientConfig {
 		result.DisableRemoteExec = b.DisableRemoteExec
 	}
 
+	if b.ArtifactCacheMaxMB != 0 {
+		result.ArtifactCacheMaxMB = b.ArtifactCacheMaxMB
+	}
+
+	if b.DisableArtifactCache {
+		result.DisableArtifactCache = b.DisableArtifactCache
+	}
+
+	if b.EnforceEphemeralDisk {
+		result.EnforceEphemeralDisk = b.EnforceEphemeralDisk
+	}
+
+	if b.CNIConfigDir != "" {
+		result.CNIConfigDir = b.CNIConfigDir
+	}
+
 	if b.TemplateConfig != nil {
 		result.TemplateConfig = b.TemplateConfig
 	}
 
+	result.Artifact = result.Artifact.Merge(b.Artifact)
+
 	// Add the servers
 	result.Servers = append(result.Servers, b.Servers...)
 
@@ -1464,6 +1783,12 @@ func (a *ClientConfig) Merge(b *ClientConfig) *ClientConfig {
 		result.HostVolumes = structs.HostVolumeSliceMerge(a.HostVolumes, b.HostVolumes)
 	}
 
+	if len(a.HostNetworks) == 0 && len(b.HostNetworks) != 0 {
+		result.HostNetworks = structs.CopySliceClientHostNetworkConfig(b.HostNetworks)
+	} else if len(b.HostNetworks) != 0 {
+		result.HostNetworks = structs.HostNetworkSliceMerge(a.HostNetworks, b.HostNetworks)
+	}
+
 	return &result
 }
 
@@ -66,6 +66,17 @@ func ParseConfigFile(path string) (*Config, error) {
 		return nil, err
 	}
 
+	if c.Audit != nil {
+		for _, sink := range c.Audit.Sinks {
+			err = durations([]td{
+				{"audit.sink.rotate_duration", &sink.RotateDuration, &sink.RotateDurationHCL},
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	// report unexpected keys
 	err = extraKeys(c)
 	if err != nil {
@@ -144,11 +155,28 @@ func extraKeys(c *Config) error {
 		removeEqualFold(&c.Client.ExtraKeysHCL, "host_volume")
 	}
 
+	// Remove HostNetwork extra keys
+	for _, hn := range c.Client.HostNetworks {
+		removeEqualFold(&c.Client.ExtraKeysHCL, hn.Name)
+		removeEqualFold(&c.Client.ExtraKeysHCL, "host_network")
+	}
+
 	for _, k := range []string{"enabled_schedulers", "start_join", "retry_join", "server_join"} {
 		removeEqualFold(&c.ExtraKeysHCL, k)
 		removeEqualFold(&c.ExtraKeysHCL, "server")
 	}
 
+	if c.Audit != nil {
+		for _, sink := range c.Audit.Sinks {
+			removeEqualFold(&c.Audit.ExtraKeysHCL, sink.Name)
+			removeEqualFold(&c.Audit.ExtraKeysHCL, "sink")
+		}
+		for _, filter := range c.Audit.Filters {
+			removeEqualFold(&c.Audit.ExtraKeysHCL, filter.Name)
+			removeEqualFold(&c.Audit.ExtraKeysHCL, "filter")
+		}
+	}
+
 	for _, k := range []string{"datadog_tags"} {
 		removeEqualFold(&c.ExtraKeysHCL, k)
 		removeEqualFold(&c.ExtraKeysHCL, "telemetry")
@@ -83,39 +83,52 @@ var basicConfig = &Config{
 		GCMaxAllocs:           50,
 		NoHostUUID:            helper.BoolToPtr(false),
 		DisableRemoteExec:     true,
+		EnforceEphemeralDisk:  true,
+		ArtifactCacheMaxMB:    2048,
+		Artifact: &ClientArtifactConfig{
+			DownloadTimeout:             "10m",
+			HTTPMaxSize:                 "1GB",
+			DecompressionFileCountLimit: 100,
+			DecompressionSizeLimit:      "2GB",
+			ProtocolDenylist:            []string{"hg"},
+		},
 		HostVolumes: []*structs.ClientHostVolumeConfig{
 			{Name: "tmp", Path: "/tmp"},
 		},
+		HostNetworks: []*structs.ClientHostNetworkConfig{
+			{Name: "public", CIDR: "10.0.0.0/8", ReservedPorts: "22"},
+		},
 	},
 	Server: &ServerConfig{
-		Enabled:                true,
-		AuthoritativeRegion:    "foobar",
-		BootstrapExpect:        5,
-		DataDir:                "/tmp/data",
-		ProtocolVersion:        3,
-		RaftProtocol:           3,
-		NumSchedulers:          helper.IntToPtr(2),
-		EnabledSchedulers:      []string{"test"},
-		NodeGCThreshold:        "12h",
-		EvalGCThreshold:        "12h",
-		JobGCInterval:          "3m",
-		JobGCThreshold:         "12h",
-		DeploymentGCThreshold:  "12h",
-		HeartbeatGrace:         30 * time.Second,
-		HeartbeatGraceHCL:      "30s",
-		MinHeartbeatTTL:        33 * time.Second,
-		MinHeartbeatTTLHCL:     "33s",
-		MaxHeartbeatsPerSecond: 11.0,
-		RetryJoin:              []string{"1.1.1.1", "2.2.2.2"},
-		StartJoin:              []string{"1.1.1.1", "2.2.2.2"},
-		RetryInterval:          15 * time.Second,
-		RetryIntervalHCL:       "15s",
-		RejoinAfterLeave:       true,
-		RetryMaxAttempts:       3,
-		NonVotingServer:        true,
-		RedundancyZone:         "foo",
-		UpgradeVersion:         "0.8.0",
-		EncryptKey:             "abc",
+		Enabled:                   true,
+		AuthoritativeRegion:       "foobar",
+		BootstrapExpect:           5,
+		DataDir:                   "/tmp/data",
+		ProtocolVersion:           3,
+		RaftProtocol:              3,
+		NumSchedulers:             helper.IntToPtr(2),
+		EnabledSchedulers:         []string{"test"},
+		NodeGCThreshold:           "12h",
+		EvalGCThreshold:           "12h",
+		JobGCInterval:             "3m",
+		JobGCThreshold:            "12h",
+		DeploymentGCThreshold:     "12h",
+		DispatchIdempotencyWindow: "30m",
+		HeartbeatGrace:            30 * time.Second,
+		HeartbeatGraceHCL:         "30s",
+		MinHeartbeatTTL:           33 * time.Second,
+		MinHeartbeatTTLHCL:        "33s",
+		MaxHeartbeatsPerSecond:    11.0,
+		RetryJoin:                 []string{"1.1.1.1", "2.2.2.2"},
+		StartJoin:                 []string{"1.1.1.1", "2.2.2.2"},
+		RetryInterval:             15 * time.Second,
+		RetryIntervalHCL:          "15s",
+		RejoinAfterLeave:          true,
+		RetryMaxAttempts:          3,
+		NonVotingServer:           true,
+		RedundancyZone:            "foo",
+		UpgradeVersion:            "0.8.0",
+		EncryptKey:                "abc",
 		ServerJoin: &ServerJoin{
 			RetryJoin:        []string{"1.1.1.1", "2.2.2.2"},
 			RetryInterval:    time.Duration(15) * time.Second,
@@ -367,12 +380,42 @@ var nonoptConfig = &Config{
 	Sentinel:                  nil,
 }
 
+var auditConfig = &Config{
+	Audit: &AuditConfig{
+		Enabled: true,
+		Sinks: []*AuditSink{
+			{
+				Name:              "audit",
+				DeliveryGuarantee: AuditDeliveryEnforced,
+				Type:              AuditSinkTypeFile,
+				Format:            AuditFormatJSON,
+				Path:              "/opt/nomad/audit/audit.json",
+				RotateDuration:    time.Hour,
+				RotateDurationHCL: "1h",
+				RotateBytes:       1024,
+				RotateMaxFiles:    5,
+			},
+		},
+		Filters: []*AuditFilter{
+			{
+				Name:       "health",
+				Type:       AuditFilterTypeHTTPEvent,
+				Endpoints:  []string{"/v1/agent/health"},
+				Stages:     []string{"*"},
+				Operations: []string{"*"},
+			},
+		},
+	},
+	HTTPAPIResponseHeaders: map[string]string{},
+}
+
 func TestConfig_Parse(t *testing.T) {
 	t.Parallel()
 
 	basicConfig.addDefaults()
 	pluginConfig.addDefaults()
 	nonoptConfig.addDefaults()
+	auditConfig.addDefaults()
 
 	cases := []struct {
 		File   string
@@ -404,6 +447,16 @@ func TestConfig_Parse(t *testing.T) {
 			nonoptConfig,
 			false,
 		},
+		{
+			"audit.hcl",
+			auditConfig,
+			false,
+		},
+		{
+			"audit.json",
+			auditConfig,
+			false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -1128,3 +1128,79 @@ func TestTelemetry_Parse(t *testing.T) {
 	require.Exactly([]string{"+nomad.raft"}, config.Telemetry.PrefixFilter)
 	require.True(config.Telemetry.DisableDispatchedJobSummaryMetrics)
 }
+
+func TestClientArtifactConfig_ToClientConfig(t *testing.T) {
+	require := require.New(t)
+
+	// Unset values use the defaults
+	var empty *ClientArtifactConfig
+	c, err := empty.ToClientConfig()
+	require.NoError(err)
+	require.Equal(30*time.Minute, c.DownloadTimeout)
+	require.EqualValues(100<<30, c.HTTPMaxBytes)
+
+	a := &ClientArtifactConfig{
+		DownloadTimeout:             "10m",
+		HTTPMaxSize:                 "1GB",
+		DecompressionFileCountLimit: 100,
+		DecompressionSizeLimit:      "2GiB",
+		ProtocolAllowlist:           []string{"https"},
+		DisableSandbox:              true,
+	}
+	c, err = a.ToClientConfig()
+	require.NoError(err)
+	require.Equal(10*time.Minute, c.DownloadTimeout)
+	require.EqualValues(1000*1000*1000, c.HTTPMaxBytes)
+	require.Equal(100, c.DecompressionFileCountLimit)
+	require.EqualValues(2<<30, c.DecompressionSizeLimit)
+	require.Equal([]string{"https"}, c.ProtocolAllowlist)
+	require.True(c.DisableSandbox)
+
+	// Merging keeps unset values
+	merged := a.Merge(&ClientArtifactConfig{HTTPMaxSize: "5GB"})
+	require.Equal("5GB", merged.HTTPMaxSize)
+	require.Equal("10m", merged.DownloadTimeout)
+
+	_, err = (&ClientArtifactConfig{HTTPMaxSize: "lots"}).ToClientConfig()
+	require.Error(err)
+}
+
+func TestConfig_AuditMerge(t *testing.T) {
+	require := require.New(t)
+
+	a := &Config{
+		Audit: &AuditConfig{
+			Sinks: []*AuditSink{
+				{Name: "file", Path: "/opt/nomad/audit.json"},
+			},
+			Filters: []*AuditFilter{
+				{Name: "health", Endpoints: []string{"/v1/agent/health"}},
+			},
+		},
+	}
+	b := &Config{
+		Audit: &AuditConfig{
+			Enabled: true,
+			Sinks: []*AuditSink{
+				{Name: "file", Path: "/var/nomad/audit.json", DeliveryGuarantee: AuditDeliveryEnforced},
+			},
+			Filters: []*AuditFilter{
+				{Name: "reads", Operations: []string{"GET"}},
+			},
+		},
+	}
+
+	// Sinks and filters of the same name are replaced
+	merged := a.Merge(b)
+	require.True(merged.Audit.Enabled)
+	require.Len(merged.Audit.Sinks, 1)
+	require.Equal("/var/nomad/audit.json", merged.Audit.Sinks[0].Path)
+	require.Equal(AuditDeliveryEnforced, merged.Audit.Sinks[0].DeliveryGuarantee)
+	require.Len(merged.Audit.Filters, 2)
+	require.Equal("health", merged.Audit.Filters[0].Name)
+	require.Equal("reads", merged.Audit.Filters[1].Name)
+
+	// The merged config doesn't share slices with its inputs
+	require.Len(a.Audit.Filters, 1)
+	require.Equal("/opt/nomad/audit.json", a.Audit.Sinks[0].Path)
+}
@@ -1362,7 +1362,7 @@ func newConnect(serviceName string, nc *structs.ConsulConnect, networks structs.
 	// Advertise host IP:port
 	cc.SidecarService = &api.AgentServiceRegistration{
 		Tags:    helper.CopySliceString(nc.SidecarService.Tags),
-		Address: net.IP,
+		Address: net.PortIP(port),
 		Port:    port.Value,
 
 		// Automatically configure the proxy to bind to all addresses
@@ -1397,6 +1397,28 @@ func TestGetAddress(t *testing.T) {
 	}
 }
 
+// TestGetAddress_HostNetwork asserts that ports allocated on a host network
+// are advertised on the host network's IP in host address mode.
+func TestGetAddress_HostNetwork(t *testing.T) {
+	networks := structs.Networks{
+		{
+			IP:            "127.0.0.1",
+			ReservedPorts: []structs.Port{{Label: "admin", Value: 5000}},
+			DynamicPorts:  []structs.Port{{Label: "http", Value: 23456, HostNetwork: "public", HostIP: "10.0.0.5"}},
+		},
+	}
+
+	ip, port, err := getAddress(structs.AddressModeHost, "http", networks, nil)
+	require.NoError(t, err)
+	require.Equal(t, "10.0.0.5", ip)
+	require.Equal(t, 23456, port)
+
+	ip, port, err = getAddress(structs.AddressModeAuto, "admin", networks, nil)
+	require.NoError(t, err)
+	require.Equal(t, "127.0.0.1", ip)
+	require.Equal(t, 5000, port)
+}
+
 func TestConsul_ServiceName_Duplicates(t *testing.T) {
 	t.Parallel()
 	ctx := setupFake(t)
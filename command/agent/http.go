@@ -58,6 +58,10 @@ type HTTPServer struct {
 	Addr       string
 
 	wsUpgrader *websocket.Upgrader
+
+	// auditor writes the audit events of requests, nil if audit logging
+	// is disabled
+	auditor *auditor
 }
 
 // NewHTTPServer starts new HTTP server over the agent
@@ -86,6 +90,13 @@ func NewHTTPServer(agent *Agent, config *Config) (*HTTPServer, error) {
 		ln = tls.NewListener(tcpKeepAliveListener{ln.(*net.TCPListener)}, tlsConfig)
 	}
 
+	// Setup audit logging
+	auditor, err := newAuditor(config.Audit, agent.httpLogger)
+	if err != nil {
+		ln.Close()
+		return nil, err
+	}
+
 	// Create the mux
 	mux := http.NewServeMux()
 
@@ -103,6 +114,7 @@ func NewHTTPServer(agent *Agent, config *Config) (*HTTPServer, error) {
 		logger:     agent.httpLogger,
 		Addr:       ln.Addr().String(),
 		wsUpgrader: wsUpgrader,
+		auditor:    auditor,
 	}
 	srv.registerHandlers(config.EnableDebug)
 
@@ -143,6 +155,9 @@ func (s *HTTPServer) Shutdown() {
 		s.logger.Debug("shutting down http server")
 		s.listener.Close()
 		<-s.listenerCh // block until http.Serve has returned.
+		if s.auditor != nil {
+			s.auditor.Close()
+		}
 	}
 }
 
@@ -167,6 +182,17 @@ func (s *HTTPServer) registerHandlers(enableDebug bool) {
 	s.mux.HandleFunc("/v1/acl/policies", s.wrap(s.ACLPoliciesRequest))
 	s.mux.HandleFunc("/v1/acl/policy/", s.wrap(s.ACLPolicySpecificRequest))
 
+	s.mux.HandleFunc("/v1/acl/roles", s.wrap(s.ACLRolesRequest))
+	s.mux.HandleFunc("/v1/acl/role/", s.wrap(s.ACLRoleSpecificRequest))
+
+	s.mux.HandleFunc("/v1/acl/auth-methods", s.wrap(s.ACLAuthMethodsRequest))
+	s.mux.HandleFunc("/v1/acl/auth-method/", s.wrap(s.ACLAuthMethodSpecificRequest))
+	s.mux.HandleFunc("/v1/acl/binding-rules", s.wrap(s.ACLBindingRulesRequest))
+	s.mux.HandleFunc("/v1/acl/binding-rule", s.wrap(s.ACLBindingRuleSpecificRequest))
+	s.mux.HandleFunc("/v1/acl/binding-rule/", s.wrap(s.ACLBindingRuleSpecificRequest))
+	s.mux.HandleFunc("/v1/acl/oidc/auth-url", s.wrap(s.ACLOIDCAuthURLRequest))
+	s.mux.HandleFunc("/v1/acl/oidc/complete-auth", s.wrap(s.ACLOIDCCompleteAuthRequest))
+
 	s.mux.HandleFunc("/v1/acl/bootstrap", s.wrap(s.ACLTokenBootstrap))
 	s.mux.HandleFunc("/v1/acl/tokens", s.wrap(s.ACLTokensRequest))
 	s.mux.HandleFunc("/v1/acl/token", s.wrap(s.ACLTokenSpecificRequest))
@@ -197,6 +223,9 @@ func (s *HTTPServer) registerHandlers(enableDebug bool) {
 
 	s.mux.HandleFunc("/v1/search", s.wrap(s.SearchRequest))
 
+	s.mux.HandleFunc("/v1/vars", s.wrap(s.VariablesListRequest))
+	s.mux.HandleFunc("/v1/var/", s.wrap(s.VariableSpecificRequest))
+
 	s.mux.HandleFunc("/v1/operator/raft/", s.wrap(s.OperatorRequest))
 	s.mux.HandleFunc("/v1/operator/autopilot/configuration", s.wrap(s.OperatorAutopilotConfiguration))
 	s.mux.HandleFunc("/v1/operator/autopilot/health", s.wrap(s.OperatorServerHealth))
@@ -205,6 +234,9 @@ func (s *HTTPServer) registerHandlers(enableDebug bool) {
 	s.mux.HandleFunc("/v1/system/reconcile/summaries", s.wrap(s.ReconcileJobSummaries))
 
 	s.mux.HandleFunc("/v1/operator/scheduler/configuration", s.wrap(s.OperatorSchedulerConfiguration))
+	s.mux.HandleFunc("/v1/operator/keyring/rotate", s.wrap(s.OperatorKeyringRotate))
+
+	s.mux.HandleFunc("/.well-known/jwks.json", s.wrap(s.JWKSRequest))
 
 	if uiEnabled {
 		s.mux.Handle("/ui/", http.StripPrefix("/ui/", handleUI(http.FileServer(&UIAssetWrapper{FileSystem: assetFS()}))))
@@ -296,6 +328,20 @@ func (s *HTTPServer) wrap(handler func(resp http.ResponseWriter, req *http.Reque
 		defer func() {
 			s.logger.Debug("request complete", "method", req.Method, "path", reqURL, "duration", time.Now().Sub(start))
 		}()
+
+		// Write the audit event of the request, refusing to handle it if
+		// the event cannot be delivered
+		var audit *auditEvent
+		if s.auditor != nil {
+			var err error
+			if audit, err = s.auditReceived(req); err != nil {
+				s.logger.Error("failed to audit request", "method", req.Method, "path", reqURL, "error", err)
+				resp.WriteHeader(http.StatusInternalServerError)
+				resp.Write([]byte(err.Error()))
+				return
+			}
+		}
+
 		obj, err := handler(resp, req)
 
 		// Check for an error
@@ -316,6 +362,16 @@ func (s *HTTPServer) wrap(handler func(resp http.ResponseWriter, req *http.Reque
 				} else if strings.HasSuffix(errMsg, structs.ErrTokenNotFound.Error()) {
 					errMsg = structs.ErrTokenNotFound.Error()
 					code = 403
+				} else if strings.HasSuffix(errMsg, structs.ErrTokenExpired.Error()) {
+					errMsg = structs.ErrTokenExpired.Error()
+					code = 403
+				}
+			}
+
+			if audit != nil {
+				if aerr := s.auditComplete(audit, code, errMsg); aerr != nil {
+					code = http.StatusInternalServerError
+					errMsg = aerr.Error()
 				}
 			}
 
@@ -332,9 +388,9 @@ func (s *HTTPServer) wrap(handler func(resp http.ResponseWriter, req *http.Reque
 			}
 		}
 
-		// Write out the JSON object
+		// Encode the JSON object
+		var buf bytes.Buffer
 		if obj != nil {
-			var buf bytes.Buffer
 			if prettyPrint {
 				enc := codec.NewEncoder(&buf, structs.JsonHandlePretty)
 				err = enc.Encode(obj)
@@ -348,6 +404,22 @@ func (s *HTTPServer) wrap(handler func(resp http.ResponseWriter, req *http.Reque
 			if err != nil {
 				goto HAS_ERR
 			}
+		}
+
+		// Write the audit event of the response. Handlers that wrote their
+		// response themselves can no longer be failed.
+		if audit != nil {
+			if err := s.auditComplete(audit, http.StatusOK, ""); err != nil {
+				s.logger.Error("failed to audit request", "method", req.Method, "path", reqURL, "error", err)
+				if obj != nil {
+					resp.WriteHeader(http.StatusInternalServerError)
+					resp.Write([]byte(err.Error()))
+					return
+				}
+			}
+		}
+
+		if obj != nil {
 			resp.Header().Set("Content-Type", "application/json")
 			resp.Write(buf.Bytes())
 		}
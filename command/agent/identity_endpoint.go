@@ -0,0 +1,33 @@
+package agent
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// JWKSRequest is used to return the public keys verifying workload identities
+// as a JSON Web Key Set. It doesn't require an ACL token so third parties can
+// verify the identities of tasks.
+func (s *HTTPServer) JWKSRequest(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	if req.Method != "GET" {
+		return nil, CodedError(405, ErrInvalidMethod)
+	}
+
+	args := structs.KeyringListPublicRequest{}
+	if s.parse(resp, req, &args.Region, &args.QueryOptions) {
+		return nil, nil
+	}
+
+	var out structs.KeyringListPublicResponse
+	if err := s.agent.RPC("Keyring.ListPublic", &args, &out); err != nil {
+		return nil, err
+	}
+
+	setMeta(resp, &out.QueryMeta)
+	jwks := &structs.JWKS{Keys: out.Keys}
+	if jwks.Keys == nil {
+		jwks.Keys = make([]*structs.JWK, 0)
+	}
+	return jwks, nil
+}
@@ -0,0 +1,49 @@
+package agent
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTP_JWKS(t *testing.T) {
+	t.Parallel()
+	httpTest(t, nil, func(s *TestAgent) {
+		testutil.WaitForLeader(t, s.RPC)
+
+		var out struct {
+			Keys []map[string]string `json:"keys"`
+		}
+		testutil.WaitForResult(func() (bool, error) {
+			req, err := http.NewRequest("GET", "/.well-known/jwks.json", nil)
+			if err != nil {
+				return false, err
+			}
+			respW := httptest.NewRecorder()
+			s.Server.mux.ServeHTTP(respW, req)
+			if respW.Code != 200 {
+				return false, fmt.Errorf("unexpected code %d: %s", respW.Code, respW.Body.String())
+			}
+
+			if err := json.Unmarshal(respW.Body.Bytes(), &out); err != nil {
+				return false, err
+			}
+			return len(out.Keys) == 1, nil
+		}, func(err error) {
+			t.Fatalf("no public keys: %v", err)
+		})
+
+		key := out.Keys[0]
+		require.Equal(t, "EC", key["kty"])
+		require.Equal(t, "ES256", key["alg"])
+		require.Equal(t, "sig", key["use"])
+		require.NotEmpty(t, key["kid"])
+		require.NotEmpty(t, key["x"])
+		require.NotEmpty(t, key["y"])
+	})
+}
@@ -78,6 +78,9 @@ func (s *HTTPServer) JobSpecificRequest(resp http.ResponseWriter, req *http.Requ
 	case strings.HasSuffix(path, "/deployment"):
 		jobName := strings.TrimSuffix(path, "/deployment")
 		return s.jobLatestDeployment(resp, req, jobName)
+	case strings.HasSuffix(path, "/pause"):
+		jobName := strings.TrimSuffix(path, "/pause")
+		return s.jobPause(resp, req, jobName)
 	case strings.HasSuffix(path, "/stable"):
 		jobName := strings.TrimSuffix(path, "/stable")
 		return s.jobStable(resp, req, jobName)
@@ -516,6 +519,35 @@ func (s *HTTPServer) jobRevert(resp http.ResponseWriter, req *http.Request,
 	return out, nil
 }
 
+func (s *HTTPServer) jobPause(resp http.ResponseWriter, req *http.Request,
+	jobName string) (interface{}, error) {
+
+	if req.Method != "PUT" && req.Method != "POST" {
+		return nil, CodedError(405, ErrInvalidMethod)
+	}
+
+	var pauseRequest structs.JobPauseRequest
+	if err := decodeBody(req, &pauseRequest); err != nil {
+		return nil, CodedError(400, err.Error())
+	}
+	if pauseRequest.JobID == "" {
+		return nil, CodedError(400, "JobID must be specified")
+	}
+	if pauseRequest.JobID != jobName {
+		return nil, CodedError(400, "Job ID does not match")
+	}
+
+	s.parseWriteRequest(req, &pauseRequest.WriteRequest)
+
+	var out structs.JobRegisterResponse
+	if err := s.agent.RPC("Job.Pause", &pauseRequest, &out); err != nil {
+		return nil, err
+	}
+
+	setMeta(resp, &out.QueryMeta)
+	return out, nil
+}
+
 func (s *HTTPServer) jobStable(resp http.ResponseWriter, req *http.Request,
 	jobName string) (interface{}, error) {
 
@@ -665,6 +697,10 @@ func ApiJobToStructJob(job *api.Job) *structs.Job {
 			SpecType:        *job.Periodic.SpecType,
 			ProhibitOverlap: *job.Periodic.ProhibitOverlap,
 			TimeZone:        *job.Periodic.TimeZone,
+			CatchUp:         *job.Periodic.CatchUp,
+			MaxCatchUp:      *job.Periodic.MaxCatchUp,
+			Specs:           helper.CopySliceString(job.Periodic.Specs),
+			Exclusions:      helper.CopySliceString(job.Periodic.Exclusions),
 		}
 
 		if job.Periodic.Spec != nil {
@@ -680,6 +716,16 @@ func ApiJobToStructJob(job *api.Job) *structs.Job {
 		}
 	}
 
+	if l := len(job.DependsOn); l != 0 {
+		j.DependsOn = make([]*structs.JobDependency, l)
+		for i, dep := range job.DependsOn {
+			j.DependsOn[i] = &structs.JobDependency{
+				JobID:     *dep.JobID,
+				Condition: *dep.Condition,
+			}
+		}
+	}
+
 	if l := len(job.TaskGroups); l != 0 {
 		j.TaskGroups = make([]*structs.TaskGroup, l)
 		for i, taskGroup := range job.TaskGroups {
@@ -728,6 +774,13 @@ func ApiTgToStructsTG(taskGroup *api.TaskGroup, tg *structs.TaskGroup) {
 		}
 	}
 
+	if taskGroup.Array != nil {
+		tg.Array = &structs.ArrayConfig{
+			Size:        *taskGroup.Array.Size,
+			MaxParallel: *taskGroup.Array.MaxParallel,
+		}
+	}
+
 	tg.EphemeralDisk = &structs.EphemeralDisk{
 		Sticky:  *taskGroup.EphemeralDisk.Sticky,
 		SizeMB:  *taskGroup.EphemeralDisk.SizeMB,
@@ -779,6 +832,10 @@ func ApiTgToStructsTG(taskGroup *api.TaskGroup, tg *structs.TaskGroup) {
 		if taskGroup.Update.AutoPromote != nil {
 			tg.Update.AutoPromote = *taskGroup.Update.AutoPromote
 		}
+
+		if taskGroup.Update.PauseAfterBatch != nil {
+			tg.Update.PauseAfterBatch = *taskGroup.Update.PauseAfterBatch
+		}
 	}
 
 	if l := len(taskGroup.Tasks); l != 0 {
@@ -893,6 +950,13 @@ func ApiTaskToStructsTask(apiTask *api.Task, structsTask *structs.Task) {
 		}
 	}
 
+	if apiTask.Identity != nil {
+		structsTask.Identity = &structs.WorkloadIdentity{
+			Env:  *apiTask.Identity.Env,
+			File: *apiTask.Identity.File,
+		}
+	}
+
 	if l := len(apiTask.Templates); l != 0 {
 		structsTask.Templates = make([]*structs.Template, l)
 		for i, template := range apiTask.Templates {
@@ -909,6 +973,14 @@ func ApiTaskToStructsTask(apiTask *api.Task, structsTask *structs.Task) {
 				Envvars:      *template.Envvars,
 				VaultGrace:   *template.VaultGrace,
 			}
+			if template.ChangeScript != nil {
+				structsTask.Templates[i].ChangeScript = &structs.ChangeScript{
+					Command:     *template.ChangeScript.Command,
+					Args:        template.ChangeScript.Args,
+					Timeout:     *template.ChangeScript.Timeout,
+					FailOnError: *template.ChangeScript.FailOnError,
+				}
+			}
 		}
 	}
 
@@ -967,13 +1039,22 @@ func ApiNetworkResourceToStructs(in []*api.NetworkResource) []*structs.NetworkRe
 			MBits: *nw.MBits,
 		}
 
+		if nw.DNS != nil {
+			out[i].DNS = &structs.DNSConfig{
+				Servers:  nw.DNS.Servers,
+				Searches: nw.DNS.Searches,
+				Options:  nw.DNS.Options,
+			}
+		}
+
 		if l := len(nw.DynamicPorts); l != 0 {
 			out[i].DynamicPorts = make([]structs.Port, l)
 			for j, dp := range nw.DynamicPorts {
 				out[i].DynamicPorts[j] = structs.Port{
-					Label: dp.Label,
-					Value: dp.Value,
-					To:    dp.To,
+					Label:       dp.Label,
+					Value:       dp.Value,
+					To:          dp.To,
+					HostNetwork: dp.HostNetwork,
 				}
 			}
 		}
@@ -982,9 +1063,10 @@ func ApiNetworkResourceToStructs(in []*api.NetworkResource) []*structs.NetworkRe
 			out[i].ReservedPorts = make([]structs.Port, l)
 			for j, rp := range nw.ReservedPorts {
 				out[i].ReservedPorts[j] = structs.Port{
-					Label: rp.Label,
-					Value: rp.Value,
-					To:    rp.To,
+					Label:       rp.Label,
+					Value:       rp.Value,
+					To:          rp.To,
+					HostNetwork: rp.HostNetwork,
 				}
 			}
 		}
@@ -1312,6 +1312,75 @@ func TestHTTP_JobRevert(t *testing.T) {
 	})
 }
 
+func TestHTTP_JobPause(t *testing.T) {
+	t.Parallel()
+	httpTest(t, nil, func(s *TestAgent) {
+		// Create the job and register it
+		job := mock.Job()
+		regReq := structs.JobRegisterRequest{
+			Job: job,
+			WriteRequest: structs.WriteRequest{
+				Region:    "global",
+				Namespace: structs.DefaultNamespace,
+			},
+		}
+		var regResp structs.JobRegisterResponse
+		if err := s.Agent.RPC("Job.Register", &regReq, &regResp); err != nil {
+			t.Fatalf("err: %v", err)
+		}
+
+		args := structs.JobPauseRequest{
+			JobID: job.ID,
+			Pause: true,
+			WriteRequest: structs.WriteRequest{
+				Region:    "global",
+				Namespace: structs.DefaultNamespace,
+			},
+		}
+		buf := encodeReq(args)
+
+		// Make the HTTP request
+		req, err := http.NewRequest("PUT", "/v1/job/"+job.ID+"/pause", buf)
+		if err != nil {
+			t.Fatalf("err: %v", err)
+		}
+		respW := httptest.NewRecorder()
+
+		// Make the request
+		obj, err := s.Server.JobSpecificRequest(respW, req)
+		if err != nil {
+			t.Fatalf("err: %v", err)
+		}
+
+		// Check the response
+		pauseResp := obj.(structs.JobRegisterResponse)
+		if pauseResp.EvalID == "" {
+			t.Fatalf("bad: %v", pauseResp)
+		}
+
+		// Check for the index
+		if respW.HeaderMap.Get("X-Nomad-Index") == "" {
+			t.Fatalf("missing index")
+		}
+
+		// Check the job is paused
+		getReq := structs.JobSpecificRequest{
+			JobID: job.ID,
+			QueryOptions: structs.QueryOptions{
+				Region:    "global",
+				Namespace: structs.DefaultNamespace,
+			},
+		}
+		var getResp structs.SingleJobResponse
+		if err := s.Agent.RPC("Job.GetJob", &getReq, &getResp); err != nil {
+			t.Fatalf("err: %v", err)
+		}
+		if !getResp.Job.Paused {
+			t.Fatalf("job should be paused")
+		}
+	})
+}
+
 func TestHTTP_JobStable(t *testing.T) {
 	t.Parallel()
 	httpTest(t, nil, func(s *TestAgent) {
@@ -1425,6 +1494,9 @@ func TestJobs_ApiJobToStructsJob(t *testing.T) {
 			SpecType:        helper.StringToPtr("cron"),
 			ProhibitOverlap: helper.BoolToPtr(true),
 			TimeZone:        helper.StringToPtr("test zone"),
+			CatchUp:         helper.StringToPtr("all"),
+			MaxCatchUp:      helper.IntToPtr(3),
+			Exclusions:      []string{"2019-12-25"},
 		},
 		ParameterizedJob: &api.ParameterizedJobConfig{
 			Payload:      "payload",
@@ -1705,6 +1777,12 @@ func TestJobs_ApiJobToStructsJob(t *testing.T) {
 								RightDelim:   helper.StringToPtr("def"),
 								Envvars:      helper.BoolToPtr(true),
 								VaultGrace:   helper.TimeToPtr(3 * time.Second),
+								ChangeScript: &api.ChangeScript{
+									Command:     helper.StringToPtr("/bin/foo"),
+									Args:        []string{"-h"},
+									Timeout:     helper.TimeToPtr(5 * time.Second),
+									FailOnError: helper.BoolToPtr(true),
+								},
 							},
 						},
 						DispatchPayload: &api.DispatchPayloadConfig{
@@ -1771,6 +1849,9 @@ func TestJobs_ApiJobToStructsJob(t *testing.T) {
 			SpecType:        "cron",
 			ProhibitOverlap: true,
 			TimeZone:        "test zone",
+			CatchUp:         "all",
+			MaxCatchUp:      3,
+			Exclusions:      []string{"2019-12-25"},
 		},
 		ParameterizedJob: &structs.ParameterizedJobConfig{
 			Payload:      "payload",
@@ -2050,6 +2131,12 @@ func TestJobs_ApiJobToStructsJob(t *testing.T) {
 								RightDelim:   "def",
 								Envvars:      true,
 								VaultGrace:   3 * time.Second,
+								ChangeScript: &structs.ChangeScript{
+									Command:     "/bin/foo",
+									Args:        []string{"-h"},
+									Timeout:     5 * time.Second,
+									FailOnError: true,
+								},
 							},
 						},
 						DispatchPayload: &structs.DispatchPayloadConfig{
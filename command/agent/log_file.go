@@ -123,7 +123,7 @@ func (l *logFile) pruneFiles() error {
 // Write is used to implement io.Writer
 func (l *logFile) Write(b []byte) (int, error) {
 	// Filter out log entries that do not match log level criteria
-	if !l.logFilter.Check(b) {
+	if l.logFilter != nil && !l.logFilter.Check(b) {
 		return 0, nil
 	}
 
@@ -144,3 +144,15 @@ func (l *logFile) Write(b []byte) (int, error) {
 	l.BytesWritten += int64(n)
 	return n, err
 }
+
+// Close closes the current file
+func (l *logFile) Close() error {
+	l.acquire.Lock()
+	defer l.acquire.Unlock()
+	if l.FileInfo == nil {
+		return nil
+	}
+	err := l.FileInfo.Close()
+	l.FileInfo = nil
+	return err
+}
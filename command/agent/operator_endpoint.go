@@ -274,3 +274,29 @@ func (s *HTTPServer) schedulerUpdateConfig(resp http.ResponseWriter, req *http.R
 	setIndex(resp, reply.Index)
 	return reply, nil
 }
+
+// OperatorKeyringRotate is used to generate a new active root key, optionally
+// re-encrypting all variables with it.
+func (s *HTTPServer) OperatorKeyringRotate(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	if req.Method != "PUT" && req.Method != "POST" {
+		return nil, CodedError(405, ErrInvalidMethod)
+	}
+
+	var args structs.KeyringRotateRequest
+	s.parseWriteRequest(req, &args.WriteRequest)
+
+	if full := req.URL.Query().Get("full"); full != "" {
+		val, err := strconv.ParseBool(full)
+		if err != nil {
+			return nil, CodedError(http.StatusBadRequest, fmt.Sprintf("Error parsing full value: %v", err))
+		}
+		args.Full = val
+	}
+
+	var reply structs.KeyringRotateResponse
+	if err := s.agent.RPC("Keyring.Rotate", &args, &reply); err != nil {
+		return nil, err
+	}
+	setIndex(resp, reply.Index)
+	return reply, nil
+}
@@ -382,3 +382,23 @@ func TestOperator_SchedulerCASConfiguration(t *testing.T) {
 		require.False(reply.SchedulerConfig.PreemptionConfig.BatchSchedulerEnabled)
 	})
 }
+
+func TestOperator_KeyringRotate(t *testing.T) {
+	t.Parallel()
+	httpTest(t, nil, func(s *TestAgent) {
+		require := require.New(t)
+		req, _ := http.NewRequest("PUT", "/v1/operator/keyring/rotate?full=true", nil)
+		resp := httptest.NewRecorder()
+		obj, err := s.Server.OperatorKeyringRotate(resp, req)
+		require.Nil(err)
+		out, ok := obj.(structs.KeyringRotateResponse)
+		require.True(ok)
+		require.NotEmpty(out.KeyID)
+		require.NotZero(out.Index)
+
+		req, _ = http.NewRequest("PUT", "/v1/operator/keyring/rotate?full=maybe", nil)
+		_, err = s.Server.OperatorKeyringRotate(httptest.NewRecorder(), req)
+		require.Error(err)
+		require.Equal(400, err.(HTTPCodedError).Code())
+	})
+}
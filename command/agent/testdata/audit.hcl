@@ -0,0 +1,20 @@
+audit {
+  enabled = true
+
+  sink "audit" {
+    type               = "file"
+    format             = "json"
+    delivery_guarantee = "enforced"
+    path               = "/opt/nomad/audit/audit.json"
+    rotate_duration    = "1h"
+    rotate_bytes       = 1024
+    rotate_max_files   = 5
+  }
+
+  filter "health" {
+    type       = "HTTPEvent"
+    endpoints  = ["/v1/agent/health"]
+    stages     = ["*"]
+    operations = ["*"]
+  }
+}
@@ -91,10 +91,25 @@ client {
   gc_max_allocs            = 50
   no_host_uuid             = false
   disable_remote_exec      = true
+  enforce_ephemeral_disk   = true
+  artifact_cache_max_mb    = 2048
 
   host_volume "tmp" {
     path = "/tmp"
   }
+
+  host_network "public" {
+    cidr           = "10.0.0.0/8"
+    reserved_ports = "22"
+  }
+
+  artifact {
+    download_timeout               = "10m"
+    http_max_size                  = "1GB"
+    decompression_file_count_limit = 100
+    decompression_size_limit       = "2GB"
+    protocol_denylist              = ["hg"]
+  }
 }
 
 server {
@@ -111,6 +126,7 @@ server {
   job_gc_threshold          = "12h"
   eval_gc_threshold         = "12h"
   deployment_gc_threshold   = "12h"
+  dispatch_idempotency_window = "30m"
   heartbeat_grace           = "30s"
   min_heartbeat_ttl         = "33s"
   max_heartbeats_per_second = 11.0
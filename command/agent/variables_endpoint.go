@@ -0,0 +1,160 @@
+package agent
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+func (s *HTTPServer) VariablesListRequest(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	if req.Method != "GET" {
+		return nil, CodedError(405, ErrInvalidMethod)
+	}
+
+	args := structs.VariablesListRequest{}
+	if s.parse(resp, req, &args.Region, &args.QueryOptions) {
+		return nil, nil
+	}
+
+	var out structs.VariablesListResponse
+	if err := s.agent.RPC("Variables.List", &args, &out); err != nil {
+		return nil, err
+	}
+
+	setMeta(resp, &out.QueryMeta)
+	if out.Data == nil {
+		out.Data = make([]*structs.VariableMetadata, 0)
+	}
+	return out.Data, nil
+}
+
+func (s *HTTPServer) VariableSpecificRequest(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	path := strings.TrimPrefix(req.URL.Path, "/v1/var/")
+	if len(path) == 0 {
+		return nil, CodedError(400, "Missing variable path")
+	}
+	switch req.Method {
+	case "GET":
+		return s.variableQuery(resp, req, path)
+	case "PUT", "POST":
+		return s.variableUpsert(resp, req, path)
+	case "DELETE":
+		return s.variableDelete(resp, req, path)
+	default:
+		return nil, CodedError(405, ErrInvalidMethod)
+	}
+}
+
+func (s *HTTPServer) variableQuery(resp http.ResponseWriter, req *http.Request,
+	path string) (interface{}, error) {
+	args := structs.VariablesReadRequest{
+		Path: path,
+	}
+	if s.parse(resp, req, &args.Region, &args.QueryOptions) {
+		return nil, nil
+	}
+
+	var out structs.VariablesReadResponse
+	if err := s.agent.RPC("Variables.Read", &args, &out); err != nil {
+		return nil, err
+	}
+
+	setMeta(resp, &out.QueryMeta)
+	if out.Data == nil {
+		return nil, CodedError(404, "variable not found")
+	}
+	return out.Data, nil
+}
+
+func (s *HTTPServer) variableUpsert(resp http.ResponseWriter, req *http.Request,
+	path string) (interface{}, error) {
+	// Parse the variable
+	var v structs.VariableDecrypted
+	if err := decodeBody(req, &v); err != nil {
+		return nil, CodedError(400, err.Error())
+	}
+
+	// Ensure the path matches
+	if v.Path == "" {
+		v.Path = path
+	} else if v.Path != path {
+		return nil, CodedError(400, "Variable path does not match request path")
+	}
+
+	// Format the request
+	args := structs.VariablesUpsertRequest{
+		Var: &v,
+	}
+	s.parseWriteRequest(req, &args.WriteRequest)
+	if v.Namespace != "" && v.Namespace != args.RequestNamespace() {
+		return nil, CodedError(400, "Variable namespace does not match request namespace")
+	}
+
+	checkIndex, err := parseCheckIndex(req)
+	if err != nil {
+		return nil, err
+	}
+	args.CheckIndex = checkIndex
+
+	var out structs.VariablesUpsertResponse
+	if err := s.agent.RPC("Variables.Upsert", &args, &out); err != nil {
+		return nil, err
+	}
+	setIndex(resp, out.Index)
+
+	if out.Conflict != nil {
+		return nil, variableConflictError(out.Conflict)
+	}
+	return out.Output, nil
+}
+
+func (s *HTTPServer) variableDelete(resp http.ResponseWriter, req *http.Request,
+	path string) (interface{}, error) {
+
+	args := structs.VariablesDeleteRequest{
+		Path: path,
+	}
+	s.parseWriteRequest(req, &args.WriteRequest)
+
+	checkIndex, err := parseCheckIndex(req)
+	if err != nil {
+		return nil, err
+	}
+	args.CheckIndex = checkIndex
+
+	var out structs.VariablesDeleteResponse
+	if err := s.agent.RPC("Variables.Delete", &args, &out); err != nil {
+		return nil, err
+	}
+	setIndex(resp, out.Index)
+
+	if out.Conflict != nil {
+		return nil, variableConflictError(out.Conflict)
+	}
+	return nil, nil
+}
+
+// parseCheckIndex parses the check-and-set index of a variable write from
+// the "cas" query parameter.
+func parseCheckIndex(req *http.Request) (*uint64, error) {
+	params := req.URL.Query()
+	if _, ok := params["cas"]; !ok {
+		return nil, nil
+	}
+
+	casVal, err := strconv.ParseUint(params.Get("cas"), 10, 64)
+	if err != nil {
+		return nil, CodedError(http.StatusBadRequest, fmt.Sprintf("Error parsing cas value: %v", err))
+	}
+	return &casVal, nil
+}
+
+// variableConflictError returns the error of a failed check-and-set write of
+// a variable.
+func variableConflictError(conflict *structs.VariableDecrypted) error {
+	return CodedError(http.StatusConflict, fmt.Sprintf("check-and-set conflict: variable %q has modify index %d",
+		conflict.Path, conflict.ModifyIndex))
+}
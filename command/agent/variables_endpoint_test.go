@@ -0,0 +1,81 @@
+package agent
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTP_Variables(t *testing.T) {
+	t.Parallel()
+	httpTest(t, nil, func(s *TestAgent) {
+		require := require.New(t)
+
+		// Create a variable, waiting for the leader to create a root key
+		v := &structs.VariableDecrypted{
+			Items: structs.VariableItems{"password": "hunter2"},
+		}
+		var obj interface{}
+		testutil.WaitForResult(func() (bool, error) {
+			req, err := http.NewRequest("PUT", "/v1/var/app/db", encodeReq(v))
+			require.Nil(err)
+			obj, err = s.Server.VariableSpecificRequest(httptest.NewRecorder(), req)
+			return err == nil, err
+		}, func(err error) {
+			t.Fatalf("failed to write variable: %v", err)
+		})
+		out := obj.(*structs.VariableDecrypted)
+		require.Equal("app/db", out.Path)
+		require.Equal("hunter2", out.Items["password"])
+
+		// A mismatched path is rejected
+		v.Path = "other"
+		req, err := http.NewRequest("PUT", "/v1/var/app/db", encodeReq(v))
+		require.Nil(err)
+		_, err = s.Server.VariableSpecificRequest(httptest.NewRecorder(), req)
+		require.Error(err)
+		require.Equal(400, err.(HTTPCodedError).Code())
+
+		// A stale check index returns a conflict
+		v.Path = ""
+		req, err = http.NewRequest("PUT", "/v1/var/app/db?cas=0", encodeReq(v))
+		require.Nil(err)
+		_, err = s.Server.VariableSpecificRequest(httptest.NewRecorder(), req)
+		require.Error(err)
+		require.Equal(409, err.(HTTPCodedError).Code())
+
+		// Read it back
+		req, err = http.NewRequest("GET", "/v1/var/app/db", nil)
+		require.Nil(err)
+		respW := httptest.NewRecorder()
+		obj, err = s.Server.VariableSpecificRequest(respW, req)
+		require.Nil(err)
+		require.Equal("hunter2", obj.(*structs.VariableDecrypted).Items["password"])
+		require.NotEmpty(respW.Header().Get("X-Nomad-Index"))
+
+		// List it
+		req, err = http.NewRequest("GET", "/v1/vars?prefix=app", nil)
+		require.Nil(err)
+		obj, err = s.Server.VariablesListRequest(httptest.NewRecorder(), req)
+		require.Nil(err)
+		list := obj.([]*structs.VariableMetadata)
+		require.Len(list, 1)
+		require.Equal("app/db", list[0].Path)
+
+		// Delete it
+		req, err = http.NewRequest("DELETE", "/v1/var/app/db", nil)
+		require.Nil(err)
+		_, err = s.Server.VariableSpecificRequest(httptest.NewRecorder(), req)
+		require.Nil(err)
+
+		req, err = http.NewRequest("GET", "/v1/var/app/db", nil)
+		require.Nil(err)
+		_, err = s.Server.VariableSpecificRequest(httptest.NewRecorder(), req)
+		require.Error(err)
+		require.Equal(404, err.(HTTPCodedError).Code())
+	})
+}
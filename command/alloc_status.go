@@ -309,7 +309,11 @@ func formatAllocNetworkInfo(alloc *api.Allocation) string {
 	addrs := make([]string, len(nw.DynamicPorts)+len(nw.ReservedPorts)+1)
 	addrs[0] = "Label|Dynamic|Address"
 	portFmt := func(port *api.Port, dyn string) string {
-		s := fmt.Sprintf("%s|%s|%s:%d", port.Label, dyn, nw.IP, port.Value)
+		ip := nw.IP
+		if port.HostIP != "" {
+			ip = port.HostIP
+		}
+		s := fmt.Sprintf("%s|%s|%s:%d", port.Label, dyn, ip, port.Value)
 		if port.To > 0 {
 			s += fmt.Sprintf(" -> %d", port.To)
 		}
@@ -496,6 +500,12 @@ func buildDisplayMessage(event *api.TaskEvent) string {
 		desc = event.DriverMessage
 	case api.TaskLeaderDead:
 		desc = "Leader Task in Group dead"
+	case api.TaskDiskExceeded:
+		if event.DiskLimit != 0 {
+			desc = fmt.Sprintf("Allocation exceeded its ephemeral disk limit of %d MB", event.DiskLimit)
+		} else {
+			desc = "Allocation exceeded its ephemeral disk limit"
+		}
 	default:
 		desc = event.Message
 	}
@@ -516,7 +526,11 @@ func (c *AllocStatusCommand) outputTaskResources(alloc *api.Allocation, task str
 	for _, nw := range resource.Networks {
 		ports := append(nw.DynamicPorts, nw.ReservedPorts...)
 		for _, port := range ports {
-			addr = append(addr, fmt.Sprintf("%v: %v:%v\n", port.Label, nw.IP, port.Value))
+			ip := nw.IP
+			if port.HostIP != "" {
+				ip = port.HostIP
+			}
+			addr = append(addr, fmt.Sprintf("%v: %v:%v\n", port.Label, ip, port.Value))
 		}
 	}
 
@@ -572,6 +586,8 @@ func (c *AllocStatusCommand) outputTaskResources(alloc *api.Allocation, task str
 func (c *AllocStatusCommand) outputVerboseResourceUsage(task string, resourceUsage *api.ResourceUsage) {
 	memoryStats := resourceUsage.MemoryStats
 	cpuStats := resourceUsage.CpuStats
+	networkStats := resourceUsage.NetworkStats
+	blockIOStats := resourceUsage.BlockIOStats
 	deviceStats := resourceUsage.DeviceStats
 
 	if memoryStats != nil && len(memoryStats.Measured) > 0 {
@@ -638,6 +654,60 @@ func (c *AllocStatusCommand) outputVerboseResourceUsage(task string, resourceUsa
 		c.Ui.Output(formatList(out))
 	}
 
+	if networkStats != nil && len(networkStats.Measured) > 0 {
+		c.Ui.Output("")
+		c.Ui.Output("Network Stats")
+
+		// Sort the measured stats
+		sort.Strings(networkStats.Measured)
+
+		var measuredStats []string
+		for _, measured := range networkStats.Measured {
+			switch measured {
+			case "Rx Bytes":
+				measuredStats = append(measuredStats, humanize.IBytes(networkStats.RxBytes))
+			case "Tx Bytes":
+				measuredStats = append(measuredStats, humanize.IBytes(networkStats.TxBytes))
+			case "Rx Packets":
+				measuredStats = append(measuredStats, fmt.Sprintf("%v", networkStats.RxPackets))
+			case "Tx Packets":
+				measuredStats = append(measuredStats, fmt.Sprintf("%v", networkStats.TxPackets))
+			}
+		}
+
+		out := make([]string, 2)
+		out[0] = strings.Join(networkStats.Measured, "|")
+		out[1] = strings.Join(measuredStats, "|")
+		c.Ui.Output(formatList(out))
+	}
+
+	if blockIOStats != nil && len(blockIOStats.Measured) > 0 {
+		c.Ui.Output("")
+		c.Ui.Output("Block IO Stats")
+
+		// Sort the measured stats
+		sort.Strings(blockIOStats.Measured)
+
+		var measuredStats []string
+		for _, measured := range blockIOStats.Measured {
+			switch measured {
+			case "Read Bytes":
+				measuredStats = append(measuredStats, humanize.IBytes(blockIOStats.ReadBytes))
+			case "Write Bytes":
+				measuredStats = append(measuredStats, humanize.IBytes(blockIOStats.WriteBytes))
+			case "Read Ops":
+				measuredStats = append(measuredStats, fmt.Sprintf("%v", blockIOStats.ReadOps))
+			case "Write Ops":
+				measuredStats = append(measuredStats, fmt.Sprintf("%v", blockIOStats.WriteOps))
+			}
+		}
+
+		out := make([]string, 2)
+		out[0] = strings.Join(blockIOStats.Measured, "|")
+		out[1] = strings.Join(measuredStats, "|")
+		c.Ui.Output(formatList(out))
+	}
+
 	if len(deviceStats) > 0 {
 		c.Ui.Output("")
 		c.Ui.Output("Device Stats")
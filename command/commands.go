@@ -74,6 +74,56 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"acl auth-method": func() (cli.Command, error) {
+			return &ACLAuthMethodCommand{
+				Meta: meta,
+			}, nil
+		},
+		"acl auth-method apply": func() (cli.Command, error) {
+			return &ACLAuthMethodApplyCommand{
+				Meta: meta,
+			}, nil
+		},
+		"acl auth-method delete": func() (cli.Command, error) {
+			return &ACLAuthMethodDeleteCommand{
+				Meta: meta,
+			}, nil
+		},
+		"acl auth-method info": func() (cli.Command, error) {
+			return &ACLAuthMethodInfoCommand{
+				Meta: meta,
+			}, nil
+		},
+		"acl auth-method list": func() (cli.Command, error) {
+			return &ACLAuthMethodListCommand{
+				Meta: meta,
+			}, nil
+		},
+		"acl binding-rule": func() (cli.Command, error) {
+			return &ACLBindingRuleCommand{
+				Meta: meta,
+			}, nil
+		},
+		"acl binding-rule apply": func() (cli.Command, error) {
+			return &ACLBindingRuleApplyCommand{
+				Meta: meta,
+			}, nil
+		},
+		"acl binding-rule delete": func() (cli.Command, error) {
+			return &ACLBindingRuleDeleteCommand{
+				Meta: meta,
+			}, nil
+		},
+		"acl binding-rule info": func() (cli.Command, error) {
+			return &ACLBindingRuleInfoCommand{
+				Meta: meta,
+			}, nil
+		},
+		"acl binding-rule list": func() (cli.Command, error) {
+			return &ACLBindingRuleListCommand{
+				Meta: meta,
+			}, nil
+		},
 		"acl bootstrap": func() (cli.Command, error) {
 			return &ACLBootstrapCommand{
 				Meta: meta,
@@ -104,6 +154,31 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"acl role": func() (cli.Command, error) {
+			return &ACLRoleCommand{
+				Meta: meta,
+			}, nil
+		},
+		"acl role apply": func() (cli.Command, error) {
+			return &ACLRoleApplyCommand{
+				Meta: meta,
+			}, nil
+		},
+		"acl role delete": func() (cli.Command, error) {
+			return &ACLRoleDeleteCommand{
+				Meta: meta,
+			}, nil
+		},
+		"acl role info": func() (cli.Command, error) {
+			return &ACLRoleInfoCommand{
+				Meta: meta,
+			}, nil
+		},
+		"acl role list": func() (cli.Command, error) {
+			return &ACLRoleListCommand{
+				Meta: meta,
+			}, nil
+		},
 		"acl token": func() (cli.Command, error) {
 			return &ACLTokenCommand{
 				Meta: meta,
@@ -316,6 +391,11 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"job pause": func() (cli.Command, error) {
+			return &JobPauseCommand{
+				Meta: meta,
+			}, nil
+		},
 		"job periodic": func() (cli.Command, error) {
 			return &JobPeriodicCommand{
 				Meta: meta,
@@ -326,6 +406,16 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"job periodic history": func() (cli.Command, error) {
+			return &JobPeriodicHistoryCommand{
+				Meta: meta,
+			}, nil
+		},
+		"job periodic list": func() (cli.Command, error) {
+			return &JobPeriodicListCommand{
+				Meta: meta,
+			}, nil
+		},
 		"job plan": func() (cli.Command, error) {
 			return &JobPlanCommand{
 				Meta: meta,
@@ -336,6 +426,16 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"job restart": func() (cli.Command, error) {
+			return &JobRestartCommand{
+				Meta: meta,
+			}, nil
+		},
+		"job resume": func() (cli.Command, error) {
+			return &JobResumeCommand{
+				Meta: meta,
+			}, nil
+		},
 		"job revert": func() (cli.Command, error) {
 			return &JobRevertCommand{
 				Meta: meta,
@@ -361,6 +461,11 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"login": func() (cli.Command, error) {
+			return &LoginCommand{
+				Meta: meta,
+			}, nil
+		},
 		"logs": func() (cli.Command, error) {
 			return &AllocLogsCommand{
 				Meta: meta,
@@ -620,6 +725,31 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"var": func() (cli.Command, error) {
+			return &VarCommand{
+				Meta: meta,
+			}, nil
+		},
+		"var get": func() (cli.Command, error) {
+			return &VarGetCommand{
+				Meta: meta,
+			}, nil
+		},
+		"var list": func() (cli.Command, error) {
+			return &VarListCommand{
+				Meta: meta,
+			}, nil
+		},
+		"var purge": func() (cli.Command, error) {
+			return &VarPurgeCommand{
+				Meta: meta,
+			}, nil
+		},
+		"var put": func() (cli.Command, error) {
+			return &VarPutCommand{
+				Meta: meta,
+			}, nil
+		},
 		"version": func() (cli.Command, error) {
 			return &VersionCommand{
 				Version: version.GetVersion(),
@@ -6,6 +6,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/hashicorp/nomad/api"
 	"github.com/hashicorp/nomad/api/contexts"
 	flaghelper "github.com/hashicorp/nomad/helper/flag-helpers"
 	"github.com/posener/complete"
@@ -41,6 +42,12 @@ Dispatch Options:
     once to inject multiple metadata key/value pairs. Arbitrary keys are not
     allowed. The parameterized job must allow the key to be merged.
 
+  -idempotency-token
+    Optional identifier used to prevent more than one instance of the job from
+    being dispatched. If a dispatched job with the same token is still running,
+    or was dispatched within the server's idempotency window, its ID is returned
+    instead of dispatching a new instance.
+
   -detach
     Return immediately instead of entering monitor mode. After job dispatch,
     the evaluation ID will be printed to the screen, which can be used to
@@ -59,9 +66,10 @@ func (c *JobDispatchCommand) Synopsis() string {
 func (c *JobDispatchCommand) AutocompleteFlags() complete.Flags {
 	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
 		complete.Flags{
-			"-meta":    complete.PredictAnything,
-			"-detach":  complete.PredictNothing,
-			"-verbose": complete.PredictNothing,
+			"-meta":              complete.PredictAnything,
+			"-detach":            complete.PredictNothing,
+			"-verbose":           complete.PredictNothing,
+			"-idempotency-token": complete.PredictAnything,
 		})
 }
 
@@ -84,6 +92,7 @@ func (c *JobDispatchCommand) Name() string { return "job dispatch" }
 
 func (c *JobDispatchCommand) Run(args []string) int {
 	var detach, verbose bool
+	var idempotencyToken string
 	var meta []string
 
 	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
@@ -91,6 +100,7 @@ func (c *JobDispatchCommand) Run(args []string) int {
 	flags.BoolVar(&detach, "detach", false, "")
 	flags.BoolVar(&verbose, "verbose", false, "")
 	flags.Var((*flaghelper.StringFlag)(&meta), "meta", "")
+	flags.StringVar(&idempotencyToken, "idempotency-token", "", "")
 
 	if err := flags.Parse(args); err != nil {
 		return 1
@@ -148,7 +158,12 @@ func (c *JobDispatchCommand) Run(args []string) int {
 	}
 
 	// Dispatch the job
-	resp, _, err := client.Jobs().Dispatch(job, metaMap, payload, nil)
+	opts := &api.DispatchOptions{
+		Meta:             metaMap,
+		Payload:          payload,
+		IdempotencyToken: idempotencyToken,
+	}
+	resp, _, err := client.Jobs().DispatchOpts(job, opts, nil)
 	if err != nil {
 		c.Ui.Error(fmt.Sprintf("Failed to dispatch job: %s", err))
 		return 1
@@ -3,6 +3,7 @@ package command
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/nomad/api"
 	"github.com/hashicorp/nomad/api/contexts"
@@ -34,6 +35,10 @@ Inspect Options:
 
   -t
     Format and display job using a Go template.
+
+  -schedule <count>
+    Display the next count launches of a periodic job instead of the job
+    specification. Launches on excluded dates are not displayed.
 `
 	return strings.TrimSpace(helpText)
 }
@@ -45,9 +50,10 @@ func (c *JobInspectCommand) Synopsis() string {
 func (c *JobInspectCommand) AutocompleteFlags() complete.Flags {
 	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
 		complete.Flags{
-			"-version": complete.PredictAnything,
-			"-json":    complete.PredictNothing,
-			"-t":       complete.PredictAnything,
+			"-version":  complete.PredictAnything,
+			"-json":     complete.PredictNothing,
+			"-t":        complete.PredictAnything,
+			"-schedule": complete.PredictAnything,
 		})
 }
 
@@ -71,12 +77,14 @@ func (c *JobInspectCommand) Name() string { return "job inspect" }
 func (c *JobInspectCommand) Run(args []string) int {
 	var json bool
 	var tmpl, versionStr string
+	var schedule int
 
 	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
 	flags.Usage = func() { c.Ui.Output(c.Help()) }
 	flags.BoolVar(&json, "json", false, "")
 	flags.StringVar(&tmpl, "t", "", "")
 	flags.StringVar(&versionStr, "version", "", "")
+	flags.IntVar(&schedule, "schedule", 0, "")
 
 	if err := flags.Parse(args); err != nil {
 		return 1
@@ -149,6 +157,34 @@ func (c *JobInspectCommand) Run(args []string) int {
 		return 1
 	}
 
+	// Output the upcoming launches of the periodic job
+	if schedule > 0 {
+		if job.Periodic == nil || job.IsParameterized() {
+			c.Ui.Error(fmt.Sprintf("Job %q is not a periodic job", *job.ID))
+			return 1
+		}
+
+		launches, err := periodicSchedule(job.Periodic, time.Now(), schedule)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error determining the schedule: %s", err))
+			return 1
+		}
+
+		if json || len(tmpl) > 0 {
+			out, err := Format(json, tmpl, launches)
+			if err != nil {
+				c.Ui.Error(err.Error())
+				return 1
+			}
+
+			c.Ui.Output(out)
+			return 0
+		}
+
+		c.Ui.Output(formatPeriodicSchedule(launches, time.Now()))
+		return 0
+	}
+
 	// If output format is specified, format and output the data
 	if json || len(tmpl) > 0 {
 		out, err := Format(json, tmpl, job)
@@ -178,6 +214,47 @@ func (c *JobInspectCommand) Run(args []string) int {
 	return 0
 }
 
+// periodicSchedule returns up to count launches of the periodic config after
+// the passed time, in the config's time zone.
+func periodicSchedule(periodic *api.PeriodicConfig, from time.Time, count int) ([]time.Time, error) {
+	location, err := periodic.GetLocation()
+	if err != nil {
+		return nil, err
+	}
+
+	launches := make([]time.Time, 0, count)
+	next := from.In(location)
+	for len(launches) < count {
+		next, err = periodic.Next(next)
+		if err != nil {
+			return nil, err
+		}
+		if next.IsZero() {
+			break
+		}
+		launches = append(launches, next)
+	}
+	return launches, nil
+}
+
+// formatPeriodicSchedule returns a table of the launches and how long from
+// now they are.
+func formatPeriodicSchedule(launches []time.Time, now time.Time) string {
+	if len(launches) == 0 {
+		return "No upcoming launches"
+	}
+
+	out := make([]string, len(launches)+1)
+	out[0] = "Launch|Day|In"
+	for i, launch := range launches {
+		out[i+1] = fmt.Sprintf("%s|%s|%s",
+			formatTime(launch),
+			launch.Weekday(),
+			formatTimeDifference(now, launch, time.Second))
+	}
+	return formatList(out)
+}
+
 // getJob retrieves the job optionally at a particular version.
 func getJob(client *api.Client, jobID string, version *uint64) (*api.Job, error) {
 	if version == nil {
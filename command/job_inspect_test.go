@@ -3,11 +3,15 @@ package command
 import (
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/mitchellh/cli"
 	"github.com/posener/complete"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestInspectCommand_Implements(t *testing.T) {
@@ -81,3 +85,69 @@ func TestInspectCommand_AutocompleteArgs(t *testing.T) {
 	assert.Equal(1, len(res))
 	assert.Equal(j.ID, res[0])
 }
+
+func TestInspectCommand_Schedule(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	periodic := &api.PeriodicConfig{
+		SpecType:   helper.StringToPtr(api.PeriodicSpecCron),
+		Specs:      []string{"0 9 * * 1-5", "0 12 * * 6"},
+		Exclusions: []string{"2019-12-25"},
+		TimeZone:   helper.StringToPtr("America/New_York"),
+	}
+	periodic.Canonicalize()
+	loc, err := periodic.GetLocation()
+	require.NoError(err)
+
+	from := time.Date(2019, 12, 20, 18, 0, 0, 0, loc)
+	launches, err := periodicSchedule(periodic, from, 5)
+	require.NoError(err)
+	require.Len(launches, 5)
+
+	expected := []time.Time{
+		time.Date(2019, 12, 21, 12, 0, 0, 0, loc),
+		time.Date(2019, 12, 23, 9, 0, 0, 0, loc),
+		time.Date(2019, 12, 24, 9, 0, 0, 0, loc),
+		time.Date(2019, 12, 26, 9, 0, 0, 0, loc),
+		time.Date(2019, 12, 27, 9, 0, 0, 0, loc),
+	}
+	for i := range expected {
+		require.True(expected[i].Equal(launches[i]), "launch %d: got %v; want %v", i, launches[i], expected[i])
+	}
+
+	out := formatPeriodicSchedule(launches, from)
+	require.Regexp(`2019-12-21T12:00:00-05:00\s+Saturday\s+18h`, out)
+	require.Regexp(`2019-12-26T09:00:00-05:00\s+Thursday`, out)
+}
+
+func TestInspectCommand_Schedule_Run(t *testing.T) {
+	t.Parallel()
+	srv, client, url := testServer(t, false, nil)
+	defer srv.Shutdown()
+
+	ui := new(cli.MockUi)
+	cmd := &JobInspectCommand{Meta: Meta{Ui: ui}}
+
+	// Fails on a non-periodic job
+	_, _, err := client.Jobs().Register(testJob("job_not_periodic"), nil)
+	require.NoError(t, err)
+	code := cmd.Run([]string{"-address=" + url, "-schedule=3", "job_not_periodic"})
+	require.Equal(t, 1, code)
+	require.Contains(t, ui.ErrorWriter.String(), "is not a periodic job")
+
+	j := testJob("job1_is_periodic")
+	j.Periodic = &api.PeriodicConfig{
+		SpecType: helper.StringToPtr(api.PeriodicSpecCron),
+		Specs:    []string{"@daily", "@hourly"},
+	}
+	_, _, err = client.Jobs().Register(j, nil)
+	require.NoError(t, err)
+
+	code = cmd.Run([]string{"-address=" + url, "-schedule=3", "job1_is_periodic"})
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+
+	out := ui.OutputWriter.String()
+	require.Contains(t, out, "Launch")
+	require.Len(t, strings.Split(strings.TrimSpace(out), "\n"), 4)
+}
@@ -0,0 +1,149 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/nomad/api/contexts"
+	"github.com/posener/complete"
+)
+
+type JobPauseCommand struct {
+	Meta
+}
+
+func (c *JobPauseCommand) Help() string {
+	helpText := `
+Usage: nomad job pause [options] <job>
+
+  Pause is used to suspend a job without stopping or purging it. The count of
+  all the task groups of the job is set to zero, stopping their allocations,
+  and the counts prior to pausing are kept so they can be restored by the
+  "nomad job resume" command. Periodic launches and dispatches of the job are
+  blocked while it is paused.
+
+General Options:
+
+  ` + generalOptionsUsage() + `
+
+Pause Options:
+
+  -detach
+    Return immediately instead of entering monitor mode. After job pause,
+    the evaluation ID will be printed to the screen, which can be used to
+    examine the evaluation using the eval-status command.
+
+  -vault-token
+    The Vault token used to verify that the caller has access to the Vault
+    policies in the job.
+
+  -verbose
+    Display full information.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *JobPauseCommand) Synopsis() string {
+	return "Pause a job without purging it"
+}
+
+func (c *JobPauseCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-detach":      complete.PredictNothing,
+			"-vault-token": complete.PredictAnything,
+			"-verbose":     complete.PredictNothing,
+		})
+}
+
+func (c *JobPauseCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictFunc(func(a complete.Args) []string {
+		client, err := c.Meta.Client()
+		if err != nil {
+			return nil
+		}
+
+		resp, _, err := client.Search().PrefixSearch(a.Last, contexts.Jobs, nil)
+		if err != nil {
+			return []string{}
+		}
+		return resp.Matches[contexts.Jobs]
+	})
+}
+
+func (c *JobPauseCommand) Name() string { return "job pause" }
+
+func (c *JobPauseCommand) Run(args []string) int {
+	var detach, verbose bool
+	var vaultToken string
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.BoolVar(&detach, "detach", false, "")
+	flags.BoolVar(&verbose, "verbose", false, "")
+	flags.StringVar(&vaultToken, "vault-token", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Truncate the id unless full length is requested
+	length := shortId
+	if verbose {
+		length = fullId
+	}
+
+	// Check that we got exactly one argument
+	args = flags.Args()
+	if l := len(args); l != 1 {
+		c.Ui.Error("This command takes one argument: <job>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	// Parse the Vault token
+	if vaultToken == "" {
+		// Check the environment variable
+		vaultToken = os.Getenv("VAULT_TOKEN")
+	}
+
+	// Check if the job exists
+	jobID := args[0]
+	jobs, _, err := client.Jobs().PrefixList(jobID)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error pausing job: %s", err))
+		return 1
+	}
+	if len(jobs) == 0 {
+		c.Ui.Error(fmt.Sprintf("No job(s) with prefix or id %q found", jobID))
+		return 1
+	}
+	if len(jobs) > 1 && strings.TrimSpace(jobID) != jobs[0].ID {
+		c.Ui.Error(fmt.Sprintf("Prefix matched multiple jobs\n\n%s", createStatusListOutput(jobs)))
+		return 1
+	}
+
+	// Prefix lookup matched a single job
+	resp, _, err := client.Jobs().Pause(jobs[0].ID, true, nil, vaultToken)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error pausing job: %s", err))
+		return 1
+	}
+
+	// Nothing to do
+	evalCreated := resp.EvalID != ""
+	if detach || !evalCreated {
+		return 0
+	}
+
+	mon := newMonitor(c.Ui, client, length)
+	return mon.monitor(resp.EvalID, false)
+}
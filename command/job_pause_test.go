@@ -0,0 +1,93 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobPauseCommand_Implements(t *testing.T) {
+	t.Parallel()
+	var _ cli.Command = &JobPauseCommand{}
+}
+
+func TestJobPauseCommand_Fails(t *testing.T) {
+	t.Parallel()
+	ui := new(cli.MockUi)
+	cmd := &JobPauseCommand{Meta: Meta{Ui: ui}}
+
+	// Fails on misuse
+	if code := cmd.Run([]string{"some", "bad", "args"}); code != 1 {
+		t.Fatalf("expected exit code 1, got: %d", code)
+	}
+	if out := ui.ErrorWriter.String(); !strings.Contains(out, commandErrorText(cmd)) {
+		t.Fatalf("expected help output, got: %s", out)
+	}
+	ui.ErrorWriter.Reset()
+
+	if code := cmd.Run([]string{"-address=nope", "foo"}); code != 1 {
+		t.Fatalf("expected exit code 1, got: %d", code)
+	}
+	if out := ui.ErrorWriter.String(); !strings.Contains(out, "Error pausing job") {
+		t.Fatalf("expected failed query error, got: %s", out)
+	}
+	ui.ErrorWriter.Reset()
+}
+
+func TestJobPauseCommand_Run(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	srv, client, url := testServer(t, false, nil)
+	defer srv.Shutdown()
+
+	ui := new(cli.MockUi)
+	cmd := &JobPauseCommand{Meta: Meta{Ui: ui, flagAddress: url}}
+
+	// Create a job
+	state := srv.Agent.Server().State()
+	j := mock.Job()
+	j.TaskGroups[0].Count = 3
+	require.Nil(state.UpsertJob(1000, j))
+
+	require.Equal(0, cmd.Run([]string{"-address=" + url, "-detach", j.ID}))
+
+	out, _, err := client.Jobs().Info(j.ID, nil)
+	require.NoError(err)
+	require.True(out.Paused)
+	require.Equal(0, *out.TaskGroups[0].Count)
+	require.Equal(map[string]int{"web": 3}, out.PausedCounts)
+
+	// Pausing again fails
+	require.Equal(1, cmd.Run([]string{"-address=" + url, "-detach", j.ID}))
+	require.Contains(ui.ErrorWriter.String(), "already paused")
+}
+
+func TestJobPauseCommand_AutocompleteArgs(t *testing.T) {
+	assert := assert.New(t)
+	t.Parallel()
+
+	srv, _, url := testServer(t, true, nil)
+	defer srv.Shutdown()
+
+	ui := new(cli.MockUi)
+	cmd := &JobPauseCommand{Meta: Meta{Ui: ui, flagAddress: url}}
+
+	// Create a fake job
+	state := srv.Agent.Server().State()
+	j := mock.Job()
+	assert.Nil(state.UpsertJob(1000, j))
+
+	prefix := j.ID[:len(j.ID)-5]
+	args := complete.Args{Last: prefix}
+	predictor := cmd.AutocompleteArgs()
+
+	res := predictor.Predict(args)
+	assert.Equal(1, len(res))
+	assert.Equal(j.ID, res[0])
+}
@@ -1,8 +1,14 @@
 package command
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/mitchellh/cli"
 )
 
@@ -30,7 +36,100 @@ Usage: nomad job periodic <subcommand> [options] [args]
 
       $ nomad job periodic force <job_id>
 
+  List periodic jobs and their next launch:
+
+      $ nomad job periodic list
+
+  Show the launch history of a periodic job:
+
+      $ nomad job periodic history <job_id>
+
   Please see the individual subcommand help for detailed usage information.
 `
 	return strings.TrimSpace(helpText)
 }
+
+// formatNextPeriodicLaunch returns the next launch of the periodic job and
+// how long from now it is. An empty string is returned if the next launch
+// can't be determined.
+func formatNextPeriodicLaunch(job *api.Job) string {
+	if job.Stop != nil && *job.Stop {
+		return "none (job stopped)"
+	} else if job.Paused {
+		return "none (job paused)"
+	}
+
+	location, err := job.Periodic.GetLocation()
+	if err != nil {
+		return ""
+	}
+
+	now := time.Now().In(location)
+	next, err := job.Periodic.Next(now)
+	if err != nil {
+		return ""
+	} else if next.IsZero() {
+		return "none"
+	}
+
+	return fmt.Sprintf("%s (%s from now)", formatTime(next), formatTimeDifference(now, next, time.Second))
+}
+
+// periodicChildren returns the jobs launched by the periodic job, most recent
+// launch first.
+func periodicChildren(client *api.Client, jobID string) ([]*api.JobListStub, error) {
+	prefix := fmt.Sprintf("%s%s", jobID, structs.PeriodicLaunchSuffix)
+	jobs, _, err := client.Jobs().PrefixList(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	children := make([]*api.JobListStub, 0, len(jobs))
+	for _, child := range jobs {
+		// Ensure that we are only returning jobs whose parent is the
+		// requested job.
+		if child.ParentID == jobID {
+			children = append(children, child)
+		}
+	}
+
+	sort.Slice(children, func(i, j int) bool {
+		return periodicLaunchTime(children[i].ID).After(periodicLaunchTime(children[j].ID))
+	})
+	return children, nil
+}
+
+// periodicLaunchTime returns the launch time encoded in the ID of a job
+// launched by a periodic job, or the zero time if it can't be parsed.
+func periodicLaunchTime(childID string) time.Time {
+	index := strings.LastIndex(childID, structs.PeriodicLaunchSuffix)
+	if index == -1 {
+		return time.Time{}
+	}
+
+	launch, err := strconv.ParseInt(childID[index+len(structs.PeriodicLaunchSuffix):], 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return time.Unix(launch, 0)
+}
+
+// periodicOutcome returns the outcome of a job launched by a periodic job. A
+// dead job failed if any of its allocations failed or were lost.
+func periodicOutcome(child *api.JobListStub) string {
+	if child.Stop {
+		return "stopped"
+	} else if child.Status != structs.JobStatusDead {
+		return child.Status
+	} else if child.JobSummary == nil {
+		return "unknown"
+	}
+
+	for _, tg := range child.JobSummary.Summary {
+		if tg.Failed != 0 || tg.Lost != 0 {
+			return "failed"
+		}
+	}
+	return "success"
+}
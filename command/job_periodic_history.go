@@ -0,0 +1,192 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/posener/complete"
+)
+
+type JobPeriodicHistoryCommand struct {
+	Meta
+}
+
+func (c *JobPeriodicHistoryCommand) Help() string {
+	helpText := `
+Usage: nomad job periodic history [options] <job id>
+
+  Display the launch history of a periodic job. The jobs launched by the
+  periodic job are listed from the most recent launch with their status and
+  outcome. A launch failed if any of its allocations failed or were lost.
+
+  Launched jobs are only listed until they are garbage collected.
+
+General Options:
+
+  ` + generalOptionsUsage() + `
+
+Periodic History Options:
+
+  -verbose
+    Display full information.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *JobPeriodicHistoryCommand) Synopsis() string {
+	return "Display the launch history of a periodic job"
+}
+
+func (c *JobPeriodicHistoryCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-verbose": complete.PredictNothing,
+		})
+}
+
+func (c *JobPeriodicHistoryCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictFunc(func(a complete.Args) []string {
+		client, err := c.Meta.Client()
+		if err != nil {
+			return nil
+		}
+
+		resp, _, err := client.Jobs().PrefixList(a.Last)
+		if err != nil {
+			return []string{}
+		}
+
+		// filter this by periodic jobs
+		matches := make([]string, 0, len(resp))
+		for _, job := range resp {
+			if job.Periodic {
+				matches = append(matches, job.ID)
+			}
+		}
+		return matches
+	})
+}
+
+func (c *JobPeriodicHistoryCommand) Name() string { return "job periodic history" }
+
+func (c *JobPeriodicHistoryCommand) Run(args []string) int {
+	var verbose bool
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.BoolVar(&verbose, "verbose", false, "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got exactly one argument
+	args = flags.Args()
+	if l := len(args); l != 1 {
+		c.Ui.Error("This command takes one argument: <job id>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	// Truncate the id unless full length is requested
+	length := shortId
+	if verbose {
+		length = fullId
+	}
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	// Check if the job exists
+	jobID := args[0]
+	jobs, _, err := client.Jobs().PrefixList(jobID)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error querying periodic job: %s", err))
+		return 1
+	}
+	// filter non-periodic jobs
+	periodicJobs := make([]*api.JobListStub, 0, len(jobs))
+	for _, j := range jobs {
+		if j.Periodic {
+			periodicJobs = append(periodicJobs, j)
+		}
+	}
+	if len(periodicJobs) == 0 {
+		c.Ui.Error(fmt.Sprintf("No periodic job(s) with prefix or id %q found", jobID))
+		return 1
+	}
+	if len(periodicJobs) > 1 {
+		c.Ui.Error(fmt.Sprintf("Prefix matched multiple periodic jobs\n\n%s", createStatusListOutput(periodicJobs)))
+		return 1
+	}
+	jobID = periodicJobs[0].ID
+
+	job, _, err := client.Jobs().Info(jobID, nil)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error querying periodic job %q: %s", jobID, err))
+		return 1
+	}
+
+	children, err := periodicChildren(client, jobID)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error querying launches of job %q: %s", jobID, err))
+		return 1
+	}
+
+	specs := job.Periodic.Specs
+	if job.Periodic.Spec != nil && *job.Periodic.Spec != "" {
+		specs = []string{*job.Periodic.Spec}
+	}
+
+	basic := []string{
+		fmt.Sprintf("ID|%s", *job.ID),
+		fmt.Sprintf("Cron|%s", strings.Join(specs, ", ")),
+	}
+	if len(job.Periodic.Exclusions) != 0 {
+		basic = append(basic, fmt.Sprintf("Exclusions|%s", strings.Join(job.Periodic.Exclusions, ", ")))
+	}
+	if next := formatNextPeriodicLaunch(job); next != "" {
+		basic = append(basic, fmt.Sprintf("Next Launch|%s", next))
+	}
+	c.Ui.Output(formatKV(basic))
+
+	if len(children) == 0 {
+		c.Ui.Output("\nNo launches of periodic job found")
+		return 0
+	}
+
+	c.Ui.Output(c.Colorize().Color("\n[bold]Launches[reset]"))
+	c.Ui.Output(formatPeriodicHistory(children, length))
+	return 0
+}
+
+// formatPeriodicHistory returns a table of the jobs launched by a periodic
+// job with their outcome.
+func formatPeriodicHistory(children []*api.JobListStub, length int) string {
+	out := make([]string, len(children)+1)
+	out[0] = "Launch Time|ID|Status|Outcome"
+	for i, child := range children {
+		launch := "<unknown>"
+		if t := periodicLaunchTime(child.ID); !t.IsZero() {
+			launch = formatTime(t)
+		}
+
+		id := child.ID
+		if length == shortId {
+			// Only the launch suffix identifies the child
+			id = child.ID[strings.LastIndex(child.ID, "/")+1:]
+		}
+
+		out[i+1] = fmt.Sprintf("%s|%s|%s|%s",
+			launch,
+			id,
+			child.Status,
+			periodicOutcome(child))
+	}
+	return formatList(out)
+}
@@ -0,0 +1,96 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/helper"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobPeriodicHistoryCommand_Implements(t *testing.T) {
+	t.Parallel()
+	var _ cli.Command = &JobPeriodicHistoryCommand{}
+}
+
+func TestJobPeriodicHistoryCommand_Fails(t *testing.T) {
+	t.Parallel()
+	ui := new(cli.MockUi)
+	cmd := &JobPeriodicHistoryCommand{Meta: Meta{Ui: ui}}
+
+	// Fails on misuse
+	code := cmd.Run([]string{"some", "bad", "args"})
+	require.Equal(t, 1, code, "expected error")
+	require.Contains(t, ui.ErrorWriter.String(), commandErrorText(cmd), "expected help output")
+	ui.ErrorWriter.Reset()
+
+	code = cmd.Run([]string{"-address=nope", "12"})
+	require.Equal(t, 1, code, "expected error")
+	require.Contains(t, ui.ErrorWriter.String(), "Error querying periodic job")
+}
+
+func TestJobPeriodicHistoryCommand_Run(t *testing.T) {
+	t.Parallel()
+	srv, client, url := testServer(t, false, nil)
+	defer srv.Shutdown()
+
+	// Register a periodic job and force a launch of it
+	j := testJob("job1_is_periodic")
+	j.Periodic = &api.PeriodicConfig{
+		SpecType: helper.StringToPtr(api.PeriodicSpecCron),
+		Spec:     helper.StringToPtr("0 0 1 1 *"),
+	}
+	_, _, err := client.Jobs().Register(j, nil)
+	require.NoError(t, err)
+
+	_, _, err = client.Jobs().PeriodicForce(*j.ID, nil)
+	require.NoError(t, err)
+
+	ui := new(cli.MockUi)
+	cmd := &JobPeriodicHistoryCommand{Meta: Meta{Ui: ui}}
+	code := cmd.Run([]string{"-address=" + url, "-verbose", "job1_is"})
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+
+	out := ui.OutputWriter.String()
+	require.Contains(t, out, "Next Launch")
+	require.Contains(t, out, "Launches")
+	require.Contains(t, out, "job1_is_periodic"+structs.PeriodicLaunchSuffix)
+}
+
+func TestJobPeriodicHistoryCommand_Format(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	children := []*api.JobListStub{
+		{
+			ID:     "example/periodic-1500000200",
+			Status: "running",
+		},
+		{
+			ID:     "example/periodic-1500000100",
+			Status: "dead",
+			JobSummary: &api.JobSummary{
+				Summary: map[string]api.TaskGroupSummary{
+					"web": {Complete: 1, Failed: 1},
+				},
+			},
+		},
+		{
+			ID:     "example/periodic-1500000000",
+			Status: "dead",
+			JobSummary: &api.JobSummary{
+				Summary: map[string]api.TaskGroupSummary{
+					"web": {Complete: 2},
+				},
+			},
+		},
+	}
+
+	out := formatPeriodicHistory(children, shortId)
+	require.Regexp(`periodic-1500000200\s+running\s+running`, out)
+	require.Regexp(`periodic-1500000100\s+dead\s+failed`, out)
+	require.Regexp(`periodic-1500000000\s+dead\s+success`, out)
+	require.NotContains(out, "example/")
+}
@@ -0,0 +1,131 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/posener/complete"
+)
+
+type JobPeriodicListCommand struct {
+	Meta
+}
+
+func (c *JobPeriodicListCommand) Help() string {
+	helpText := `
+Usage: nomad job periodic list [options] [<job id prefix>]
+
+  List the periodic jobs with their next launch and the time of their most
+  recent launch. If a job ID prefix is given, only the periodic jobs matching
+  the prefix are listed.
+
+General Options:
+
+  ` + generalOptionsUsage() + `
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *JobPeriodicListCommand) Synopsis() string {
+	return "List periodic jobs and their next launch"
+}
+
+func (c *JobPeriodicListCommand) AutocompleteFlags() complete.Flags {
+	return c.Meta.AutocompleteFlags(FlagSetClient)
+}
+
+func (c *JobPeriodicListCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *JobPeriodicListCommand) Name() string { return "job periodic list" }
+
+func (c *JobPeriodicListCommand) Run(args []string) int {
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got at most one argument
+	args = flags.Args()
+	if l := len(args); l > 1 {
+		c.Ui.Error("This command takes at most one argument: [<job id prefix>]")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	var prefix string
+	if len(args) == 1 {
+		prefix = args[0]
+	}
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	jobs, _, err := client.Jobs().PrefixList(prefix)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error querying jobs: %s", err))
+		return 1
+	}
+
+	out := []string{"ID|Status|Catch Up|Last Launch|Next Launch"}
+	for _, stub := range jobs {
+		// Parameterized periodic jobs are only launched through their
+		// dispatched children.
+		if !stub.Periodic || stub.ParameterizedJob {
+			continue
+		}
+
+		job, _, err := client.Jobs().Info(stub.ID, nil)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error querying job %q: %s", stub.ID, err))
+			return 1
+		}
+
+		children, err := periodicChildren(client, stub.ID)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error querying launches of job %q: %s", stub.ID, err))
+			return 1
+		}
+
+		out = append(out, formatPeriodicListEntry(job, stub.Status, children))
+	}
+
+	if len(out) == 1 {
+		c.Ui.Output("No periodic jobs found")
+		return 0
+	}
+
+	c.Ui.Output(formatList(out))
+	return 0
+}
+
+// formatPeriodicListEntry returns a row of the periodic job list for the job
+// and the children it launched, most recent launch first.
+func formatPeriodicListEntry(job *api.Job, status string, children []*api.JobListStub) string {
+	last := "<none>"
+	if len(children) != 0 {
+		if t := periodicLaunchTime(children[0].ID); !t.IsZero() {
+			last = formatTime(t)
+		}
+	}
+
+	next := formatNextPeriodicLaunch(job)
+	if next == "" {
+		next = "<unknown>"
+	}
+
+	catchUp := api.PeriodicCatchUpLatest
+	if job.Periodic.CatchUp != nil && *job.Periodic.CatchUp != "" {
+		catchUp = *job.Periodic.CatchUp
+	}
+
+	return fmt.Sprintf("%s|%s|%s|%s|%s", *job.ID, status, catchUp, last, next)
+}
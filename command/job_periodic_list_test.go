@@ -0,0 +1,66 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/helper"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobPeriodicListCommand_Implements(t *testing.T) {
+	t.Parallel()
+	var _ cli.Command = &JobPeriodicListCommand{}
+}
+
+func TestJobPeriodicListCommand_Fails(t *testing.T) {
+	t.Parallel()
+	ui := new(cli.MockUi)
+	cmd := &JobPeriodicListCommand{Meta: Meta{Ui: ui}}
+
+	// Fails on misuse
+	code := cmd.Run([]string{"some", "bad", "args"})
+	require.Equal(t, 1, code, "expected error")
+	require.Contains(t, ui.ErrorWriter.String(), commandErrorText(cmd), "expected help output")
+	ui.ErrorWriter.Reset()
+
+	code = cmd.Run([]string{"-address=nope"})
+	require.Equal(t, 1, code, "expected error")
+	require.Contains(t, ui.ErrorWriter.String(), "Error querying jobs")
+}
+
+func TestJobPeriodicListCommand_Run(t *testing.T) {
+	t.Parallel()
+	srv, client, url := testServer(t, false, nil)
+	defer srv.Shutdown()
+
+	ui := new(cli.MockUi)
+	cmd := &JobPeriodicListCommand{Meta: Meta{Ui: ui}}
+
+	code := cmd.Run([]string{"-address=" + url})
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+	require.Contains(t, ui.OutputWriter.String(), "No periodic jobs found")
+	ui.OutputWriter.Reset()
+
+	// Register a periodic and a non-periodic job
+	_, _, err := client.Jobs().Register(testJob("job_not_periodic"), nil)
+	require.NoError(t, err)
+
+	j := testJob("job1_is_periodic")
+	j.Periodic = &api.PeriodicConfig{
+		SpecType: helper.StringToPtr(api.PeriodicSpecCron),
+		Spec:     helper.StringToPtr("0 0 1 1 *"),
+		CatchUp:  helper.StringToPtr(api.PeriodicCatchUpAll),
+	}
+	_, _, err = client.Jobs().Register(j, nil)
+	require.NoError(t, err)
+
+	code = cmd.Run([]string{"-address=" + url})
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+
+	out := ui.OutputWriter.String()
+	require.Regexp(t, `job1_is_periodic\s+running\s+all\s+<none>`, out)
+	require.Contains(t, out, "from now")
+	require.NotContains(t, out, "job_not_periodic")
+}
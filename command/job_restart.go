@@ -0,0 +1,658 @@
+package command
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/api/contexts"
+	"github.com/hashicorp/nomad/helper"
+	flaghelper "github.com/hashicorp/nomad/helper/flag-helpers"
+	"github.com/posener/complete"
+)
+
+const (
+	// jobRestartPollInterval is the interval at which the restarted
+	// allocations are polled while waiting for them to be healthy.
+	jobRestartPollInterval = 1 * time.Second
+
+	// jobRestartDefaultBatchTimeout is the default time to wait for the
+	// allocations of a batch to be healthy.
+	jobRestartDefaultBatchTimeout = 10 * time.Minute
+)
+
+// errJobRestartInterrupted is returned when the restart of a job is
+// interrupted by the operator.
+var errJobRestartInterrupted = fmt.Errorf("interrupted")
+
+type JobRestartCommand struct {
+	Meta
+}
+
+func (c *JobRestartCommand) Help() string {
+	helpText := `
+Usage: nomad job restart [options] <job id>
+
+  Restart is used to restart or reschedule the running allocations of a job in
+  batches. After restarting a batch, the command waits for its allocations to
+  be running again and healthy before moving on to the next batch. The health
+  of an allocation is determined by the health checks of its deployment or
+  migrate block, as when it was placed.
+
+  If the command is interrupted or fails, the allocations which were already
+  restarted can be skipped by running the command again with the -since flag
+  printed on exit. The time it holds is recorded by the cluster when the
+  allocations were restarted, rather than taken from the local clock.
+
+General Options:
+
+  ` + generalOptionsUsage() + `
+
+Restart Options:
+
+  -batch-size=<n|n%>
+    Number of allocations to restart at once. It may also be given as a
+    percentage of the allocations to restart. Defaults to 1.
+
+  -batch-wait=<duration>
+    Time to wait between batches once the allocations of a batch are healthy.
+    Defaults to 0.
+
+  -batch-timeout=<duration>
+    Time to wait for the allocations of a batch to be restarted and healthy
+    before failing. Set to 0 to wait indefinitely. Defaults to 10m.
+
+  -group
+    Group may be specified many times and is used to only restart the
+    allocations of that particular group. If no specific groups are specified,
+    the allocations of all groups are restarted.
+
+  -task
+    Task may be specified many times and is used to only restart that
+    particular task within the allocations. If no specific tasks are specified,
+    all tasks are restarted. Cannot be used with -reschedule.
+
+  -reschedule
+    Stop the allocations and reschedule them, possibly on other nodes, instead
+    of restarting their tasks in place.
+
+  -since=<time>
+    Skip the allocations restarted or rescheduled since the given RFC3339
+    time. Used to resume an interrupted restart.
+
+  -verbose
+    Display full information.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *JobRestartCommand) Synopsis() string {
+	return "Restart or reschedule the allocations of a job in batches"
+}
+
+func (c *JobRestartCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-batch-size":    complete.PredictAnything,
+			"-batch-wait":    complete.PredictAnything,
+			"-batch-timeout": complete.PredictAnything,
+			"-group":         complete.PredictAnything,
+			"-task":          complete.PredictAnything,
+			"-reschedule":    complete.PredictNothing,
+			"-since":         complete.PredictAnything,
+			"-verbose":       complete.PredictNothing,
+		})
+}
+
+func (c *JobRestartCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictFunc(func(a complete.Args) []string {
+		client, err := c.Meta.Client()
+		if err != nil {
+			return nil
+		}
+
+		resp, _, err := client.Search().PrefixSearch(a.Last, contexts.Jobs, nil)
+		if err != nil {
+			return []string{}
+		}
+		return resp.Matches[contexts.Jobs]
+	})
+}
+
+func (c *JobRestartCommand) Name() string { return "job restart" }
+
+func (c *JobRestartCommand) Run(args []string) int {
+	var reschedule, verbose bool
+	var batchSizeStr, sinceStr string
+	var batchWait, batchTimeout time.Duration
+	var groups, tasks []string
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.StringVar(&batchSizeStr, "batch-size", "1", "")
+	flags.DurationVar(&batchWait, "batch-wait", 0, "")
+	flags.DurationVar(&batchTimeout, "batch-timeout", jobRestartDefaultBatchTimeout, "")
+	flags.Var((*flaghelper.StringFlag)(&groups), "group", "")
+	flags.Var((*flaghelper.StringFlag)(&tasks), "task", "")
+	flags.BoolVar(&reschedule, "reschedule", false, "")
+	flags.StringVar(&sinceStr, "since", "", "")
+	flags.BoolVar(&verbose, "verbose", false, "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got exactly one argument
+	args = flags.Args()
+	if l := len(args); l != 1 {
+		c.Ui.Error("This command takes one argument: <job id>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	if reschedule && len(tasks) != 0 {
+		c.Ui.Error("The -task flag cannot be used with -reschedule")
+		return 1
+	}
+	if batchWait < 0 {
+		c.Ui.Error("The -batch-wait flag must not be negative")
+		return 1
+	}
+	if batchTimeout < 0 {
+		c.Ui.Error("The -batch-timeout flag must not be negative")
+		return 1
+	}
+	if _, _, err := parseRestartBatchSize(batchSizeStr); err != nil {
+		c.Ui.Error(fmt.Sprintf("Invalid -batch-size: %v", err))
+		return 1
+	}
+
+	// The time allocations are considered restarted since is taken from the
+	// times recorded by the cluster, which the local clock may not agree with.
+	// Without -since, every running allocation is restarted.
+	var since time.Time
+	if sinceStr != "" {
+		t, err := time.Parse(time.RFC3339, sinceStr)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Invalid -since: %v", err))
+			return 1
+		}
+		since = t
+	}
+
+	// Truncate the id unless full length is requested
+	length := shortId
+	if verbose {
+		length = fullId
+	}
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	// Check if the job exists
+	jobID := args[0]
+	jobs, _, err := client.Jobs().PrefixList(jobID)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error restarting job: %s", err))
+		return 1
+	}
+	if len(jobs) == 0 {
+		c.Ui.Error(fmt.Sprintf("No job(s) with prefix or id %q found", jobID))
+		return 1
+	}
+	if len(jobs) > 1 && strings.TrimSpace(jobID) != jobs[0].ID {
+		c.Ui.Error(fmt.Sprintf("Prefix matched multiple jobs\n\n%s", createStatusListOutput(jobs)))
+		return 1
+	}
+	jobID = jobs[0].ID
+
+	job, _, err := client.Jobs().Info(jobID, nil)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error retrieving job: %s", err))
+		return 1
+	}
+	if err := validateRestartTargets(job, groups, tasks); err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+
+	stubs, _, err := client.Jobs().Allocations(jobID, false, nil)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error retrieving allocations: %s", err))
+		return 1
+	}
+	allocs := restartCandidates(stubs, groups, tasks, reschedule, since)
+	if len(allocs) == 0 {
+		c.Ui.Output(fmt.Sprintf("No allocations of job %q to restart", jobID))
+		return 0
+	}
+
+	size, percent, _ := parseRestartBatchSize(batchSizeStr)
+	if percent {
+		size = int(math.Ceil(float64(len(allocs)*size) / 100))
+	}
+	if size > len(allocs) {
+		size = len(allocs)
+	}
+
+	action := "Restarting"
+	if reschedule {
+		action = "Rescheduling"
+	}
+	c.Ui.Output(fmt.Sprintf("==> %s %d allocation(s) of job %q in batches of %d",
+		action, len(allocs), jobID, size))
+
+	signalCh := make(chan os.Signal, 1)
+	signal.Notify(signalCh, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signalCh)
+
+	// resumeSince is the earliest time an allocation was restarted or
+	// rescheduled, as recorded by the cluster
+	resumeSince := since
+	for i := 0; i < len(allocs); i += size {
+		end := i + size
+		if end > len(allocs) {
+			end = len(allocs)
+		}
+
+		batch := allocs[i:end]
+		if err := c.restartBatch(client, batch, tasks, reschedule, batchTimeout, length, signalCh, &resumeSince); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error restarting job %q: %s", jobID, err))
+			c.outputResume(resumeSince)
+			return 1
+		}
+
+		if end == len(allocs) || batchWait == 0 {
+			continue
+		}
+
+		c.Ui.Output(fmt.Sprintf("==> Waiting %s before restarting the next batch", batchWait))
+		select {
+		case <-time.After(batchWait):
+		case <-signalCh:
+			c.Ui.Error(fmt.Sprintf("Error restarting job %q: %s", jobID, errJobRestartInterrupted))
+			c.outputResume(resumeSince)
+			return 1
+		}
+	}
+
+	c.Ui.Output(fmt.Sprintf("==> Finished restarting %d allocation(s) of job %q", len(allocs), jobID))
+	return 0
+}
+
+// outputResume outputs the -since flag to pass to resume the restart. Nothing
+// is output if no allocation was seen restarted, as running the command again
+// without it restarts the same allocations.
+func (c *JobRestartCommand) outputResume(since time.Time) {
+	if since.IsZero() {
+		return
+	}
+	c.Ui.Error(fmt.Sprintf("To resume, run the command again with -since=%s",
+		since.UTC().Format(time.RFC3339Nano)))
+}
+
+// restartBatch restarts or reschedules a batch of allocations and waits for
+// them to be healthy. An error is returned if they are not healthy within the
+// given timeout, unless it is 0. The since time is moved back to the time any
+// allocation of the batch is seen restarted, if earlier.
+func (c *JobRestartCommand) restartBatch(client *api.Client, batch []*api.AllocationListStub,
+	tasks []string, reschedule bool, timeout time.Duration, length int, signalCh <-chan os.Signal,
+	since *time.Time) error {
+
+	var timeoutCh <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		timeoutCh = timer.C
+	}
+
+	for _, stub := range batch {
+		alloc, _, err := client.Allocations().Info(stub.ID, nil)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve allocation %q: %v", limit(stub.ID, length), err)
+		}
+
+		if reschedule {
+			c.Ui.Output(fmt.Sprintf("    Rescheduling allocation %q", limit(alloc.ID, length)))
+			if _, err := client.Allocations().Stop(alloc, nil); err != nil {
+				return fmt.Errorf("failed to stop allocation %q: %v", limit(alloc.ID, length), err)
+			}
+			continue
+		}
+
+		c.Ui.Output(fmt.Sprintf("    Restarting allocation %q", limit(alloc.ID, length)))
+		if len(tasks) == 0 {
+			if err := client.Allocations().Restart(alloc, "", nil); err != nil {
+				return fmt.Errorf("failed to restart allocation %q: %v", limit(alloc.ID, length), err)
+			}
+			continue
+		}
+		for _, task := range tasks {
+			if _, ok := alloc.TaskStates[task]; !ok {
+				continue
+			}
+			if err := client.Allocations().Restart(alloc, task, nil); err != nil {
+				return fmt.Errorf("failed to restart task %q of allocation %q: %v", task, limit(alloc.ID, length), err)
+			}
+		}
+	}
+
+	// Wait for every allocation of the batch to be healthy
+	pending := make(map[string]string, len(batch))
+	for _, stub := range batch {
+		pending[stub.ID] = ""
+	}
+
+	ticker := time.NewTicker(jobRestartPollInterval)
+	defer ticker.Stop()
+	for len(pending) != 0 {
+		select {
+		case <-ticker.C:
+		case <-signalCh:
+			return errJobRestartInterrupted
+		case <-timeoutCh:
+			return fmt.Errorf("timed out after %s waiting for %d allocation(s) to be healthy",
+				timeout, len(pending))
+		}
+
+		for id, replacement := range pending {
+			healthy, next, restartedAt, err := restartedAllocHealthy(client, id, replacement, tasks, reschedule)
+			if !restartedAt.IsZero() && (since.IsZero() || restartedAt.Before(*since)) {
+				*since = restartedAt
+			}
+			if err != nil {
+				return fmt.Errorf("allocation %q: %v", limit(id, length), err)
+			}
+			if healthy {
+				delete(pending, id)
+				continue
+			}
+			pending[id] = next
+		}
+	}
+
+	return nil
+}
+
+// restartedAllocHealthy returns whether a restarted allocation is healthy. For
+// rescheduled allocations, the health of the replacement allocation is
+// returned along with its ID once it is known. The time the allocation was
+// restarted is returned once it is seen restarted: the time its tasks were
+// signaled to restart, or the time its replacement was created.
+func restartedAllocHealthy(client *api.Client, allocID, replacement string,
+	tasks []string, reschedule bool) (bool, string, time.Time, error) {
+
+	if !reschedule {
+		alloc, _, err := client.Allocations().Info(allocID, nil)
+		if err != nil {
+			return false, "", time.Time{}, err
+		}
+		if err := checkAllocNotFailed(alloc); err != nil {
+			return false, "", time.Time{}, err
+		}
+		if !tasksRestarted(alloc, tasks) {
+			return false, "", time.Time{}, nil
+		}
+		restartedAt := firstRestartSignal(alloc.TaskStates, tasks)
+		healthy, err := restartedInPlaceHealthy(alloc)
+		return healthy, "", restartedAt, err
+	}
+
+	if replacement == "" {
+		alloc, _, err := client.Allocations().Info(allocID, nil)
+		if err != nil {
+			return false, "", time.Time{}, err
+		}
+		if alloc.NextAllocation == "" {
+			return false, "", time.Time{}, nil
+		}
+		replacement = alloc.NextAllocation
+	}
+
+	alloc, _, err := client.Allocations().Info(replacement, nil)
+	if err != nil {
+		return false, replacement, time.Time{}, err
+	}
+	restartedAt := time.Unix(0, alloc.CreateTime)
+	if err := checkAllocNotFailed(alloc); err != nil {
+		return false, replacement, restartedAt, err
+	}
+	if alloc.ClientStatus != api.AllocClientStatusRunning {
+		return false, replacement, restartedAt, nil
+	}
+
+	// Allocations part of a deployment must be marked healthy
+	if alloc.DeploymentID != "" {
+		if alloc.DeploymentStatus == nil || alloc.DeploymentStatus.Healthy == nil {
+			return false, replacement, restartedAt, nil
+		}
+		if !*alloc.DeploymentStatus.Healthy {
+			return false, replacement, restartedAt, fmt.Errorf("allocation %q is unhealthy", alloc.ID)
+		}
+	}
+	return true, replacement, restartedAt, nil
+}
+
+// restartedInPlaceHealthy returns whether an allocation whose tasks were
+// restarted in place is healthy again. Clients watch the health of such an
+// allocation again once its tasks are restarted, so only a health set after
+// the last restart counts. Allocations whose health is not watched, or is set
+// by the operator, are healthy once their tasks are running.
+func restartedInPlaceHealthy(alloc *api.Allocation) (bool, error) {
+	ds := alloc.DeploymentStatus
+	if ds == nil || ds.Healthy == nil || healthSetManually(alloc) {
+		return true, nil
+	}
+
+	if !ds.Timestamp.After(lastRestartSignal(alloc)) {
+		return false, nil
+	}
+	if !*ds.Healthy {
+		return false, fmt.Errorf("allocation %q is unhealthy", alloc.ID)
+	}
+	return true, nil
+}
+
+// healthSetManually returns whether the health of the allocation is set by
+// the operator rather than watched by the client.
+func healthSetManually(alloc *api.Allocation) bool {
+	if alloc.DeploymentID == "" || alloc.Job == nil {
+		return false
+	}
+	tg := alloc.Job.LookupTaskGroup(alloc.TaskGroup)
+	if tg == nil || tg.Update == nil || tg.Update.MaxParallel == nil || *tg.Update.MaxParallel == 0 {
+		return true
+	}
+	return tg.Update.HealthCheck != nil && *tg.Update.HealthCheck == "manual"
+}
+
+// firstRestartSignal returns the earliest of the times the given tasks, or all
+// the tasks if none are given, were last signaled to restart. Tasks which were
+// never signaled are ignored.
+func firstRestartSignal(states map[string]*api.TaskState, tasks []string) time.Time {
+	var first int64
+	for name, state := range states {
+		if len(tasks) != 0 && !helper.SliceStringContains(tasks, name) {
+			continue
+		}
+
+		var last int64
+		for _, e := range state.Events {
+			if e.Type == api.TaskRestartSignal && e.Time > last {
+				last = e.Time
+			}
+		}
+		if last != 0 && (first == 0 || last < first) {
+			first = last
+		}
+	}
+	if first == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, first)
+}
+
+// lastRestartSignal returns the time of the last restart signal received by
+// the tasks of the allocation.
+func lastRestartSignal(alloc *api.Allocation) time.Time {
+	var last int64
+	for _, state := range alloc.TaskStates {
+		for _, e := range state.Events {
+			if e.Type == api.TaskRestartSignal && e.Time > last {
+				last = e.Time
+			}
+		}
+	}
+	return time.Unix(0, last)
+}
+
+// checkAllocNotFailed returns an error if the allocation has failed or was
+// lost.
+func checkAllocNotFailed(alloc *api.Allocation) error {
+	switch alloc.ClientStatus {
+	case api.AllocClientStatusFailed, api.AllocClientStatusLost:
+		return fmt.Errorf("allocation %q is %s", alloc.ID, alloc.ClientStatus)
+	}
+	return nil
+}
+
+// tasksRestarted returns whether the given tasks of the allocation, or all its
+// tasks if none are given, have been started again since they were last
+// signaled to restart. Tasks which are not running are not restarted and are
+// ignored.
+func tasksRestarted(alloc *api.Allocation, tasks []string) bool {
+	for name, state := range alloc.TaskStates {
+		if len(tasks) != 0 && !helper.SliceStringContains(tasks, name) {
+			continue
+		}
+		if state.State == "dead" {
+			continue
+		}
+
+		signaled, started := false, false
+		for _, e := range state.Events {
+			switch e.Type {
+			case api.TaskRestartSignal:
+				signaled, started = true, false
+			case api.TaskStarted:
+				started = true
+			}
+		}
+		if !signaled || !started || state.State != "running" {
+			return false
+		}
+	}
+	return true
+}
+
+// parseRestartBatchSize parses a batch size given either as a number of
+// allocations or as a percentage.
+func parseRestartBatchSize(s string) (int, bool, error) {
+	percent := strings.HasSuffix(s, "%")
+	n, err := strconv.Atoi(strings.TrimSuffix(s, "%"))
+	if err != nil {
+		return 0, false, fmt.Errorf("%q is not a number or a percentage", s)
+	}
+	if n < 1 {
+		return 0, false, fmt.Errorf("must be greater than 0")
+	}
+	if percent && n > 100 {
+		return 0, false, fmt.Errorf("percentage must not be greater than 100")
+	}
+	return n, percent, nil
+}
+
+// validateRestartTargets returns an error if one of the given groups or tasks
+// does not exist in the job.
+func validateRestartTargets(job *api.Job, groups, tasks []string) error {
+	for _, group := range groups {
+		if job.LookupTaskGroup(group) == nil {
+			return fmt.Errorf("Group %q not found in job %q", group, *job.ID)
+		}
+	}
+
+TASKS:
+	for _, task := range tasks {
+		for _, tg := range job.TaskGroups {
+			if len(groups) != 0 && !helper.SliceStringContains(groups, *tg.Name) {
+				continue
+			}
+			for _, t := range tg.Tasks {
+				if t.Name == task {
+					continue TASKS
+				}
+			}
+		}
+		return fmt.Errorf("Task %q not found in job %q", task, *job.ID)
+	}
+	return nil
+}
+
+// restartCandidates returns the running allocations to restart, sorted by
+// name. Allocations restarted or created since the given time, if set, are
+// skipped so an interrupted restart can be resumed.
+func restartCandidates(stubs []*api.AllocationListStub, groups, tasks []string,
+	reschedule bool, since time.Time) []*api.AllocationListStub {
+
+	var out []*api.AllocationListStub
+	for _, stub := range stubs {
+		if stub.DesiredStatus != api.AllocDesiredStatusRun || stub.ClientStatus != api.AllocClientStatusRunning {
+			continue
+		}
+		if len(groups) != 0 && !helper.SliceStringContains(groups, stub.TaskGroup) {
+			continue
+		}
+		if !since.IsZero() {
+			if stub.CreateTime >= since.UnixNano() {
+				continue
+			}
+			if !reschedule && restartedSince(stub, tasks, since) {
+				continue
+			}
+		}
+		out = append(out, stub)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Name != out[j].Name {
+			return out[i].Name < out[j].Name
+		}
+		return out[i].ID < out[j].ID
+	})
+	return out
+}
+
+// restartedSince returns whether all the given tasks of the allocation, or all
+// its tasks if none are given, were signaled to restart since the given time.
+func restartedSince(stub *api.AllocationListStub, tasks []string, since time.Time) bool {
+	restarted := false
+	for name, state := range stub.TaskStates {
+		if len(tasks) != 0 && !helper.SliceStringContains(tasks, name) {
+			continue
+		}
+
+		found := false
+		for _, e := range state.Events {
+			if e.Type == api.TaskRestartSignal && e.Time >= since.UnixNano() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+		restarted = true
+	}
+	return restarted
+}
@@ -0,0 +1,338 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/helper"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobRestartCommand_Implements(t *testing.T) {
+	t.Parallel()
+	var _ cli.Command = &JobRestartCommand{}
+}
+
+func TestJobRestartCommand_Fails(t *testing.T) {
+	t.Parallel()
+	ui := new(cli.MockUi)
+	cmd := &JobRestartCommand{Meta: Meta{Ui: ui}}
+
+	// Fails on misuse
+	if code := cmd.Run([]string{"some", "bad", "args"}); code != 1 {
+		t.Fatalf("expected exit code 1, got: %d", code)
+	}
+	if out := ui.ErrorWriter.String(); !strings.Contains(out, commandErrorText(cmd)) {
+		t.Fatalf("expected help output, got: %s", out)
+	}
+	ui.ErrorWriter.Reset()
+
+	// Fails on tasks with reschedule
+	if code := cmd.Run([]string{"-reschedule", "-task=foo", "12"}); code != 1 {
+		t.Fatalf("expected exit code 1, got: %d", code)
+	}
+	if out := ui.ErrorWriter.String(); !strings.Contains(out, "-task flag cannot be used") {
+		t.Fatalf("expected task error, got: %s", out)
+	}
+	ui.ErrorWriter.Reset()
+
+	// Fails on invalid batch size
+	if code := cmd.Run([]string{"-batch-size=0", "12"}); code != 1 {
+		t.Fatalf("expected exit code 1, got: %d", code)
+	}
+	if out := ui.ErrorWriter.String(); !strings.Contains(out, "Invalid -batch-size") {
+		t.Fatalf("expected batch size error, got: %s", out)
+	}
+	ui.ErrorWriter.Reset()
+
+	// Fails on negative batch timeout
+	if code := cmd.Run([]string{"-batch-timeout=-1s", "12"}); code != 1 {
+		t.Fatalf("expected exit code 1, got: %d", code)
+	}
+	if out := ui.ErrorWriter.String(); !strings.Contains(out, "-batch-timeout flag must not be negative") {
+		t.Fatalf("expected batch timeout error, got: %s", out)
+	}
+	ui.ErrorWriter.Reset()
+
+	if code := cmd.Run([]string{"-address=nope", "12"}); code != 1 {
+		t.Fatalf("expected exit code 1, got: %d", code)
+	}
+	if out := ui.ErrorWriter.String(); !strings.Contains(out, "Error restarting") {
+		t.Fatalf("expected failed to restart error, got: %s", out)
+	}
+	ui.ErrorWriter.Reset()
+}
+
+func TestJobRestartCommand_ParseBatchSize(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	n, percent, err := parseRestartBatchSize("3")
+	require.NoError(err)
+	require.Equal(3, n)
+	require.False(percent)
+
+	n, percent, err = parseRestartBatchSize("25%")
+	require.NoError(err)
+	require.Equal(25, n)
+	require.True(percent)
+
+	for _, s := range []string{"", "0", "-1", "0%", "101%", "foo"} {
+		_, _, err = parseRestartBatchSize(s)
+		require.Error(err, s)
+	}
+}
+
+func TestJobRestartCommand_Candidates(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	since := time.Now()
+	before := since.Add(-time.Minute).UnixNano()
+	after := since.Add(time.Minute).UnixNano()
+
+	stub := func(name, group string, created int64) *api.AllocationListStub {
+		return &api.AllocationListStub{
+			ID:            name,
+			Name:          name,
+			TaskGroup:     group,
+			DesiredStatus: api.AllocDesiredStatusRun,
+			ClientStatus:  api.AllocClientStatusRunning,
+			CreateTime:    created,
+			TaskStates: map[string]*api.TaskState{
+				"web":     {State: "running"},
+				"sidecar": {State: "running"},
+			},
+		}
+	}
+
+	// Restarted since the given time
+	restarted := stub("example.web[1]", "web", before)
+	restarted.TaskStates["web"].Events = []*api.TaskEvent{{Type: api.TaskRestartSignal, Time: after}}
+	restarted.TaskStates["sidecar"].Events = []*api.TaskEvent{{Type: api.TaskRestartSignal, Time: after}}
+
+	// Only the sidecar was restarted since the given time
+	partial := stub("example.web[0]", "web", before)
+	partial.TaskStates["sidecar"].Events = []*api.TaskEvent{{Type: api.TaskRestartSignal, Time: after}}
+
+	// Stopped
+	stopped := stub("example.web[3]", "web", before)
+	stopped.DesiredStatus = api.AllocDesiredStatusStop
+
+	stubs := []*api.AllocationListStub{
+		stub("example.web[2]", "web", before),
+		restarted,
+		stub("example.cache[0]", "cache", before),
+		stub("example.web[4]", "web", after),
+		stopped,
+		partial,
+	}
+
+	names := func(allocs []*api.AllocationListStub) []string {
+		var out []string
+		for _, a := range allocs {
+			out = append(out, a.Name)
+		}
+		return out
+	}
+
+	out := restartCandidates(stubs, nil, nil, false, since)
+	require.Equal([]string{"example.cache[0]", "example.web[0]", "example.web[2]"}, names(out))
+
+	out = restartCandidates(stubs, []string{"web"}, []string{"sidecar"}, false, since)
+	require.Equal([]string{"example.web[2]"}, names(out))
+
+	out = restartCandidates(stubs, []string{"web"}, nil, true, since)
+	require.Equal([]string{"example.web[0]", "example.web[1]", "example.web[2]"}, names(out))
+
+	// Without a time, every running allocation is a candidate
+	out = restartCandidates(stubs, []string{"web"}, nil, false, time.Time{})
+	require.Equal([]string{"example.web[0]", "example.web[1]", "example.web[2]", "example.web[4]"}, names(out))
+}
+
+func TestJobRestartCommand_FirstRestartSignal(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	states := map[string]*api.TaskState{
+		"web": {Events: []*api.TaskEvent{
+			{Type: api.TaskRestartSignal, Time: 100},
+			{Type: api.TaskStarted, Time: 150},
+			{Type: api.TaskRestartSignal, Time: 300},
+		}},
+		"sidecar": {Events: []*api.TaskEvent{
+			{Type: api.TaskRestartSignal, Time: 200},
+		}},
+		"init": {Events: []*api.TaskEvent{
+			{Type: api.TaskStarted, Time: 50},
+		}},
+	}
+
+	// The earliest of the last restart signal of each task is used
+	require.Equal(time.Unix(0, 200), firstRestartSignal(states, nil))
+	require.Equal(time.Unix(0, 300), firstRestartSignal(states, []string{"web"}))
+	require.True(firstRestartSignal(states, []string{"init"}).IsZero())
+}
+
+func TestJobRestartCommand_RestartedInPlaceHealthy(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	signaled := time.Now()
+	alloc := &api.Allocation{
+		ID:           "foo",
+		TaskGroup:    "web",
+		DeploymentID: "d1",
+		Job: &api.Job{
+			TaskGroups: []*api.TaskGroup{{
+				Name: helper.StringToPtr("web"),
+				Update: &api.UpdateStrategy{
+					MaxParallel: helper.IntToPtr(1),
+					HealthCheck: helper.StringToPtr("checks"),
+				},
+			}},
+		},
+		TaskStates: map[string]*api.TaskState{
+			"web": {
+				State:  "running",
+				Events: []*api.TaskEvent{{Type: api.TaskRestartSignal, Time: signaled.UnixNano()}},
+			},
+		},
+	}
+
+	// Health was never set so it is not watched
+	healthy, err := restartedInPlaceHealthy(alloc)
+	require.NoError(err)
+	require.True(healthy)
+
+	// Health set before the restart is not enough
+	alloc.DeploymentStatus = &api.AllocDeploymentStatus{
+		Healthy:   helper.BoolToPtr(true),
+		Timestamp: signaled.Add(-time.Minute),
+	}
+	healthy, err = restartedInPlaceHealthy(alloc)
+	require.NoError(err)
+	require.False(healthy)
+
+	// Health set after the restart
+	alloc.DeploymentStatus.Timestamp = signaled.Add(time.Second)
+	healthy, err = restartedInPlaceHealthy(alloc)
+	require.NoError(err)
+	require.True(healthy)
+
+	// Unhealthy after the restart
+	alloc.DeploymentStatus.Healthy = helper.BoolToPtr(false)
+	_, err = restartedInPlaceHealthy(alloc)
+	require.Error(err)
+
+	// Health set manually is not watched again
+	alloc.DeploymentStatus.Timestamp = signaled.Add(-time.Minute)
+	alloc.Job.TaskGroups[0].Update.HealthCheck = helper.StringToPtr("manual")
+	healthy, err = restartedInPlaceHealthy(alloc)
+	require.NoError(err)
+	require.True(healthy)
+}
+
+func TestJobRestartCommand_Run(t *testing.T) {
+	srv, client, url := testServer(t, true, nil)
+	defer srv.Shutdown()
+
+	require := require.New(t)
+
+	// Wait for a node to be ready
+	testutil.WaitForResult(func() (bool, error) {
+		nodes, _, err := client.Nodes().List(nil)
+		if err != nil {
+			return false, err
+		}
+		for _, node := range nodes {
+			if _, ok := node.Drivers["mock_driver"]; ok &&
+				node.Status == structs.NodeStatusReady {
+				return true, nil
+			}
+		}
+		return false, fmt.Errorf("no ready nodes")
+	}, func(err error) {
+		t.Fatalf("err: %v", err)
+	})
+
+	ui := new(cli.MockUi)
+	cmd := &JobRestartCommand{Meta: Meta{Ui: ui}}
+
+	jobID := "job1_sfx"
+	job1 := testJob(jobID)
+	job1.TaskGroups[0].Tasks[0].Config["run_for"] = "30s"
+	resp, _, err := client.Jobs().Register(job1, nil)
+	require.NoError(err)
+	if code := waitForSuccess(ui, client, fullId, t, resp.EvalID); code != 0 {
+		t.Fatalf("status code non zero saw %d", code)
+	}
+
+	// Wait for the alloc to be running
+	testutil.WaitForResult(func() (bool, error) {
+		allocs, _, err := client.Jobs().Allocations(jobID, false, nil)
+		if err != nil {
+			return false, err
+		}
+		if len(allocs) != 1 {
+			return false, fmt.Errorf("expected 1 alloc, got %d", len(allocs))
+		}
+		if allocs[0].ClientStatus != api.AllocClientStatusRunning {
+			return false, fmt.Errorf("alloc is not running, is: %s", allocs[0].ClientStatus)
+		}
+		return true, nil
+	}, func(err error) {
+		t.Fatalf("err: %v", err)
+	})
+	ui.OutputWriter.Reset()
+
+	require.Equal(0, cmd.Run([]string{"-address=" + url, jobID}), ui.ErrorWriter.String())
+	out := ui.OutputWriter.String()
+	require.Contains(out, "Restarting allocation")
+	require.Contains(out, "Finished restarting 1 allocation(s)")
+	ui.OutputWriter.Reset()
+
+	// Running again since the first restart, as recorded by the client,
+	// skips the restarted alloc
+	allocs, _, err := client.Jobs().Allocations(jobID, false, nil)
+	require.NoError(err)
+	require.Len(allocs, 1)
+	restartedAt := firstRestartSignal(allocs[0].TaskStates, nil)
+	require.False(restartedAt.IsZero())
+	since := restartedAt.UTC().Format(time.RFC3339Nano)
+	require.Equal(0, cmd.Run([]string{"-address=" + url, "-since=" + since, jobID}), ui.ErrorWriter.String())
+	require.Contains(ui.OutputWriter.String(), "No allocations")
+}
+
+func TestJobRestartCommand_AutocompleteArgs(t *testing.T) {
+	assert := assert.New(t)
+	t.Parallel()
+
+	srv, _, url := testServer(t, true, nil)
+	defer srv.Shutdown()
+
+	ui := new(cli.MockUi)
+	cmd := &JobRestartCommand{Meta: Meta{Ui: ui, flagAddress: url}}
+
+	// Create a fake job
+	state := srv.Agent.Server().State()
+	j := mock.Job()
+	assert.Nil(state.UpsertJob(1000, j))
+
+	prefix := j.ID[:len(j.ID)-5]
+	args := complete.Args{Last: prefix}
+	predictor := cmd.AutocompleteArgs()
+
+	res := predictor.Predict(args)
+	assert.Equal(1, len(res))
+	assert.Equal(j.ID, res[0])
+}
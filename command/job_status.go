@@ -3,11 +3,13 @@ package command
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/nomad/api"
 	"github.com/hashicorp/nomad/api/contexts"
+	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/posener/complete"
 )
@@ -166,6 +168,11 @@ func (c *JobStatusCommand) Run(args []string) int {
 	parameterized := job.IsParameterized()
 
 	// Format the job info
+	status := getStatusString(*job.Status, job.Stop)
+	if job.Paused {
+		status = fmt.Sprintf("%s (paused)", status)
+	}
+
 	basic := []string{
 		fmt.Sprintf("ID|%s", *job.ID),
 		fmt.Sprintf("Name|%s", *job.Name),
@@ -173,25 +180,14 @@ func (c *JobStatusCommand) Run(args []string) int {
 		fmt.Sprintf("Type|%s", *job.Type),
 		fmt.Sprintf("Priority|%d", *job.Priority),
 		fmt.Sprintf("Datacenters|%s", strings.Join(job.Datacenters, ",")),
-		fmt.Sprintf("Status|%s", getStatusString(*job.Status, job.Stop)),
+		fmt.Sprintf("Status|%s", status),
 		fmt.Sprintf("Periodic|%v", periodic),
 		fmt.Sprintf("Parameterized|%v", parameterized),
 	}
 
 	if periodic && !parameterized {
-		if *job.Stop {
-			basic = append(basic, fmt.Sprintf("Next Periodic Launch|none (job stopped)"))
-		} else {
-			location, err := job.Periodic.GetLocation()
-			if err == nil {
-				now := time.Now().In(location)
-				next, err := job.Periodic.Next(now)
-				if err == nil {
-					basic = append(basic, fmt.Sprintf("Next Periodic Launch|%s",
-						fmt.Sprintf("%s (%s from now)",
-							formatTime(next), formatTimeDifference(now, next, time.Second))))
-				}
-			}
+		if next := formatNextPeriodicLaunch(job); next != "" {
+			basic = append(basic, fmt.Sprintf("Next Periodic Launch|%s", next))
 		}
 	}
 
@@ -335,6 +331,15 @@ func (c *JobStatusCommand) outputJobInfo(client *api.Client, job *api.Job) error
 		return err
 	}
 
+	if err := c.outputArraySummary(client, job); err != nil {
+		return err
+	}
+
+	if len(job.DependsOn) != 0 {
+		c.Ui.Output(c.Colorize().Color("\n[bold]Dependencies[reset]"))
+		c.Ui.Output(formatJobDependencies(job, jobEvals))
+	}
+
 	// Determine latest evaluation with failures whose follow up hasn't
 	// completed, this is done while formatting
 	var latestFailedPlacement *api.Evaluation
@@ -526,6 +531,12 @@ func (c *JobStatusCommand) outputJobSummary(client *api.Client, job *api.Job) er
 		c.Ui.Output(formatList(summaries))
 	}
 
+	// Display the completion of sysbatch jobs on each node
+	if len(summary.Nodes) != 0 {
+		c.Ui.Output(c.Colorize().Color("\n[bold]Node Summary[reset]"))
+		c.Ui.Output(formatNodeSummary(summary.Nodes, c.length))
+	}
+
 	// Always display the summary if we are periodic or parameterized, but
 	// only display if the summary is non-zero on normal jobs
 	if summary.Children != nil && (parameterizedJob || periodic || summary.Children.Sum() > 0) {
@@ -544,6 +555,166 @@ func (c *JobStatusCommand) outputJobSummary(client *api.Client, job *api.Job) er
 	return nil
 }
 
+// formatNodeSummary returns the number of nodes a sysbatch job completed,
+// failed or is still running or pending on, followed by a table of the
+// status of the job on each node.
+func formatNodeSummary(nodes map[string]*api.JobNodeSummary, uuidLength int) string {
+	statuses := make(map[string]string, len(nodes))
+	counts := make(map[string]int, 4)
+	ids := make([]string, 0, len(nodes))
+	for id, node := range nodes {
+		status := jobNodeStatus(node)
+		statuses[id] = status
+		counts[status]++
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	totals := []string{
+		"Complete|Failed|Running|Pending",
+		fmt.Sprintf("%d|%d|%d|%d",
+			counts[structs.AllocClientStatusComplete],
+			counts[structs.AllocClientStatusFailed],
+			counts[structs.AllocClientStatusRunning],
+			counts[structs.AllocClientStatusPending]),
+	}
+
+	out := []string{"Node ID|Status"}
+	for _, id := range ids {
+		out = append(out, fmt.Sprintf("%s|%s", limit(id, uuidLength), statuses[id]))
+	}
+	return formatList(totals) + "\n\n" + formatList(out)
+}
+
+// jobNodeStatus returns the completion of a sysbatch job on a node, as
+// structs.JobNodeSummary.Status does for the server's summary.
+func jobNodeStatus(node *api.JobNodeSummary) string {
+	status := structs.AllocClientStatusComplete
+	for _, alloc := range node.Allocs {
+		switch alloc.ClientStatus {
+		case structs.AllocClientStatusFailed, structs.AllocClientStatusLost:
+			return structs.AllocClientStatusFailed
+		case structs.AllocClientStatusRunning:
+			status = structs.AllocClientStatusRunning
+		case structs.AllocClientStatusPending:
+			if status == structs.AllocClientStatusComplete {
+				status = structs.AllocClientStatusPending
+			}
+		}
+	}
+	return status
+}
+
+// outputArraySummary displays the outcome of each index of the job's array
+// task groups
+func (c *JobStatusCommand) outputArraySummary(client *api.Client, job *api.Job) error {
+	hasArray := false
+	for _, tg := range job.TaskGroups {
+		if tg.Array != nil {
+			hasArray = true
+			break
+		}
+	}
+	if !hasArray {
+		return nil
+	}
+
+	// Query the allocations of the current job regardless of -all-allocs
+	stubs, _, err := client.Jobs().Allocations(*job.ID, false, nil)
+	if err != nil {
+		return fmt.Errorf("Error querying job allocations: %s", err)
+	}
+
+	c.Ui.Output(c.Colorize().Color("\n[bold]Array Summary[reset]"))
+	c.Ui.Output(formatArraySummary(job, stubs, c.length))
+	return nil
+}
+
+// formatArraySummary returns a table with the status of the latest allocation
+// and the number of attempts of each index of the job's array task groups
+func formatArraySummary(job *api.Job, stubs []*api.AllocationListStub, uuidLength int) string {
+	type arrayIndex struct {
+		latest   *api.AllocationListStub
+		attempts int
+	}
+
+	indexes := make(map[string]map[int]*arrayIndex)
+	for _, alloc := range stubs {
+		idx, ok := allocNameIndex(alloc.Name)
+		if !ok {
+			continue
+		}
+		if indexes[alloc.TaskGroup] == nil {
+			indexes[alloc.TaskGroup] = make(map[int]*arrayIndex)
+		}
+		ai, ok := indexes[alloc.TaskGroup][idx]
+		if !ok {
+			ai = &arrayIndex{}
+			indexes[alloc.TaskGroup][idx] = ai
+		}
+		ai.attempts++
+		if ai.latest == nil || ai.latest.CreateIndex < alloc.CreateIndex {
+			ai.latest = alloc
+		}
+	}
+
+	out := []string{"Task Group|Index|Status|Attempts|Alloc ID"}
+	for _, tg := range job.TaskGroups {
+		if tg.Array == nil || tg.Array.Size == nil {
+			continue
+		}
+		for i := 0; i < *tg.Array.Size; i++ {
+			ai, ok := indexes[*tg.Name][i]
+			if !ok {
+				out = append(out, fmt.Sprintf("%s|%d|pending|0|<none>", *tg.Name, i))
+				continue
+			}
+			out = append(out, fmt.Sprintf("%s|%d|%s|%d|%s",
+				*tg.Name, i, ai.latest.ClientStatus, ai.attempts,
+				limit(ai.latest.ID, uuidLength)))
+		}
+	}
+	return formatList(out)
+}
+
+// formatJobDependencies returns a table of the job's dependencies marking the
+// ones the latest blocked evaluation is waiting on
+func formatJobDependencies(job *api.Job, evals []*api.Evaluation) string {
+	var waiting *api.Evaluation
+	for _, eval := range evals {
+		if eval.Status != structs.EvalStatusBlocked || len(eval.WaitingOnJobs) == 0 {
+			continue
+		}
+		if waiting == nil || waiting.CreateIndex < eval.CreateIndex {
+			waiting = eval
+		}
+	}
+
+	out := []string{"Job ID|Condition|Status"}
+	for _, dep := range job.DependsOn {
+		status := "met"
+		if waiting != nil && helper.SliceStringContains(waiting.WaitingOnJobs, *dep.JobID) {
+			status = "waiting"
+		}
+		out = append(out, fmt.Sprintf("%s|%s|%s", *dep.JobID, *dep.Condition, status))
+	}
+	return formatList(out)
+}
+
+// allocNameIndex returns the index of an allocation given its name in the
+// form "<job>.<group>[<index>]"
+func allocNameIndex(name string) (int, bool) {
+	start := strings.LastIndex(name, "[")
+	if start == -1 || !strings.HasSuffix(name, "]") {
+		return 0, false
+	}
+	idx, err := strconv.Atoi(name[start+1 : len(name)-1])
+	if err != nil {
+		return 0, false
+	}
+	return idx, true
+}
+
 // outputReschedulingEvals displays eval IDs and time for any
 // delayed evaluations by task group
 func (c *JobStatusCommand) outputReschedulingEvals(client *api.Client, job *api.Job, allocListStubs []*api.AllocationListStub, uuidLength int) error {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/nomad/api"
 	"github.com/hashicorp/nomad/command/agent"
+	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/hashicorp/nomad/testutil"
@@ -241,6 +242,89 @@ func TestJobStatusCommand_Fails(t *testing.T) {
 	}
 }
 
+func TestJobStatusCommand_ArraySummary(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	job := &api.Job{
+		TaskGroups: []*api.TaskGroup{
+			{
+				Name:  helper.StringToPtr("shard"),
+				Array: &api.ArrayConfig{Size: helper.IntToPtr(3)},
+			},
+			{
+				Name: helper.StringToPtr("web"),
+			},
+		},
+	}
+
+	stubs := []*api.AllocationListStub{
+		{ID: "aaaaaaaa-1", Name: "example.shard[0]", TaskGroup: "shard", ClientStatus: "complete", CreateIndex: 10},
+		{ID: "bbbbbbbb-1", Name: "example.shard[1]", TaskGroup: "shard", ClientStatus: "failed", CreateIndex: 10},
+		{ID: "cccccccc-1", Name: "example.shard[1]", TaskGroup: "shard", ClientStatus: "running", CreateIndex: 20},
+		{ID: "dddddddd-1", Name: "example.web[0]", TaskGroup: "web", ClientStatus: "running", CreateIndex: 10},
+	}
+
+	out := formatArraySummary(job, stubs, shortId)
+	lines := strings.Split(out, "\n")
+	require.Len(lines, 4)
+	require.Regexp(`shard\s+0\s+complete\s+1\s+aaaaaaaa`, lines[1])
+	require.Regexp(`shard\s+1\s+running\s+2\s+cccccccc`, lines[2])
+	require.Regexp(`shard\s+2\s+pending\s+0\s+<none>`, lines[3])
+}
+
+func TestJobStatusCommand_NodeSummary(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	nodes := map[string]*api.JobNodeSummary{
+		"aaaaaaaa-1": {Allocs: map[string]api.JobNodeAlloc{
+			"maint": {ClientStatus: "complete"},
+			"clean": {ClientStatus: "complete"},
+		}},
+		"bbbbbbbb-1": {Allocs: map[string]api.JobNodeAlloc{
+			"maint": {ClientStatus: "lost"},
+			"clean": {ClientStatus: "running"},
+		}},
+		"cccccccc-1": {Allocs: map[string]api.JobNodeAlloc{
+			"maint": {ClientStatus: "complete"},
+			"clean": {ClientStatus: "pending"},
+		}},
+	}
+
+	out := formatNodeSummary(nodes, shortId)
+	lines := strings.Split(out, "\n")
+	require.Len(lines, 7)
+	require.Regexp(`Complete\s+Failed\s+Running\s+Pending`, lines[0])
+	require.Regexp(`1\s+1\s+0\s+1`, lines[1])
+	require.Regexp(`aaaaaaaa\s+complete`, lines[4])
+	require.Regexp(`bbbbbbbb\s+failed`, lines[5])
+	require.Regexp(`cccccccc\s+pending`, lines[6])
+}
+
+func TestJobStatusCommand_Dependencies(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	job := &api.Job{
+		DependsOn: []*api.JobDependency{
+			{JobID: helper.StringToPtr("extract"), Condition: helper.StringToPtr("success")},
+			{JobID: helper.StringToPtr("transform"), Condition: helper.StringToPtr("complete")},
+		},
+	}
+
+	evals := []*api.Evaluation{
+		{ID: "old", Status: "complete", WaitingOnJobs: []string{"extract", "transform"}, CreateIndex: 10},
+		{ID: "new", Status: "blocked", WaitingOnJobs: []string{"transform"}, CreateIndex: 20},
+	}
+
+	out := formatJobDependencies(job, evals)
+	lines := strings.Split(out, "\n")
+	require.Len(lines, 3)
+	require.Regexp(`extract\s+success\s+met`, lines[1])
+	require.Regexp(`transform\s+complete\s+waiting`, lines[2])
+}
+
 func TestJobStatusCommand_AutocompleteArgs(t *testing.T) {
 	assert := assert.New(t)
 	t.Parallel()
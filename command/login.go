@@ -0,0 +1,235 @@
+package command
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/posener/complete"
+)
+
+const (
+	// defaultOIDCCallbackAddr is the address the login command listens on
+	// for the redirect of the OIDC provider
+	defaultOIDCCallbackAddr = "localhost:4649"
+
+	// oidcCallbackPath is the path the OIDC provider redirects users to
+	oidcCallbackPath = "/oidc/callback"
+)
+
+type LoginCommand struct {
+	Meta
+}
+
+func (c *LoginCommand) Help() string {
+	helpText := `
+Usage: nomad login [options]
+
+  Login is used to log in with an ACL auth method and create a short-lived
+  ACL token. For OIDC auth methods, the command prints the URL to log in at
+  with the provider, and listens locally for the provider to redirect back
+  once the login is complete. The created token is then written out, and can
+  be used by setting the NOMAD_TOKEN environment variable to its secret ID.
+
+General Options:
+
+  ` + generalOptionsUsage() + `
+
+Login Options:
+
+  -method=""
+    Specifies the name of the auth method to log in with. The default auth
+    method is used if not specified.
+
+  -oidc-callback-addr="localhost:4649"
+    Specifies the address to listen on for the redirect of the OIDC provider.
+    The callback URI "http://<addr>/oidc/callback" must be one of the allowed
+    redirect URIs of the auth method.
+
+  -json
+    Output the ACL token in a JSON format.
+
+  -t
+    Format and display the ACL token using a Go template.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *LoginCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-method":             complete.PredictAnything,
+			"-oidc-callback-addr": complete.PredictAnything,
+			"-json":               complete.PredictNothing,
+			"-t":                  complete.PredictAnything,
+		})
+}
+
+func (c *LoginCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *LoginCommand) Synopsis() string {
+	return "Log in with an ACL auth method"
+}
+
+func (c *LoginCommand) Name() string { return "login" }
+
+func (c *LoginCommand) Run(args []string) int {
+	var methodName, callbackAddr, tmpl string
+	var json bool
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.StringVar(&methodName, "method", "", "")
+	flags.StringVar(&callbackAddr, "oidc-callback-addr", defaultOIDCCallbackAddr, "")
+	flags.BoolVar(&json, "json", false, "")
+	flags.StringVar(&tmpl, "t", "", "")
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got no arguments
+	args = flags.Args()
+	if l := len(args); l != 0 {
+		c.Ui.Error("This command takes no arguments")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	// Find the default auth method if none is given
+	if methodName == "" {
+		methods, _, err := client.ACLAuthMethods().List(nil)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error listing ACL auth methods: %s", err))
+			return 1
+		}
+		for _, m := range methods {
+			if m.Default {
+				methodName = m.Name
+				break
+			}
+		}
+		if methodName == "" {
+			c.Ui.Error("No default auth method found, specify one with -method")
+			return 1
+		}
+	}
+
+	token, err := c.loginOIDC(client, methodName, callbackAddr)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error logging in: %s", err))
+		return 1
+	}
+
+	if json || len(tmpl) > 0 {
+		out, err := Format(json, tmpl, token)
+		if err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+
+		c.Ui.Output(out)
+		return 0
+	}
+
+	c.Ui.Output(formatKVACLToken(token))
+	return 0
+}
+
+// oidcCallback holds the parameters the OIDC provider redirected the user
+// with.
+type oidcCallback struct {
+	state string
+	code  string
+	err   error
+}
+
+// loginOIDC logs in with an OIDC auth method. It listens on the callback
+// address for the redirect of the provider, and completes the login with the
+// authorization code it receives.
+func (c *LoginCommand) loginOIDC(client *api.Client, methodName, callbackAddr string) (*api.ACLToken, error) {
+	ln, err := net.Listen("tcp", callbackAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for the OIDC callback: %v", err)
+	}
+	defer ln.Close()
+
+	clientNonce := uuid.Generate()
+	redirectURI := fmt.Sprintf("http://%s%s", callbackAddr, oidcCallbackPath)
+
+	resp, _, err := client.ACLOIDC().GetAuthURL(&api.ACLOIDCAuthURLRequest{
+		AuthMethodName: methodName,
+		RedirectURI:    redirectURI,
+		ClientNonce:    clientNonce,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Serve the callback
+	callbackCh := make(chan *oidcCallback, 1)
+	mux := http.NewServeMux()
+	mux.HandleFunc(oidcCallbackPath, func(w http.ResponseWriter, req *http.Request) {
+		q := req.URL.Query()
+		cb := &oidcCallback{
+			state: q.Get("state"),
+			code:  q.Get("code"),
+		}
+		if e := q.Get("error"); e != "" {
+			cb.err = fmt.Errorf("OIDC provider returned %s: %s", e, q.Get("error_description"))
+			fmt.Fprintln(w, "Login failed, return to the terminal for details.")
+		} else {
+			fmt.Fprintln(w, "Login complete, you may close this window and return to the terminal.")
+		}
+
+		select {
+		case callbackCh <- cb:
+		default:
+		}
+	})
+	srv := &http.Server{Handler: mux}
+	go srv.Serve(ln)
+	defer srv.Close()
+
+	c.Ui.Output(fmt.Sprintf("Complete the login via your OIDC provider at:\n\n    %s\n", resp.AuthURL))
+	c.Ui.Output("Waiting for the OIDC provider to redirect back...")
+
+	signalCh := make(chan os.Signal, 1)
+	signal.Notify(signalCh, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signalCh)
+
+	var cb *oidcCallback
+	select {
+	case cb = <-callbackCh:
+	case <-signalCh:
+		return nil, fmt.Errorf("login interrupted")
+	}
+	if cb.err != nil {
+		return nil, cb.err
+	}
+
+	token, _, err := client.ACLOIDC().CompleteAuth(&api.ACLOIDCCompleteAuthRequest{
+		AuthMethodName: methodName,
+		ClientNonce:    clientNonce,
+		State:          cb.state,
+		Code:           cb.code,
+		RedirectURI:    redirectURI,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
@@ -0,0 +1,52 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/nomad/command/agent"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoginCommand_Implements(t *testing.T) {
+	t.Parallel()
+	var _ cli.Command = &LoginCommand{}
+}
+
+func TestLoginCommand_Fails(t *testing.T) {
+	assert := assert.New(t)
+	t.Parallel()
+	config := func(c *agent.Config) {
+		c.ACL.Enabled = true
+	}
+
+	srv, _, url := testServer(t, true, config)
+	defer srv.Shutdown()
+
+	ui := new(cli.MockUi)
+	cmd := &LoginCommand{Meta: Meta{Ui: ui, flagAddress: url}}
+
+	// Fails on arguments
+	code := cmd.Run([]string{"-address=" + url, "some", "bad", "args"})
+	assert.Equal(1, code)
+	if out := ui.ErrorWriter.String(); !strings.Contains(out, commandErrorText(cmd)) {
+		t.Fatalf("expected help output, got: %s", out)
+	}
+	ui.ErrorWriter.Reset()
+
+	// Fails without a default auth method
+	code = cmd.Run([]string{"-address=" + url})
+	assert.Equal(1, code)
+	if out := ui.ErrorWriter.String(); !strings.Contains(out, "No default auth method") {
+		t.Fatalf("expected missing default error, got: %s", out)
+	}
+	ui.ErrorWriter.Reset()
+
+	// Fails with a missing auth method
+	code = cmd.Run([]string{"-address=" + url, "-method=missing", "-oidc-callback-addr=127.0.0.1:0"})
+	assert.Equal(1, code)
+	if out := ui.ErrorWriter.String(); !strings.Contains(out, "not found") {
+		t.Fatalf("expected missing auth method error, got: %s", out)
+	}
+}
@@ -0,0 +1,78 @@
+package command
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/mitchellh/cli"
+)
+
+type VarCommand struct {
+	Meta
+}
+
+func (f *VarCommand) Help() string {
+	helpText := `
+Usage: nomad var <subcommand> [options] [args]
+
+  This command groups subcommands for interacting with variables. Variables
+  are encrypted key/value items stored at a path of a namespace, and are
+  accessible to ACL tokens according to the variables rules of their policies.
+
+  Create or update a variable:
+
+      $ nomad var put <path> <key>=<value>...
+
+  Read a variable:
+
+      $ nomad var get <path>
+
+  List variables:
+
+      $ nomad var list [<prefix>]
+
+  Delete a variable:
+
+      $ nomad var purge <path>
+
+  Please see the individual subcommand help for detailed usage information.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (f *VarCommand) Synopsis() string {
+	return "Interact with variables"
+}
+
+func (f *VarCommand) Name() string { return "var" }
+
+func (f *VarCommand) Run(args []string) int {
+	return cli.RunResultHelp
+}
+
+// formatVariable returns the metadata and items of a variable formatted for
+// display
+func formatVariable(v *api.Variable) string {
+	basic := []string{
+		fmt.Sprintf("Namespace|%s", v.Namespace),
+		fmt.Sprintf("Path|%s", v.Path),
+		fmt.Sprintf("Create Time|%s", formatUnixNanoTime(v.CreateTime)),
+		fmt.Sprintf("Modify Time|%s", formatUnixNanoTime(v.ModifyTime)),
+		fmt.Sprintf("Check Index|%d", v.ModifyIndex),
+	}
+
+	keys := make([]string, 0, len(v.Items))
+	for k := range v.Items {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	items := make([]string, 0, len(keys))
+	for _, k := range keys {
+		items = append(items, fmt.Sprintf("%s|%s", k, v.Items[k]))
+	}
+
+	return fmt.Sprintf("%s\n\n%s\n%s", formatKV(basic), "Items", formatKV(items))
+}
@@ -0,0 +1,92 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/posener/complete"
+)
+
+type VarPurgeCommand struct {
+	Meta
+}
+
+func (c *VarPurgeCommand) Help() string {
+	helpText := `
+Usage: nomad var purge [options] <path>
+
+  Purge is used to permanently delete the variable at the given path. If ACLs
+  are enabled, this command requires a token with the 'destroy' variables
+  capability for the path.
+
+General Options:
+
+  ` + generalOptionsUsage() + `
+
+Purge Options:
+
+  -check-index <index>
+    Only delete the variable if its current check index matches the given
+    index.
+`
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *VarPurgeCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-check-index": complete.PredictAnything,
+		})
+}
+
+func (c *VarPurgeCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *VarPurgeCommand) Synopsis() string {
+	return "Delete a variable"
+}
+
+func (c *VarPurgeCommand) Name() string { return "var purge" }
+
+func (c *VarPurgeCommand) Run(args []string) int {
+	var checkIndex int64
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.Int64Var(&checkIndex, "check-index", -1, "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got exactly one argument
+	args = flags.Args()
+	if l := len(args); l != 1 {
+		c.Ui.Error("This command takes one argument: <path>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+	path := args[0]
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	if checkIndex >= 0 {
+		_, err = client.Variables().CheckedDelete(path, uint64(checkIndex), nil)
+	} else {
+		_, err = client.Variables().Delete(path, nil)
+	}
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error deleting variable: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(fmt.Sprintf("Successfully purged variable %q", path))
+	return 0
+}
@@ -0,0 +1,105 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/posener/complete"
+)
+
+type VarPutCommand struct {
+	Meta
+}
+
+func (c *VarPutCommand) Help() string {
+	helpText := `
+Usage: nomad var put [options] <path> <key>=<value> [<key>=<value>...]
+
+  Put is used to create or update the variable at the given path. The items
+  of the variable are replaced by the given items. If ACLs are enabled, this
+  command requires a token with the 'write' variables capability for the path.
+
+General Options:
+
+  ` + generalOptionsUsage() + `
+
+Put Options:
+
+  -check-index <index>
+    Only write the variable if its current check index matches the given
+    index. An index of 0 only creates the variable if it doesn't exist.
+`
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *VarPutCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-check-index": complete.PredictAnything,
+		})
+}
+
+func (c *VarPutCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *VarPutCommand) Synopsis() string {
+	return "Create or update a variable"
+}
+
+func (c *VarPutCommand) Name() string { return "var put" }
+
+func (c *VarPutCommand) Run(args []string) int {
+	var checkIndex int64
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.Int64Var(&checkIndex, "check-index", -1, "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got a path and at least one item
+	args = flags.Args()
+	if l := len(args); l < 2 {
+		c.Ui.Error("This command takes at least two arguments: <path> <key>=<value>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	v := &api.Variable{
+		Path:  args[0],
+		Items: make(map[string]string, len(args)-1),
+	}
+	for _, arg := range args[1:] {
+		parts := strings.SplitN(arg, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			c.Ui.Error(fmt.Sprintf("Invalid item %q: items must be in the form <key>=<value>", arg))
+			return 1
+		}
+		v.Items[parts[0]] = parts[1]
+	}
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	if checkIndex >= 0 {
+		v, _, err = client.Variables().CheckedUpsert(v, uint64(checkIndex), nil)
+	} else {
+		v, _, err = client.Variables().Upsert(v, nil)
+	}
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error writing variable: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(fmt.Sprintf("Successfully wrote variable %q with check index %d", v.Path, v.ModifyIndex))
+	return 0
+}
@@ -0,0 +1,104 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVarCommands_Implements(t *testing.T) {
+	t.Parallel()
+	var _ cli.Command = &VarCommand{}
+	var _ cli.Command = &VarGetCommand{}
+	var _ cli.Command = &VarListCommand{}
+	var _ cli.Command = &VarPurgeCommand{}
+	var _ cli.Command = &VarPutCommand{}
+}
+
+func TestVarPutCommand_Fails(t *testing.T) {
+	t.Parallel()
+	ui := new(cli.MockUi)
+	cmd := &VarPutCommand{Meta: Meta{Ui: ui}}
+
+	// Fails on misuse
+	if code := cmd.Run([]string{"app/db"}); code != 1 {
+		t.Fatalf("expected exit code 1, got: %d", code)
+	}
+	if out := ui.ErrorWriter.String(); !strings.Contains(out, commandErrorText(cmd)) {
+		t.Fatalf("expected help output, got: %s", out)
+	}
+	ui.ErrorWriter.Reset()
+
+	// Fails on malformed items
+	if code := cmd.Run([]string{"app/db", "password"}); code != 1 {
+		t.Fatalf("expected exit code 1, got: %d", code)
+	}
+	if out := ui.ErrorWriter.String(); !strings.Contains(out, "Invalid item") {
+		t.Fatalf("expected invalid item error, got: %s", out)
+	}
+}
+
+func TestVarCommands_Good(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	srv, client, url := testServer(t, false, nil)
+	defer srv.Shutdown()
+
+	// Write a variable, waiting for the leader to create a root key
+	ui := new(cli.MockUi)
+	put := &VarPutCommand{Meta: Meta{Ui: ui}}
+	testutil.WaitForResult(func() (bool, error) {
+		code := put.Run([]string{"-address=" + url, "app/db", "user=admin", "password=hunter2"})
+		return code == 0, nil
+	}, func(err error) {
+		t.Fatalf("failed to write variable: %s", ui.ErrorWriter.String())
+	})
+	require.Contains(ui.OutputWriter.String(), `Successfully wrote variable "app/db"`)
+
+	v, _, err := client.Variables().Read("app/db", nil)
+	require.Nil(err)
+	require.Equal("hunter2", v.Items["password"])
+
+	// A stale check index is rejected
+	ui.OutputWriter.Reset()
+	code := put.Run([]string{"-address=" + url, "-check-index=0", "app/db", "password=changed"})
+	require.Equal(1, code)
+	require.Contains(ui.ErrorWriter.String(), "check-and-set conflict")
+
+	// Read the variable and a single item
+	ui = new(cli.MockUi)
+	get := &VarGetCommand{Meta: Meta{Ui: ui}}
+	require.Equal(0, get.Run([]string{"-address=" + url, "app/db"}))
+	out := ui.OutputWriter.String()
+	require.Contains(out, "app/db")
+	require.Contains(out, "hunter2")
+
+	ui.OutputWriter.Reset()
+	require.Equal(0, get.Run([]string{"-address=" + url, "-item=user", "app/db"}))
+	require.Equal("admin", strings.TrimSpace(ui.OutputWriter.String()))
+
+	// List by prefix
+	ui = new(cli.MockUi)
+	list := &VarListCommand{Meta: Meta{Ui: ui}}
+	require.Equal(0, list.Run([]string{"-address=" + url, "app/"}))
+	require.Contains(ui.OutputWriter.String(), "app/db")
+
+	ui.OutputWriter.Reset()
+	require.Equal(0, list.Run([]string{"-address=" + url, "other/"}))
+	require.Contains(ui.OutputWriter.String(), "No variables found")
+
+	// Purge the variable
+	ui = new(cli.MockUi)
+	purge := &VarPurgeCommand{Meta: Meta{Ui: ui}}
+	require.Equal(0, purge.Run([]string{"-address=" + url, "app/db"}))
+	require.Contains(ui.OutputWriter.String(), `Successfully purged variable "app/db"`)
+
+	ui = new(cli.MockUi)
+	get.Meta.Ui = ui
+	require.Equal(1, get.Run([]string{"-address=" + url, "app/db"}))
+	require.Contains(ui.ErrorWriter.String(), "variable not found")
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/nomad/client/taskenv"
 	"github.com/hashicorp/nomad/drivers/docker/docklog"
 	"github.com/hashicorp/nomad/drivers/shared/eventer"
+	"github.com/hashicorp/nomad/drivers/shared/resolvconf"
 	nstructs "github.com/hashicorp/nomad/nomad/structs"
 	"github.com/hashicorp/nomad/plugins/base"
 	"github.com/hashicorp/nomad/plugins/drivers"
@@ -910,6 +911,14 @@ func (d *Driver) createContainerConfig(task *drivers.TaskConfig, driverConfig *T
 		}
 	}
 
+	// Apply the group's DNS configuration. Options set in the task's driver
+	// config take precedence.
+	if task.DNS != nil {
+		if err := d.setupDNS(task, driverConfig, hostConfig); err != nil {
+			return c, err
+		}
+	}
+
 	// Setup port mapping and exposed ports
 	if len(task.Resources.NomadResources.Networks) == 0 {
 		if len(driverConfig.PortMap) > 0 {
@@ -933,9 +942,11 @@ func (d *Driver) createContainerConfig(task *drivers.TaskConfig, driverConfig *T
 			hostPortStr := strconv.Itoa(port.Value)
 			containerPort := docker.Port(strconv.Itoa(containerPortInt))
 
-			publishedPorts[containerPort+"/tcp"] = getPortBinding(network.IP, hostPortStr)
-			publishedPorts[containerPort+"/udp"] = getPortBinding(network.IP, hostPortStr)
-			logger.Debug("allocated static port", "ip", network.IP, "port", port.Value)
+			// Ports allocated on a host network are bound on its IP
+			hostIP := network.PortIP(port)
+			publishedPorts[containerPort+"/tcp"] = getPortBinding(hostIP, hostPortStr)
+			publishedPorts[containerPort+"/udp"] = getPortBinding(hostIP, hostPortStr)
+			logger.Debug("allocated static port", "ip", hostIP, "port", port.Value)
 
 			exposedPorts[containerPort+"/tcp"] = struct{}{}
 			exposedPorts[containerPort+"/udp"] = struct{}{}
@@ -954,9 +965,11 @@ func (d *Driver) createContainerConfig(task *drivers.TaskConfig, driverConfig *T
 			hostPortStr := strconv.Itoa(port.Value)
 			containerPort := docker.Port(strconv.Itoa(containerPortInt))
 
-			publishedPorts[containerPort+"/tcp"] = getPortBinding(network.IP, hostPortStr)
-			publishedPorts[containerPort+"/udp"] = getPortBinding(network.IP, hostPortStr)
-			logger.Debug("allocated mapped port", "ip", network.IP, "port", port.Value)
+			// Ports allocated on a host network are bound on its IP
+			hostIP := network.PortIP(port)
+			publishedPorts[containerPort+"/tcp"] = getPortBinding(hostIP, hostPortStr)
+			publishedPorts[containerPort+"/udp"] = getPortBinding(hostIP, hostPortStr)
+			logger.Debug("allocated mapped port", "ip", hostIP, "port", port.Value)
 
 			exposedPorts[containerPort+"/tcp"] = struct{}{}
 			exposedPorts[containerPort+"/udp"] = struct{}{}
@@ -1038,6 +1051,45 @@ func (d *Driver) createContainerConfig(task *drivers.TaskConfig, driverConfig *T
 	}, nil
 }
 
+// setupDNS applies the task's DNS configuration to the container. Docker
+// rejects DNS options for containers joining another container's network, so
+// tasks sharing the group's network namespace have a generated resolv.conf
+// mounted instead.
+func (d *Driver) setupDNS(task *drivers.TaskConfig, driverConfig *TaskConfig, hostConfig *docker.HostConfig) error {
+	dns := task.DNS.Copy()
+	if len(driverConfig.DNSServers) > 0 {
+		dns.Servers = hostConfig.DNS
+	}
+	if len(driverConfig.DNSSearchDomains) > 0 {
+		dns.Searches = driverConfig.DNSSearchDomains
+	}
+	if len(driverConfig.DNSOptions) > 0 {
+		dns.Options = driverConfig.DNSOptions
+	}
+
+	if !strings.HasPrefix(hostConfig.NetworkMode, "container:") {
+		hostConfig.DNS = dns.Servers
+		hostConfig.DNSSearch = dns.Searches
+		hostConfig.DNSOptions = dns.Options
+		return nil
+	}
+
+	dnsMount, err := resolvconf.GenerateDNSMount(task.TaskDir().Dir, dns)
+	if err != nil {
+		return fmt.Errorf("failed to build mount for resolv.conf: %v", err)
+	}
+	hostConfig.DNS = nil
+	hostConfig.DNSSearch = nil
+	hostConfig.DNSOptions = nil
+	hostConfig.Mounts = append(hostConfig.Mounts, docker.HostMount{
+		Type:     "bind",
+		Target:   dnsMount.TaskPath,
+		Source:   dnsMount.HostPath,
+		ReadOnly: dnsMount.Readonly,
+	})
+	return nil
+}
+
 // detectIP of Docker container. Returns the first IP found as well as true if
 // the IP should be advertised (bridge network IPs return false). Returns an
 // empty string and false if no IP could be found.
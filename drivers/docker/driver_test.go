@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"math/rand"
+	"os"
 	"path/filepath"
 	"reflect"
 	"runtime"
@@ -1012,6 +1013,62 @@ func TestDockerDriver_CreateContainerConfig(t *testing.T) {
 	require.Equal(t, containerName, c.Name)
 }
 
+func TestDockerDriver_CreateContainerConfig_DNS(t *testing.T) {
+	t.Parallel()
+
+	task, cfg, _ := dockerTask(t)
+	cfg.DNSSearchDomains = []string{"example.com"}
+	require.NoError(t, task.EncodeConcreteDriverConfig(cfg))
+
+	task.DNS = &drivers.DNSConfig{
+		Servers:  []string{"10.0.0.1"},
+		Searches: []string{"service.consul"},
+		Options:  []string{"ndots:2"},
+	}
+
+	dh := dockerDriverHarness(t, nil)
+	driver := dh.Impl().(*Driver)
+
+	// Without a shared network the DNS options are set on the container and
+	// options from the driver config take precedence
+	c, err := driver.createContainerConfig(task, cfg, "org/repo:0.1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"10.0.0.1"}, c.HostConfig.DNS)
+	require.Equal(t, []string{"example.com"}, c.HostConfig.DNSSearch)
+	require.Equal(t, []string{"ndots:2"}, c.HostConfig.DNSOptions)
+
+	// When joining the group's network a resolv.conf is mounted instead
+	task.AllocDir, err = ioutil.TempDir("", "nomad-docker-dns")
+	require.NoError(t, err)
+	defer os.RemoveAll(task.AllocDir)
+	require.NoError(t, os.MkdirAll(task.TaskDir().Dir, 0755))
+
+	task.NetworkIsolation = &drivers.NetworkIsolationSpec{
+		Mode:   drivers.NetIsolationModeGroup,
+		Path:   "/var/run/docker/netns/abc",
+		Labels: map[string]string{dockerNetSpecLabelKey: "abc"},
+	}
+
+	c, err = driver.createContainerConfig(task, cfg, "org/repo:0.1")
+	require.NoError(t, err)
+	require.Equal(t, "container:abc", c.HostConfig.NetworkMode)
+	require.Empty(t, c.HostConfig.DNS)
+	require.Empty(t, c.HostConfig.DNSSearch)
+	require.Empty(t, c.HostConfig.DNSOptions)
+
+	resolvConf := filepath.Join(task.TaskDir().Dir, "resolv.conf")
+	require.Contains(t, c.HostConfig.Mounts, docker.HostMount{
+		Type:     "bind",
+		Target:   "/etc/resolv.conf",
+		Source:   resolvConf,
+		ReadOnly: true,
+	})
+
+	contents, err := ioutil.ReadFile(resolvConf)
+	require.NoError(t, err)
+	require.Equal(t, "nameserver 10.0.0.1\nsearch example.com\noptions ndots:2\n", string(contents))
+}
+
 func TestDockerDriver_CreateContainerConfig_User(t *testing.T) {
 	t.Parallel()
 
@@ -1530,6 +1587,39 @@ func TestDockerDriver_CreateContainerConfig_PortsMapping(t *testing.T) {
 
 }
 
+// TestDockerDriver_CreateContainerConfig_HostNetworkPorts asserts that ports
+// allocated on a host network are bound on the host network's IP.
+func TestDockerDriver_CreateContainerConfig_HostNetworkPorts(t *testing.T) {
+	t.Parallel()
+
+	task, cfg, port := dockerTask(t)
+	res := port[0]
+	dyn := port[1]
+	network := task.Resources.NomadResources.Networks[0]
+	network.DynamicPorts[0].HostNetwork = "public"
+	network.DynamicPorts[0].HostIP = "10.0.0.5"
+
+	dh := dockerDriverHarness(t, nil)
+	driver := dh.Impl().(*Driver)
+
+	c, err := driver.createContainerConfig(task, cfg, "org/repo:0.1")
+	require.NoError(t, err)
+
+	hostIP := "127.0.0.1"
+	publicIP := "10.0.0.5"
+	if runtime.GOOS == "windows" {
+		hostIP = ""
+		publicIP = ""
+	}
+	expectedPortBindings := map[docker.Port][]docker.PortBinding{
+		docker.Port(fmt.Sprintf("%d/tcp", res)): {{HostIP: hostIP, HostPort: fmt.Sprintf("%d", res)}},
+		docker.Port(fmt.Sprintf("%d/udp", res)): {{HostIP: hostIP, HostPort: fmt.Sprintf("%d", res)}},
+		docker.Port(fmt.Sprintf("%d/tcp", dyn)): {{HostIP: publicIP, HostPort: fmt.Sprintf("%d", dyn)}},
+		docker.Port(fmt.Sprintf("%d/udp", dyn)): {{HostIP: publicIP, HostPort: fmt.Sprintf("%d", dyn)}},
+	}
+	require.Exactly(t, expectedPortBindings, c.HostConfig.PortBindings)
+}
+
 func TestDockerDriver_CleanupContainer(t *testing.T) {
 	if !tu.IsCI() {
 		t.Parallel()
@@ -33,6 +33,21 @@ func TestDriver_DockerStatsCollector(t *testing.T) {
 	stats.MemoryStats.CommitPeak = 321323
 	stats.MemoryStats.PrivateWorkingSet = 62222
 
+	stats.Networks = map[string]docker.NetworkStats{
+		"eth0": {RxBytes: 100, TxBytes: 200, RxPackets: 1, TxPackets: 2},
+		"eth1": {RxBytes: 10, TxBytes: 20, RxPackets: 3, TxPackets: 4},
+	}
+	stats.BlkioStats.IOServiceBytesRecursive = []docker.BlkioStatsEntry{
+		{Op: "Read", Value: 4096},
+		{Op: "Write", Value: 8192},
+		{Op: "Total", Value: 12288},
+	}
+	stats.BlkioStats.IOServicedRecursive = []docker.BlkioStatsEntry{
+		{Op: "Read", Value: 1},
+		{Op: "Write", Value: 2},
+		{Op: "Total", Value: 3},
+	}
+
 	go dockerStatsCollector(dst, src, time.Second)
 
 	select {
@@ -51,6 +66,10 @@ func TestDriver_DockerStatsCollector(t *testing.T) {
 			require.Equal(stats.MemoryStats.MaxUsage, ru.ResourceUsage.MemoryStats.MaxUsage)
 			require.Equal(stats.CPUStats.ThrottlingData.ThrottledPeriods, ru.ResourceUsage.CpuStats.ThrottledPeriods)
 			require.Equal(stats.CPUStats.ThrottlingData.ThrottledTime, ru.ResourceUsage.CpuStats.ThrottledTime)
+			require.EqualValues(4096, ru.ResourceUsage.BlockIOStats.ReadBytes)
+			require.EqualValues(8192, ru.ResourceUsage.BlockIOStats.WriteBytes)
+			require.EqualValues(1, ru.ResourceUsage.BlockIOStats.ReadOps)
+			require.EqualValues(2, ru.ResourceUsage.BlockIOStats.WriteOps)
 		} else {
 			require.Equal(stats.MemoryStats.PrivateWorkingSet, ru.ResourceUsage.MemoryStats.RSS)
 			require.Equal(stats.MemoryStats.Commit, ru.ResourceUsage.MemoryStats.Usage)
@@ -59,6 +78,10 @@ func TestDriver_DockerStatsCollector(t *testing.T) {
 			require.Equal(stats.CPUStats.ThrottlingData.ThrottledTime, ru.ResourceUsage.CpuStats.ThrottledTime)
 
 		}
+		require.EqualValues(110, ru.ResourceUsage.NetworkStats.RxBytes)
+		require.EqualValues(220, ru.ResourceUsage.NetworkStats.TxBytes)
+		require.EqualValues(4, ru.ResourceUsage.NetworkStats.RxPackets)
+		require.EqualValues(6, ru.ResourceUsage.NetworkStats.TxPackets)
 	case <-time.After(time.Second):
 		require.Fail("receiving stats should not block here")
 	}
@@ -4,6 +4,7 @@ package util
 
 import (
 	"runtime"
+	"strings"
 
 	docker "github.com/fsouza/go-dockerclient"
 	cstructs "github.com/hashicorp/nomad/client/structs"
@@ -13,6 +14,8 @@ import (
 var (
 	DockerMeasuredCPUStats = []string{"Throttled Periods", "Throttled Time", "Percent"}
 	DockerMeasuredMemStats = []string{"RSS", "Cache", "Swap", "Usage", "Max Usage"}
+
+	DockerMeasuredBlockIOStats = []string{"Read Bytes", "Write Bytes", "Read Ops", "Write Ops"}
 )
 
 func DockerStatsToTaskResourceUsage(s *docker.Stats) *cstructs.TaskResourceUsage {
@@ -43,10 +46,32 @@ func DockerStatsToTaskResourceUsage(s *docker.Stats) *cstructs.TaskResourceUsage
 		s.CPUStats.CPUUsage.TotalUsage, s.PreCPUStats.CPUUsage.TotalUsage, runtime.NumCPU())
 	cs.TotalTicks = (cs.Percent / 100) * stats.TotalTicksAvailable() / float64(runtime.NumCPU())
 
+	bs := &cstructs.BlockIOStats{
+		Measured: DockerMeasuredBlockIOStats,
+	}
+	for _, entry := range s.BlkioStats.IOServiceBytesRecursive {
+		switch strings.ToLower(entry.Op) {
+		case "read":
+			bs.ReadBytes += entry.Value
+		case "write":
+			bs.WriteBytes += entry.Value
+		}
+	}
+	for _, entry := range s.BlkioStats.IOServicedRecursive {
+		switch strings.ToLower(entry.Op) {
+		case "read":
+			bs.ReadOps += entry.Value
+		case "write":
+			bs.WriteOps += entry.Value
+		}
+	}
+
 	return &cstructs.TaskResourceUsage{
 		ResourceUsage: &cstructs.ResourceUsage{
-			MemoryStats: ms,
-			CpuStats:    cs,
+			MemoryStats:  ms,
+			CpuStats:     cs,
+			NetworkStats: DockerNetworkStats(s),
+			BlockIOStats: bs,
 		},
 		Timestamp: s.Read.UTC().UnixNano(),
 	}
@@ -48,8 +48,9 @@ func DockerStatsToTaskResourceUsage(s *docker.Stats) *cstructs.TaskResourceUsage
 
 	return &cstructs.TaskResourceUsage{
 		ResourceUsage: &cstructs.ResourceUsage{
-			MemoryStats: ms,
-			CpuStats:    cs,
+			MemoryStats:  ms,
+			CpuStats:     cs,
+			NetworkStats: DockerNetworkStats(s),
 		},
 		Timestamp: s.Read.UTC().UnixNano(),
 	}
@@ -1,5 +1,16 @@
 package util
 
+import (
+	docker "github.com/fsouza/go-dockerclient"
+	cstructs "github.com/hashicorp/nomad/client/structs"
+)
+
+var (
+	// DockerMeasuredNetworkStats is the list of network stats the Docker
+	// driver exposes
+	DockerMeasuredNetworkStats = []string{"Rx Bytes", "Tx Bytes", "Rx Packets", "Tx Packets"}
+)
+
 func CalculateCPUPercent(newSample, oldSample, newTotal, oldTotal uint64, cores int) float64 {
 	numerator := newSample - oldSample
 	denom := newTotal - oldTotal
@@ -9,3 +20,24 @@ func CalculateCPUPercent(newSample, oldSample, newTotal, oldTotal uint64, cores
 
 	return (float64(numerator) / float64(denom)) * float64(cores) * 100.0
 }
+
+// DockerNetworkStats sums the stats of every network interface attached to
+// the container. Nil is returned if the container has no interfaces of its
+// own, such as when it joins another network namespace.
+func DockerNetworkStats(s *docker.Stats) *cstructs.NetworkStats {
+	if len(s.Networks) == 0 {
+		return nil
+	}
+
+	ns := &cstructs.NetworkStats{
+		Measured: DockerMeasuredNetworkStats,
+	}
+	for _, n := range s.Networks {
+		ns.RxBytes += n.RxBytes
+		ns.TxBytes += n.TxBytes
+		ns.RxPackets += n.RxPackets
+		ns.TxPackets += n.TxPackets
+	}
+
+	return ns
+}
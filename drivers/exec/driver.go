@@ -12,8 +12,10 @@ import (
 	"github.com/hashicorp/consul-template/signals"
 	hclog "github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/nomad/client/fingerprint"
+	linuxcaps "github.com/hashicorp/nomad/drivers/shared/capabilities"
 	"github.com/hashicorp/nomad/drivers/shared/eventer"
 	"github.com/hashicorp/nomad/drivers/shared/executor"
+	"github.com/hashicorp/nomad/drivers/shared/resolvconf"
 	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/helper/pluginutils/loader"
 	"github.com/hashicorp/nomad/plugins/base"
@@ -59,13 +61,25 @@ var (
 	}
 
 	// configSpec is the hcl specification returned by the ConfigSchema RPC
-	configSpec = hclspec.NewObject(map[string]*hclspec.Spec{})
+	configSpec = hclspec.NewObject(map[string]*hclspec.Spec{
+		"pids_limit": hclspec.NewDefault(
+			hclspec.NewAttr("pids_limit", "number", false),
+			hclspec.NewLiteral("0"),
+		),
+		"allow_caps": hclspec.NewDefault(
+			hclspec.NewAttr("allow_caps", "list(string)", false),
+			hclspec.NewLiteral(`["ALL"]`),
+		),
+	})
 
 	// taskConfigSpec is the hcl specification for the driver config section of
 	// a task within a job. It is returned in the TaskConfigSchema RPC
 	taskConfigSpec = hclspec.NewObject(map[string]*hclspec.Spec{
-		"command": hclspec.NewAttr("command", "string", true),
-		"args":    hclspec.NewAttr("args", "list(string)", false),
+		"command":    hclspec.NewAttr("command", "string", true),
+		"args":       hclspec.NewAttr("args", "list(string)", false),
+		"pids_limit": hclspec.NewAttr("pids_limit", "number", false),
+		"cap_add":    hclspec.NewAttr("cap_add", "list(string)", false),
+		"cap_drop":   hclspec.NewAttr("cap_drop", "list(string)", false),
 	})
 
 	// capabilities is returned by the Capabilities RPC and indicates what
@@ -88,6 +102,9 @@ type Driver struct {
 	// event can be broadcast to all callers
 	eventer *eventer.Eventer
 
+	// config is the driver configuration set by the SetConfig RPC
+	config *Config
+
 	// nomadConfig is the client config from nomad
 	nomadConfig *base.ClientDriverConfig
 
@@ -111,10 +128,23 @@ type Driver struct {
 	fingerprintLock    sync.Mutex
 }
 
+// Config is the driver configuration set by the SetConfig RPC call
+type Config struct {
+	// PidsLimit is the default maximum number of processes for tasks that do
+	// not set their own pids_limit. Zero means no limit.
+	PidsLimit int64 `codec:"pids_limit"`
+
+	// AllowCaps is the list of Linux capabilities tasks may be granted
+	AllowCaps []string `codec:"allow_caps"`
+}
+
 // TaskConfig is the driver configuration of a task within a job
 type TaskConfig struct {
-	Command string   `codec:"command"`
-	Args    []string `codec:"args"`
+	Command   string   `codec:"command"`
+	Args      []string `codec:"args"`
+	PidsLimit int64    `codec:"pids_limit"`
+	CapAdd    []string `codec:"cap_add"`
+	CapDrop   []string `codec:"cap_drop"`
 }
 
 // TaskState is the state which is encoded in the handle returned in
@@ -133,6 +163,7 @@ func NewExecDriver(logger hclog.Logger) drivers.DriverPlugin {
 	logger = logger.Named(pluginName)
 	return &Driver{
 		eventer:        eventer.NewEventer(ctx, logger),
+		config:         &Config{},
 		tasks:          newTaskStore(),
 		ctx:            ctx,
 		signalShutdown: cancel,
@@ -171,6 +202,14 @@ func (d *Driver) ConfigSchema() (*hclspec.Spec, error) {
 }
 
 func (d *Driver) SetConfig(cfg *base.Config) error {
+	var config Config
+	if cfg != nil && len(cfg.PluginConfig) != 0 {
+		if err := base.MsgPackDecode(cfg.PluginConfig, &config); err != nil {
+			return err
+		}
+	}
+
+	d.config = &config
 	if cfg != nil && cfg.AgentConfig != nil {
 		d.nomadConfig = cfg.AgentConfig.Driver
 	}
@@ -324,6 +363,30 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 	}
 
 	d.logger.Info("starting task", "driver_cfg", hclog.Fmt("%+v", driverConfig))
+
+	if driverConfig.PidsLimit < 0 {
+		return nil, nil, fmt.Errorf("pids_limit must not be negative: %d", driverConfig.PidsLimit)
+	}
+	pidsLimit := driverConfig.PidsLimit
+	if pidsLimit == 0 {
+		pidsLimit = d.config.PidsLimit
+	}
+
+	caps, err := linuxcaps.Calculate(d.config.AllowCaps, driverConfig.CapAdd, driverConfig.CapDrop)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Mount a resolv.conf generated from the group's DNS configuration
+	mounts := cfg.Mounts
+	if cfg.DNS != nil {
+		dnsMount, err := resolvconf.GenerateDNSMount(cfg.TaskDir().Dir, cfg.DNS)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to build mount for resolv.conf: %v", err)
+		}
+		mounts = append(append([]*drivers.MountConfig{}, mounts...), dnsMount)
+	}
+
 	handle := drivers.NewTaskHandle(taskHandleVersion)
 	handle.Config = cfg
 
@@ -356,9 +419,11 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		TaskDir:          cfg.TaskDir().Dir,
 		StdoutPath:       cfg.StdoutPath,
 		StderrPath:       cfg.StderrPath,
-		Mounts:           cfg.Mounts,
+		Mounts:           mounts,
 		Devices:          cfg.Devices,
 		NetworkIsolation: cfg.NetworkIsolation,
+		PidsLimit:        pidsLimit,
+		Capabilities:     caps,
 	}
 
 	ps, err := exec.Launch(execCmd)
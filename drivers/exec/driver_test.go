@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/nomad/helper/testtask"
 	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/base"
 	"github.com/hashicorp/nomad/plugins/drivers"
 	dtestutil "github.com/hashicorp/nomad/plugins/drivers/testutils"
 	"github.com/hashicorp/nomad/testutil"
@@ -91,6 +92,21 @@ func TestExecDriver_Fingerprint(t *testing.T) {
 	}
 }
 
+// TestExecDriver_SetConfig asserts that a missing plugin config leaves the
+// driver with its defaults
+func TestExecDriver_SetConfig(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	d := NewExecDriver(testlog.HCLogger(t)).(*Driver)
+	require.NoError(d.SetConfig(nil))
+	require.NotNil(d.config)
+	require.Zero(d.config.PidsLimit)
+
+	require.NoError(d.SetConfig(&base.Config{}))
+	require.NotNil(d.config)
+}
+
 func TestExecDriver_StartWait(t *testing.T) {
 	t.Parallel()
 	require := require.New(t)
@@ -659,11 +675,17 @@ func TestConfig_ParseAllHCL(t *testing.T) {
 config {
   command = "/bin/bash"
   args = ["-c", "echo hello"]
+  pids_limit = 100
+  cap_add = ["net_bind_service"]
+  cap_drop = ["all"]
 }`
 
 	expected := &TaskConfig{
-		Command: "/bin/bash",
-		Args:    []string{"-c", "echo hello"},
+		Command:   "/bin/bash",
+		Args:      []string{"-c", "echo hello"},
+		PidsLimit: 100,
+		CapAdd:    []string{"net_bind_service"},
+		CapDrop:   []string{"all"},
 	}
 
 	var tc *TaskConfig
@@ -330,6 +330,9 @@ config {
   jar_path = "/tmp/jar.jar"
   jvm_options = ["-Xmx600"]
   args = ["arg1", "arg2"]
+  pids_limit = 100
+  cap_add = ["net_bind_service"]
+  cap_drop = ["all"]
 }`
 
 	expected := &TaskConfig{
@@ -338,6 +341,9 @@ config {
 		JarPath:   "/tmp/jar.jar",
 		JvmOpts:   []string{"-Xmx600"},
 		Args:      []string{"arg1", "arg2"},
+		PidsLimit: 100,
+		CapAdd:    []string{"net_bind_service"},
+		CapDrop:   []string{"all"},
 	}
 
 	var tc *TaskConfig
@@ -0,0 +1,112 @@
+package capabilities
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/syndtr/gocapability/capability"
+)
+
+const (
+	// All is the keyword used in allow_caps, cap_add and cap_drop to refer to
+	// every capability supported by the kernel
+	All = "ALL"
+)
+
+// Supported returns a list of all capabilities supported by the kernel
+func Supported() []string {
+	allCaps := []string{}
+	last := capability.CAP_LAST_CAP
+	// workaround for RHEL6 which has no /proc/sys/kernel/cap_last_cap
+	if last == capability.Cap(63) {
+		last = capability.CAP_BLOCK_SUSPEND
+	}
+	for _, cap := range capability.List() {
+		if cap > last {
+			continue
+		}
+		allCaps = append(allCaps, fmt.Sprintf("CAP_%s", strings.ToUpper(cap.String())))
+	}
+	return allCaps
+}
+
+// Normalize returns the canonical form of a capability name, so that
+// "net_raw", "NET_RAW" and "CAP_NET_RAW" all refer to CAP_NET_RAW.
+func Normalize(name string) string {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	if name == All || strings.HasPrefix(name, "CAP_") {
+		return name
+	}
+	return "CAP_" + name
+}
+
+// Calculate returns the capabilities granted to a task. The task starts with
+// every capability in allowCaps, capDrop is then removed and capAdd added
+// back. Capabilities in capAdd must be permitted by allowCaps, and a nil
+// allowCaps permits every capability. The returned list preserves the kernel
+// ordering of capabilities.
+func Calculate(allowCaps, capAdd, capDrop []string) ([]string, error) {
+	supported := Supported()
+	if allowCaps == nil {
+		allowCaps = []string{All}
+	}
+
+	allowed, err := expand(supported, allowCaps)
+	if err != nil {
+		return nil, fmt.Errorf("invalid allow_caps: %v", err)
+	}
+	add, err := expand(supported, capAdd)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cap_add: %v", err)
+	}
+	drop, err := expand(supported, capDrop)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cap_drop: %v", err)
+	}
+
+	granted := make(map[string]struct{}, len(allowed))
+	for c := range allowed {
+		if _, ok := drop[c]; !ok {
+			granted[c] = struct{}{}
+		}
+	}
+	for c := range add {
+		if _, ok := allowed[c]; !ok {
+			return nil, fmt.Errorf("cap_add capability %q is not permitted by the driver's allow_caps", c)
+		}
+		granted[c] = struct{}{}
+	}
+
+	caps := []string{}
+	for _, c := range supported {
+		if _, ok := granted[c]; ok {
+			caps = append(caps, c)
+		}
+	}
+	return caps, nil
+}
+
+// expand normalizes the given capability names into a set, replacing the All
+// keyword with every supported capability.
+func expand(supported, names []string) (map[string]struct{}, error) {
+	known := make(map[string]struct{}, len(supported))
+	for _, c := range supported {
+		known[c] = struct{}{}
+	}
+
+	set := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		c := Normalize(name)
+		if c == All {
+			for _, s := range supported {
+				set[s] = struct{}{}
+			}
+			continue
+		}
+		if _, ok := known[c]; !ok {
+			return nil, fmt.Errorf("unknown capability %q", name)
+		}
+		set[c] = struct{}{}
+	}
+	return set, nil
+}
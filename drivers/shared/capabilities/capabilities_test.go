@@ -0,0 +1,86 @@
+package capabilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	require.Equal(t, "CAP_NET_RAW", Normalize("net_raw"))
+	require.Equal(t, "CAP_NET_RAW", Normalize("NET_RAW"))
+	require.Equal(t, "CAP_NET_RAW", Normalize("cap_net_raw"))
+	require.Equal(t, All, Normalize("all"))
+}
+
+func TestCalculate(t *testing.T) {
+	cases := []struct {
+		name     string
+		allow    []string
+		add      []string
+		drop     []string
+		expected []string
+		err      string
+	}{
+		{
+			name:     "all allowed",
+			allow:    []string{"ALL"},
+			expected: Supported(),
+		},
+		{
+			name:     "unset allowlist",
+			drop:     []string{"ALL"},
+			add:      []string{"sys_admin"},
+			expected: []string{"CAP_SYS_ADMIN"},
+		},
+		{
+			name:     "allowlist",
+			allow:    []string{"net_bind_service", "CAP_CHOWN"},
+			expected: []string{"CAP_CHOWN", "CAP_NET_BIND_SERVICE"},
+		},
+		{
+			name:     "drop",
+			allow:    []string{"chown", "kill", "net_raw"},
+			drop:     []string{"net_raw"},
+			expected: []string{"CAP_CHOWN", "CAP_KILL"},
+		},
+		{
+			name:     "drop all and add",
+			allow:    []string{"ALL"},
+			add:      []string{"net_bind_service"},
+			drop:     []string{"all"},
+			expected: []string{"CAP_NET_BIND_SERVICE"},
+		},
+		{
+			name:     "drop all",
+			allow:    []string{"ALL"},
+			drop:     []string{"ALL"},
+			expected: []string{},
+		},
+		{
+			name:  "add not allowed",
+			allow: []string{"chown"},
+			add:   []string{"sys_admin"},
+			err:   `cap_add capability "CAP_SYS_ADMIN" is not permitted`,
+		},
+		{
+			name:  "unknown",
+			allow: []string{"ALL"},
+			drop:  []string{"bogus"},
+			err:   `invalid cap_drop: unknown capability "bogus"`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			caps, err := Calculate(c.allow, c.add, c.drop)
+			if c.err != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), c.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.expected, caps)
+		})
+	}
+}
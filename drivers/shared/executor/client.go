@@ -44,6 +44,10 @@ func (c *grpcExecutorClient) Launch(cmd *ExecCommand) (*ProcessState, error) {
 		Mounts:             drivers.MountsToProto(cmd.Mounts),
 		Devices:            drivers.DevicesToProto(cmd.Devices),
 		NetworkIsolation:   drivers.NetworkIsolationSpecToProto(cmd.NetworkIsolation),
+		PidsLimit:          cmd.PidsLimit,
+	}
+	if cmd.Capabilities != nil {
+		req.Capabilities = &proto.Capabilities{Names: cmd.Capabilities}
 	}
 	resp, err := c.client.Launch(ctx, req)
 	if err != nil {
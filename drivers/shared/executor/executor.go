@@ -128,6 +128,16 @@ type ExecCommand struct {
 	Devices []*drivers.DeviceConfig
 
 	NetworkIsolation *drivers.NetworkIsolationSpec
+
+	// PidsLimit is the maximum number of processes the task may run. It is
+	// applied to the task's pids cgroup by the isolating executor when
+	// ResourceLimits is set and ignored by the universal executor. Zero means
+	// no limit.
+	PidsLimit int64
+
+	// Capabilities is the set of Linux capabilities granted to the task. A
+	// nil value grants every capability supported by the kernel.
+	Capabilities []string
 }
 
 // SetWriters sets the writer for the process stdout and stderr. This should
@@ -3,6 +3,7 @@
 package executor
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"io"
@@ -10,6 +11,7 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -21,6 +23,7 @@ import (
 	"github.com/hashicorp/nomad/client/allocdir"
 	"github.com/hashicorp/nomad/client/stats"
 	cstructs "github.com/hashicorp/nomad/client/structs"
+	"github.com/hashicorp/nomad/drivers/shared/capabilities"
 	shelpers "github.com/hashicorp/nomad/helper/stats"
 	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/hashicorp/nomad/nomad/structs"
@@ -31,7 +34,6 @@ import (
 	lconfigs "github.com/opencontainers/runc/libcontainer/configs"
 	ldevices "github.com/opencontainers/runc/libcontainer/devices"
 	lutils "github.com/opencontainers/runc/libcontainer/utils"
-	"github.com/syndtr/gocapability/capability"
 	"golang.org/x/sys/unix"
 )
 
@@ -45,6 +47,13 @@ var (
 
 	// ExecutorCgroupMeasuredCpuStats is the list of CPU stats captures by the executor
 	ExecutorCgroupMeasuredCpuStats = []string{"System Mode", "User Mode", "Throttled Periods", "Throttled Time", "Percent"}
+
+	// ExecutorCgroupMeasuredBlockIOStats is the list of block io stats captured by the executor
+	ExecutorCgroupMeasuredBlockIOStats = []string{"Read Bytes", "Write Bytes", "Read Ops", "Write Ops"}
+
+	// ExecutorMeasuredNetworkStats is the list of network stats captured by
+	// the executor when the task runs in an isolated network namespace
+	ExecutorMeasuredNetworkStats = []string{"Rx Bytes", "Tx Bytes", "Rx Packets", "Tx Packets"}
 )
 
 // LibcontainerExecutor implements an Executor with the runc/libcontainer api
@@ -424,10 +433,26 @@ func (l *LibcontainerExecutor) handleStats(ch chan *cstructs.TaskResourceUsage,
 			TotalTicks:       l.systemCpuStats.TicksConsumed(totalPercent),
 			Measured:         ExecutorCgroupMeasuredCpuStats,
 		}
+		// Block IO Related Stats
+		bs := blockIOStats(&stats.BlkioStats)
+
+		// Network Related Stats are only meaningful when the task has its
+		// own network namespace as otherwise the host's interfaces would be
+		// reported
+		var ns *cstructs.NetworkStats
+		if l.command.NetworkIsolation != nil {
+			ns, err = procNetworkStats(l.userProc)
+			if err != nil {
+				l.logger.Debug("error collecting network stats", "error", err)
+			}
+		}
+
 		taskResUsage := cstructs.TaskResourceUsage{
 			ResourceUsage: &cstructs.ResourceUsage{
-				MemoryStats: ms,
-				CpuStats:    cs,
+				MemoryStats:  ms,
+				CpuStats:     cs,
+				BlockIOStats: bs,
+				NetworkStats: ns,
 			},
 			Timestamp: ts.UTC().UnixNano(),
 			Pids:      pidStats,
@@ -442,6 +467,100 @@ func (l *LibcontainerExecutor) handleStats(ch chan *cstructs.TaskResourceUsage,
 	}
 }
 
+// blockIOStats sums the read and write entries of the blkio cgroup stats
+// across all block devices.
+func blockIOStats(stats *cgroups.BlkioStats) *cstructs.BlockIOStats {
+	bs := &cstructs.BlockIOStats{
+		Measured: ExecutorCgroupMeasuredBlockIOStats,
+	}
+
+	for _, entry := range stats.IoServiceBytesRecursive {
+		switch strings.ToLower(entry.Op) {
+		case "read":
+			bs.ReadBytes += entry.Value
+		case "write":
+			bs.WriteBytes += entry.Value
+		}
+	}
+
+	for _, entry := range stats.IoServicedRecursive {
+		switch strings.ToLower(entry.Op) {
+		case "read":
+			bs.ReadOps += entry.Value
+		case "write":
+			bs.WriteOps += entry.Value
+		}
+	}
+
+	return bs
+}
+
+// procNetworkStats returns the network stats of the network namespace the
+// process is running in.
+func procNetworkStats(proc *libcontainer.Process) (*cstructs.NetworkStats, error) {
+	pid, err := proc.Pid()
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(fmt.Sprintf("/proc/%d/net/dev", pid))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return parseNetDev(f)
+}
+
+// parseNetDev sums the counters of every non-loopback interface in the
+// /proc/<pid>/net/dev formatted input.
+func parseNetDev(r io.Reader) (*cstructs.NetworkStats, error) {
+	ns := &cstructs.NetworkStats{
+		Measured: ExecutorMeasuredNetworkStats,
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		idx := strings.Index(line, ":")
+		if idx < 0 {
+			// Header lines do not contain an interface name
+			continue
+		}
+
+		if strings.TrimSpace(line[:idx]) == "lo" {
+			continue
+		}
+
+		// Receive fields are followed by transmit fields:
+		// bytes packets errs drop fifo frame compressed multicast
+		fields := strings.Fields(line[idx+1:])
+		if len(fields) < 10 {
+			return nil, fmt.Errorf("unexpected net/dev format: %q", line)
+		}
+
+		counters := make([]uint64, 0, 4)
+		for _, i := range []int{0, 1, 8, 9} {
+			v, err := strconv.ParseUint(fields[i], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse net/dev counter %q: %v", fields[i], err)
+			}
+			counters = append(counters, v)
+		}
+
+		ns.RxBytes += counters[0]
+		ns.RxPackets += counters[1]
+		ns.TxBytes += counters[2]
+		ns.TxPackets += counters[3]
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return ns, nil
+}
+
 // Signal sends a signal to the process managed by the executor
 func (l *LibcontainerExecutor) Signal(s os.Signal) error {
 	return l.userProc.Signal(s)
@@ -550,45 +669,31 @@ func (l *LibcontainerExecutor) handleExecWait(ch chan *waitResult, process *libc
 }
 
 func configureCapabilities(cfg *lconfigs.Config, command *ExecCommand) error {
-	// TODO: allow better control of these
-	// use capabilities list as prior to adopting libcontainer in 0.9
-	allCaps := supportedCaps()
+	// use capabilities list as prior to adopting libcontainer in 0.9 unless
+	// the driver restricted them
+	caps := command.Capabilities
+	if caps == nil {
+		caps = capabilities.Supported()
+	}
 
 	// match capabilities used in Nomad 0.8
 	if command.User == "root" {
 		cfg.Capabilities = &lconfigs.Capabilities{
-			Bounding:    allCaps,
-			Permitted:   allCaps,
-			Effective:   allCaps,
+			Bounding:    caps,
+			Permitted:   caps,
+			Effective:   caps,
 			Ambient:     nil,
 			Inheritable: nil,
 		}
 	} else {
 		cfg.Capabilities = &lconfigs.Capabilities{
-			Bounding: allCaps,
+			Bounding: caps,
 		}
 	}
 
 	return nil
 }
 
-// supportedCaps returns a list of all supported capabilities in kernel
-func supportedCaps() []string {
-	allCaps := []string{}
-	last := capability.CAP_LAST_CAP
-	// workaround for RHEL6 which has no /proc/sys/kernel/cap_last_cap
-	if last == capability.Cap(63) {
-		last = capability.CAP_BLOCK_SUSPEND
-	}
-	for _, cap := range capability.List() {
-		if cap > last {
-			continue
-		}
-		allCaps = append(allCaps, fmt.Sprintf("CAP_%s", strings.ToUpper(cap.String())))
-	}
-	return allCaps
-}
-
 // configureIsolation prepares the isolation primitives of the container.
 // The process runs in a container configured with the following:
 //
@@ -693,6 +798,11 @@ func configureCgroups(cfg *lconfigs.Config, command *ExecCommand) error {
 	id := uuid.Generate()
 	cfg.Cgroups.Path = filepath.Join("/", defaultCgroupParent, id)
 
+	// Limit the number of processes to protect the host from fork bombs
+	if command.PidsLimit > 0 {
+		cfg.Cgroups.Resources.PidsLimit = command.PidsLimit
+	}
+
 	if command.Resources == nil || command.Resources.NomadResources == nil {
 		return nil
 	}
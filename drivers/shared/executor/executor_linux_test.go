@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/plugins/drivers"
 	tu "github.com/hashicorp/nomad/testutil"
+	"github.com/opencontainers/runc/libcontainer/cgroups"
 	lconfigs "github.com/opencontainers/runc/libcontainer/configs"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/sys/unix"
@@ -164,6 +165,36 @@ passwd`
 	}, func(err error) { t.Error(err) })
 }
 
+// TestExecutor_PidsLimit asserts that the pids limit is applied to the task's
+// cgroup
+func TestExecutor_PidsLimit(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	testutil.ExecCompatible(t)
+
+	testExecCmd := testExecutorCommandWithChroot(t)
+	execCmd, allocDir := testExecCmd.command, testExecCmd.allocDir
+	execCmd.Cmd = "/bin/sleep"
+	execCmd.Args = []string{"10"}
+	execCmd.ResourceLimits = true
+	execCmd.PidsLimit = 25
+	defer allocDir.Destroy()
+
+	executor := NewExecutorWithIsolation(testlog.HCLogger(t))
+	defer executor.Shutdown("SIGKILL", 0)
+
+	ps, err := executor.Launch(execCmd)
+	require.NoError(err)
+	require.NotZero(ps.Pid)
+
+	state, err := executor.(*LibcontainerExecutor).container.State()
+	require.NoError(err)
+
+	data, err := ioutil.ReadFile(filepath.Join(state.CgroupPaths["pids"], "pids.max"))
+	require.NoError(err)
+	require.Equal("25", strings.TrimSpace(string(data)))
+}
+
 // TestExecutor_CgroupPaths asserts that process starts with independent cgroups
 // hierarchy created for this process
 func TestExecutor_CgroupPaths(t *testing.T) {
@@ -300,10 +331,13 @@ func TestExecutor_Capabilities(t *testing.T) {
 	testutil.ExecCompatible(t)
 
 	cases := []struct {
-		user string
-		caps string
+		name    string
+		user    string
+		allowed []string
+		caps    string
 	}{
 		{
+			name: "nobody",
 			user: "nobody",
 			caps: `
 CapInh: 0000000000000000
@@ -313,18 +347,41 @@ CapBnd: 0000003fffffffff
 CapAmb: 0000000000000000`,
 		},
 		{
+			name: "root",
 			user: "root",
 			caps: `
 CapInh: 0000000000000000
 CapPrm: 0000003fffffffff
 CapEff: 0000003fffffffff
 CapBnd: 0000003fffffffff
+CapAmb: 0000000000000000`,
+		},
+		{
+			name:    "root restricted",
+			user:    "root",
+			allowed: []string{"CAP_CHOWN", "CAP_KILL"},
+			caps: `
+CapInh: 0000000000000000
+CapPrm: 0000000000000021
+CapEff: 0000000000000021
+CapBnd: 0000000000000021
+CapAmb: 0000000000000000`,
+		},
+		{
+			name:    "root none",
+			user:    "root",
+			allowed: []string{},
+			caps: `
+CapInh: 0000000000000000
+CapPrm: 0000000000000000
+CapEff: 0000000000000000
+CapBnd: 0000000000000000
 CapAmb: 0000000000000000`,
 		},
 	}
 
 	for _, c := range cases {
-		t.Run(c.user, func(t *testing.T) {
+		t.Run(c.name, func(t *testing.T) {
 			require := require.New(t)
 
 			testExecCmd := testExecutorCommandWithChroot(t)
@@ -332,6 +389,7 @@ CapAmb: 0000000000000000`,
 			defer allocDir.Destroy()
 
 			execCmd.User = c.user
+			execCmd.Capabilities = c.allowed
 			execCmd.ResourceLimits = true
 			execCmd.Cmd = "/bin/bash"
 			execCmd.Args = []string{"-c", "cat /proc/$$/status"}
@@ -484,3 +542,45 @@ func TestExecutor_cmdMounts(t *testing.T) {
 
 	require.EqualValues(t, expected, cmdMounts(input))
 }
+
+func TestExecutor_blockIOStats(t *testing.T) {
+	input := &cgroups.BlkioStats{
+		IoServiceBytesRecursive: []cgroups.BlkioStatEntry{
+			{Major: 8, Minor: 0, Op: "Read", Value: 1024},
+			{Major: 8, Minor: 0, Op: "Write", Value: 2048},
+			{Major: 8, Minor: 0, Op: "Total", Value: 3072},
+			{Major: 8, Minor: 16, Op: "Read", Value: 1},
+		},
+		IoServicedRecursive: []cgroups.BlkioStatEntry{
+			{Major: 8, Minor: 0, Op: "Read", Value: 3},
+			{Major: 8, Minor: 0, Op: "Write", Value: 4},
+			{Major: 8, Minor: 0, Op: "Total", Value: 7},
+		},
+	}
+
+	bs := blockIOStats(input)
+	require.EqualValues(t, 1025, bs.ReadBytes)
+	require.EqualValues(t, 2048, bs.WriteBytes)
+	require.EqualValues(t, 3, bs.ReadOps)
+	require.EqualValues(t, 4, bs.WriteOps)
+	require.Equal(t, ExecutorCgroupMeasuredBlockIOStats, bs.Measured)
+}
+
+func TestExecutor_parseNetDev(t *testing.T) {
+	input := `Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+    lo:    1000      10    0    0    0     0          0         0     1000      10    0    0    0     0       0          0
+  eth0:    2048      20    0    0    0     0          0         0     4096      40    0    0    0     0       0          0
+  eth1:       2       1    0    0    0     0          0         0        4       2    0    0    0     0       0          0
+`
+
+	ns, err := parseNetDev(strings.NewReader(input))
+	require.NoError(t, err)
+	require.EqualValues(t, 2050, ns.RxBytes)
+	require.EqualValues(t, 21, ns.RxPackets)
+	require.EqualValues(t, 4100, ns.TxBytes)
+	require.EqualValues(t, 42, ns.TxPackets)
+
+	_, err = parseNetDev(strings.NewReader("eth0: 1 2 3\n"))
+	require.Error(t, err)
+}
@@ -39,6 +39,8 @@ type LaunchRequest struct {
 	Mounts               []*proto1.Mount              `protobuf:"bytes,11,rep,name=mounts,proto3" json:"mounts,omitempty"`
 	Devices              []*proto1.Device             `protobuf:"bytes,12,rep,name=devices,proto3" json:"devices,omitempty"`
 	NetworkIsolation     *proto1.NetworkIsolationSpec `protobuf:"bytes,13,opt,name=network_isolation,json=networkIsolation,proto3" json:"network_isolation,omitempty"`
+	PidsLimit            int64                        `protobuf:"varint,14,opt,name=pids_limit,json=pidsLimit,proto3" json:"pids_limit,omitempty"`
+	Capabilities         *Capabilities                `protobuf:"bytes,15,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
 	XXX_unrecognized     []byte                       `json:"-"`
 	XXX_sizecache        int32                        `json:"-"`
@@ -159,6 +161,58 @@ func (m *LaunchRequest) GetNetworkIsolation() *proto1.NetworkIsolationSpec {
 	return nil
 }
 
+func (m *LaunchRequest) GetPidsLimit() int64 {
+	if m != nil {
+		return m.PidsLimit
+	}
+	return 0
+}
+
+func (m *LaunchRequest) GetCapabilities() *Capabilities {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
+type Capabilities struct {
+	Names                []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Capabilities) Reset()         { *m = Capabilities{} }
+func (m *Capabilities) String() string { return proto.CompactTextString(m) }
+func (*Capabilities) ProtoMessage()    {}
+func (*Capabilities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_executor_43dc81e71868eb7b, []int{1}
+}
+func (m *Capabilities) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Capabilities.Unmarshal(m, b)
+}
+func (m *Capabilities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Capabilities.Marshal(b, m, deterministic)
+}
+func (dst *Capabilities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Capabilities.Merge(dst, src)
+}
+func (m *Capabilities) XXX_Size() int {
+	return xxx_messageInfo_Capabilities.Size(m)
+}
+func (m *Capabilities) XXX_DiscardUnknown() {
+	xxx_messageInfo_Capabilities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Capabilities proto.InternalMessageInfo
+
+func (m *Capabilities) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
 type LaunchResponse struct {
 	Process              *ProcessState `protobuf:"bytes,1,opt,name=process,proto3" json:"process,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -170,7 +224,7 @@ func (m *LaunchResponse) Reset()         { *m = LaunchResponse{} }
 func (m *LaunchResponse) String() string { return proto.CompactTextString(m) }
 func (*LaunchResponse) ProtoMessage()    {}
 func (*LaunchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_executor_43dc81e71868eb7b, []int{1}
+	return fileDescriptor_executor_43dc81e71868eb7b, []int{2}
 }
 func (m *LaunchResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LaunchResponse.Unmarshal(m, b)
@@ -207,7 +261,7 @@ func (m *WaitRequest) Reset()         { *m = WaitRequest{} }
 func (m *WaitRequest) String() string { return proto.CompactTextString(m) }
 func (*WaitRequest) ProtoMessage()    {}
 func (*WaitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_executor_43dc81e71868eb7b, []int{2}
+	return fileDescriptor_executor_43dc81e71868eb7b, []int{3}
 }
 func (m *WaitRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WaitRequest.Unmarshal(m, b)
@@ -238,7 +292,7 @@ func (m *WaitResponse) Reset()         { *m = WaitResponse{} }
 func (m *WaitResponse) String() string { return proto.CompactTextString(m) }
 func (*WaitResponse) ProtoMessage()    {}
 func (*WaitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_executor_43dc81e71868eb7b, []int{3}
+	return fileDescriptor_executor_43dc81e71868eb7b, []int{4}
 }
 func (m *WaitResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WaitResponse.Unmarshal(m, b)
@@ -277,7 +331,7 @@ func (m *ShutdownRequest) Reset()         { *m = ShutdownRequest{} }
 func (m *ShutdownRequest) String() string { return proto.CompactTextString(m) }
 func (*ShutdownRequest) ProtoMessage()    {}
 func (*ShutdownRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_executor_43dc81e71868eb7b, []int{4}
+	return fileDescriptor_executor_43dc81e71868eb7b, []int{5}
 }
 func (m *ShutdownRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShutdownRequest.Unmarshal(m, b)
@@ -321,7 +375,7 @@ func (m *ShutdownResponse) Reset()         { *m = ShutdownResponse{} }
 func (m *ShutdownResponse) String() string { return proto.CompactTextString(m) }
 func (*ShutdownResponse) ProtoMessage()    {}
 func (*ShutdownResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_executor_43dc81e71868eb7b, []int{5}
+	return fileDescriptor_executor_43dc81e71868eb7b, []int{6}
 }
 func (m *ShutdownResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShutdownResponse.Unmarshal(m, b)
@@ -352,7 +406,7 @@ func (m *UpdateResourcesRequest) Reset()         { *m = UpdateResourcesRequest{}
 func (m *UpdateResourcesRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateResourcesRequest) ProtoMessage()    {}
 func (*UpdateResourcesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_executor_43dc81e71868eb7b, []int{6}
+	return fileDescriptor_executor_43dc81e71868eb7b, []int{7}
 }
 func (m *UpdateResourcesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateResourcesRequest.Unmarshal(m, b)
@@ -389,7 +443,7 @@ func (m *UpdateResourcesResponse) Reset()         { *m = UpdateResourcesResponse
 func (m *UpdateResourcesResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateResourcesResponse) ProtoMessage()    {}
 func (*UpdateResourcesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_executor_43dc81e71868eb7b, []int{7}
+	return fileDescriptor_executor_43dc81e71868eb7b, []int{8}
 }
 func (m *UpdateResourcesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateResourcesResponse.Unmarshal(m, b)
@@ -419,7 +473,7 @@ func (m *VersionRequest) Reset()         { *m = VersionRequest{} }
 func (m *VersionRequest) String() string { return proto.CompactTextString(m) }
 func (*VersionRequest) ProtoMessage()    {}
 func (*VersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_executor_43dc81e71868eb7b, []int{8}
+	return fileDescriptor_executor_43dc81e71868eb7b, []int{9}
 }
 func (m *VersionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VersionRequest.Unmarshal(m, b)
@@ -450,7 +504,7 @@ func (m *VersionResponse) Reset()         { *m = VersionResponse{} }
 func (m *VersionResponse) String() string { return proto.CompactTextString(m) }
 func (*VersionResponse) ProtoMessage()    {}
 func (*VersionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_executor_43dc81e71868eb7b, []int{9}
+	return fileDescriptor_executor_43dc81e71868eb7b, []int{10}
 }
 func (m *VersionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VersionResponse.Unmarshal(m, b)
@@ -488,7 +542,7 @@ func (m *StatsRequest) Reset()         { *m = StatsRequest{} }
 func (m *StatsRequest) String() string { return proto.CompactTextString(m) }
 func (*StatsRequest) ProtoMessage()    {}
 func (*StatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_executor_43dc81e71868eb7b, []int{10}
+	return fileDescriptor_executor_43dc81e71868eb7b, []int{11}
 }
 func (m *StatsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatsRequest.Unmarshal(m, b)
@@ -526,7 +580,7 @@ func (m *StatsResponse) Reset()         { *m = StatsResponse{} }
 func (m *StatsResponse) String() string { return proto.CompactTextString(m) }
 func (*StatsResponse) ProtoMessage()    {}
 func (*StatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_executor_43dc81e71868eb7b, []int{11}
+	return fileDescriptor_executor_43dc81e71868eb7b, []int{12}
 }
 func (m *StatsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatsResponse.Unmarshal(m, b)
@@ -564,7 +618,7 @@ func (m *SignalRequest) Reset()         { *m = SignalRequest{} }
 func (m *SignalRequest) String() string { return proto.CompactTextString(m) }
 func (*SignalRequest) ProtoMessage()    {}
 func (*SignalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_executor_43dc81e71868eb7b, []int{12}
+	return fileDescriptor_executor_43dc81e71868eb7b, []int{13}
 }
 func (m *SignalRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignalRequest.Unmarshal(m, b)
@@ -601,7 +655,7 @@ func (m *SignalResponse) Reset()         { *m = SignalResponse{} }
 func (m *SignalResponse) String() string { return proto.CompactTextString(m) }
 func (*SignalResponse) ProtoMessage()    {}
 func (*SignalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_executor_43dc81e71868eb7b, []int{13}
+	return fileDescriptor_executor_43dc81e71868eb7b, []int{14}
 }
 func (m *SignalResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignalResponse.Unmarshal(m, b)
@@ -634,7 +688,7 @@ func (m *ExecRequest) Reset()         { *m = ExecRequest{} }
 func (m *ExecRequest) String() string { return proto.CompactTextString(m) }
 func (*ExecRequest) ProtoMessage()    {}
 func (*ExecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_executor_43dc81e71868eb7b, []int{14}
+	return fileDescriptor_executor_43dc81e71868eb7b, []int{15}
 }
 func (m *ExecRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExecRequest.Unmarshal(m, b)
@@ -687,7 +741,7 @@ func (m *ExecResponse) Reset()         { *m = ExecResponse{} }
 func (m *ExecResponse) String() string { return proto.CompactTextString(m) }
 func (*ExecResponse) ProtoMessage()    {}
 func (*ExecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_executor_43dc81e71868eb7b, []int{15}
+	return fileDescriptor_executor_43dc81e71868eb7b, []int{16}
 }
 func (m *ExecResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExecResponse.Unmarshal(m, b)
@@ -735,7 +789,7 @@ func (m *ProcessState) Reset()         { *m = ProcessState{} }
 func (m *ProcessState) String() string { return proto.CompactTextString(m) }
 func (*ProcessState) ProtoMessage()    {}
 func (*ProcessState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_executor_43dc81e71868eb7b, []int{16}
+	return fileDescriptor_executor_43dc81e71868eb7b, []int{17}
 }
 func (m *ProcessState) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProcessState.Unmarshal(m, b)
@@ -785,6 +839,7 @@ func (m *ProcessState) GetTime() *timestamp.Timestamp {
 
 func init() {
 	proto.RegisterType((*LaunchRequest)(nil), "hashicorp.nomad.plugins.executor.proto.LaunchRequest")
+	proto.RegisterType((*Capabilities)(nil), "hashicorp.nomad.plugins.executor.proto.Capabilities")
 	proto.RegisterType((*LaunchResponse)(nil), "hashicorp.nomad.plugins.executor.proto.LaunchResponse")
 	proto.RegisterType((*WaitRequest)(nil), "hashicorp.nomad.plugins.executor.proto.WaitRequest")
 	proto.RegisterType((*WaitResponse)(nil), "hashicorp.nomad.plugins.executor.proto.WaitResponse")
@@ -1204,65 +1259,68 @@ func init() {
 }
 
 var fileDescriptor_executor_43dc81e71868eb7b = []byte{
-	// 955 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x5b, 0x6f, 0x1b, 0x45,
-	0x14, 0xee, 0xc6, 0xf1, 0xed, 0xd8, 0x4e, 0xcc, 0x08, 0x85, 0xad, 0x79, 0xa8, 0xd9, 0x07, 0x6a,
-	0x41, 0x59, 0x47, 0xe9, 0x0d, 0x09, 0x41, 0x11, 0x49, 0x41, 0x48, 0x21, 0x8a, 0xd6, 0x85, 0x4a,
-	0x3c, 0x60, 0x26, 0xbb, 0xc3, 0xee, 0x28, 0xf6, 0xce, 0x32, 0x33, 0xeb, 0x06, 0x09, 0x89, 0x27,
-	0xfe, 0x01, 0x48, 0xfc, 0x38, 0x7e, 0x0c, 0x9a, 0xdb, 0xc6, 0x4e, 0x4b, 0xb5, 0x2e, 0xe2, 0xc9,
-	0x33, 0x67, 0xcf, 0xf7, 0x9d, 0xcb, 0x9c, 0xf3, 0x19, 0xee, 0x25, 0x9c, 0xae, 0x08, 0x17, 0x53,
-	0x91, 0x61, 0x4e, 0x92, 0x29, 0xb9, 0x22, 0x71, 0x29, 0x19, 0x9f, 0x16, 0x9c, 0x49, 0x56, 0x5d,
-	0x43, 0x7d, 0x45, 0xef, 0x67, 0x58, 0x64, 0x34, 0x66, 0xbc, 0x08, 0x73, 0xb6, 0xc4, 0x49, 0x58,
-	0x2c, 0xca, 0x94, 0xe6, 0x22, 0xdc, 0xf4, 0x1b, 0xdd, 0x49, 0x19, 0x4b, 0x17, 0xc4, 0x90, 0x5c,
-	0x94, 0x3f, 0x4d, 0x25, 0x5d, 0x12, 0x21, 0xf1, 0xb2, 0xb0, 0x0e, 0x9f, 0xa6, 0x54, 0x66, 0xe5,
-	0x45, 0x18, 0xb3, 0xe5, 0xb4, 0xe2, 0x9c, 0x6a, 0xce, 0xa9, 0xe5, 0x9c, 0xba, 0xcc, 0x4c, 0x26,
-	0xe6, 0x66, 0xe0, 0xc1, 0xdf, 0xbb, 0x30, 0x38, 0xc5, 0x65, 0x1e, 0x67, 0x11, 0xf9, 0xb9, 0x24,
-	0x42, 0xa2, 0x21, 0x34, 0xe2, 0x65, 0xe2, 0x7b, 0x63, 0x6f, 0xd2, 0x8d, 0xd4, 0x11, 0x21, 0xd8,
-	0xc5, 0x3c, 0x15, 0xfe, 0xce, 0xb8, 0x31, 0xe9, 0x46, 0xfa, 0x8c, 0xce, 0xa0, 0xcb, 0x89, 0x60,
-	0x25, 0x8f, 0x89, 0xf0, 0x1b, 0x63, 0x6f, 0xd2, 0x3b, 0x3a, 0x0c, 0xff, 0xad, 0x26, 0x1b, 0xdf,
-	0x84, 0x0c, 0x23, 0x87, 0x8b, 0xae, 0x29, 0xd0, 0x1d, 0xe8, 0x09, 0x99, 0xb0, 0x52, 0xce, 0x0b,
-	0x2c, 0x33, 0x7f, 0x57, 0x47, 0x07, 0x63, 0x3a, 0xc7, 0x32, 0xb3, 0x0e, 0x84, 0x73, 0xe3, 0xd0,
-	0xac, 0x1c, 0x08, 0xe7, 0xda, 0x61, 0x08, 0x0d, 0x92, 0xaf, 0xfc, 0x96, 0x4e, 0x52, 0x1d, 0x55,
-	0xde, 0xa5, 0x20, 0xdc, 0x6f, 0x6b, 0x5f, 0x7d, 0x46, 0xb7, 0xa1, 0x23, 0xb1, 0xb8, 0x9c, 0x27,
-	0x94, 0xfb, 0x1d, 0x6d, 0x6f, 0xab, 0xfb, 0x09, 0xe5, 0xe8, 0x2e, 0xec, 0xbb, 0x7c, 0xe6, 0x0b,
-	0xba, 0xa4, 0x52, 0xf8, 0xdd, 0xb1, 0x37, 0xe9, 0x44, 0x7b, 0xce, 0x7c, 0xaa, 0xad, 0xe8, 0x10,
-	0xde, 0xbe, 0xc0, 0x82, 0xc6, 0xf3, 0x82, 0xb3, 0x98, 0x08, 0x31, 0x8f, 0x53, 0xce, 0xca, 0xc2,
-	0x07, 0xed, 0x8d, 0xf4, 0xb7, 0x73, 0xf3, 0xe9, 0x58, 0x7f, 0x41, 0x27, 0xd0, 0x5a, 0xb2, 0x32,
-	0x97, 0xc2, 0xef, 0x8d, 0x1b, 0x93, 0xde, 0xd1, 0xbd, 0x9a, 0xad, 0xfa, 0x46, 0x81, 0x22, 0x8b,
-	0x45, 0x5f, 0x41, 0x3b, 0x21, 0x2b, 0xaa, 0x3a, 0xde, 0xd7, 0x34, 0x1f, 0xd5, 0xa4, 0x39, 0xd1,
-	0xa8, 0xc8, 0xa1, 0x51, 0x06, 0x6f, 0xe5, 0x44, 0xbe, 0x60, 0xfc, 0x72, 0x4e, 0x05, 0x5b, 0x60,
-	0x49, 0x59, 0xee, 0x0f, 0xf4, 0x23, 0x7e, 0x52, 0x93, 0xf2, 0xcc, 0xe0, 0xbf, 0x76, 0xf0, 0x59,
-	0x41, 0xe2, 0x68, 0x98, 0xdf, 0xb0, 0x06, 0x3f, 0xc2, 0x9e, 0x9b, 0x2e, 0x51, 0xb0, 0x5c, 0x10,
-	0x74, 0x06, 0x6d, 0xdb, 0x36, 0x3d, 0x62, 0xbd, 0xa3, 0x07, 0x61, 0xbd, 0x55, 0x08, 0x6d, 0x4b,
-	0x67, 0x12, 0x4b, 0x12, 0x39, 0x92, 0x60, 0x00, 0xbd, 0xe7, 0x98, 0x4a, 0x3b, 0xbd, 0xc1, 0x0f,
-	0xd0, 0x37, 0xd7, 0xff, 0x29, 0xdc, 0x29, 0xec, 0xcf, 0xb2, 0x52, 0x26, 0xec, 0x45, 0xee, 0x16,
-	0xe6, 0x00, 0x5a, 0x82, 0xa6, 0x39, 0x5e, 0xd8, 0x9d, 0xb1, 0x37, 0xf4, 0x1e, 0xf4, 0x53, 0x8e,
-	0x63, 0x32, 0x2f, 0x08, 0xa7, 0x2c, 0xf1, 0x77, 0xc6, 0xde, 0xa4, 0x11, 0xf5, 0xb4, 0xed, 0x5c,
-	0x9b, 0x02, 0x04, 0xc3, 0x6b, 0x36, 0x93, 0x71, 0x90, 0xc1, 0xc1, 0xb7, 0x45, 0xa2, 0x82, 0x56,
-	0x7b, 0x62, 0x03, 0x6d, 0xec, 0x9c, 0xf7, 0x9f, 0x77, 0x2e, 0xb8, 0x0d, 0xef, 0xbc, 0x14, 0xc9,
-	0x26, 0x31, 0x84, 0xbd, 0xef, 0x08, 0x17, 0x94, 0xb9, 0x2a, 0x83, 0x0f, 0x61, 0xbf, 0xb2, 0xd8,
-	0xde, 0xfa, 0xd0, 0x5e, 0x19, 0x93, 0xad, 0xdc, 0x5d, 0x83, 0x0f, 0xa0, 0xaf, 0xfa, 0x56, 0x65,
-	0x3e, 0x82, 0x0e, 0xcd, 0x25, 0xe1, 0x2b, 0xdb, 0xa4, 0x46, 0x54, 0xdd, 0x83, 0xe7, 0x30, 0xb0,
-	0xbe, 0x96, 0xf6, 0x4b, 0x68, 0x0a, 0x65, 0xd8, 0xb2, 0xc4, 0x67, 0x58, 0x5c, 0x1a, 0x22, 0x03,
-	0x0f, 0xee, 0xc2, 0x60, 0xa6, 0x5f, 0xe2, 0xd5, 0x0f, 0xd5, 0x74, 0x0f, 0xa5, 0x8a, 0x75, 0x8e,
-	0xb6, 0xfc, 0x4b, 0xe8, 0x3d, 0xbd, 0x22, 0xb1, 0x03, 0x3e, 0x82, 0x4e, 0x42, 0x70, 0xb2, 0xa0,
-	0x39, 0xb1, 0x49, 0x8d, 0x42, 0xa3, 0xcb, 0xa1, 0xd3, 0xe5, 0xf0, 0x99, 0xd3, 0xe5, 0xa8, 0xf2,
-	0x75, 0x52, 0xba, 0xf3, 0xb2, 0x94, 0x36, 0xae, 0xa5, 0x34, 0x38, 0x86, 0xbe, 0x09, 0x66, 0xeb,
-	0x3f, 0x80, 0x16, 0x2b, 0x65, 0x51, 0x4a, 0x1d, 0xab, 0x1f, 0xd9, 0x1b, 0x7a, 0x17, 0xba, 0xe4,
-	0x8a, 0xca, 0x79, 0xcc, 0x12, 0xa2, 0x39, 0x9b, 0x51, 0x47, 0x19, 0x8e, 0x59, 0x42, 0x82, 0xdf,
-	0x3d, 0xe8, 0xaf, 0x4f, 0xac, 0x8a, 0x5d, 0xd0, 0xc4, 0x56, 0xaa, 0x8e, 0xaf, 0xc5, 0xaf, 0xf5,
-	0xa6, 0xb1, 0xde, 0x1b, 0x14, 0xc2, 0xae, 0xfa, 0xc7, 0xd1, 0x82, 0xfc, 0xfa, 0xb2, 0xb5, 0xdf,
-	0xd1, 0x9f, 0x5d, 0xe8, 0x3c, 0xb5, 0x8b, 0x84, 0x7e, 0x81, 0x96, 0xd9, 0x7e, 0xf4, 0xb0, 0xee,
-	0xd6, 0x6d, 0xfc, 0x17, 0x8d, 0x1e, 0x6d, 0x0b, 0xb3, 0xef, 0x77, 0x0b, 0x09, 0xd8, 0x55, 0x3a,
-	0x80, 0xee, 0xd7, 0x65, 0x58, 0x13, 0x91, 0xd1, 0x83, 0xed, 0x40, 0x55, 0xd0, 0xdf, 0xa0, 0xe3,
-	0xd6, 0x19, 0x3d, 0xae, 0xcb, 0x71, 0x43, 0x4e, 0x46, 0x1f, 0x6f, 0x0f, 0xac, 0x12, 0xf8, 0xc3,
-	0x83, 0xfd, 0x1b, 0x2b, 0x8d, 0x3e, 0xab, 0xcb, 0xf7, 0x6a, 0xd5, 0x19, 0x3d, 0x79, 0x63, 0x7c,
-	0x95, 0xd6, 0xaf, 0xd0, 0xb6, 0xda, 0x81, 0x6a, 0xbf, 0xe8, 0xa6, 0xfc, 0x8c, 0x1e, 0x6f, 0x8d,
-	0xab, 0xa2, 0x5f, 0x41, 0x53, 0xeb, 0x02, 0xaa, 0xfd, 0xac, 0xeb, 0xda, 0x35, 0x7a, 0xb8, 0x25,
-	0xca, 0xc5, 0x3d, 0xf4, 0xd4, 0xfc, 0x1b, 0x61, 0xa9, 0x3f, 0xff, 0x1b, 0x8a, 0x55, 0x7f, 0xfe,
-	0x6f, 0xe8, 0x97, 0x9e, 0x7f, 0xb5, 0x86, 0xf5, 0xe7, 0x7f, 0x4d, 0xef, 0xea, 0xcf, 0xff, 0xba,
-	0x6e, 0x05, 0xb7, 0xd0, 0x5f, 0x1e, 0x0c, 0x94, 0x69, 0x26, 0x39, 0xc1, 0x4b, 0x9a, 0xa7, 0xe8,
-	0x49, 0x4d, 0xf1, 0x56, 0x28, 0x23, 0xe0, 0x16, 0xe9, 0x52, 0xf9, 0xfc, 0xcd, 0x09, 0x5c, 0x5a,
-	0x13, 0xef, 0xd0, 0xfb, 0xa2, 0xfd, 0x7d, 0xd3, 0x68, 0x56, 0x4b, 0xff, 0xdc, 0xff, 0x27, 0x00,
-	0x00, 0xff, 0xff, 0xad, 0xfe, 0x69, 0xb2, 0xaf, 0x0b, 0x00, 0x00,
+	// 1006 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x6d, 0x6f, 0x1b, 0x45,
+	0x10, 0xe6, 0xe2, 0xf8, 0x6d, 0x6c, 0x27, 0x61, 0x55, 0x85, 0xeb, 0x21, 0x54, 0x73, 0x42, 0xd4,
+	0x82, 0x72, 0x8e, 0xd2, 0x34, 0x45, 0x42, 0x50, 0x44, 0x52, 0x10, 0x52, 0x88, 0xa2, 0x4b, 0xa1,
+	0x88, 0x0f, 0x98, 0xcd, 0xdd, 0xe2, 0x5b, 0xc5, 0xbe, 0x3d, 0x76, 0xf7, 0xdc, 0x20, 0x21, 0xf1,
+	0x89, 0x7f, 0x00, 0x12, 0x7f, 0x8c, 0xff, 0x83, 0xf6, 0xed, 0x62, 0xa7, 0x05, 0xce, 0x45, 0xfd,
+	0x74, 0x3b, 0x73, 0xf3, 0x3c, 0x33, 0xbb, 0xb3, 0xf3, 0x2c, 0xdc, 0x4b, 0x39, 0x5d, 0x10, 0x2e,
+	0xc6, 0x22, 0xc3, 0x9c, 0xa4, 0x63, 0x72, 0x45, 0x92, 0x52, 0x32, 0x3e, 0x2e, 0x38, 0x93, 0xac,
+	0x32, 0x23, 0x6d, 0xa2, 0x77, 0x33, 0x2c, 0x32, 0x9a, 0x30, 0x5e, 0x44, 0x39, 0x9b, 0xe3, 0x34,
+	0x2a, 0x66, 0xe5, 0x94, 0xe6, 0x22, 0x5a, 0x8d, 0x0b, 0xee, 0x4c, 0x19, 0x9b, 0xce, 0x88, 0x21,
+	0xb9, 0x28, 0x7f, 0x1c, 0x4b, 0x3a, 0x27, 0x42, 0xe2, 0x79, 0x61, 0x03, 0x3e, 0x9e, 0x52, 0x99,
+	0x95, 0x17, 0x51, 0xc2, 0xe6, 0xe3, 0x8a, 0x73, 0xac, 0x39, 0xc7, 0x96, 0x73, 0xec, 0x2a, 0x33,
+	0x95, 0x18, 0xcb, 0xc0, 0xc3, 0xbf, 0x9a, 0x30, 0x38, 0xc1, 0x65, 0x9e, 0x64, 0x31, 0xf9, 0xa9,
+	0x24, 0x42, 0xa2, 0x1d, 0x68, 0x24, 0xf3, 0xd4, 0xf7, 0x86, 0xde, 0xa8, 0x1b, 0xab, 0x25, 0x42,
+	0xb0, 0x89, 0xf9, 0x54, 0xf8, 0x1b, 0xc3, 0xc6, 0xa8, 0x1b, 0xeb, 0x35, 0x3a, 0x85, 0x2e, 0x27,
+	0x82, 0x95, 0x3c, 0x21, 0xc2, 0x6f, 0x0c, 0xbd, 0x51, 0x6f, 0x7f, 0x2f, 0xfa, 0xa7, 0x3d, 0xd9,
+	0xfc, 0x26, 0x65, 0x14, 0x3b, 0x5c, 0x7c, 0x4d, 0x81, 0xee, 0x40, 0x4f, 0xc8, 0x94, 0x95, 0x72,
+	0x52, 0x60, 0x99, 0xf9, 0x9b, 0x3a, 0x3b, 0x18, 0xd7, 0x19, 0x96, 0x99, 0x0d, 0x20, 0x9c, 0x9b,
+	0x80, 0x66, 0x15, 0x40, 0x38, 0xd7, 0x01, 0x3b, 0xd0, 0x20, 0xf9, 0xc2, 0x6f, 0xe9, 0x22, 0xd5,
+	0x52, 0xd5, 0x5d, 0x0a, 0xc2, 0xfd, 0xb6, 0x8e, 0xd5, 0x6b, 0x74, 0x1b, 0x3a, 0x12, 0x8b, 0xcb,
+	0x49, 0x4a, 0xb9, 0xdf, 0xd1, 0xfe, 0xb6, 0xb2, 0x8f, 0x29, 0x47, 0x77, 0x61, 0xdb, 0xd5, 0x33,
+	0x99, 0xd1, 0x39, 0x95, 0xc2, 0xef, 0x0e, 0xbd, 0x51, 0x27, 0xde, 0x72, 0xee, 0x13, 0xed, 0x45,
+	0x7b, 0x70, 0xeb, 0x02, 0x0b, 0x9a, 0x4c, 0x0a, 0xce, 0x12, 0x22, 0xc4, 0x24, 0x99, 0x72, 0x56,
+	0x16, 0x3e, 0xe8, 0x68, 0xa4, 0xff, 0x9d, 0x99, 0x5f, 0x47, 0xfa, 0x0f, 0x3a, 0x86, 0xd6, 0x9c,
+	0x95, 0xb9, 0x14, 0x7e, 0x6f, 0xd8, 0x18, 0xf5, 0xf6, 0xef, 0xd5, 0x3c, 0xaa, 0xaf, 0x14, 0x28,
+	0xb6, 0x58, 0xf4, 0x05, 0xb4, 0x53, 0xb2, 0xa0, 0xea, 0xc4, 0xfb, 0x9a, 0xe6, 0x83, 0x9a, 0x34,
+	0xc7, 0x1a, 0x15, 0x3b, 0x34, 0xca, 0xe0, 0xf5, 0x9c, 0xc8, 0x67, 0x8c, 0x5f, 0x4e, 0xa8, 0x60,
+	0x33, 0x2c, 0x29, 0xcb, 0xfd, 0x81, 0x6e, 0xe2, 0x47, 0x35, 0x29, 0x4f, 0x0d, 0xfe, 0x4b, 0x07,
+	0x3f, 0x2f, 0x48, 0x12, 0xef, 0xe4, 0x37, 0xbc, 0xe8, 0x2d, 0x80, 0x82, 0xa6, 0xc2, 0x9c, 0xa7,
+	0xbf, 0x35, 0xf4, 0x46, 0x8d, 0xb8, 0xab, 0x3c, 0xfa, 0x28, 0xd1, 0xb7, 0xd0, 0x4f, 0x70, 0x81,
+	0x2f, 0xe8, 0x8c, 0x4a, 0x4a, 0x84, 0xbf, 0xad, 0x6b, 0x38, 0x88, 0xea, 0x0d, 0x47, 0x74, 0xb4,
+	0x84, 0x8d, 0x57, 0x98, 0xc2, 0x77, 0xa0, 0xbf, 0xfc, 0x17, 0xdd, 0x82, 0x66, 0x8e, 0xe7, 0x44,
+	0xf8, 0x9e, 0xbe, 0x1f, 0xc6, 0x08, 0x7f, 0x80, 0x2d, 0x77, 0xf9, 0x45, 0xc1, 0x72, 0x41, 0xd0,
+	0x29, 0xb4, 0x6d, 0x57, 0x7d, 0x6f, 0xbd, 0x62, 0x6c, 0xc7, 0xcf, 0x25, 0x96, 0x24, 0x76, 0x24,
+	0xe1, 0x00, 0x7a, 0x4f, 0x31, 0x95, 0x76, 0xb8, 0xc2, 0xef, 0xa1, 0x6f, 0xcc, 0x57, 0x94, 0xee,
+	0x04, 0xb6, 0xcf, 0xb3, 0x52, 0xa6, 0xec, 0x59, 0xee, 0xe6, 0x79, 0x17, 0x5a, 0x82, 0x4e, 0x73,
+	0x3c, 0xb3, 0x23, 0x6d, 0x2d, 0xf4, 0x36, 0xf4, 0xa7, 0x1c, 0x27, 0x64, 0x52, 0x10, 0x4e, 0x59,
+	0xea, 0x6f, 0xe8, 0xe6, 0xf4, 0xb4, 0xef, 0x4c, 0xbb, 0x42, 0x04, 0x3b, 0xd7, 0x6c, 0xa6, 0xe2,
+	0x30, 0x83, 0xdd, 0xaf, 0x8b, 0x54, 0x25, 0xad, 0xc6, 0xd8, 0x26, 0x5a, 0x91, 0x04, 0xef, 0x7f,
+	0x4b, 0x42, 0x78, 0x1b, 0xde, 0x78, 0x2e, 0x93, 0x2d, 0x62, 0x07, 0xb6, 0xbe, 0x21, 0x5c, 0x50,
+	0xe6, 0x76, 0x19, 0xbe, 0x0f, 0xdb, 0x95, 0xc7, 0x9e, 0xad, 0x0f, 0xed, 0x85, 0x71, 0xd9, 0x9d,
+	0x3b, 0x33, 0x7c, 0x0f, 0xfa, 0xea, 0xdc, 0xaa, 0xca, 0x03, 0xe8, 0xd0, 0x5c, 0x12, 0xbe, 0xb0,
+	0x87, 0xd4, 0x88, 0x2b, 0x3b, 0x7c, 0x0a, 0x03, 0x1b, 0x6b, 0x69, 0x3f, 0x87, 0xa6, 0x50, 0x8e,
+	0x35, 0xb7, 0xf8, 0x04, 0x8b, 0x4b, 0x43, 0x64, 0xe0, 0xe1, 0x5d, 0x18, 0x9c, 0xeb, 0x4e, 0xbc,
+	0xb8, 0x51, 0x4d, 0xd7, 0x28, 0xb5, 0x59, 0x17, 0x68, 0xb7, 0x7f, 0x09, 0xbd, 0xc7, 0x57, 0x24,
+	0x71, 0xc0, 0x43, 0xe8, 0xa4, 0x04, 0xa7, 0x33, 0x9a, 0x13, 0x5b, 0x54, 0x10, 0x99, 0x67, 0x23,
+	0x72, 0xcf, 0x46, 0xf4, 0xc4, 0x3d, 0x1b, 0x71, 0x15, 0xeb, 0x94, 0x7e, 0xe3, 0x79, 0xa5, 0x6f,
+	0x5c, 0x2b, 0x7d, 0x78, 0x04, 0x7d, 0x93, 0xcc, 0xee, 0x7f, 0x17, 0x5a, 0xac, 0x94, 0x45, 0x29,
+	0x75, 0xae, 0x7e, 0x6c, 0x2d, 0xf4, 0x26, 0x74, 0xc9, 0x15, 0x95, 0x93, 0x84, 0xa5, 0x44, 0x73,
+	0x36, 0xe3, 0x8e, 0x72, 0x1c, 0xb1, 0x94, 0x84, 0xbf, 0x79, 0xd0, 0x5f, 0xbe, 0xb1, 0x2a, 0x77,
+	0x41, 0x53, 0xbb, 0x53, 0xb5, 0xfc, 0x57, 0xfc, 0xd2, 0xd9, 0x34, 0x96, 0xcf, 0x06, 0x45, 0xb0,
+	0xa9, 0x1e, 0x44, 0x7f, 0xf3, 0x3f, 0xb7, 0xad, 0xe3, 0xf6, 0xff, 0xe8, 0x42, 0xe7, 0xb1, 0x1d,
+	0x24, 0xf4, 0x33, 0xb4, 0xcc, 0xf4, 0xa3, 0x07, 0x75, 0xa7, 0x6e, 0xe5, 0xa9, 0x0c, 0x0e, 0xd7,
+	0x85, 0xd9, 0xfe, 0xbd, 0x86, 0x04, 0x6c, 0x2a, 0x1d, 0x40, 0xf7, 0xeb, 0x32, 0x2c, 0x89, 0x48,
+	0x70, 0xb0, 0x1e, 0xa8, 0x4a, 0xfa, 0x2b, 0x74, 0xdc, 0x38, 0xa3, 0x87, 0x75, 0x39, 0x6e, 0xc8,
+	0x49, 0xf0, 0xe1, 0xfa, 0xc0, 0xaa, 0x80, 0xdf, 0x3d, 0xd8, 0xbe, 0x31, 0xd2, 0xe8, 0x93, 0xba,
+	0x7c, 0x2f, 0x56, 0x9d, 0xe0, 0xd1, 0x4b, 0xe3, 0xab, 0xb2, 0x7e, 0x81, 0xb6, 0xd5, 0x0e, 0x54,
+	0xbb, 0xa3, 0xab, 0xf2, 0x13, 0x3c, 0x5c, 0x1b, 0x57, 0x65, 0xbf, 0x82, 0xa6, 0xd6, 0x05, 0x54,
+	0xbb, 0xad, 0xcb, 0xda, 0x15, 0x3c, 0x58, 0x13, 0xe5, 0xf2, 0xee, 0x79, 0xea, 0xfe, 0x1b, 0x61,
+	0xa9, 0x7f, 0xff, 0x57, 0x14, 0x2b, 0x38, 0x5c, 0x17, 0xb6, 0x7c, 0xff, 0xd5, 0x18, 0xd6, 0xbf,
+	0xff, 0x4b, 0x7a, 0x17, 0x1c, 0xac, 0x07, 0xaa, 0x92, 0xfe, 0xe9, 0xc1, 0x40, 0xb9, 0xce, 0x25,
+	0x27, 0x78, 0x4e, 0xf3, 0x29, 0x7a, 0x54, 0x53, 0xbc, 0x15, 0xca, 0x08, 0xb8, 0x45, 0xba, 0x52,
+	0x3e, 0x7d, 0x79, 0x02, 0x57, 0xd6, 0xc8, 0xdb, 0xf3, 0x3e, 0x6b, 0x7f, 0xd7, 0x34, 0x9a, 0xd5,
+	0xd2, 0x9f, 0xfb, 0x7f, 0x0f, 0x00, 0xd3, 0x27, 0x1e, 0x83, 0x4e, 0x0c, 0x00, 0x00,
 }
@@ -20,7 +20,7 @@ type grpcExecutorServer struct {
 }
 
 func (s *grpcExecutorServer) Launch(ctx context.Context, req *proto.LaunchRequest) (*proto.LaunchResponse, error) {
-	ps, err := s.impl.Launch(&ExecCommand{
+	cmd := &ExecCommand{
 		Cmd:                req.Cmd,
 		Args:               req.Args,
 		Resources:          drivers.ResourcesFromProto(req.Resources),
@@ -34,8 +34,16 @@ func (s *grpcExecutorServer) Launch(ctx context.Context, req *proto.LaunchReques
 		Mounts:             drivers.MountsFromProto(req.Mounts),
 		Devices:            drivers.DevicesFromProto(req.Devices),
 		NetworkIsolation:   drivers.NetworkIsolationSpecFromProto(req.NetworkIsolation),
-	})
+		PidsLimit:          req.PidsLimit,
+	}
+
+	// An empty capabilities message grants no capabilities, as opposed to a
+	// missing one which grants them all
+	if req.Capabilities != nil {
+		cmd.Capabilities = append([]string{}, req.Capabilities.Names...)
+	}
 
+	ps, err := s.impl.Launch(cmd)
 	if err != nil {
 		return nil, err
 	}
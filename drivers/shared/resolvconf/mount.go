@@ -0,0 +1,85 @@
+package resolvconf
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/nomad/plugins/drivers"
+)
+
+const (
+	// hostResolvConf is the path of the host's resolver configuration
+	hostResolvConf = "/etc/resolv.conf"
+
+	// taskResolvConf is the path the generated resolver configuration is
+	// mounted at inside the task
+	taskResolvConf = "/etc/resolv.conf"
+)
+
+// GenerateDNSMount writes a resolv.conf built from the given DNS config into
+// taskDir and returns a mount which places it at /etc/resolv.conf within the
+// task. If no servers are configured the host's nameservers are used.
+func GenerateDNSMount(taskDir string, dns *drivers.DNSConfig) (*drivers.MountConfig, error) {
+	servers := dns.Servers
+	if len(servers) == 0 {
+		var err error
+		if servers, err = hostNameservers(hostResolvConf); err != nil {
+			return nil, err
+		}
+	}
+
+	path := filepath.Join(taskDir, "resolv.conf")
+	if err := ioutil.WriteFile(path, render(servers, dns.Searches, dns.Options), 0644); err != nil {
+		return nil, fmt.Errorf("failed to write resolv.conf: %v", err)
+	}
+
+	return &drivers.MountConfig{
+		TaskPath:        taskResolvConf,
+		HostPath:        path,
+		Readonly:        true,
+		PropagationMode: "private",
+	}, nil
+}
+
+// render returns the contents of a resolv.conf with the given nameservers,
+// search domains and options
+func render(servers, searches, options []string) []byte {
+	var b strings.Builder
+	for _, s := range servers {
+		fmt.Fprintf(&b, "nameserver %s\n", s)
+	}
+	if len(searches) > 0 {
+		fmt.Fprintf(&b, "search %s\n", strings.Join(searches, " "))
+	}
+	if len(options) > 0 {
+		fmt.Fprintf(&b, "options %s\n", strings.Join(options, " "))
+	}
+	return []byte(b.String())
+}
+
+// hostNameservers returns the nameservers listed in the resolv.conf at path.
+// A missing file results in no nameservers.
+func hostNameservers(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read host resolv.conf: %v", err)
+	}
+	defer f.Close()
+
+	var servers []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "nameserver" {
+			servers = append(servers, fields[1])
+		}
+	}
+	return servers, scanner.Err()
+}
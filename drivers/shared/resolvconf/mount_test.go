@@ -0,0 +1,55 @@
+package resolvconf
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/nomad/plugins/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateDNSMount(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nomad-resolvconf")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	dns := &drivers.DNSConfig{
+		Servers:  []string{"10.0.0.1", "10.0.0.2"},
+		Searches: []string{"service.consul", "example.com"},
+		Options:  []string{"ndots:2", "edns0"},
+	}
+
+	mount, err := GenerateDNSMount(dir, dns)
+	require.NoError(t, err)
+	require.Equal(t, "/etc/resolv.conf", mount.TaskPath)
+	require.Equal(t, filepath.Join(dir, "resolv.conf"), mount.HostPath)
+	require.True(t, mount.Readonly)
+
+	contents, err := ioutil.ReadFile(mount.HostPath)
+	require.NoError(t, err)
+	require.Equal(t, `nameserver 10.0.0.1
+nameserver 10.0.0.2
+search service.consul example.com
+options ndots:2 edns0
+`, string(contents))
+}
+
+func TestHostNameservers(t *testing.T) {
+	f, err := ioutil.TempFile("", "nomad-resolvconf")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+
+	_, err = f.WriteString("# comment\nnameserver 127.0.0.53\nsearch local\nnameserver ::1\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	servers, err := hostNameservers(f.Name())
+	require.NoError(t, err)
+	require.Equal(t, []string{"127.0.0.53", "::1"}, servers)
+
+	servers, err = hostNameservers(filepath.Join(os.TempDir(), "does-not-exist"))
+	require.NoError(t, err)
+	require.Empty(t, servers)
+}
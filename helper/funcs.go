@@ -190,6 +190,30 @@ func SliceSetDisjoint(first, second []string) (bool, []string) {
 	return false, flattened
 }
 
+// SliceStringEquals returns true if the slices contain the same strings in
+// the same order. Nil and empty slices are equal.
+func SliceStringEquals(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// SliceStringContains returns true if the slice contains the string.
+func SliceStringContains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
 // CompareSliceSetString returns true if the slices contain the same strings.
 // Order is ignored. The slice may be copied but is never altered. The slice is
 // assumed to be a set. Multiple instances of an entry are treated the same as
@@ -432,6 +432,7 @@ func parseUpdate(result **api.UpdateStrategy, list *ast.ObjectList) error {
 		"auto_revert",
 		"auto_promote",
 		"canary",
+		"pause_after_batch",
 	}
 	if err := helper.CheckHCLKeys(o.Val, valid); err != nil {
 		return err
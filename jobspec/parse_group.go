@@ -40,6 +40,7 @@ func parseGroups(result *api.Job, list *ast.ObjectList) error {
 		// Check for invalid keys
 		valid := []string{
 			"count",
+			"array",
 			"constraint",
 			"affinity",
 			"restart",
@@ -63,6 +64,7 @@ func parseGroups(result *api.Job, list *ast.ObjectList) error {
 		if err := hcl.DecodeObject(&m, item.Val); err != nil {
 			return err
 		}
+		delete(m, "array")
 		delete(m, "constraint")
 		delete(m, "affinity")
 		delete(m, "meta")
@@ -84,6 +86,13 @@ func parseGroups(result *api.Job, list *ast.ObjectList) error {
 			return err
 		}
 
+		// If we have an array block, then parse that
+		if o := listVal.Filter("array"); len(o.Items) > 0 {
+			if err := parseArray(&g.Array, o); err != nil {
+				return multierror.Prefix(err, fmt.Sprintf("'%s', array ->", n))
+			}
+		}
+
 		// Parse constraints
 		if o := listVal.Filter("constraint"); len(o.Items) > 0 {
 			if err := parseConstraints(&g.Constraints, o); err != nil {
@@ -209,6 +218,38 @@ func parseGroups(result *api.Job, list *ast.ObjectList) error {
 	return nil
 }
 
+func parseArray(result **api.ArrayConfig, list *ast.ObjectList) error {
+	list = list.Elem()
+	if len(list.Items) > 1 {
+		return fmt.Errorf("only one 'array' block allowed")
+	}
+
+	// Get our array object
+	obj := list.Items[0]
+
+	// Check for invalid keys
+	valid := []string{
+		"size",
+		"max_parallel",
+	}
+	if err := helper.CheckHCLKeys(obj.Val, valid); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	if err := hcl.DecodeObject(&m, obj.Val); err != nil {
+		return err
+	}
+
+	var array api.ArrayConfig
+	if err := mapstructure.WeakDecode(m, &array); err != nil {
+		return err
+	}
+	*result = &array
+
+	return nil
+}
+
 func parseEphemeralDisk(result **api.EphemeralDisk, list *ast.ObjectList) error {
 	list = list.Elem()
 	if len(list.Items) > 1 {
@@ -30,6 +30,7 @@ func parseJob(result *api.Job, list *ast.ObjectList) error {
 	}
 	delete(m, "constraint")
 	delete(m, "affinity")
+	delete(m, "depends_on")
 	delete(m, "meta")
 	delete(m, "migrate")
 	delete(m, "parameterized")
@@ -63,6 +64,7 @@ func parseJob(result *api.Job, list *ast.ObjectList) error {
 		"affinity",
 		"spread",
 		"datacenters",
+		"depends_on",
 		"group",
 		"id",
 		"meta",
@@ -126,6 +128,13 @@ func parseJob(result *api.Job, list *ast.ObjectList) error {
 		}
 	}
 
+	// Parse dependencies
+	if o := listVal.Filter("depends_on"); len(o.Items) > 0 {
+		if err := parseDependencies(&result.DependsOn, o); err != nil {
+			return multierror.Prefix(err, "depends_on ->")
+		}
+	}
+
 	// If we have a reschedule stanza, then parse that
 	if o := listVal.Filter("reschedule"); len(o.Items) > 0 {
 		if err := parseReschedulePolicy(&result.Reschedule, o); err != nil {
@@ -219,8 +228,12 @@ func parsePeriodic(result **api.PeriodicConfig, list *ast.ObjectList) error {
 	valid := []string{
 		"enabled",
 		"cron",
+		"crons",
+		"exclusions",
 		"prohibit_overlap",
 		"time_zone",
+		"catch_up",
+		"max_catch_up",
 	}
 	if err := helper.CheckHCLKeys(o.Val, valid); err != nil {
 		return err
@@ -240,6 +253,12 @@ func parsePeriodic(result **api.PeriodicConfig, list *ast.ObjectList) error {
 		m["Spec"] = cron
 	}
 
+	// If "crons" is provided, set the type to "cron" and store the specs.
+	if crons, ok := m["crons"]; ok {
+		m["SpecType"] = api.PeriodicSpecCron
+		m["Specs"] = crons
+	}
+
 	// Build the constraint
 	var p api.PeriodicConfig
 	if err := mapstructure.WeakDecode(m, &p); err != nil {
@@ -282,3 +301,31 @@ func parseParameterizedJob(result **api.ParameterizedJobConfig, list *ast.Object
 	*result = &d
 	return nil
 }
+
+func parseDependencies(result *[]*api.JobDependency, list *ast.ObjectList) error {
+	for _, o := range list.Elem().Items {
+		// Check for invalid keys
+		valid := []string{
+			"job",
+			"condition",
+		}
+		if err := helper.CheckHCLKeys(o.Val, valid); err != nil {
+			return err
+		}
+
+		var m map[string]interface{}
+		if err := hcl.DecodeObject(&m, o.Val); err != nil {
+			return err
+		}
+
+		// Build the dependency
+		var d api.JobDependency
+		if err := mapstructure.WeakDecode(m, &d); err != nil {
+			return err
+		}
+
+		*result = append(*result, &d)
+	}
+
+	return nil
+}
@@ -22,6 +22,7 @@ func ParseNetwork(o *ast.ObjectList) (*api.NetworkResource, error) {
 	valid := []string{
 		"mode",
 		"mbits",
+		"dns",
 		"port",
 	}
 	if err := helper.CheckHCLKeys(o.Items[0].Val, valid); err != nil {
@@ -33,6 +34,8 @@ func ParseNetwork(o *ast.ObjectList) (*api.NetworkResource, error) {
 	if err := hcl.DecodeObject(&m, o.Items[0].Val); err != nil {
 		return nil, err
 	}
+
+	delete(m, "dns")
 	if err := mapstructure.WeakDecode(m, &r); err != nil {
 		return nil, err
 	}
@@ -47,15 +50,54 @@ func ParseNetwork(o *ast.ObjectList) (*api.NetworkResource, error) {
 		return nil, multierror.Prefix(err, "network, ports ->")
 	}
 
+	// Filter dns
+	if dns := networkObj.Filter("dns"); len(dns.Items) > 0 {
+		if len(dns.Items) > 1 {
+			return nil, multierror.Prefix(fmt.Errorf("cannot have more than 1 dns block"), "network ->")
+		}
+
+		d, err := parseDNS(dns.Items[0])
+		if err != nil {
+			return nil, multierror.Prefix(err, "network ->")
+		}
+
+		r.DNS = d
+	}
+
 	return &r, nil
 }
 
+func parseDNS(dns *ast.ObjectItem) (*api.DNSConfig, error) {
+	valid := []string{
+		"servers",
+		"searches",
+		"options",
+	}
+
+	if err := helper.CheckHCLKeys(dns.Val, valid); err != nil {
+		return nil, multierror.Prefix(err, "dns ->")
+	}
+
+	var dnsCfg api.DNSConfig
+	var m map[string]interface{}
+	if err := hcl.DecodeObject(&m, dns.Val); err != nil {
+		return nil, err
+	}
+
+	if err := mapstructure.WeakDecode(m, &dnsCfg); err != nil {
+		return nil, err
+	}
+
+	return &dnsCfg, nil
+}
+
 func parsePorts(networkObj *ast.ObjectList, nw *api.NetworkResource) error {
 	// Check for invalid keys
 	valid := []string{
 		"mbits",
 		"port",
 		"mode",
+		"dns",
 	}
 	if err := helper.CheckHCLKeys(networkObj, valid); err != nil {
 		return err
@@ -60,6 +60,7 @@ func parseTask(item *ast.ObjectItem) (*api.Task, error) {
 		"dispatch_payload",
 		"driver",
 		"env",
+		"identity",
 		"kill_timeout",
 		"leader",
 		"logs",
@@ -88,6 +89,7 @@ func parseTask(item *ast.ObjectItem) (*api.Task, error) {
 	delete(m, "affinity")
 	delete(m, "dispatch_payload")
 	delete(m, "env")
+	delete(m, "identity")
 	delete(m, "logs")
 	delete(m, "meta")
 	delete(m, "resources")
@@ -249,6 +251,33 @@ func parseTask(item *ast.ObjectItem) (*api.Task, error) {
 		t.Vault = v
 	}
 
+	// If we have an identity block parse that
+	if o := listVal.Filter("identity"); len(o.Items) > 0 {
+		if len(o.Items) > 1 {
+			return nil, fmt.Errorf("only one identity block is allowed in a task. Number of identity blocks found: %d", len(o.Items))
+		}
+		var m map[string]interface{}
+		identityBlock := o.Items[0]
+
+		// Check for invalid keys
+		valid := []string{
+			"env",
+			"file",
+		}
+		if err := helper.CheckHCLKeys(identityBlock.Val, valid); err != nil {
+			return nil, multierror.Prefix(err, "identity ->")
+		}
+
+		if err := hcl.DecodeObject(&m, identityBlock.Val); err != nil {
+			return nil, err
+		}
+
+		t.Identity = &api.WorkloadIdentity{}
+		if err := mapstructure.WeakDecode(m, t.Identity); err != nil {
+			return nil, err
+		}
+	}
+
 	// If we have a dispatch_payload block parse that
 	if o := listVal.Filter("dispatch_payload"); len(o.Items) > 0 {
 		if len(o.Items) > 1 {
@@ -350,6 +379,7 @@ func parseTemplates(result *[]*api.Template, list *ast.ObjectList) error {
 		// Check for invalid keys
 		valid := []string{
 			"change_mode",
+			"change_script",
 			"change_signal",
 			"data",
 			"destination",
@@ -369,6 +399,7 @@ func parseTemplates(result *[]*api.Template, list *ast.ObjectList) error {
 		if err := hcl.DecodeObject(&m, o.Val); err != nil {
 			return err
 		}
+		delete(m, "change_script")
 
 		templ := &api.Template{
 			ChangeMode: helper.StringToPtr("restart"),
@@ -388,12 +419,64 @@ func parseTemplates(result *[]*api.Template, list *ast.ObjectList) error {
 			return err
 		}
 
+		// Parse the change script
+		var listVal *ast.ObjectList
+		if ot, ok := o.Val.(*ast.ObjectType); ok {
+			listVal = ot.List
+		} else {
+			return fmt.Errorf("template: should be an object")
+		}
+		if so := listVal.Filter("change_script"); len(so.Items) > 0 {
+			if len(so.Items) > 1 {
+				return fmt.Errorf("only one 'change_script' block allowed per template")
+			}
+			script, err := parseChangeScript(so.Items[0])
+			if err != nil {
+				return err
+			}
+			templ.ChangeScript = script
+		}
+
 		*result = append(*result, templ)
 	}
 
 	return nil
 }
 
+func parseChangeScript(o *ast.ObjectItem) (*api.ChangeScript, error) {
+	valid := []string{
+		"command",
+		"args",
+		"timeout",
+		"fail_on_error",
+	}
+	if err := helper.CheckHCLKeys(o.Val, valid); err != nil {
+		return nil, multierror.Prefix(err, "change_script ->")
+	}
+
+	var m map[string]interface{}
+	if err := hcl.DecodeObject(&m, o.Val); err != nil {
+		return nil, err
+	}
+
+	script := &api.ChangeScript{
+		Timeout: helper.TimeToPtr(5 * time.Second),
+	}
+	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
+		WeaklyTypedInput: true,
+		Result:           script,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := dec.Decode(m); err != nil {
+		return nil, err
+	}
+
+	return script, nil
+}
+
 func parseResources(result *api.Resources, list *ast.ObjectList) error {
 	list = list.Elem()
 	if len(list.Items) == 0 {
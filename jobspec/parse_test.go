@@ -178,6 +178,7 @@ func TestParse(t *testing.T) {
 							ProgressDeadline: helper.TimeToPtr(1 * time.Minute),
 							AutoRevert:       helper.BoolToPtr(false),
 							AutoPromote:      helper.BoolToPtr(false),
+							PauseAfterBatch:  helper.BoolToPtr(true),
 							Canary:           helper.IntToPtr(2),
 						},
 						Migrate: &api.MigrateStrategy{
@@ -324,6 +325,19 @@ func TestParse(t *testing.T) {
 										LeftDelim:  helper.StringToPtr("--"),
 										RightDelim: helper.StringToPtr("__"),
 									},
+									{
+										SourcePath: helper.StringToPtr("baz"),
+										DestPath:   helper.StringToPtr("baz"),
+										ChangeMode: helper.StringToPtr(structs.TemplateChangeModeScript),
+										ChangeScript: &api.ChangeScript{
+											Command:     helper.StringToPtr("/bin/foo"),
+											Args:        []string{"-debug", "-verbose"},
+											Timeout:     helper.TimeToPtr(5 * time.Second),
+											FailOnError: helper.BoolToPtr(false),
+										},
+										Splay: helper.TimeToPtr(5 * time.Second),
+										Perms: helper.StringToPtr("0644"),
+									},
 								},
 								Leader:     true,
 								KillSignal: "",
@@ -474,6 +488,22 @@ func TestParse(t *testing.T) {
 					Spec:            helper.StringToPtr("*/5 * * *"),
 					ProhibitOverlap: helper.BoolToPtr(true),
 					TimeZone:        helper.StringToPtr("Europe/Minsk"),
+					CatchUp:         helper.StringToPtr("all"),
+					MaxCatchUp:      helper.IntToPtr(5),
+				},
+			},
+			false,
+		},
+
+		{
+			"periodic-crons.hcl",
+			&api.Job{
+				ID:   helper.StringToPtr("foo"),
+				Name: helper.StringToPtr("foo"),
+				Periodic: &api.PeriodicConfig{
+					SpecType:   helper.StringToPtr(api.PeriodicSpecCron),
+					Specs:      []string{"0 9 * * 1-5", "0 12 * * 6"},
+					Exclusions: []string{"2019-12-25", "2019-12-31/2020-01-01"},
 				},
 			},
 			false,
@@ -705,6 +735,32 @@ func TestParse(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"task-identity.hcl",
+			&api.Job{
+				ID:   helper.StringToPtr("foo"),
+				Name: helper.StringToPtr("foo"),
+				TaskGroups: []*api.TaskGroup{
+					{
+						Name: helper.StringToPtr("bar"),
+						Tasks: []*api.Task{
+							{
+								Name:   "bar",
+								Driver: "docker",
+								Identity: &api.WorkloadIdentity{
+									Env:  helper.BoolToPtr(true),
+									File: helper.BoolToPtr(false),
+								},
+								Config: map[string]interface{}{
+									"image": "hashicorp/image",
+								},
+							},
+						},
+					},
+				},
+			},
+			false,
+		},
 		{
 			"service-check-driver-address.hcl",
 			&api.Job{
@@ -918,6 +974,67 @@ func TestParse(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"depends-on.hcl",
+			&api.Job{
+				ID:   helper.StringToPtr("report"),
+				Name: helper.StringToPtr("report"),
+				Type: helper.StringToPtr("batch"),
+				DependsOn: []*api.JobDependency{
+					{
+						JobID: helper.StringToPtr("extract"),
+					},
+					{
+						JobID:     helper.StringToPtr("cleanup"),
+						Condition: helper.StringToPtr("complete"),
+					},
+				},
+				TaskGroups: []*api.TaskGroup{
+					{
+						Name: helper.StringToPtr("report"),
+						Tasks: []*api.Task{
+							{
+								Name:   "report",
+								Driver: "exec",
+								Config: map[string]interface{}{
+									"command": "/bin/report",
+								},
+							},
+						},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"array-job.hcl",
+			&api.Job{
+				ID:          helper.StringToPtr("foo"),
+				Name:        helper.StringToPtr("foo"),
+				Type:        helper.StringToPtr("batch"),
+				Datacenters: []string{"dc1"},
+				TaskGroups: []*api.TaskGroup{
+					{
+						Name: helper.StringToPtr("bar"),
+						Array: &api.ArrayConfig{
+							Size:        helper.IntToPtr(500),
+							MaxParallel: helper.IntToPtr(20),
+						},
+						Tasks: []*api.Task{
+							{
+								Name:   "bar",
+								Driver: "raw_exec",
+								Config: map[string]interface{}{
+									"command": "bash",
+									"args":    []interface{}{"-c", "echo ${NOMAD_ARRAY_INDEX}"},
+								},
+							},
+						},
+					},
+				},
+			},
+			false,
+		},
 		{
 			"tg-network.hcl",
 			&api.Job{
@@ -933,11 +1050,17 @@ func TestParse(t *testing.T) {
 								Mode: "bridge",
 								ReservedPorts: []api.Port{
 									{
-										Label: "http",
-										Value: 80,
-										To:    8080,
+										Label:       "http",
+										Value:       80,
+										To:          8080,
+										HostNetwork: "public",
 									},
 								},
+								DNS: &api.DNSConfig{
+									Servers:  []string{"8.8.8.8"},
+									Searches: []string{"service.consul"},
+									Options:  []string{"ndots:2"},
+								},
 							},
 						},
 						Services: []*api.Service{
@@ -0,0 +1,20 @@
+job "foo" {
+  datacenters = ["dc1"]
+  type        = "batch"
+
+  group "bar" {
+    array {
+      size         = 500
+      max_parallel = 20
+    }
+
+    task "bar" {
+      driver = "raw_exec"
+
+      config {
+        command = "bash"
+        args    = ["-c", "echo ${NOMAD_ARRAY_INDEX}"]
+      }
+    }
+  }
+}
@@ -98,6 +98,7 @@ job "binstore-storagelocker" {
       progress_deadline = "1m"
       auto_revert       = false
       auto_promote      = false
+      pause_after_batch = true
       canary            = 2
     }
 
@@ -278,6 +279,19 @@ job "binstore-storagelocker" {
         left_delimiter  = "--"
         right_delimiter = "__"
       }
+
+      template {
+        source      = "baz"
+        destination = "baz"
+        change_mode = "script"
+
+        change_script {
+          command       = "/bin/foo"
+          args          = ["-debug", "-verbose"]
+          timeout       = "5s"
+          fail_on_error = false
+        }
+      }
     }
 
     task "storagelocker" {
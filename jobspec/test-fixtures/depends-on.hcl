@@ -0,0 +1,22 @@
+job "report" {
+  type = "batch"
+
+  depends_on {
+    job = "extract"
+  }
+
+  depends_on {
+    job       = "cleanup"
+    condition = "complete"
+  }
+
+  group "report" {
+    task "report" {
+      driver = "exec"
+
+      config {
+        command = "/bin/report"
+      }
+    }
+  }
+}
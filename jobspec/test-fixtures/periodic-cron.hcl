@@ -3,5 +3,7 @@ job "foo" {
     cron             = "*/5 * * *"
     prohibit_overlap = true
     time_zone        = "Europe/Minsk"
+    catch_up         = "all"
+    max_catch_up     = 5
   }
 }
@@ -0,0 +1,6 @@
+job "foo" {
+  periodic {
+    crons      = ["0 9 * * 1-5", "0 12 * * 6"]
+    exclusions = ["2019-12-25", "2019-12-31/2020-01-01"]
+  }
+}
@@ -0,0 +1,14 @@
+job "foo" {
+  task "bar" {
+    driver = "docker"
+
+    identity {
+      env  = true
+      file = false
+    }
+
+    config {
+      image = "hashicorp/image"
+    }
+  }
+}
@@ -8,8 +8,15 @@ job "foo" {
       mode = "bridge"
 
       port "http" {
-        static = 80
-        to     = 8080
+        static       = 80
+        to           = 8080
+        host_network = "public"
+      }
+
+      dns {
+        servers  = ["8.8.8.8"]
+        searches = ["service.consul"]
+        options  = ["ndots:2"]
       }
     }
 
@@ -13,6 +13,7 @@ import (
 	// into their command logic. This is because they are run as separate
 	// processes along side of a task. By early importing them we can avoid
 	// additional code being imported and thus reserving memory
+	_ "github.com/hashicorp/nomad/client/allocrunner/taskrunner/getter"
 	_ "github.com/hashicorp/nomad/client/logmon"
 	_ "github.com/hashicorp/nomad/drivers/docker/docklog"
 	_ "github.com/hashicorp/nomad/drivers/shared/executor"
@@ -32,6 +33,7 @@ var (
 	// commands above.
 	hidden = []string{
 		"alloc-status",
+		"artifact-getter",
 		"check",
 		"client-config",
 		"eval-status",
@@ -1,6 +1,7 @@
 package nomad
 
 import (
+	"fmt"
 	"time"
 
 	metrics "github.com/armon/go-metrics"
@@ -31,10 +32,60 @@ func (s *Server) ResolveToken(secretID string) (*acl.ACL, error) {
 		return nil, err
 	}
 
+	// Resolve workload identities signed for tasks
+	if isIdentityToken(secretID) {
+		aclObj, err := resolveIdentityFromSnapshot(snap, secretID, time.Now())
+		if err != nil {
+			s.logger.Debug("failed to resolve workload identity", "error", err)
+			return nil, structs.ErrTokenNotFound
+		}
+		return aclObj, nil
+	}
+
 	// Resolve the ACL
 	return resolveTokenFromSnapshotCache(snap, s.aclCache, secretID)
 }
 
+// ResolveSecretToken is used to translate an ACL Token Secret ID into the
+// ACL token, nil if ACLs are disabled or the token doesn't exist.
+func (s *Server) ResolveSecretToken(secretID string) (*structs.ACLToken, error) {
+	if !s.config.ACLEnabled {
+		return nil, nil
+	}
+	if secretID == "" {
+		return structs.AnonymousACLToken, nil
+	}
+
+	snap, err := s.fsm.State().Snapshot()
+	if err != nil {
+		return nil, err
+	}
+	return snap.ACLTokenBySecretID(nil, secretID)
+}
+
+// resolveIdentityFromSnapshot is used to resolve the ACL object of a workload
+// identity. The identity grants capabilities on its job for as long as its
+// allocation isn't terminal.
+func resolveIdentityFromSnapshot(snap *state.StateSnapshot, token string, now time.Time) (*acl.ACL, error) {
+	claims, err := verifyIdentity(snap, token, now)
+	if err != nil {
+		return nil, err
+	}
+
+	alloc, err := snap.AllocByID(nil, claims.AllocID)
+	if err != nil {
+		return nil, err
+	}
+	if alloc == nil || alloc.TerminalStatus() {
+		return nil, fmt.Errorf("allocation %q of workload identity is not running", claims.AllocID)
+	}
+	if alloc.Namespace != claims.Namespace || alloc.JobID != claims.JobID {
+		return nil, fmt.Errorf("workload identity claims don't match allocation %q", claims.AllocID)
+	}
+
+	return acl.NewWorkloadACL(claims.Namespace, claims.JobID), nil
+}
+
 // resolveTokenFromSnapshotCache is used to resolve an ACL object from a snapshot of state,
 // using a cache to avoid parsing and ACL construction when possible. It is split from resolveToken
 // to simplify testing.
@@ -54,6 +105,9 @@ func resolveTokenFromSnapshotCache(snap *state.StateSnapshot, cache *lru.TwoQueu
 		if token == nil {
 			return nil, structs.ErrTokenNotFound
 		}
+		if token.IsExpired(time.Now()) {
+			return nil, structs.ErrTokenExpired
+		}
 	}
 
 	// Check if this is a management token
@@ -61,9 +115,13 @@ func resolveTokenFromSnapshotCache(snap *state.StateSnapshot, cache *lru.TwoQueu
 		return acl.ManagementACL, nil
 	}
 
-	// Get all associated policies
-	policies := make([]*structs.ACLPolicy, 0, len(token.Policies))
-	for _, policyName := range token.Policies {
+	// Get all associated policies, including the policies of its roles
+	policyNames, err := aclTokenPolicyNames(&snap.StateStore, token)
+	if err != nil {
+		return nil, err
+	}
+	policies := make([]*structs.ACLPolicy, 0, len(policyNames))
+	for _, policyName := range policyNames {
 		policy, err := snap.ACLPolicyByName(nil, policyName)
 		if err != nil {
 			return nil, err
@@ -84,3 +142,36 @@ func resolveTokenFromSnapshotCache(snap *state.StateSnapshot, cache *lru.TwoQueu
 	}
 	return aclObj, nil
 }
+
+// aclTokenPolicyNames returns the names of the policies associated with a
+// token, directly or through its roles. Roles that don't exist are ignored,
+// since they don't grant any more privilege.
+func aclTokenPolicyNames(state *state.StateStore, token *structs.ACLToken) ([]string, error) {
+	if len(token.Roles) == 0 {
+		return token.Policies, nil
+	}
+
+	seen := make(map[string]struct{}, len(token.Policies))
+	names := make([]string, 0, len(token.Policies))
+	add := func(policies []string) {
+		for _, name := range policies {
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			seen[name] = struct{}{}
+			names = append(names, name)
+		}
+	}
+
+	add(token.Policies)
+	for _, roleName := range token.Roles {
+		role, err := state.ACLRoleByName(nil, roleName)
+		if err != nil {
+			return nil, err
+		}
+		if role != nil {
+			add(role.Policies)
+		}
+	}
+	return names, nil
+}
@@ -1,6 +1,7 @@
 package nomad
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -12,6 +13,7 @@ import (
 	log "github.com/hashicorp/go-hclog"
 	memdb "github.com/hashicorp/go-memdb"
 	policy "github.com/hashicorp/nomad/acl"
+	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/hashicorp/nomad/nomad/state"
 	"github.com/hashicorp/nomad/nomad/structs"
@@ -149,8 +151,13 @@ func (a *ACL) ListPolicies(args *structs.ACLPolicyListRequest, reply *structs.AC
 			return structs.ErrTokenNotFound
 		}
 
-		policies = make(map[string]struct{}, len(token.Policies))
-		for _, p := range token.Policies {
+		names, err := aclTokenPolicyNames(&snap.StateStore, token)
+		if err != nil {
+			return err
+		}
+
+		policies = make(map[string]struct{}, len(names))
+		for _, p := range names {
 			policies[p] = struct{}{}
 		}
 	}
@@ -160,33 +167,895 @@ func (a *ACL) ListPolicies(args *structs.ACLPolicyListRequest, reply *structs.AC
 		queryOpts: &args.QueryOptions,
 		queryMeta: &reply.QueryMeta,
 		run: func(ws memdb.WatchSet, state *state.StateStore) error {
-			// Iterate over all the policies
-			var err error
-			var iter memdb.ResultIterator
-			if prefix := args.QueryOptions.Prefix; prefix != "" {
-				iter, err = state.ACLPolicyByNamePrefix(ws, prefix)
-			} else {
-				iter, err = state.ACLPolicies(ws)
-			}
+			// Iterate over all the policies
+			var err error
+			var iter memdb.ResultIterator
+			if prefix := args.QueryOptions.Prefix; prefix != "" {
+				iter, err = state.ACLPolicyByNamePrefix(ws, prefix)
+			} else {
+				iter, err = state.ACLPolicies(ws)
+			}
+			if err != nil {
+				return err
+			}
+
+			// Convert all the policies to a list stub
+			reply.Policies = nil
+			for {
+				raw := iter.Next()
+				if raw == nil {
+					break
+				}
+				policy := raw.(*structs.ACLPolicy)
+				if _, ok := policies[policy.Name]; ok || mgt {
+					reply.Policies = append(reply.Policies, policy.Stub())
+				}
+			}
+
+			// Use the last index that affected the policy table
+			index, err := state.Index("acl_policy")
+			if err != nil {
+				return err
+			}
+
+			// Ensure we never set the index to zero, otherwise a blocking query cannot be used.
+			// We floor the index at one, since realistically the first write must have a higher index.
+			if index == 0 {
+				index = 1
+			}
+			reply.Index = index
+			return nil
+		}}
+	return a.srv.blockingRPC(&opts)
+}
+
+// GetPolicy is used to get a specific policy
+func (a *ACL) GetPolicy(args *structs.ACLPolicySpecificRequest, reply *structs.SingleACLPolicyResponse) error {
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+	if done, err := a.srv.forward("ACL.GetPolicy", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "get_policy"}, time.Now())
+
+	// Check management level permissions
+	acl, err := a.srv.ResolveToken(args.AuthToken)
+	if err != nil {
+		return err
+	} else if acl == nil {
+		return structs.ErrPermissionDenied
+	}
+
+	// If the policy is the anonymous one, anyone can get it
+	// If it is not a management token determine if it can get this policy
+	mgt := acl.IsManagement()
+	if !mgt && args.Name != "anonymous" {
+		snap, err := a.srv.fsm.State().Snapshot()
+		if err != nil {
+			return err
+		}
+
+		token, err := snap.ACLTokenBySecretID(nil, args.AuthToken)
+		if err != nil {
+			return err
+		}
+		if token == nil {
+			return structs.ErrTokenNotFound
+		}
+
+		names, err := aclTokenPolicyNames(&snap.StateStore, token)
+		if err != nil {
+			return err
+		}
+
+		found := false
+		for _, p := range names {
+			if p == args.Name {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return structs.ErrPermissionDenied
+		}
+	}
+
+	// Setup the blocking query
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, state *state.StateStore) error {
+			// Look for the policy
+			out, err := state.ACLPolicyByName(ws, args.Name)
+			if err != nil {
+				return err
+			}
+
+			// Setup the output
+			reply.Policy = out
+			if out != nil {
+				reply.Index = out.ModifyIndex
+				rules, err := policy.Parse(out.Rules)
+
+				if err != nil {
+					return err
+				}
+				reply.Policy.RulesJSON = rules
+			} else {
+				// Use the last index that affected the policy table
+				index, err := state.Index("acl_policy")
+				if err != nil {
+					return err
+				}
+				reply.Index = index
+			}
+			return nil
+		}}
+	return a.srv.blockingRPC(&opts)
+}
+
+// GetPolicies is used to get a set of policies
+func (a *ACL) GetPolicies(args *structs.ACLPolicySetRequest, reply *structs.ACLPolicySetResponse) error {
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+	if done, err := a.srv.forward("ACL.GetPolicies", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "get_policies"}, time.Now())
+
+	var token *structs.ACLToken
+	var err error
+	if args.AuthToken == "" {
+		// No need to look up the anonymous token
+		token = structs.AnonymousACLToken
+	} else {
+		// For client typed tokens, allow them to query any policies associated with that token.
+		// This is used by clients which are resolving the policies to enforce. Any associated
+		// policies need to be fetched so that the client can determine what to allow.
+		token, err = a.srv.State().ACLTokenBySecretID(nil, args.AuthToken)
+		if err != nil {
+			return err
+		}
+	}
+
+	if token == nil {
+		return structs.ErrTokenNotFound
+	}
+	if token.IsExpired(time.Now()) {
+		return structs.ErrTokenExpired
+	}
+	if token.Type != structs.ACLManagementToken {
+		// Tokens may query the policies of their roles
+		names, err := aclTokenPolicyNames(a.srv.State(), token)
+		if err != nil {
+			return err
+		}
+		allowed := &structs.ACLToken{Policies: names}
+		if !allowed.PolicySubset(args.Names) {
+			return structs.ErrPermissionDenied
+		}
+	}
+
+	// Setup the blocking query
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, state *state.StateStore) error {
+			// Setup the output
+			reply.Policies = make(map[string]*structs.ACLPolicy, len(args.Names))
+
+			// Look for the policy
+			for _, policyName := range args.Names {
+				out, err := state.ACLPolicyByName(ws, policyName)
+				if err != nil {
+					return err
+				}
+				if out != nil {
+					reply.Policies[policyName] = out
+				}
+			}
+
+			// Use the last index that affected the policy table
+			index, err := state.Index("acl_policy")
+			if err != nil {
+				return err
+			}
+			reply.Index = index
+			return nil
+		}}
+	return a.srv.blockingRPC(&opts)
+}
+
+// UpsertRoles is used to create or update a set of roles
+func (a *ACL) UpsertRoles(args *structs.ACLRoleUpsertRequest, reply *structs.GenericResponse) error {
+	// Ensure ACLs are enabled, and always flow modification requests to the authoritative region
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+	args.Region = a.srv.config.AuthoritativeRegion
+
+	if done, err := a.srv.forward("ACL.UpsertRoles", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "upsert_roles"}, time.Now())
+
+	// Check management level permissions
+	if acl, err := a.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if acl == nil || !acl.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	// Validate non-zero set of roles
+	if len(args.Roles) == 0 {
+		return structs.NewErrRPCCoded(400, "must specify as least one role")
+	}
+
+	// Snapshot the state
+	state, err := a.srv.State().Snapshot()
+	if err != nil {
+		return err
+	}
+
+	// Validate each role and that its policies exist, compute hash
+	for idx, role := range args.Roles {
+		if err := role.Validate(); err != nil {
+			return structs.NewErrRPCCodedf(400, "role %d invalid: %v", idx, err)
+		}
+		for _, policyName := range role.Policies {
+			policy, err := state.ACLPolicyByName(nil, policyName)
+			if err != nil {
+				return structs.NewErrRPCCodedf(400, "policy lookup failed: %v", err)
+			}
+			if policy == nil {
+				return structs.NewErrRPCCodedf(400, "role %d invalid: cannot find policy %s", idx, policyName)
+			}
+		}
+		role.SetHash()
+	}
+
+	// Update via Raft
+	_, index, err := a.srv.raftApply(structs.ACLRoleUpsertRequestType, args)
+	if err != nil {
+		return err
+	}
+
+	// Update the index
+	reply.Index = index
+	return nil
+}
+
+// DeleteRoles is used to delete roles
+func (a *ACL) DeleteRoles(args *structs.ACLRoleDeleteRequest, reply *structs.GenericResponse) error {
+	// Ensure ACLs are enabled, and always flow modification requests to the authoritative region
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+	args.Region = a.srv.config.AuthoritativeRegion
+
+	if done, err := a.srv.forward("ACL.DeleteRoles", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "delete_roles"}, time.Now())
+
+	// Check management level permissions
+	if acl, err := a.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if acl == nil || !acl.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	// Validate non-zero set of roles
+	if len(args.Names) == 0 {
+		return structs.NewErrRPCCoded(400, "must specify as least one role")
+	}
+
+	// Update via Raft
+	_, index, err := a.srv.raftApply(structs.ACLRoleDeleteRequestType, args)
+	if err != nil {
+		return err
+	}
+
+	// Update the index
+	reply.Index = index
+	return nil
+}
+
+// ListRoles is used to list the roles
+func (a *ACL) ListRoles(args *structs.ACLRoleListRequest, reply *structs.ACLRoleListResponse) error {
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+	if done, err := a.srv.forward("ACL.ListRoles", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "list_roles"}, time.Now())
+
+	// Check management level permissions
+	acl, err := a.srv.ResolveToken(args.AuthToken)
+	if err != nil {
+		return err
+	} else if acl == nil {
+		return structs.ErrPermissionDenied
+	}
+
+	// If it is not a management token determine the roles that may be listed
+	mgt := acl.IsManagement()
+	var roles map[string]struct{}
+	if !mgt {
+		token, err := a.requestACLToken(args.AuthToken)
+		if err != nil {
+			return err
+		}
+
+		roles = make(map[string]struct{}, len(token.Roles))
+		for _, r := range token.Roles {
+			roles[r] = struct{}{}
+		}
+	}
+
+	// Setup the blocking query
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, state *state.StateStore) error {
+			// Iterate over all the roles
+			var err error
+			var iter memdb.ResultIterator
+			if prefix := args.QueryOptions.Prefix; prefix != "" {
+				iter, err = state.ACLRoleByNamePrefix(ws, prefix)
+			} else {
+				iter, err = state.ACLRoles(ws)
+			}
+			if err != nil {
+				return err
+			}
+
+			// Convert all the roles to a list stub
+			reply.Roles = nil
+			for {
+				raw := iter.Next()
+				if raw == nil {
+					break
+				}
+				role := raw.(*structs.ACLRole)
+				if _, ok := roles[role.Name]; ok || mgt {
+					reply.Roles = append(reply.Roles, role.Stub())
+				}
+			}
+
+			// Use the last index that affected the role table
+			index, err := state.Index("acl_role")
+			if err != nil {
+				return err
+			}
+
+			// Ensure we never set the index to zero, otherwise a blocking query cannot be used.
+			// We floor the index at one, since realistically the first write must have a higher index.
+			if index == 0 {
+				index = 1
+			}
+			reply.Index = index
+			return nil
+		}}
+	return a.srv.blockingRPC(&opts)
+}
+
+// GetRole is used to get a specific role
+func (a *ACL) GetRole(args *structs.ACLRoleSpecificRequest, reply *structs.SingleACLRoleResponse) error {
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+	if done, err := a.srv.forward("ACL.GetRole", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "get_role"}, time.Now())
+
+	// Check management level permissions
+	acl, err := a.srv.ResolveToken(args.AuthToken)
+	if err != nil {
+		return err
+	} else if acl == nil {
+		return structs.ErrPermissionDenied
+	}
+
+	// If it is not a management token determine if it can get this role
+	if !acl.IsManagement() {
+		token, err := a.requestACLToken(args.AuthToken)
+		if err != nil {
+			return err
+		}
+		if !helper.SliceStringContains(token.Roles, args.Name) {
+			return structs.ErrPermissionDenied
+		}
+	}
+
+	// Setup the blocking query
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, state *state.StateStore) error {
+			// Look for the role
+			out, err := state.ACLRoleByName(ws, args.Name)
+			if err != nil {
+				return err
+			}
+
+			// Setup the output
+			reply.Role = out
+			if out != nil {
+				reply.Index = out.ModifyIndex
+			} else {
+				// Use the last index that affected the role table
+				index, err := state.Index("acl_role")
+				if err != nil {
+					return err
+				}
+				reply.Index = index
+			}
+			return nil
+		}}
+	return a.srv.blockingRPC(&opts)
+}
+
+// GetRoles is used to get a set of roles
+func (a *ACL) GetRoles(args *structs.ACLRoleSetRequest, reply *structs.ACLRoleSetResponse) error {
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+	if done, err := a.srv.forward("ACL.GetRoles", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "get_roles"}, time.Now())
+
+	// For client typed tokens, allow them to query any roles associated with
+	// that token. This is used by clients which are resolving the policies to
+	// enforce.
+	token, err := a.requestACLToken(args.AuthToken)
+	if err != nil {
+		return err
+	}
+	if token.Type != structs.ACLManagementToken {
+		for _, name := range args.Names {
+			if !helper.SliceStringContains(token.Roles, name) {
+				return structs.ErrPermissionDenied
+			}
+		}
+	}
+
+	// Setup the blocking query
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, state *state.StateStore) error {
+			// Setup the output
+			reply.Roles = make(map[string]*structs.ACLRole, len(args.Names))
+
+			// Look for the roles
+			for _, roleName := range args.Names {
+				out, err := state.ACLRoleByName(ws, roleName)
+				if err != nil {
+					return err
+				}
+				if out != nil {
+					reply.Roles[roleName] = out
+				}
+			}
+
+			// Use the last index that affected the role table
+			index, err := state.Index("acl_role")
+			if err != nil {
+				return err
+			}
+			reply.Index = index
+			return nil
+		}}
+	return a.srv.blockingRPC(&opts)
+}
+
+// UpsertAuthMethods is used to create or update a set of auth methods
+func (a *ACL) UpsertAuthMethods(args *structs.ACLAuthMethodUpsertRequest, reply *structs.GenericResponse) error {
+	// Ensure ACLs are enabled, and always flow modification requests to the authoritative region
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+	args.Region = a.srv.config.AuthoritativeRegion
+
+	if done, err := a.srv.forward("ACL.UpsertAuthMethods", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "upsert_auth_methods"}, time.Now())
+
+	// Check management level permissions
+	if acl, err := a.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if acl == nil || !acl.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	// Validate non-zero set of auth methods
+	if len(args.AuthMethods) == 0 {
+		return structs.NewErrRPCCoded(400, "must specify as least one auth method")
+	}
+
+	// Snapshot the state
+	state, err := a.srv.State().Snapshot()
+	if err != nil {
+		return err
+	}
+
+	// Validate each auth method and that there is at most one default, compute hash
+	var defaultMethod string
+	for idx, method := range args.AuthMethods {
+		if err := method.Validate(); err != nil {
+			return structs.NewErrRPCCodedf(400, "auth method %d invalid: %v", idx, err)
+		}
+		if method.Default {
+			if defaultMethod != "" {
+				return structs.NewErrRPCCodedf(400, "auth method %d invalid: default auth method is %s", idx, defaultMethod)
+			}
+			defaultMethod = method.Name
+		}
+		method.SetHash()
+	}
+	if defaultMethod != "" {
+		iter, err := state.ACLAuthMethods(nil)
+		if err != nil {
+			return err
+		}
+		for raw := iter.Next(); raw != nil; raw = iter.Next() {
+			method := raw.(*structs.ACLAuthMethod)
+			if method.Default && method.Name != defaultMethod {
+				return structs.NewErrRPCCodedf(400, "default auth method is already %s", method.Name)
+			}
+		}
+	}
+
+	// Update via Raft
+	_, index, err := a.srv.raftApply(structs.ACLAuthMethodUpsertRequestType, args)
+	if err != nil {
+		return err
+	}
+
+	// Update the index
+	reply.Index = index
+	return nil
+}
+
+// DeleteAuthMethods is used to delete auth methods and their binding rules
+func (a *ACL) DeleteAuthMethods(args *structs.ACLAuthMethodDeleteRequest, reply *structs.GenericResponse) error {
+	// Ensure ACLs are enabled, and always flow modification requests to the authoritative region
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+	args.Region = a.srv.config.AuthoritativeRegion
+
+	if done, err := a.srv.forward("ACL.DeleteAuthMethods", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "delete_auth_methods"}, time.Now())
+
+	// Check management level permissions
+	if acl, err := a.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if acl == nil || !acl.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	// Validate non-zero set of auth methods
+	if len(args.Names) == 0 {
+		return structs.NewErrRPCCoded(400, "must specify as least one auth method")
+	}
+
+	// Update via Raft
+	_, index, err := a.srv.raftApply(structs.ACLAuthMethodDeleteRequestType, args)
+	if err != nil {
+		return err
+	}
+
+	// Update the index
+	reply.Index = index
+	return nil
+}
+
+// ListAuthMethods is used to list the auth methods. Any token may list them,
+// since the stubs are needed to log in.
+func (a *ACL) ListAuthMethods(args *structs.ACLAuthMethodListRequest, reply *structs.ACLAuthMethodListResponse) error {
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+	if done, err := a.srv.forward("ACL.ListAuthMethods", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "list_auth_methods"}, time.Now())
+
+	// Setup the blocking query
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, state *state.StateStore) error {
+			// Iterate over all the auth methods
+			iter, err := state.ACLAuthMethods(ws)
+			if err != nil {
+				return err
+			}
+
+			// Convert all the auth methods to a list stub
+			reply.AuthMethods = nil
+			for raw := iter.Next(); raw != nil; raw = iter.Next() {
+				method := raw.(*structs.ACLAuthMethod)
+				reply.AuthMethods = append(reply.AuthMethods, method.Stub())
+			}
+
+			// Use the last index that affected the auth method table
+			index, err := state.Index("acl_auth_method")
+			if err != nil {
+				return err
+			}
+
+			// Ensure we never set the index to zero, otherwise a blocking query cannot be used.
+			// We floor the index at one, since realistically the first write must have a higher index.
+			if index == 0 {
+				index = 1
+			}
+			reply.Index = index
+			return nil
+		}}
+	return a.srv.blockingRPC(&opts)
+}
+
+// GetAuthMethod is used to get a specific auth method
+func (a *ACL) GetAuthMethod(args *structs.ACLAuthMethodSpecificRequest, reply *structs.SingleACLAuthMethodResponse) error {
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+	if done, err := a.srv.forward("ACL.GetAuthMethod", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "get_auth_method"}, time.Now())
+
+	// Check management level permissions, the config holds the client secret
+	if acl, err := a.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if acl == nil || !acl.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	// Setup the blocking query
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, state *state.StateStore) error {
+			// Look for the auth method
+			out, err := state.ACLAuthMethodByName(ws, args.Name)
+			if err != nil {
+				return err
+			}
+
+			// Setup the output
+			reply.AuthMethod = out
+			if out != nil {
+				reply.Index = out.ModifyIndex
+			} else {
+				// Use the last index that affected the auth method table
+				index, err := state.Index("acl_auth_method")
+				if err != nil {
+					return err
+				}
+				reply.Index = index
+			}
+			return nil
+		}}
+	return a.srv.blockingRPC(&opts)
+}
+
+// GetAuthMethods is used to get a set of auth methods
+func (a *ACL) GetAuthMethods(args *structs.ACLAuthMethodSetRequest, reply *structs.ACLAuthMethodSetResponse) error {
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+	if done, err := a.srv.forward("ACL.GetAuthMethods", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "get_auth_methods"}, time.Now())
+
+	// Check management level permissions
+	if acl, err := a.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if acl == nil || !acl.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	// Setup the blocking query
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, state *state.StateStore) error {
+			// Setup the output
+			reply.AuthMethods = make(map[string]*structs.ACLAuthMethod, len(args.Names))
+
+			// Look for the auth methods
+			for _, name := range args.Names {
+				out, err := state.ACLAuthMethodByName(ws, name)
+				if err != nil {
+					return err
+				}
+				if out != nil {
+					reply.AuthMethods[name] = out
+				}
+			}
+
+			// Use the last index that affected the auth method table
+			index, err := state.Index("acl_auth_method")
+			if err != nil {
+				return err
+			}
+			reply.Index = index
+			return nil
+		}}
+	return a.srv.blockingRPC(&opts)
+}
+
+// UpsertBindingRules is used to create or update a set of binding rules
+func (a *ACL) UpsertBindingRules(args *structs.ACLBindingRuleUpsertRequest, reply *structs.ACLBindingRuleUpsertResponse) error {
+	// Ensure ACLs are enabled, and always flow modification requests to the authoritative region
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+	args.Region = a.srv.config.AuthoritativeRegion
+
+	if done, err := a.srv.forward("ACL.UpsertBindingRules", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "upsert_binding_rules"}, time.Now())
+
+	// Check management level permissions
+	if acl, err := a.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if acl == nil || !acl.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	// Validate non-zero set of binding rules
+	if len(args.BindingRules) == 0 {
+		return structs.NewErrRPCCoded(400, "must specify as least one binding rule")
+	}
+
+	// Snapshot the state
+	state, err := a.srv.State().Snapshot()
+	if err != nil {
+		return err
+	}
+
+	// Validate each binding rule and that its auth method exists, compute hash
+	for idx, rule := range args.BindingRules {
+		if err := rule.Validate(); err != nil {
+			return structs.NewErrRPCCodedf(400, "binding rule %d invalid: %v", idx, err)
+		}
+		method, err := state.ACLAuthMethodByName(nil, rule.AuthMethod)
+		if err != nil {
+			return structs.NewErrRPCCodedf(400, "auth method lookup failed: %v", err)
+		}
+		if method == nil {
+			return structs.NewErrRPCCodedf(400, "binding rule %d invalid: cannot find auth method %s", idx, rule.AuthMethod)
+		}
+
+		// Generate an ID if new
+		if rule.ID == "" {
+			rule.ID = uuid.Generate()
+		} else {
+			out, err := state.ACLBindingRuleByID(nil, rule.ID)
+			if err != nil {
+				return structs.NewErrRPCCodedf(400, "binding rule lookup failed: %v", err)
+			}
+			if out == nil {
+				return structs.NewErrRPCCodedf(404, "cannot find binding rule %s", rule.ID)
+			}
+		}
+		rule.SetHash()
+	}
+
+	// Update via Raft
+	_, index, err := a.srv.raftApply(structs.ACLBindingRuleUpsertRequestType, args)
+	if err != nil {
+		return err
+	}
+
+	// Populate the response. We do a lookup against the state to
+	// pickup the proper create / modify indexes.
+	state, err = a.srv.State().Snapshot()
+	if err != nil {
+		return err
+	}
+	for _, rule := range args.BindingRules {
+		out, err := state.ACLBindingRuleByID(nil, rule.ID)
+		if err != nil {
+			return structs.NewErrRPCCodedf(400, "binding rule lookup failed: %v", err)
+		}
+		reply.BindingRules = append(reply.BindingRules, out)
+	}
+
+	// Update the index
+	reply.Index = index
+	return nil
+}
+
+// DeleteBindingRules is used to delete binding rules
+func (a *ACL) DeleteBindingRules(args *structs.ACLBindingRuleDeleteRequest, reply *structs.GenericResponse) error {
+	// Ensure ACLs are enabled, and always flow modification requests to the authoritative region
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+	args.Region = a.srv.config.AuthoritativeRegion
+
+	if done, err := a.srv.forward("ACL.DeleteBindingRules", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "delete_binding_rules"}, time.Now())
+
+	// Check management level permissions
+	if acl, err := a.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if acl == nil || !acl.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	// Validate non-zero set of binding rules
+	if len(args.IDs) == 0 {
+		return structs.NewErrRPCCoded(400, "must specify as least one binding rule")
+	}
+
+	// Update via Raft
+	_, index, err := a.srv.raftApply(structs.ACLBindingRuleDeleteRequestType, args)
+	if err != nil {
+		return err
+	}
+
+	// Update the index
+	reply.Index = index
+	return nil
+}
+
+// ListBindingRules is used to list the binding rules
+func (a *ACL) ListBindingRules(args *structs.ACLBindingRuleListRequest, reply *structs.ACLBindingRuleListResponse) error {
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+	if done, err := a.srv.forward("ACL.ListBindingRules", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "list_binding_rules"}, time.Now())
+
+	// Check management level permissions
+	if acl, err := a.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if acl == nil || !acl.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	// Setup the blocking query
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, state *state.StateStore) error {
+			// Iterate over all the binding rules
+			iter, err := state.ACLBindingRules(ws)
 			if err != nil {
 				return err
 			}
 
-			// Convert all the policies to a list stub
-			reply.Policies = nil
-			for {
-				raw := iter.Next()
-				if raw == nil {
-					break
-				}
-				policy := raw.(*structs.ACLPolicy)
-				if _, ok := policies[policy.Name]; ok || mgt {
-					reply.Policies = append(reply.Policies, policy.Stub())
-				}
+			// Convert all the binding rules to a list stub
+			reply.BindingRules = nil
+			for raw := iter.Next(); raw != nil; raw = iter.Next() {
+				rule := raw.(*structs.ACLBindingRule)
+				reply.BindingRules = append(reply.BindingRules, rule.Stub())
 			}
 
-			// Use the last index that affected the policy table
-			index, err := state.Index("acl_policy")
+			// Use the last index that affected the binding rule table
+			index, err := state.Index("acl_binding_rule")
 			if err != nil {
 				return err
 			}
@@ -202,78 +1071,41 @@ func (a *ACL) ListPolicies(args *structs.ACLPolicyListRequest, reply *structs.AC
 	return a.srv.blockingRPC(&opts)
 }
 
-// GetPolicy is used to get a specific policy
-func (a *ACL) GetPolicy(args *structs.ACLPolicySpecificRequest, reply *structs.SingleACLPolicyResponse) error {
+// GetBindingRule is used to get a specific binding rule
+func (a *ACL) GetBindingRule(args *structs.ACLBindingRuleSpecificRequest, reply *structs.SingleACLBindingRuleResponse) error {
 	if !a.srv.config.ACLEnabled {
 		return aclDisabled
 	}
-	if done, err := a.srv.forward("ACL.GetPolicy", args, args, reply); done {
+	if done, err := a.srv.forward("ACL.GetBindingRule", args, args, reply); done {
 		return err
 	}
-	defer metrics.MeasureSince([]string{"nomad", "acl", "get_policy"}, time.Now())
+	defer metrics.MeasureSince([]string{"nomad", "acl", "get_binding_rule"}, time.Now())
 
 	// Check management level permissions
-	acl, err := a.srv.ResolveToken(args.AuthToken)
-	if err != nil {
+	if acl, err := a.srv.ResolveToken(args.AuthToken); err != nil {
 		return err
-	} else if acl == nil {
+	} else if acl == nil || !acl.IsManagement() {
 		return structs.ErrPermissionDenied
 	}
 
-	// If the policy is the anonymous one, anyone can get it
-	// If it is not a management token determine if it can get this policy
-	mgt := acl.IsManagement()
-	if !mgt && args.Name != "anonymous" {
-		snap, err := a.srv.fsm.State().Snapshot()
-		if err != nil {
-			return err
-		}
-
-		token, err := snap.ACLTokenBySecretID(nil, args.AuthToken)
-		if err != nil {
-			return err
-		}
-		if token == nil {
-			return structs.ErrTokenNotFound
-		}
-
-		found := false
-		for _, p := range token.Policies {
-			if p == args.Name {
-				found = true
-				break
-			}
-		}
-
-		if !found {
-			return structs.ErrPermissionDenied
-		}
-	}
-
 	// Setup the blocking query
 	opts := blockingOptions{
 		queryOpts: &args.QueryOptions,
 		queryMeta: &reply.QueryMeta,
 		run: func(ws memdb.WatchSet, state *state.StateStore) error {
-			// Look for the policy
-			out, err := state.ACLPolicyByName(ws, args.Name)
+			// Look for the binding rule
+			out, err := state.ACLBindingRuleByID(ws, args.ID)
 			if err != nil {
 				return err
 			}
 
 			// Setup the output
-			reply.Policy = out
+			reply.BindingRule = out
 			if out != nil {
 				reply.Index = out.ModifyIndex
-				rules, err := policy.Parse(out.Rules)
-
-				if err != nil {
-					return err
-				}
-				reply.Policy.RulesJSON = rules
 			} else {
-				// Use the last index that affected the policy table
-				index, err := state.Index("acl_policy")
+				// Use the last index that affected the binding rule table
+				index, err := state.Index("acl_binding_rule")
 				if err != nil {
 					return err
 				}
@@ -284,35 +1116,20 @@ func (a *ACL) GetPolicy(args *structs.ACLPolicySpecificRequest, reply *structs.S
 	return a.srv.blockingRPC(&opts)
 }
 
-// GetPolicies is used to get a set of policies
-func (a *ACL) GetPolicies(args *structs.ACLPolicySetRequest, reply *structs.ACLPolicySetResponse) error {
+// GetBindingRules is used to get a set of binding rules
+func (a *ACL) GetBindingRules(args *structs.ACLBindingRuleSetRequest, reply *structs.ACLBindingRuleSetResponse) error {
 	if !a.srv.config.ACLEnabled {
 		return aclDisabled
 	}
-	if done, err := a.srv.forward("ACL.GetPolicies", args, args, reply); done {
+	if done, err := a.srv.forward("ACL.GetBindingRules", args, args, reply); done {
 		return err
 	}
-	defer metrics.MeasureSince([]string{"nomad", "acl", "get_policies"}, time.Now())
-
-	var token *structs.ACLToken
-	var err error
-	if args.AuthToken == "" {
-		// No need to look up the anonymous token
-		token = structs.AnonymousACLToken
-	} else {
-		// For client typed tokens, allow them to query any policies associated with that token.
-		// This is used by clients which are resolving the policies to enforce. Any associated
-		// policies need to be fetched so that the client can determine what to allow.
-		token, err = a.srv.State().ACLTokenBySecretID(nil, args.AuthToken)
-		if err != nil {
-			return err
-		}
-	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "get_binding_rules"}, time.Now())
 
-	if token == nil {
-		return structs.ErrTokenNotFound
-	}
-	if token.Type != structs.ACLManagementToken && !token.PolicySubset(args.Names) {
+	// Check management level permissions
+	if acl, err := a.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if acl == nil || !acl.IsManagement() {
 		return structs.ErrPermissionDenied
 	}
 
@@ -322,21 +1139,21 @@ func (a *ACL) GetPolicies(args *structs.ACLPolicySetRequest, reply *structs.ACLP
 		queryMeta: &reply.QueryMeta,
 		run: func(ws memdb.WatchSet, state *state.StateStore) error {
 			// Setup the output
-			reply.Policies = make(map[string]*structs.ACLPolicy, len(args.Names))
+			reply.BindingRules = make(map[string]*structs.ACLBindingRule, len(args.IDs))
 
-			// Look for the policy
-			for _, policyName := range args.Names {
-				out, err := state.ACLPolicyByName(ws, policyName)
+			// Look for the binding rules
+			for _, id := range args.IDs {
+				out, err := state.ACLBindingRuleByID(ws, id)
 				if err != nil {
 					return err
 				}
 				if out != nil {
-					reply.Policies[policyName] = out
+					reply.BindingRules[id] = out
 				}
 			}
 
-			// Use the last index that affected the policy table
-			index, err := state.Index("acl_policy")
+			// Use the last index that affected the binding rule table
+			index, err := state.Index("acl_binding_rule")
 			if err != nil {
 				return err
 			}
@@ -346,6 +1163,169 @@ func (a *ACL) GetPolicies(args *structs.ACLPolicySetRequest, reply *structs.ACLP
 	return a.srv.blockingRPC(&opts)
 }
 
+// OIDCAuthURL is used to start an OIDC login. It returns the URL of the
+// provider the user logs in at. No token is required.
+func (a *ACL) OIDCAuthURL(args *structs.ACLOIDCAuthURLRequest, reply *structs.ACLOIDCAuthURLResponse) error {
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+	if done, err := a.srv.forward("ACL.OIDCAuthURL", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "oidc_auth_url"}, time.Now())
+
+	if err := args.Validate(); err != nil {
+		return structs.NewErrRPCCodedf(400, "invalid OIDC auth URL request: %v", err)
+	}
+
+	method, err := a.oidcAuthMethod(args.AuthMethodName, args.RedirectURI)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), oidcRequestTimeout)
+	defer cancel()
+	provider, err := newOIDCProvider(ctx, method)
+	if err != nil {
+		return structs.NewErrRPCCodedf(500, "%v", err)
+	}
+
+	reply.AuthURL = provider.authURL(args.RedirectURI, args.ClientNonce)
+	return nil
+}
+
+// OIDCCompleteAuth is used to complete an OIDC login. It verifies the user
+// with the provider, and creates a token with the roles and policies of the
+// binding rules matching the user's claims. No token is required.
+func (a *ACL) OIDCCompleteAuth(args *structs.ACLOIDCCompleteAuthRequest, reply *structs.ACLLoginResponse) error {
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+	if err := args.Validate(); err != nil {
+		return structs.NewErrRPCCodedf(400, "invalid OIDC complete auth request: %v", err)
+	}
+
+	// Force the request to the authoritative region if the method creates
+	// global tokens
+	method, err := a.oidcAuthMethod(args.AuthMethodName, args.RedirectURI)
+	if err != nil {
+		return err
+	}
+	if method.TokenLocalityIsGlobal() {
+		args.Region = a.srv.config.AuthoritativeRegion
+	}
+
+	if done, err := a.srv.forward("ACL.OIDCCompleteAuth", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "oidc_complete_auth"}, time.Now())
+
+	ctx, cancel := context.WithTimeout(context.Background(), oidcRequestTimeout)
+	defer cancel()
+	provider, err := newOIDCProvider(ctx, method)
+	if err != nil {
+		return structs.NewErrRPCCodedf(500, "%v", err)
+	}
+
+	now := time.Now().UTC()
+	claims, err := provider.completeAuth(ctx, args, now)
+	if err != nil {
+		a.logger.Debug("OIDC login failed", "auth_method", method.Name, "error", err)
+		return structs.NewErrRPCCodedf(403, "OIDC login failed: %v", err)
+	}
+
+	// Evaluate the binding rules
+	state, err := a.srv.State().Snapshot()
+	if err != nil {
+		return err
+	}
+	roles, policies, err := bindACLAuthClaims(state, method.Name, claims)
+	if err != nil {
+		return err
+	}
+	if len(roles) == 0 && len(policies) == 0 {
+		return structs.NewErrRPCCoded(403, "no binding rules matched the user")
+	}
+
+	// Create the token
+	expiration := now.Add(method.MaxTokenTTL)
+	token := &structs.ACLToken{
+		AccessorID:     uuid.Generate(),
+		SecretID:       uuid.Generate(),
+		Name:           fmt.Sprintf("OIDC-%s", method.Name),
+		Type:           structs.ACLClientToken,
+		Policies:       policies,
+		Roles:          roles,
+		Global:         method.TokenLocalityIsGlobal(),
+		CreateTime:     now,
+		ExpirationTTL:  method.MaxTokenTTL,
+		ExpirationTime: &expiration,
+	}
+	token.SetHash()
+
+	req := &structs.ACLTokenUpsertRequest{
+		Tokens:       []*structs.ACLToken{token},
+		WriteRequest: structs.WriteRequest{Region: args.Region},
+	}
+	_, index, err := a.srv.raftApply(structs.ACLTokenUpsertRequestType, req)
+	if err != nil {
+		return err
+	}
+
+	// Populate the response. We do a lookup against the state to
+	// pickup the proper create / modify indexes.
+	state, err = a.srv.State().Snapshot()
+	if err != nil {
+		return err
+	}
+	out, err := state.ACLTokenByAccessorID(nil, token.AccessorID)
+	if err != nil {
+		return structs.NewErrRPCCodedf(400, "token lookup failed: %v", err)
+	}
+	reply.ACLToken = out
+	reply.Index = index
+	return nil
+}
+
+// oidcAuthMethod looks up an OIDC auth method and checks the redirect URI is
+// allowed by it.
+func (a *ACL) oidcAuthMethod(name, redirectURI string) (*structs.ACLAuthMethod, error) {
+	method, err := a.srv.State().ACLAuthMethodByName(nil, name)
+	if err != nil {
+		return nil, err
+	}
+	if method == nil {
+		return nil, structs.NewErrRPCCodedf(404, "auth method %s not found", name)
+	}
+	if method.Type != structs.ACLAuthMethodTypeOIDC {
+		return nil, structs.NewErrRPCCodedf(400, "auth method %s is not an OIDC auth method", name)
+	}
+	if !helper.SliceStringContains(method.Config.AllowedRedirectURIs, redirectURI) {
+		return nil, structs.NewErrRPCCodedf(400, "redirect URI %s is not allowed by auth method %s", redirectURI, name)
+	}
+	return method, nil
+}
+
+// requestACLToken looks up the unexpired token of a request, which may be the
+// anonymous token
+func (a *ACL) requestACLToken(secretID string) (*structs.ACLToken, error) {
+	if secretID == "" {
+		return structs.AnonymousACLToken, nil
+	}
+
+	token, err := a.srv.State().ACLTokenBySecretID(nil, secretID)
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return nil, structs.ErrTokenNotFound
+	}
+	if token.IsExpired(time.Now()) {
+		return nil, structs.ErrTokenExpired
+	}
+	return token, nil
+}
+
 // Bootstrap is used to bootstrap the initial token
 func (a *ACL) Bootstrap(args *structs.ACLTokenBootstrapRequest, reply *structs.ACLTokenUpsertResponse) error {
 	// Ensure ACLs are enabled, and always flow modification requests to the authoritative region
@@ -505,12 +1485,34 @@ func (a *ACL) UpsertTokens(args *structs.ACLTokenUpsertRequest, reply *structs.A
 			return structs.NewErrRPCCodedf(400, "token %d invalid: %v", idx, err)
 		}
 
+		// Verify the roles exist
+		for _, roleName := range token.Roles {
+			role, err := state.ACLRoleByName(nil, roleName)
+			if err != nil {
+				return structs.NewErrRPCCodedf(400, "role lookup failed: %v", err)
+			}
+			if role == nil {
+				return structs.NewErrRPCCodedf(400, "token %d invalid: cannot find role %s", idx, roleName)
+			}
+		}
+
 		// Generate an accessor and secret ID if new
 		if token.AccessorID == "" {
 			token.AccessorID = uuid.Generate()
 			token.SecretID = uuid.Generate()
 			token.CreateTime = time.Now().UTC()
 
+			// Compute the expiration time from the TTL
+			if token.ExpirationTTL != 0 {
+				if token.ExpirationTime != nil {
+					return structs.NewErrRPCCodedf(400, "token %d invalid: cannot set both expiration time and TTL", idx)
+				}
+				expiration := token.CreateTime.Add(token.ExpirationTTL)
+				token.ExpirationTime = &expiration
+			}
+			if token.ExpirationTime != nil && !token.ExpirationTime.After(token.CreateTime) {
+				return structs.NewErrRPCCodedf(400, "token %d invalid: expiration time must be in the future", idx)
+			}
 		} else {
 			// Verify the token exists
 			out, err := state.ACLTokenByAccessorID(nil, token.AccessorID)
@@ -525,6 +1527,10 @@ func (a *ACL) UpsertTokens(args *structs.ACLTokenUpsertRequest, reply *structs.A
 			if token.Global != out.Global {
 				return structs.NewErrRPCCodedf(400, "cannot toggle global mode of %s", token.AccessorID)
 			}
+
+			// The expiration is fixed when the token is created
+			token.ExpirationTime = out.ExpirationTime
+			token.ExpirationTTL = out.ExpirationTTL
 		}
 
 		// Compute the token hash
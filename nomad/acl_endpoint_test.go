@@ -3,6 +3,7 @@ package nomad
 import (
 	"fmt"
 	"io/ioutil"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
@@ -1135,6 +1136,344 @@ func TestACLEndpoint_UpsertTokens_Invalid(t *testing.T) {
 	}
 }
 
+func TestACLEndpoint_UpsertTokens_Expiration(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	s1, root := TestACLServer(t, nil)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	// Create a token with a TTL
+	p1 := mock.ACLToken()
+	p1.AccessorID = ""
+	p1.ExpirationTTL = time.Hour
+
+	req := &structs.ACLTokenUpsertRequest{
+		Tokens: []*structs.ACLToken{p1},
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			AuthToken: root.SecretID,
+		},
+	}
+	var resp structs.ACLTokenUpsertResponse
+	require.NoError(msgpackrpc.CallWithCodec(codec, "ACL.UpsertTokens", req, &resp))
+
+	created := resp.Tokens[0]
+	require.NotNil(created.ExpirationTime)
+	require.Equal(created.CreateTime.Add(time.Hour), *created.ExpirationTime)
+
+	// Updating the token keeps the expiration
+	expiration := *created.ExpirationTime
+	created.Name = "updated"
+	created.ExpirationTime = nil
+	created.ExpirationTTL = 0
+	req.Tokens = []*structs.ACLToken{created}
+	resp = structs.ACLTokenUpsertResponse{}
+	require.NoError(msgpackrpc.CallWithCodec(codec, "ACL.UpsertTokens", req, &resp))
+	require.Equal("updated", resp.Tokens[0].Name)
+	require.NotNil(resp.Tokens[0].ExpirationTime)
+	require.True(expiration.Equal(*resp.Tokens[0].ExpirationTime))
+
+	// Setting both the expiration time and TTL is an error
+	p2 := mock.ACLToken()
+	p2.AccessorID = ""
+	p2.ExpirationTTL = time.Hour
+	p2.ExpirationTime = &expiration
+	req.Tokens = []*structs.ACLToken{p2}
+	err := msgpackrpc.CallWithCodec(codec, "ACL.UpsertTokens", req, &resp)
+	require.Error(err)
+	require.Contains(err.Error(), "both expiration time and TTL")
+
+	// An expiration in the past is an error
+	past := time.Now().Add(-time.Hour)
+	p3 := mock.ACLToken()
+	p3.AccessorID = ""
+	p3.ExpirationTime = &past
+	req.Tokens = []*structs.ACLToken{p3}
+	err = msgpackrpc.CallWithCodec(codec, "ACL.UpsertTokens", req, &resp)
+	require.Error(err)
+	require.Contains(err.Error(), "must be in the future")
+}
+
+func TestACLEndpoint_UpsertTokens_Roles(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	s1, root := TestACLServer(t, nil)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	role := mock.ACLRole()
+	require.NoError(s1.fsm.State().UpsertACLRoles(1000, []*structs.ACLRole{role}))
+
+	// Create a token with only a role
+	p1 := mock.ACLToken()
+	p1.AccessorID = ""
+	p1.Policies = nil
+	p1.Roles = []string{role.Name}
+
+	req := &structs.ACLTokenUpsertRequest{
+		Tokens: []*structs.ACLToken{p1},
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			AuthToken: root.SecretID,
+		},
+	}
+	var resp structs.ACLTokenUpsertResponse
+	require.NoError(msgpackrpc.CallWithCodec(codec, "ACL.UpsertTokens", req, &resp))
+	require.Equal([]string{role.Name}, resp.Tokens[0].Roles)
+
+	// Roles must exist
+	p2 := mock.ACLToken()
+	p2.AccessorID = ""
+	p2.Roles = []string{"missing"}
+	req.Tokens = []*structs.ACLToken{p2}
+	err := msgpackrpc.CallWithCodec(codec, "ACL.UpsertTokens", req, &resp)
+	require.Error(err)
+	require.Contains(err.Error(), "cannot find role")
+}
+
+func TestACLEndpoint_UpsertRoles(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	s1, root := TestACLServer(t, nil)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	policy := mock.ACLPolicy()
+	require.NoError(s1.fsm.State().UpsertACLPolicies(1000, []*structs.ACLPolicy{policy}))
+
+	role := mock.ACLRole()
+	role.Policies = []string{policy.Name}
+	req := &structs.ACLRoleUpsertRequest{
+		Roles: []*structs.ACLRole{role},
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			AuthToken: root.SecretID,
+		},
+	}
+
+	// Client tokens may not write roles
+	token := mock.ACLToken()
+	require.NoError(s1.fsm.State().UpsertACLTokens(1001, []*structs.ACLToken{token}))
+	req.AuthToken = token.SecretID
+	var resp structs.GenericResponse
+	err := msgpackrpc.CallWithCodec(codec, "ACL.UpsertRoles", req, &resp)
+	require.EqualError(err, structs.ErrPermissionDenied.Error())
+
+	// Management tokens may
+	req.AuthToken = root.SecretID
+	require.NoError(msgpackrpc.CallWithCodec(codec, "ACL.UpsertRoles", req, &resp))
+	require.NotEqual(uint64(0), resp.Index)
+
+	out, err := s1.fsm.State().ACLRoleByName(nil, role.Name)
+	require.NoError(err)
+	require.Equal([]string{policy.Name}, out.Policies)
+
+	// Policies must exist
+	role2 := mock.ACLRole()
+	req.Roles = []*structs.ACLRole{role2}
+	err = msgpackrpc.CallWithCodec(codec, "ACL.UpsertRoles", req, &resp)
+	require.Error(err)
+	require.Contains(err.Error(), "cannot find policy")
+
+	// Roles must be valid
+	role3 := mock.ACLRole()
+	role3.Policies = nil
+	req.Roles = []*structs.ACLRole{role3}
+	err = msgpackrpc.CallWithCodec(codec, "ACL.UpsertRoles", req, &resp)
+	require.Error(err)
+	require.Contains(err.Error(), "missing policies")
+}
+
+func TestACLEndpoint_DeleteRoles(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	s1, root := TestACLServer(t, nil)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	role := mock.ACLRole()
+	require.NoError(s1.fsm.State().UpsertACLRoles(1000, []*structs.ACLRole{role}))
+
+	req := &structs.ACLRoleDeleteRequest{
+		Names: []string{role.Name},
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			AuthToken: root.SecretID,
+		},
+	}
+	var resp structs.GenericResponse
+	require.NoError(msgpackrpc.CallWithCodec(codec, "ACL.DeleteRoles", req, &resp))
+	require.NotEqual(uint64(0), resp.Index)
+
+	out, err := s1.fsm.State().ACLRoleByName(nil, role.Name)
+	require.NoError(err)
+	require.Nil(out)
+}
+
+func TestACLEndpoint_ListRoles(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	s1, root := TestACLServer(t, nil)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	r1 := mock.ACLRole()
+	r2 := mock.ACLRole()
+	require.NoError(s1.fsm.State().UpsertACLRoles(1000, []*structs.ACLRole{r1, r2}))
+
+	token := mock.ACLToken()
+	token.Roles = []string{r1.Name}
+	require.NoError(s1.fsm.State().UpsertACLTokens(1001, []*structs.ACLToken{token}))
+
+	// Management tokens see all roles
+	get := &structs.ACLRoleListRequest{
+		QueryOptions: structs.QueryOptions{
+			Region:    "global",
+			AuthToken: root.SecretID,
+		},
+	}
+	var resp structs.ACLRoleListResponse
+	require.NoError(msgpackrpc.CallWithCodec(codec, "ACL.ListRoles", get, &resp))
+	require.Equal(uint64(1000), resp.Index)
+	require.Len(resp.Roles, 2)
+
+	// Client tokens only see their own roles
+	get.AuthToken = token.SecretID
+	resp = structs.ACLRoleListResponse{}
+	require.NoError(msgpackrpc.CallWithCodec(codec, "ACL.ListRoles", get, &resp))
+	require.Len(resp.Roles, 1)
+	require.Equal(r1.Name, resp.Roles[0].Name)
+}
+
+func TestACLEndpoint_GetRole(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	s1, root := TestACLServer(t, nil)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	r1 := mock.ACLRole()
+	r2 := mock.ACLRole()
+	require.NoError(s1.fsm.State().UpsertACLRoles(1000, []*structs.ACLRole{r1, r2}))
+
+	token := mock.ACLToken()
+	token.Roles = []string{r1.Name}
+	require.NoError(s1.fsm.State().UpsertACLTokens(1001, []*structs.ACLToken{token}))
+
+	get := &structs.ACLRoleSpecificRequest{
+		Name: r2.Name,
+		QueryOptions: structs.QueryOptions{
+			Region:    "global",
+			AuthToken: root.SecretID,
+		},
+	}
+	var resp structs.SingleACLRoleResponse
+	require.NoError(msgpackrpc.CallWithCodec(codec, "ACL.GetRole", get, &resp))
+	require.Equal(r2, resp.Role)
+
+	// Client tokens may not read roles they don't have
+	get.AuthToken = token.SecretID
+	err := msgpackrpc.CallWithCodec(codec, "ACL.GetRole", get, &resp)
+	require.EqualError(err, structs.ErrPermissionDenied.Error())
+
+	get.Name = r1.Name
+	resp = structs.SingleACLRoleResponse{}
+	require.NoError(msgpackrpc.CallWithCodec(codec, "ACL.GetRole", get, &resp))
+	require.Equal(r1, resp.Role)
+
+	// Lookup a missing role
+	get.AuthToken = root.SecretID
+	get.Name = "missing"
+	resp = structs.SingleACLRoleResponse{}
+	require.NoError(msgpackrpc.CallWithCodec(codec, "ACL.GetRole", get, &resp))
+	require.Nil(resp.Role)
+}
+
+func TestACLEndpoint_GetRoles(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	s1, _ := TestACLServer(t, nil)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	r1 := mock.ACLRole()
+	r2 := mock.ACLRole()
+	require.NoError(s1.fsm.State().UpsertACLRoles(1000, []*structs.ACLRole{r1, r2}))
+
+	token := mock.ACLToken()
+	token.Roles = []string{r1.Name}
+	require.NoError(s1.fsm.State().UpsertACLTokens(1001, []*structs.ACLToken{token}))
+
+	get := &structs.ACLRoleSetRequest{
+		Names: []string{r1.Name},
+		QueryOptions: structs.QueryOptions{
+			Region:    "global",
+			AuthToken: token.SecretID,
+		},
+	}
+	var resp structs.ACLRoleSetResponse
+	require.NoError(msgpackrpc.CallWithCodec(codec, "ACL.GetRoles", get, &resp))
+	require.Equal(uint64(1000), resp.Index)
+	require.Equal(r1, resp.Roles[r1.Name])
+
+	// Lookup a role not associated with the token
+	get.Names = []string{r2.Name}
+	err := msgpackrpc.CallWithCodec(codec, "ACL.GetRoles", get, &resp)
+	require.EqualError(err, structs.ErrPermissionDenied.Error())
+}
+
+func TestACLEndpoint_GetPolicies_Role(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	s1, _ := TestACLServer(t, nil)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	policy := mock.ACLPolicy()
+	require.NoError(s1.fsm.State().UpsertACLPolicies(1000, []*structs.ACLPolicy{policy}))
+
+	role := mock.ACLRole()
+	role.Policies = []string{policy.Name}
+	require.NoError(s1.fsm.State().UpsertACLRoles(1001, []*structs.ACLRole{role}))
+
+	token := mock.ACLToken()
+	token.Policies = nil
+	token.Roles = []string{role.Name}
+	require.NoError(s1.fsm.State().UpsertACLTokens(1002, []*structs.ACLToken{token}))
+
+	// Policies granted by a role may be fetched
+	get := &structs.ACLPolicySetRequest{
+		Names: []string{policy.Name},
+		QueryOptions: structs.QueryOptions{
+			Region:    "global",
+			AuthToken: token.SecretID,
+		},
+	}
+	var resp structs.ACLPolicySetResponse
+	require.NoError(msgpackrpc.CallWithCodec(codec, "ACL.GetPolicies", get, &resp))
+	require.Equal(policy, resp.Policies[policy.Name])
+
+	// Expired tokens may not fetch anything
+	expired := mock.ACLToken()
+	past := time.Now().Add(-time.Minute)
+	expired.ExpirationTime = &past
+	expired.Policies = []string{policy.Name}
+	require.NoError(s1.fsm.State().UpsertACLTokens(1003, []*structs.ACLToken{expired}))
+
+	get.AuthToken = expired.SecretID
+	err := msgpackrpc.CallWithCodec(codec, "ACL.GetPolicies", get, &resp)
+	require.EqualError(err, structs.ErrTokenExpired.Error())
+}
+
 func TestACLEndpoint_ResolveToken(t *testing.T) {
 	t.Parallel()
 	s1, _ := TestACLServer(t, nil)
@@ -1166,3 +1505,303 @@ func TestACLEndpoint_ResolveToken(t *testing.T) {
 	assert.Equal(t, uint64(1000), resp.Index)
 	assert.Nil(t, resp.Token)
 }
+
+func TestACLEndpoint_UpsertDeleteAuthMethods(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	s1, root := TestACLServer(t, nil)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	method := mock.ACLAuthMethod()
+	method.Default = true
+	req := &structs.ACLAuthMethodUpsertRequest{
+		AuthMethods: []*structs.ACLAuthMethod{method},
+		WriteRequest: structs.WriteRequest{
+			Region: "global",
+		},
+	}
+
+	// Client tokens may not write auth methods
+	token := mock.ACLToken()
+	require.NoError(s1.fsm.State().UpsertACLTokens(1000, []*structs.ACLToken{token}))
+	req.AuthToken = token.SecretID
+	var resp structs.GenericResponse
+	err := msgpackrpc.CallWithCodec(codec, "ACL.UpsertAuthMethods", req, &resp)
+	require.EqualError(err, structs.ErrPermissionDenied.Error())
+
+	// Management tokens may
+	req.AuthToken = root.SecretID
+	require.NoError(msgpackrpc.CallWithCodec(codec, "ACL.UpsertAuthMethods", req, &resp))
+	require.NotEqual(uint64(0), resp.Index)
+
+	out, err := s1.fsm.State().ACLAuthMethodByName(nil, method.Name)
+	require.NoError(err)
+	require.True(out.Default)
+	require.NotEmpty(out.Hash)
+
+	// Only one auth method may be the default
+	method2 := mock.ACLAuthMethod()
+	method2.Default = true
+	req.AuthMethods = []*structs.ACLAuthMethod{method2}
+	err = msgpackrpc.CallWithCodec(codec, "ACL.UpsertAuthMethods", req, &resp)
+	require.Error(err)
+	require.Contains(err.Error(), "default auth method is already")
+
+	// Auth methods must be valid
+	method2.Default = false
+	method2.Config = nil
+	err = msgpackrpc.CallWithCodec(codec, "ACL.UpsertAuthMethods", req, &resp)
+	require.Error(err)
+	require.Contains(err.Error(), "missing config")
+
+	// Delete the auth method
+	delReq := &structs.ACLAuthMethodDeleteRequest{
+		Names: []string{method.Name},
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			AuthToken: root.SecretID,
+		},
+	}
+	require.NoError(msgpackrpc.CallWithCodec(codec, "ACL.DeleteAuthMethods", delReq, &resp))
+
+	out, err = s1.fsm.State().ACLAuthMethodByName(nil, method.Name)
+	require.NoError(err)
+	require.Nil(out)
+}
+
+func TestACLEndpoint_GetListAuthMethods(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	s1, root := TestACLServer(t, nil)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	method := mock.ACLAuthMethod()
+	require.NoError(s1.fsm.State().UpsertACLAuthMethods(1000, []*structs.ACLAuthMethod{method}))
+
+	// Anonymous requests may list auth methods
+	listReq := &structs.ACLAuthMethodListRequest{
+		QueryOptions: structs.QueryOptions{
+			Region: "global",
+		},
+	}
+	var listResp structs.ACLAuthMethodListResponse
+	require.NoError(msgpackrpc.CallWithCodec(codec, "ACL.ListAuthMethods", listReq, &listResp))
+	require.Len(listResp.AuthMethods, 1)
+	require.Equal(method.Name, listResp.AuthMethods[0].Name)
+	require.Equal(uint64(1000), listResp.Index)
+
+	// Only management tokens may read the config
+	getReq := &structs.ACLAuthMethodSpecificRequest{
+		Name: method.Name,
+		QueryOptions: structs.QueryOptions{
+			Region: "global",
+		},
+	}
+	var getResp structs.SingleACLAuthMethodResponse
+	err := msgpackrpc.CallWithCodec(codec, "ACL.GetAuthMethod", getReq, &getResp)
+	require.EqualError(err, structs.ErrPermissionDenied.Error())
+
+	getReq.AuthToken = root.SecretID
+	require.NoError(msgpackrpc.CallWithCodec(codec, "ACL.GetAuthMethod", getReq, &getResp))
+	require.Equal(method, getResp.AuthMethod)
+
+	setReq := &structs.ACLAuthMethodSetRequest{
+		Names: []string{method.Name, "missing"},
+		QueryOptions: structs.QueryOptions{
+			Region:    "global",
+			AuthToken: root.SecretID,
+		},
+	}
+	var setResp structs.ACLAuthMethodSetResponse
+	require.NoError(msgpackrpc.CallWithCodec(codec, "ACL.GetAuthMethods", setReq, &setResp))
+	require.Len(setResp.AuthMethods, 1)
+	require.Equal(method, setResp.AuthMethods[method.Name])
+}
+
+func TestACLEndpoint_BindingRules(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	s1, root := TestACLServer(t, nil)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	method := mock.ACLAuthMethod()
+	require.NoError(s1.fsm.State().UpsertACLAuthMethods(1000, []*structs.ACLAuthMethod{method}))
+
+	rule := mock.ACLBindingRule(method.Name)
+	rule.ID = ""
+	req := &structs.ACLBindingRuleUpsertRequest{
+		BindingRules: []*structs.ACLBindingRule{rule},
+		WriteRequest: structs.WriteRequest{
+			Region: "global",
+		},
+	}
+
+	// Anonymous requests may not write binding rules
+	var resp structs.ACLBindingRuleUpsertResponse
+	err := msgpackrpc.CallWithCodec(codec, "ACL.UpsertBindingRules", req, &resp)
+	require.EqualError(err, structs.ErrPermissionDenied.Error())
+
+	// Management tokens may, and an ID is generated
+	req.AuthToken = root.SecretID
+	require.NoError(msgpackrpc.CallWithCodec(codec, "ACL.UpsertBindingRules", req, &resp))
+	require.Len(resp.BindingRules, 1)
+	created := resp.BindingRules[0]
+	require.NotEmpty(created.ID)
+	require.Equal(resp.Index, created.CreateIndex)
+
+	// The auth method must exist
+	rule2 := mock.ACLBindingRule("missing")
+	rule2.ID = ""
+	req.BindingRules = []*structs.ACLBindingRule{rule2}
+	err = msgpackrpc.CallWithCodec(codec, "ACL.UpsertBindingRules", req, &resp)
+	require.Error(err)
+	require.Contains(err.Error(), "cannot find auth method")
+
+	// Updated binding rules must exist
+	rule2 = mock.ACLBindingRule(method.Name)
+	req.BindingRules = []*structs.ACLBindingRule{rule2}
+	err = msgpackrpc.CallWithCodec(codec, "ACL.UpsertBindingRules", req, &resp)
+	require.Error(err)
+	require.Contains(err.Error(), "cannot find binding rule")
+
+	// List and get the binding rule
+	listReq := &structs.ACLBindingRuleListRequest{
+		QueryOptions: structs.QueryOptions{
+			Region:    "global",
+			AuthToken: root.SecretID,
+		},
+	}
+	var listResp structs.ACLBindingRuleListResponse
+	require.NoError(msgpackrpc.CallWithCodec(codec, "ACL.ListBindingRules", listReq, &listResp))
+	require.Len(listResp.BindingRules, 1)
+	require.Equal(created.ID, listResp.BindingRules[0].ID)
+
+	getReq := &structs.ACLBindingRuleSpecificRequest{
+		ID: created.ID,
+		QueryOptions: structs.QueryOptions{
+			Region:    "global",
+			AuthToken: root.SecretID,
+		},
+	}
+	var getResp structs.SingleACLBindingRuleResponse
+	require.NoError(msgpackrpc.CallWithCodec(codec, "ACL.GetBindingRule", getReq, &getResp))
+	require.Equal(created, getResp.BindingRule)
+
+	// Delete the binding rule
+	delReq := &structs.ACLBindingRuleDeleteRequest{
+		IDs: []string{created.ID},
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			AuthToken: root.SecretID,
+		},
+	}
+	var delResp structs.GenericResponse
+	require.NoError(msgpackrpc.CallWithCodec(codec, "ACL.DeleteBindingRules", delReq, &delResp))
+
+	out, err := s1.fsm.State().ACLBindingRuleByID(nil, created.ID)
+	require.NoError(err)
+	require.Nil(out)
+}
+
+func TestACLEndpoint_OIDCLogin(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	s1, _ := TestACLServer(t, nil)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	provider := newTestOIDCProvider(t)
+	defer provider.srv.Close()
+
+	method := provider.authMethod()
+	redirectURI := method.Config.AllowedRedirectURIs[0]
+	role := mock.ACLRole()
+	role.Name = "team-ops"
+	rule := mock.ACLBindingRule(method.Name)
+	policyRule := mock.ACLBindingRule(method.Name)
+	policyRule.Selector = `value.team == "ops"`
+	policyRule.BindType = structs.ACLBindingRuleBindTypePolicy
+	policyRule.BindName = "readonly"
+	state := s1.fsm.State()
+	require.NoError(state.UpsertACLAuthMethods(1000, []*structs.ACLAuthMethod{method}))
+	require.NoError(state.UpsertACLRoles(1001, []*structs.ACLRole{role}))
+	require.NoError(state.UpsertACLBindingRules(1002, []*structs.ACLBindingRule{rule, policyRule}))
+
+	// Redirect URIs must be allowed
+	authReq := &structs.ACLOIDCAuthURLRequest{
+		AuthMethodName: method.Name,
+		RedirectURI:    "http://evil.example.com/callback",
+		ClientNonce:    "client-nonce",
+		WriteRequest:   structs.WriteRequest{Region: "global"},
+	}
+	var authResp structs.ACLOIDCAuthURLResponse
+	err := msgpackrpc.CallWithCodec(codec, "ACL.OIDCAuthURL", authReq, &authResp)
+	require.Error(err)
+	require.Contains(err.Error(), "not allowed")
+
+	authReq.RedirectURI = redirectURI
+	require.NoError(msgpackrpc.CallWithCodec(codec, "ACL.OIDCAuthURL", authReq, &authResp))
+	authURL, err := url.Parse(authResp.AuthURL)
+	require.NoError(err)
+	require.Equal(provider.srv.URL+"/authorize", authURL.Scheme+"://"+authURL.Host+authURL.Path)
+	query := authURL.Query()
+	require.Equal(redirectURI, query.Get("redirect_uri"))
+	require.Equal("nomad", query.Get("client_id"))
+	state1 := query.Get("state")
+	require.NotEmpty(state1)
+
+	// The provider returns the nonce it was sent
+	provider.setClaims(map[string]interface{}{
+		"nonce":  query.Get("nonce"),
+		"team":   "ops",
+		"groups": []string{"engineering"},
+	})
+
+	completeReq := &structs.ACLOIDCCompleteAuthRequest{
+		AuthMethodName: method.Name,
+		ClientNonce:    "client-nonce",
+		State:          state1,
+		Code:           "valid-code",
+		RedirectURI:    redirectURI,
+		WriteRequest:   structs.WriteRequest{Region: "global"},
+	}
+
+	// Another client nonce fails the login
+	completeReq.ClientNonce = "other-nonce"
+	var loginResp structs.ACLLoginResponse
+	err = msgpackrpc.CallWithCodec(codec, "ACL.OIDCCompleteAuth", completeReq, &loginResp)
+	require.Error(err)
+	require.Contains(err.Error(), "nonce")
+
+	completeReq.ClientNonce = "client-nonce"
+	require.NoError(msgpackrpc.CallWithCodec(codec, "ACL.OIDCCompleteAuth", completeReq, &loginResp))
+	token := loginResp.ACLToken
+	require.NotNil(token)
+	require.Equal(structs.ACLClientToken, token.Type)
+	require.Equal([]string{"team-ops"}, token.Roles)
+	require.Equal([]string{"readonly"}, token.Policies)
+	require.False(token.Global)
+	require.NotNil(token.ExpirationTime)
+	require.WithinDuration(time.Now().Add(method.MaxTokenTTL), *token.ExpirationTime, time.Minute)
+
+	out, err := state.ACLTokenBySecretID(nil, token.SecretID)
+	require.NoError(err)
+	require.Equal(token.AccessorID, out.AccessorID)
+
+	// Users not matching any binding rule may not log in
+	provider.setClaims(map[string]interface{}{
+		"nonce":  query.Get("nonce"),
+		"team":   "dev",
+		"groups": []string{"sales"},
+	})
+	err = msgpackrpc.CallWithCodec(codec, "ACL.OIDCCompleteAuth", completeReq, &loginResp)
+	require.Error(err)
+	require.Contains(err.Error(), "no binding rules matched")
+}
@@ -0,0 +1,480 @@
+package nomad
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/nomad/helper"
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"golang.org/x/oauth2"
+)
+
+// OIDC logins are stateless on the servers. The auth URL embeds a random
+// state, and the nonce sent to the provider is a hash of the state and of a
+// nonce kept by the client. The provider returns the nonce in the ID token,
+// so only the client that started a login can complete it with the
+// authorization code the provider redirected the user with.
+
+const (
+	// oidcRequestTimeout bounds the requests made to an OIDC provider
+	oidcRequestTimeout = 30 * time.Second
+
+	// oidcMaxResponseSize bounds the size of the responses read from an
+	// OIDC provider
+	oidcMaxResponseSize = 1 << 20
+)
+
+// oidcDiscoveryDocument is the subset of the OpenID configuration of a
+// provider used to log in users.
+type oidcDiscoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// oidcJWKS is the JSON Web Key Set of a provider.
+type oidcJWKS struct {
+	Keys []oidcJWK `json:"keys"`
+}
+
+// oidcJWK is a public RSA or EC key of a provider.
+type oidcJWK struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	Curve   string `json:"crv"`
+	N       string `json:"n"`
+	E       string `json:"e"`
+	X       string `json:"x"`
+	Y       string `json:"y"`
+}
+
+// oidcHeader is the JOSE header of an ID token.
+type oidcHeader struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+}
+
+// oidcProvider is the discovered OIDC provider of an auth method.
+type oidcProvider struct {
+	method *structs.ACLAuthMethod
+	client *http.Client
+	doc    *oidcDiscoveryDocument
+}
+
+// newOIDCProvider discovers the OpenID configuration of the auth method's
+// provider.
+func newOIDCProvider(ctx context.Context, method *structs.ACLAuthMethod) (*oidcProvider, error) {
+	if method.Type != structs.ACLAuthMethodTypeOIDC || method.Config == nil {
+		return nil, fmt.Errorf("auth method %q is not an OIDC auth method", method.Name)
+	}
+
+	client := cleanhttp.DefaultClient()
+	if pems := method.Config.DiscoveryCaPem; len(pems) > 0 {
+		pool := x509.NewCertPool()
+		for _, pem := range pems {
+			if !pool.AppendCertsFromPEM([]byte(pem)) {
+				return nil, fmt.Errorf("invalid discovery CA certificate")
+			}
+		}
+		transport := cleanhttp.DefaultTransport()
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+		client.Transport = transport
+	}
+
+	p := &oidcProvider{
+		method: method,
+		client: client,
+	}
+
+	issuer := strings.TrimSuffix(method.Config.OIDCDiscoveryURL, "/")
+	var doc oidcDiscoveryDocument
+	if err := p.get(ctx, issuer+"/.well-known/openid-configuration", &doc); err != nil {
+		return nil, fmt.Errorf("failed to discover OIDC provider: %v", err)
+	}
+	if strings.TrimSuffix(doc.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("OIDC provider issuer %q does not match discovery URL %q", doc.Issuer, issuer)
+	}
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JWKSURI == "" {
+		return nil, fmt.Errorf("OIDC provider configuration is missing endpoints")
+	}
+	p.doc = &doc
+	return p, nil
+}
+
+// get decodes the JSON response of a GET request to the provider.
+func (p *oidcProvider) get(ctx context.Context, url string, out interface{}) error {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, oidcMaxResponseSize))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response code %d from %s: %s", resp.StatusCode, url, body)
+	}
+	return json.Unmarshal(body, out)
+}
+
+// oauth2Config returns the OAuth2 client configuration of a login.
+func (p *oidcProvider) oauth2Config(redirectURI string) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     p.method.Config.OIDCClientID,
+		ClientSecret: p.method.Config.OIDCClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  p.doc.AuthorizationEndpoint,
+			TokenURL: p.doc.TokenEndpoint,
+		},
+		RedirectURL: redirectURI,
+		Scopes:      append([]string{"openid"}, p.method.Config.OIDCScopes...),
+	}
+}
+
+// authURL returns the URL a user logs in at, with a new random state.
+func (p *oidcProvider) authURL(redirectURI, clientNonce string) string {
+	state := uuid.Generate()
+	return p.oauth2Config(redirectURI).AuthCodeURL(state,
+		oauth2.SetAuthURLParam("nonce", oidcNonce(state, clientNonce)))
+}
+
+// completeAuth exchanges the authorization code of a login for an ID token,
+// verifies it, and returns the mapped claims of the user.
+func (p *oidcProvider) completeAuth(ctx context.Context, args *structs.ACLOIDCCompleteAuthRequest, now time.Time) (*structs.ACLAuthClaims, error) {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.client)
+	token, err := p.oauth2Config(args.RedirectURI).Exchange(ctx, args.Code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange authorization code: %v", err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, fmt.Errorf("OIDC provider response is missing an ID token")
+	}
+
+	claims, err := p.verifyIDToken(ctx, rawIDToken, oidcNonce(args.State, args.ClientNonce), now)
+	if err != nil {
+		return nil, err
+	}
+	return oidcMapClaims(p.method.Config, claims), nil
+}
+
+// verifyIDToken verifies the signature, issuer, audience, expiry and nonce of
+// an ID token and returns its claims.
+func (p *oidcProvider) verifyIDToken(ctx context.Context, raw, nonce string, now time.Time) (map[string]interface{}, error) {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("malformed ID token")
+	}
+
+	var header oidcHeader
+	if err := oidcDecodeSegment(parts[0], &header); err != nil {
+		return nil, err
+	}
+	algs := p.method.Config.SigningAlgs
+	if len(algs) == 0 {
+		algs = []string{structs.ACLAuthMethodSigningAlgRS256}
+	}
+	if !helper.SliceStringContains(algs, header.Algorithm) {
+		return nil, fmt.Errorf("ID token signing algorithm %q is not allowed", header.Algorithm)
+	}
+
+	key, err := p.publicKey(ctx, header.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("malformed ID token signature: %v", err)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := oidcVerifySignature(header.Algorithm, key, digest[:], sig); err != nil {
+		return nil, err
+	}
+
+	var claims map[string]interface{}
+	b, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("malformed ID token: %v", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&claims); err != nil {
+		return nil, fmt.Errorf("malformed ID token: %v", err)
+	}
+
+	if iss, _ := claims["iss"].(string); iss != p.doc.Issuer {
+		return nil, fmt.Errorf("ID token issuer %q is invalid", iss)
+	}
+
+	audiences := p.method.Config.BoundAudiences
+	if len(audiences) == 0 {
+		audiences = []string{p.method.Config.OIDCClientID}
+	}
+	if !oidcAudienceMatches(claims["aud"], audiences) {
+		return nil, fmt.Errorf("ID token audience is invalid")
+	}
+
+	exp, ok := claims["exp"].(json.Number)
+	if !ok {
+		return nil, fmt.Errorf("ID token is missing an expiry")
+	}
+	expSecs, err := exp.Int64()
+	if err != nil {
+		return nil, fmt.Errorf("ID token expiry is invalid: %v", err)
+	}
+	if !now.Before(time.Unix(expSecs, 0)) {
+		return nil, fmt.Errorf("ID token is expired")
+	}
+
+	if n, _ := claims["nonce"].(string); n != nonce {
+		return nil, fmt.Errorf("ID token nonce is invalid")
+	}
+	return claims, nil
+}
+
+// publicKey returns the key of the provider's key set with the given ID, or
+// the only key if the ID is empty.
+func (p *oidcProvider) publicKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	var jwks oidcJWKS
+	if err := p.get(ctx, p.doc.JWKSURI, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to fetch OIDC provider keys: %v", err)
+	}
+
+	var found *oidcJWK
+	for i, k := range jwks.Keys {
+		if k.KeyID == kid || (kid == "" && len(jwks.Keys) == 1) {
+			found = &jwks.Keys[i]
+			break
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("OIDC provider key %q not found", kid)
+	}
+
+	switch found.KeyType {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(found.N)
+		if err != nil {
+			return nil, fmt.Errorf("malformed OIDC provider key: %v", err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(found.E)
+		if err != nil {
+			return nil, fmt.Errorf("malformed OIDC provider key: %v", err)
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+	case "EC":
+		if found.Curve != "P-256" {
+			return nil, fmt.Errorf("unsupported OIDC provider key curve %q", found.Curve)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(found.X)
+		if err != nil {
+			return nil, fmt.Errorf("malformed OIDC provider key: %v", err)
+		}
+		y, err := base64.RawURLEncoding.DecodeString(found.Y)
+		if err != nil {
+			return nil, fmt.Errorf("malformed OIDC provider key: %v", err)
+		}
+		return &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported OIDC provider key type %q", found.KeyType)
+	}
+}
+
+// oidcVerifySignature verifies the RS256 or ES256 signature of a digest.
+func oidcVerifySignature(alg string, key crypto.PublicKey, digest, sig []byte) error {
+	switch alg {
+	case structs.ACLAuthMethodSigningAlgRS256:
+		pk, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("OIDC provider key does not match algorithm %s", alg)
+		}
+		if err := rsa.VerifyPKCS1v15(pk, crypto.SHA256, digest, sig); err != nil {
+			return fmt.Errorf("invalid ID token signature")
+		}
+	case structs.ACLAuthMethodSigningAlgES256:
+		pk, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("OIDC provider key does not match algorithm %s", alg)
+		}
+		if len(sig) != 2*es256Size {
+			return fmt.Errorf("malformed ID token signature")
+		}
+		r := new(big.Int).SetBytes(sig[:es256Size])
+		s := new(big.Int).SetBytes(sig[es256Size:])
+		if !ecdsa.Verify(pk, digest, r, s) {
+			return fmt.Errorf("invalid ID token signature")
+		}
+	default:
+		return fmt.Errorf("unsupported ID token signing algorithm %q", alg)
+	}
+	return nil
+}
+
+// oidcAudienceMatches returns whether the "aud" claim, a string or a list of
+// strings, contains one of the allowed audiences.
+func oidcAudienceMatches(aud interface{}, allowed []string) bool {
+	switch v := aud.(type) {
+	case string:
+		return helper.SliceStringContains(allowed, v)
+	case []interface{}:
+		for _, a := range v {
+			if s, ok := a.(string); ok && helper.SliceStringContains(allowed, s) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// oidcNonce returns the nonce sent to the provider for a login.
+func oidcNonce(state, clientNonce string) string {
+	sum := sha256.Sum256([]byte(state + "." + clientNonce))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func oidcDecodeSegment(seg string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return fmt.Errorf("malformed ID token: %v", err)
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("malformed ID token: %v", err)
+	}
+	return nil
+}
+
+// oidcMapClaims maps the claims of an ID token to the names used by binding
+// rules. Claim names starting with "/" are JSON pointers to nested claims.
+func oidcMapClaims(config *structs.ACLAuthMethodConfig, claims map[string]interface{}) *structs.ACLAuthClaims {
+	out := &structs.ACLAuthClaims{
+		Value: make(map[string]string, len(config.ClaimMappings)),
+		List:  make(map[string][]string, len(config.ListClaimMappings)),
+	}
+
+	for claim, name := range config.ClaimMappings {
+		if v, ok := oidcClaimString(oidcLookupClaim(claims, claim)); ok {
+			out.Value[name] = v
+		}
+	}
+
+	for claim, name := range config.ListClaimMappings {
+		switch v := oidcLookupClaim(claims, claim).(type) {
+		case []interface{}:
+			list := make([]string, 0, len(v))
+			for _, e := range v {
+				if s, ok := oidcClaimString(e); ok {
+					list = append(list, s)
+				}
+			}
+			out.List[name] = list
+		default:
+			if s, ok := oidcClaimString(v); ok {
+				out.List[name] = []string{s}
+			}
+		}
+	}
+	return out
+}
+
+func oidcLookupClaim(claims map[string]interface{}, name string) interface{} {
+	if !strings.HasPrefix(name, "/") {
+		return claims[name]
+	}
+
+	var v interface{} = claims
+	for _, part := range strings.Split(strings.TrimPrefix(name, "/"), "/") {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		part = strings.Replace(strings.Replace(part, "~1", "/", -1), "~0", "~", -1)
+		v = m[part]
+	}
+	return v
+}
+
+// oidcClaimString converts a string, number or boolean claim to a string.
+func oidcClaimString(v interface{}) (string, bool) {
+	switch c := v.(type) {
+	case string:
+		return c, true
+	case json.Number:
+		return c.String(), true
+	case bool:
+		return fmt.Sprintf("%t", c), true
+	default:
+		return "", false
+	}
+}
+
+// bindACLAuthClaims evaluates the binding rules of an auth method and
+// returns the roles and policies the user with the claims is linked to.
+// Roles that don't exist are skipped.
+func bindACLAuthClaims(snap *state.StateSnapshot, method string, claims *structs.ACLAuthClaims) ([]string, []string, error) {
+	iter, err := snap.ACLBindingRulesByAuthMethod(nil, method)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles, policies []string
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		rule := raw.(*structs.ACLBindingRule)
+		name, ok, err := rule.Bind(claims)
+		if err != nil {
+			return nil, nil, fmt.Errorf("binding rule %s: %v", rule.ID, err)
+		}
+		if !ok {
+			continue
+		}
+
+		switch rule.BindType {
+		case structs.ACLBindingRuleBindTypeRole:
+			role, err := snap.ACLRoleByName(nil, name)
+			if err != nil {
+				return nil, nil, err
+			}
+			if role != nil && !helper.SliceStringContains(roles, name) {
+				roles = append(roles, name)
+			}
+		case structs.ACLBindingRuleBindTypePolicy:
+			if !helper.SliceStringContains(policies, name) {
+				policies = append(policies, name)
+			}
+		}
+	}
+	return roles, policies, nil
+}
@@ -0,0 +1,228 @@
+package nomad
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/stretchr/testify/require"
+)
+
+// testOIDCProvider is a minimal OIDC provider that issues RS256 signed ID
+// tokens with the claims set by the test.
+type testOIDCProvider struct {
+	t   *testing.T
+	srv *httptest.Server
+	key *rsa.PrivateKey
+
+	mu     sync.Mutex
+	code   string
+	claims map[string]interface{}
+}
+
+func newTestOIDCProvider(t *testing.T) *testOIDCProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	p := &testOIDCProvider{t: t, key: key, code: "valid-code"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 p.srv.URL,
+			"authorization_endpoint": p.srv.URL + "/authorize",
+			"token_endpoint":         p.srv.URL + "/token",
+			"jwks_uri":               p.srv.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "test-key",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		if r.FormValue("code") != p.code {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     p.sign(p.claims),
+		})
+	})
+	p.srv = httptest.NewServer(mux)
+	return p
+}
+
+// setClaims sets the claims of the next ID token, with valid issuer,
+// audience and expiry unless overridden.
+func (p *testOIDCProvider) setClaims(claims map[string]interface{}) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.claims = map[string]interface{}{
+		"iss": p.srv.URL,
+		"aud": "nomad",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+	for k, v := range claims {
+		p.claims[k] = v
+	}
+}
+
+func (p *testOIDCProvider) sign(claims map[string]interface{}) string {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "kid": "test-key", "typ": "JWT"})
+	require.NoError(p.t, err)
+	body, err := json.Marshal(claims)
+	require.NoError(p.t, err)
+
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(body)
+	digest := sha256.Sum256([]byte(signed))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, p.key, crypto.SHA256, digest[:])
+	require.NoError(p.t, err)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+// authMethod returns an auth method of the provider.
+func (p *testOIDCProvider) authMethod() *structs.ACLAuthMethod {
+	method := mock.ACLAuthMethod()
+	method.Config.OIDCDiscoveryURL = p.srv.URL
+	method.SetHash()
+	return method
+}
+
+func TestOIDCProvider_VerifyIDToken(t *testing.T) {
+	t.Parallel()
+	p := newTestOIDCProvider(t)
+	defer p.srv.Close()
+	ctx := context.Background()
+
+	provider, err := newOIDCProvider(ctx, p.authMethod())
+	require.NoError(t, err)
+
+	now := time.Now()
+	valid := func() map[string]interface{} {
+		return map[string]interface{}{
+			"iss":   p.srv.URL,
+			"aud":   []string{"other", "nomad"},
+			"exp":   now.Add(time.Minute).Unix(),
+			"nonce": "nonce",
+		}
+	}
+
+	claims, err := provider.verifyIDToken(ctx, p.sign(valid()), "nonce", now)
+	require.NoError(t, err)
+	require.Equal(t, p.srv.URL, claims["iss"])
+
+	cases := []struct {
+		Name   string
+		Modify func(claims map[string]interface{})
+		Err    string
+	}{
+		{
+			Name:   "issuer",
+			Modify: func(c map[string]interface{}) { c["iss"] = "https://evil.example.com" },
+			Err:    "issuer",
+		},
+		{
+			Name:   "audience",
+			Modify: func(c map[string]interface{}) { c["aud"] = "other" },
+			Err:    "audience",
+		},
+		{
+			Name:   "expired",
+			Modify: func(c map[string]interface{}) { c["exp"] = now.Add(-time.Minute).Unix() },
+			Err:    "expired",
+		},
+		{
+			Name:   "nonce",
+			Modify: func(c map[string]interface{}) { c["nonce"] = "other" },
+			Err:    "nonce",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			claims := valid()
+			c.Modify(claims)
+			_, err := provider.verifyIDToken(ctx, p.sign(claims), "nonce", now)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), c.Err)
+		})
+	}
+
+	// Tampered tokens are rejected
+	token := p.sign(valid())
+	other := p.sign(map[string]interface{}{"iss": p.srv.URL})
+	_, err = provider.verifyIDToken(ctx, token[:len(token)-10]+other[len(other)-10:], "nonce", now)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "signature")
+
+	// Disallowed algorithms are rejected
+	method := p.authMethod()
+	method.Config.SigningAlgs = []string{structs.ACLAuthMethodSigningAlgES256}
+	provider, err = newOIDCProvider(ctx, method)
+	require.NoError(t, err)
+	_, err = provider.verifyIDToken(ctx, token, "nonce", now)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not allowed")
+}
+
+func TestOIDCMapClaims(t *testing.T) {
+	t.Parallel()
+	config := &structs.ACLAuthMethodConfig{
+		ClaimMappings: map[string]string{
+			"email":           "email",
+			"admin":           "admin",
+			"/org/id":         "org",
+			"/org/missing/id": "missing",
+		},
+		ListClaimMappings: map[string]string{
+			"groups": "groups",
+			"role":   "roles",
+		},
+	}
+
+	var claims map[string]interface{}
+	dec := json.NewDecoder(strings.NewReader(`{
+		"email": "jane@example.com",
+		"admin": true,
+		"org": {"id": 42},
+		"groups": ["engineering", "ops", 7],
+		"role": "dev"
+	}`))
+	dec.UseNumber()
+	require.NoError(t, dec.Decode(&claims))
+
+	out := oidcMapClaims(config, claims)
+	require.Equal(t, map[string]string{
+		"email": "jane@example.com",
+		"admin": "true",
+		"org":   "42",
+	}, out.Value)
+	require.Equal(t, map[string][]string{
+		"groups": {"engineering", "ops", "7"},
+		"roles":  {"dev"},
+	}, out.List)
+}
@@ -2,6 +2,7 @@ package nomad
 
 import (
 	"testing"
+	"time"
 
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/hashicorp/nomad/acl"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/hashicorp/nomad/testutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestResolveACLToken(t *testing.T) {
@@ -92,6 +94,63 @@ func TestResolveACLToken(t *testing.T) {
 	}
 }
 
+func TestResolveACLToken_Roles(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	state := state.TestStateStore(t)
+	cache, err := lru.New2Q(16)
+	require.NoError(err)
+
+	// Create a token whose only policy comes from a role
+	policy := mock.ACLPolicy()
+	role := mock.ACLRole()
+	role.Policies = []string{policy.Name}
+	token := mock.ACLToken()
+	token.Policies = nil
+	token.Roles = []string{role.Name}
+	require.NoError(state.UpsertACLPolicies(100, []*structs.ACLPolicy{policy}))
+	require.NoError(state.UpsertACLRoles(110, []*structs.ACLRole{role}))
+	require.NoError(state.UpsertACLTokens(120, []*structs.ACLToken{token}))
+
+	snap, err := state.Snapshot()
+	require.NoError(err)
+
+	aclObj, err := resolveTokenFromSnapshotCache(snap, cache, token.SecretID)
+	require.NoError(err)
+	require.True(aclObj.AllowNamespaceOperation("default", acl.NamespaceCapabilityListJobs))
+
+	// Deleting the role removes its policies
+	require.NoError(state.DeleteACLRoles(130, []string{role.Name}))
+	snap, err = state.Snapshot()
+	require.NoError(err)
+
+	aclObj, err = resolveTokenFromSnapshotCache(snap, cache, token.SecretID)
+	require.NoError(err)
+	require.False(aclObj.AllowNamespaceOperation("default", acl.NamespaceCapabilityListJobs))
+}
+
+func TestResolveACLToken_Expired(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	state := state.TestStateStore(t)
+	cache, err := lru.New2Q(16)
+	require.NoError(err)
+
+	token := mock.ACLToken()
+	expiry := time.Now().Add(-time.Minute)
+	token.ExpirationTime = &expiry
+	require.NoError(state.UpsertACLTokens(100, []*structs.ACLToken{token}))
+
+	snap, err := state.Snapshot()
+	require.NoError(err)
+
+	aclObj, err := resolveTokenFromSnapshotCache(snap, cache, token.SecretID)
+	require.Equal(structs.ErrTokenExpired, err)
+	require.Nil(aclObj)
+}
+
 func TestResolveACLToken_LeaderToken(t *testing.T) {
 	t.Parallel()
 	assert := assert.New(t)
@@ -107,3 +166,48 @@ func TestResolveACLToken_LeaderToken(t *testing.T) {
 		assert.True(token.IsManagement())
 	}
 }
+
+func TestResolveWorkloadIdentity(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	state := state.TestStateStore(t)
+	key, err := newRootKey(time.Now(), testKeyEncryptionKey)
+	require.Nil(err)
+	key.Active = true
+	require.Nil(state.UpsertRootKeys(100, []*structs.RootKey{key}))
+
+	alloc := mock.Alloc()
+	stopped := mock.Alloc()
+	stopped.DesiredStatus = structs.AllocDesiredStatusStop
+	require.Nil(state.UpsertAllocs(110, []*structs.Allocation{alloc, stopped}))
+
+	snap, err := state.Snapshot()
+	require.Nil(err)
+
+	now := time.Now()
+	token, err := signIdentity(key, structs.NewIdentityClaims(alloc, "web", now, time.Hour))
+	require.Nil(err)
+
+	// The identity may read its own job only
+	aclObj, err := resolveIdentityFromSnapshot(snap, token, now)
+	require.Nil(err)
+	require.False(aclObj.IsManagement())
+	require.True(aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilityReadJob))
+	require.False(aclObj.AllowJobOp(alloc.Namespace, "other", acl.NamespaceCapabilityReadJob))
+	require.False(aclObj.AllowJobOp(alloc.Namespace, alloc.JobID, acl.NamespaceCapabilitySubmitJob))
+	require.False(aclObj.AllowNsOp(alloc.Namespace, acl.NamespaceCapabilityListJobs))
+	require.False(aclObj.AllowNodeRead())
+
+	// Identities of terminal allocations are rejected
+	token, err = signIdentity(key, structs.NewIdentityClaims(stopped, "web", now, time.Hour))
+	require.Nil(err)
+	_, err = resolveIdentityFromSnapshot(snap, token, now)
+	require.Error(err)
+
+	// Identities of unknown allocations are rejected
+	token, err = signIdentity(key, structs.NewIdentityClaims(mock.Alloc(), "web", now, time.Hour))
+	require.Nil(err)
+	_, err = resolveIdentityFromSnapshot(snap, token, now)
+	require.Error(err)
+}
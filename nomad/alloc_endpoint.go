@@ -86,8 +86,7 @@ func (a *Alloc) GetAlloc(args *structs.AllocSpecificRequest,
 	}
 	defer metrics.MeasureSince([]string{"nomad", "alloc", "get_alloc"}, time.Now())
 
-	// Check namespace read-job permissions before performing blocking query.
-	allowNsOp := acl.NamespaceValidator(acl.NamespaceCapabilityReadJob)
+	// Resolve the token before performing blocking query.
 	aclObj, err := a.srv.ResolveToken(args.AuthToken)
 	if err != nil {
 		// If ResolveToken had an unexpected error return that
@@ -125,8 +124,8 @@ func (a *Alloc) GetAlloc(args *structs.AllocSpecificRequest,
 			// Setup the output
 			reply.Alloc = out
 			if out != nil {
-				// Re-check namespace in case it differs from request.
-				if !allowNsOp(aclObj, out.Namespace) {
+				// Check read-job permissions on the allocation's job
+				if aclObj != nil && !aclObj.AllowJobOp(out.Namespace, out.JobID, acl.NamespaceCapabilityReadJob) {
 					return structs.NewErrUnknownAllocation(args.AllocID)
 				}
 
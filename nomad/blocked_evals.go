@@ -47,6 +47,10 @@ type BlockedEvals struct {
 	// classes.
 	escaped map[string]wrappedEval
 
+	// dependents is the set of evaluations waiting on the jobs their job
+	// depends on.
+	dependents map[string]wrappedEval
+
 	// system is the set of system evaluations that failed to start on nodes because of
 	// resource constraints.
 	system *systemEvals
@@ -64,6 +68,11 @@ type BlockedEvals struct {
 	// time they are being blocked.
 	unblockIndexes map[string]uint64
 
+	// jobUnblockIndexes maps jobs to the index in which evaluations waiting
+	// on them were unblocked. It serves the same purpose as unblockIndexes
+	// for evaluations waiting on job dependencies.
+	jobUnblockIndexes map[structs.NamespacedID]uint64
+
 	// duplicates is the set of evaluations for jobs that had pre-existing
 	// blocked evaluations. These should be marked as cancelled since only one
 	// blocked eval is needed per job.
@@ -113,17 +122,19 @@ type BlockedStats struct {
 // unblocked evals into the passed broker.
 func NewBlockedEvals(evalBroker *EvalBroker, logger log.Logger) *BlockedEvals {
 	return &BlockedEvals{
-		logger:           logger.Named("blocked_evals"),
-		evalBroker:       evalBroker,
-		captured:         make(map[string]wrappedEval),
-		escaped:          make(map[string]wrappedEval),
-		system:           newSystemEvals(),
-		jobs:             make(map[structs.NamespacedID]string),
-		unblockIndexes:   make(map[string]uint64),
-		capacityChangeCh: make(chan *capacityUpdate, unblockBuffer),
-		duplicateCh:      make(chan struct{}, 1),
-		stopCh:           make(chan struct{}),
-		stats:            new(BlockedStats),
+		logger:            logger.Named("blocked_evals"),
+		evalBroker:        evalBroker,
+		captured:          make(map[string]wrappedEval),
+		escaped:           make(map[string]wrappedEval),
+		dependents:        make(map[string]wrappedEval),
+		system:            newSystemEvals(),
+		jobs:              make(map[structs.NamespacedID]string),
+		unblockIndexes:    make(map[string]uint64),
+		jobUnblockIndexes: make(map[structs.NamespacedID]uint64),
+		capacityChangeCh:  make(chan *capacityUpdate, unblockBuffer),
+		duplicateCh:       make(chan struct{}, 1),
+		stopCh:            make(chan struct{}),
+		stats:             new(BlockedStats),
 	}
 }
 
@@ -195,6 +206,13 @@ func (b *BlockedEvals) processBlock(eval *structs.Evaluation, token string) {
 		return
 	}
 
+	// Evaluations waiting on job dependencies are only unblocked by changes
+	// to the jobs they wait on, so they are tracked separately.
+	if len(eval.WaitingOnJobs) != 0 {
+		b.processBlockDependents(eval, token)
+		return
+	}
+
 	// Check if the eval missed an unblock while it was in the scheduler at an
 	// older index. The scheduler could have been invoked with a snapshot of
 	// state that was prior to additional capacity being added or allocations
@@ -232,9 +250,9 @@ func (b *BlockedEvals) processBlock(eval *structs.Evaluation, token string) {
 		return
 	}
 
-	// System evals are indexed by node and re-processed on utilization changes in
-	// existing nodes
-	if eval.Type == structs.JobTypeSystem {
+	// System and sysbatch evals are indexed by node and re-processed on
+	// utilization changes in existing nodes
+	if eval.Type == structs.JobTypeSystem || eval.Type == structs.JobTypeSysBatch {
 		b.system.Add(eval, token)
 	}
 
@@ -243,6 +261,26 @@ func (b *BlockedEvals) processBlock(eval *structs.Evaluation, token string) {
 	b.captured[eval.ID] = wrapped
 }
 
+// processBlockDependents tracks an evaluation waiting on job dependencies. If
+// any of the jobs it waits on was unblocked after the evaluation's snapshot,
+// the evaluation is enqueued immediately. This should be called with the lock
+// held.
+func (b *BlockedEvals) processBlockDependents(eval *structs.Evaluation, token string) {
+	for _, jobID := range eval.WaitingOnJobs {
+		if eval.SnapshotIndex < b.jobUnblockIndexes[structs.NewNamespacedID(jobID, eval.Namespace)] {
+			b.evalBroker.EnqueueAll(map[*structs.Evaluation]string{eval: token})
+			return
+		}
+	}
+
+	b.jobs[structs.NewNamespacedID(eval.JobID, eval.Namespace)] = eval.ID
+	b.stats.TotalBlocked++
+	b.dependents[eval.ID] = wrappedEval{
+		eval:  eval,
+		token: token,
+	}
+}
+
 // processBlockJobDuplicate handles the case where the new eval is for a job
 // that we are already tracking. If the eval is a duplicate, we add the older
 // evaluation by Raft index to the list of duplicates such that it can be
@@ -269,15 +307,7 @@ func (b *BlockedEvals) processBlockJobDuplicate(eval *structs.Evaluation) (newCa
 			dup = eval
 			newCancelled = true
 		}
-	} else {
-		existingW, ok = b.escaped[existingID]
-		if !ok {
-			// This is a programming error
-			b.logger.Error("existing blocked evaluation is neither tracked as captured or escaped", "existing_id", existingID)
-			delete(b.jobs, structs.NewNamespacedID(eval.JobID, eval.Namespace))
-			return
-		}
-
+	} else if existingW, ok = b.escaped[existingID]; ok {
 		if latestEvalIndex(existingW.eval) <= latestEvalIndex(eval) {
 			delete(b.escaped, existingID)
 			b.stats.TotalEscaped--
@@ -286,6 +316,20 @@ func (b *BlockedEvals) processBlockJobDuplicate(eval *structs.Evaluation) (newCa
 			dup = eval
 			newCancelled = true
 		}
+	} else if existingW, ok = b.dependents[existingID]; ok {
+		if latestEvalIndex(existingW.eval) <= latestEvalIndex(eval) {
+			delete(b.dependents, existingID)
+			b.stats.TotalBlocked--
+			dup = existingW.eval
+		} else {
+			dup = eval
+			newCancelled = true
+		}
+	} else {
+		// This is a programming error
+		b.logger.Error("existing blocked evaluation is not tracked as captured, escaped or dependent", "existing_id", existingID)
+		delete(b.jobs, structs.NewNamespacedID(eval.JobID, eval.Namespace))
+		return
 	}
 
 	b.duplicates = append(b.duplicates, dup)
@@ -364,7 +408,9 @@ func (b *BlockedEvals) missedUnblock(eval *structs.Evaluation) bool {
 
 // Untrack causes any blocked evaluation for the passed job to be no longer
 // tracked. Untrack is called when there is a successful evaluation for the job
-// and a blocked evaluation is no longer needed.
+// and a blocked evaluation is no longer needed. Evaluations waiting on job
+// dependencies stay tracked since the evaluation that blocked them completes
+// successfully.
 func (b *BlockedEvals) Untrack(jobID, namespace string) {
 	b.l.Lock()
 	defer b.l.Unlock()
@@ -496,6 +542,39 @@ func (b *BlockedEvals) UnblockClassAndQuota(class, quota string, index uint64) {
 	}
 }
 
+// UnblockJob enqueues the evaluations waiting on the passed job so that the
+// scheduler can check their dependency conditions again.
+func (b *BlockedEvals) UnblockJob(jobID, namespace string, index uint64) {
+	b.l.Lock()
+	defer b.l.Unlock()
+
+	// Do nothing if not enabled
+	if !b.enabled {
+		return
+	}
+
+	// Store the index in which the unblock happened. We use this on subsequent
+	// block calls in case the evaluation was in the scheduler when the job
+	// changed.
+	b.jobUnblockIndexes[structs.NewNamespacedID(jobID, namespace)] = index
+
+	unblocked := make(map[*structs.Evaluation]string, 4)
+	for id, wrapped := range b.dependents {
+		if wrapped.eval.Namespace != namespace || !helper.SliceStringContains(wrapped.eval.WaitingOnJobs, jobID) {
+			continue
+		}
+
+		unblocked[wrapped.eval] = wrapped.token
+		delete(b.dependents, id)
+		delete(b.jobs, structs.NewNamespacedID(wrapped.eval.JobID, wrapped.eval.Namespace))
+	}
+
+	if l := len(unblocked); l != 0 {
+		b.stats.TotalBlocked -= l
+		b.evalBroker.EnqueueAll(unblocked)
+	}
+}
+
 // UnblockNode finds any blocked evalution that's node specific (system jobs) and enqueues
 // it on the eval broker
 func (b *BlockedEvals) UnblockNode(nodeID string, index uint64) {
@@ -685,8 +764,10 @@ func (b *BlockedEvals) Flush() {
 	b.stats.TotalQuotaLimit = 0
 	b.captured = make(map[string]wrappedEval)
 	b.escaped = make(map[string]wrappedEval)
+	b.dependents = make(map[string]wrappedEval)
 	b.jobs = make(map[structs.NamespacedID]string)
 	b.unblockIndexes = make(map[string]uint64)
+	b.jobUnblockIndexes = make(map[structs.NamespacedID]uint64)
 	b.timetable = nil
 	b.duplicates = nil
 	b.capacityChangeCh = make(chan *capacityUpdate, unblockBuffer)
@@ -758,4 +839,10 @@ func (b *BlockedEvals) pruneUnblockIndexes() {
 			delete(b.unblockIndexes, key)
 		}
 	}
+
+	for key, index := range b.jobUnblockIndexes {
+		if index < oldThreshold {
+			delete(b.jobUnblockIndexes, key)
+		}
+	}
 }
@@ -621,6 +621,52 @@ func TestBlockedEvals_UnblockNode(t *testing.T) {
 	require.Equal(t, 0, bs.TotalBlocked)
 }
 
+func TestBlockedEvals_UnblockJob(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	blocked, broker := testBlockedEvals(t)
+
+	// Create an eval waiting on two jobs and add it to the blocked tracker.
+	e := mock.Eval()
+	e.Status = structs.EvalStatusBlocked
+	e.WaitingOnJobs = []string{"extract", "cleanup"}
+	e.SnapshotIndex = 999
+	blocked.Block(e)
+	require.Equal(1, blocked.Stats().TotalBlocked)
+
+	// Capacity changes and untracking the job don't unblock it
+	blocked.Unblock("v1:123", 1000)
+	blocked.Untrack(e.JobID, e.Namespace)
+	require.Equal(1, blocked.Stats().TotalBlocked)
+
+	// Changes to other jobs don't unblock it
+	blocked.UnblockJob("other", e.Namespace, 1001)
+	blocked.UnblockJob("extract", "other-namespace", 1001)
+	require.Equal(1, blocked.Stats().TotalBlocked)
+
+	blocked.UnblockJob("cleanup", e.Namespace, 1002)
+	requireBlockedEvalsEnqueued(t, blocked, broker, 1)
+	require.Empty(blocked.dependents)
+	require.Empty(blocked.jobs)
+}
+
+func TestBlockedEvals_Block_ImmediateUnblock_Job(t *testing.T) {
+	t.Parallel()
+	blocked, broker := testBlockedEvals(t)
+
+	// Unblock the job before the eval waiting on it is blocked
+	blocked.UnblockJob("extract", structs.DefaultNamespace, 1000)
+
+	e := mock.Eval()
+	e.Status = structs.EvalStatusBlocked
+	e.WaitingOnJobs = []string{"extract"}
+	e.SnapshotIndex = 900
+	blocked.Block(e)
+
+	// Verify block caused the eval to be immediately unblocked
+	requireBlockedEvalsEnqueued(t, blocked, broker, 1)
+}
+
 func TestBlockedEvals_SystemUntrack(t *testing.T) {
 	t.Parallel()
 	blocked, _ := testBlockedEvals(t)
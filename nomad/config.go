@@ -169,6 +169,11 @@ type Config struct {
 	// the user time to inspect the job.
 	JobGCThreshold time.Duration
 
+	// DispatchIdempotencyWindow is how long after a job was dispatched its
+	// idempotency token is honored once the job is terminal. Tokens of
+	// non-terminal dispatched jobs are always honored.
+	DispatchIdempotencyWindow time.Duration
+
 	// NodeGCInterval is how often we dispatch a job to GC failed nodes.
 	NodeGCInterval time.Duration
 
@@ -184,6 +189,28 @@ type Config struct {
 	// for GC. This gives users some time to view terminal deployments.
 	DeploymentGCThreshold time.Duration
 
+	// RootKeyGCInterval is how often we dispatch a job to rotate the root key
+	// signing workload identities and GC inactive root keys.
+	RootKeyGCInterval time.Duration
+
+	// RootKeyRotationThreshold is how "old" the active root key must be to be
+	// rotated.
+	RootKeyRotationThreshold time.Duration
+
+	// KeyEncryptionKey is the AES-256 key wrapping the data keys of the root
+	// keys, which encrypt variables. It must be the same on all servers.
+	// Variables are disabled if it isn't set.
+	KeyEncryptionKey []byte
+
+	// ACLTokenExpirationGCInterval is how often we dispatch a job to delete
+	// expired ACL tokens.
+	ACLTokenExpirationGCInterval time.Duration
+
+	// WorkloadIdentityTTL is how long the workload identities signed for tasks
+	// are valid. Inactive root keys are GCed once all identities they signed
+	// have expired.
+	WorkloadIdentityTTL time.Duration
+
 	// EvalNackTimeout controls how long we allow a sub-scheduler to
 	// work on an evaluation before we consider it failed and Nack it.
 	// This allows that evaluation to be handed to another sub-scheduler
@@ -346,10 +373,15 @@ func DefaultConfig() *Config {
 		EvalGCThreshold:                  1 * time.Hour,
 		JobGCInterval:                    5 * time.Minute,
 		JobGCThreshold:                   4 * time.Hour,
+		DispatchIdempotencyWindow:        1 * time.Hour,
 		NodeGCInterval:                   5 * time.Minute,
 		NodeGCThreshold:                  24 * time.Hour,
 		DeploymentGCInterval:             5 * time.Minute,
 		DeploymentGCThreshold:            1 * time.Hour,
+		RootKeyGCInterval:                10 * time.Minute,
+		RootKeyRotationThreshold:         720 * time.Hour,
+		ACLTokenExpirationGCInterval:     5 * time.Minute,
+		WorkloadIdentityTTL:              1 * time.Hour,
 		EvalNackTimeout:                  60 * time.Second,
 		EvalDeliveryLimit:                3,
 		EvalNackInitialReenqueueDelay:    1 * time.Second,
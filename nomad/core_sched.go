@@ -3,6 +3,7 @@ package nomad
 import (
 	"fmt"
 	"math"
+	"sort"
 	"time"
 
 	log "github.com/hashicorp/go-hclog"
@@ -50,6 +51,10 @@ func (c *CoreScheduler) Process(eval *structs.Evaluation) error {
 		return c.jobGC(eval)
 	case structs.CoreJobDeploymentGC:
 		return c.deploymentGC(eval)
+	case structs.CoreJobRootKeyRotateOrGC:
+		return c.rootKeyRotateOrGC(eval)
+	case structs.CoreJobExpiredACLTokenGC:
+		return c.expiredACLTokenGC(eval)
 	case structs.CoreJobForceGC:
 		return c.forceGC(eval)
 	default:
@@ -68,6 +73,9 @@ func (c *CoreScheduler) forceGC(eval *structs.Evaluation) error {
 	if err := c.deploymentGC(eval); err != nil {
 		return err
 	}
+	if err := c.expiredACLTokenGC(eval); err != nil {
+		return err
+	}
 
 	// Node GC must occur after the others to ensure the allocations are
 	// cleared.
@@ -294,11 +302,11 @@ func (c *CoreScheduler) gcEval(eval *structs.Evaluation, thresholdIndex uint64,
 		return false, nil, err
 	}
 
-	// If the eval is from a running "batch" job we don't want to garbage
-	// collect its allocations. If there is a long running batch job and its
-	// terminal allocations get GC'd the scheduler would re-run the
-	// allocations.
-	if eval.Type == structs.JobTypeBatch {
+	// If the eval is from a running "batch" or "sysbatch" job we don't want
+	// to garbage collect its allocations. If there is a long running batch
+	// job and its terminal allocations get GC'd the scheduler would re-run
+	// the allocations.
+	if eval.Type == structs.JobTypeBatch || eval.Type == structs.JobTypeSysBatch {
 		// Check if the job is running
 
 		// Can collect if:
@@ -638,6 +646,141 @@ func (c *CoreScheduler) partitionDeploymentReap(deployments []string) []*structs
 	return requests
 }
 
+// rootKeyRotateOrGC is used to rotate the active root key once it reaches the
+// rotation threshold and to garbage collect inactive root keys. An inactive
+// key is eligible for GC once all the workload identities it signed have
+// expired, which is one identity TTL after the next key was created, and it
+// no longer encrypts any variable.
+func (c *CoreScheduler) rootKeyRotateOrGC(eval *structs.Evaluation) error {
+	ws := memdb.NewWatchSet()
+	iter, err := c.snap.RootKeys(ws)
+	if err != nil {
+		return err
+	}
+
+	var keys []*structs.RootKey
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		keys = append(keys, raw.(*structs.RootKey))
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].CreateTime.Before(keys[j].CreateTime)
+	})
+
+	now := time.Now().UTC()
+	var gcKeys []string
+	for i, key := range keys {
+		if key.Active {
+			if now.Sub(key.CreateTime) < c.srv.config.RootKeyRotationThreshold {
+				continue
+			}
+
+			c.logger.Debug("rotating root key", "key_id", key.KeyID)
+			req := &structs.KeyringRotateRequest{
+				WriteRequest: structs.WriteRequest{
+					Region:    c.srv.config.Region,
+					AuthToken: eval.LeaderACL,
+				},
+			}
+			var resp structs.KeyringRotateResponse
+			if err := c.srv.RPC("Keyring.Rotate", req, &resp); err != nil {
+				c.logger.Error("root key rotation failed", "error", err)
+				return err
+			}
+			continue
+		}
+
+		// Keys are made inactive by the creation of the next key
+		if i+1 >= len(keys) || now.Sub(keys[i+1].CreateTime) <= c.srv.config.WorkloadIdentityTTL {
+			continue
+		}
+
+		// Keys still encrypting variables are kept until the variables are
+		// re-encrypted
+		vars, err := c.snap.VariablesByKeyID(ws, key.KeyID)
+		if err != nil {
+			return err
+		}
+		if vars.Next() != nil {
+			continue
+		}
+
+		gcKeys = append(gcKeys, key.KeyID)
+	}
+
+	// Fast-path the nothing case
+	if len(gcKeys) == 0 {
+		return nil
+	}
+	c.logger.Debug("root key GC found eligible keys", "keys", len(gcKeys))
+
+	req := &structs.RootKeyDeleteRequest{
+		KeyIDs: gcKeys,
+		WriteRequest: structs.WriteRequest{
+			Region:    c.srv.config.Region,
+			AuthToken: eval.LeaderACL,
+		},
+	}
+	var resp structs.GenericResponse
+	if err := c.srv.RPC("Keyring.Delete", req, &resp); err != nil {
+		c.logger.Error("root key delete failed", "error", err)
+		return err
+	}
+	return nil
+}
+
+// expiredACLTokenGC is used to delete expired ACL tokens. Global tokens are
+// only deleted by the authoritative region, and the deletion is replicated to
+// the other regions.
+func (c *CoreScheduler) expiredACLTokenGC(eval *structs.Evaluation) error {
+	// Tokens only exist when ACLs are enabled
+	if !c.srv.config.ACLEnabled {
+		return nil
+	}
+
+	ws := memdb.NewWatchSet()
+	iter, err := c.snap.ACLTokens(ws)
+	if err != nil {
+		return err
+	}
+
+	authoritative := c.srv.config.Region == c.srv.config.AuthoritativeRegion
+	now := time.Now().UTC()
+	var local, global []string
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		token := raw.(*structs.ACLToken)
+		if !token.IsExpired(now) {
+			continue
+		}
+		if !token.Global {
+			local = append(local, token.AccessorID)
+		} else if authoritative {
+			global = append(global, token.AccessorID)
+		}
+	}
+
+	// Global and local tokens can't be deleted together
+	for _, ids := range [][]string{local, global} {
+		if len(ids) == 0 {
+			continue
+		}
+		c.logger.Debug("ACL token GC found expired tokens", "tokens", len(ids))
+
+		req := &structs.ACLTokenDeleteRequest{
+			AccessorIDs: ids,
+			WriteRequest: structs.WriteRequest{
+				Region:    c.srv.config.Region,
+				AuthToken: eval.LeaderACL,
+			},
+		}
+		var resp structs.GenericResponse
+		if err := c.srv.RPC("ACL.DeleteTokens", req, &resp); err != nil {
+			c.logger.Error("expired ACL token delete failed", "error", err)
+			return err
+		}
+	}
+	return nil
+}
+
 // allocGCEligible returns if the allocation is eligible to be garbage collected
 // according to its terminal status and its reschedule trackers
 func allocGCEligible(a *structs.Allocation, job *structs.Job, gcTime time.Time, thresholdIndex uint64) bool {
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	memdb "github.com/hashicorp/go-memdb"
+	msgpackrpc "github.com/hashicorp/net-rpc-msgpackrpc"
 	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/structs"
@@ -1721,6 +1722,70 @@ func TestCoreScheduler_JobGC_Periodic(t *testing.T) {
 	}
 }
 
+// This test ensures paused batch jobs are not gc'd and can be resumed
+func TestCoreScheduler_JobGC_Paused(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	s1 := TestServer(t, nil)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	// COMPAT Remove in 0.6: Reset the FSM time table since we reconcile which sets index 0
+	s1.fsm.timetable.table = make([]TimeTableEntry, 1, 10)
+
+	// Insert a paused batch job whose allocations have all stopped
+	state := s1.fsm.State()
+	job := mock.BatchJob()
+	job.Pause()
+	require.NoError(state.UpsertJob(1000, job))
+
+	eval := mock.Eval()
+	eval.JobID = job.ID
+	eval.Status = structs.EvalStatusComplete
+	require.NoError(state.UpsertEvals(1001, []*structs.Evaluation{eval}))
+
+	alloc := mock.Alloc()
+	alloc.JobID = job.ID
+	alloc.EvalID = eval.ID
+	alloc.DesiredStatus = structs.AllocDesiredStatusStop
+	alloc.ClientStatus = structs.AllocClientStatusComplete
+	require.NoError(state.UpsertAllocs(1002, []*structs.Allocation{alloc}))
+
+	ws := memdb.NewWatchSet()
+	out, err := state.JobByID(ws, job.Namespace, job.ID)
+	require.NoError(err)
+	require.Equal(structs.JobStatusDead, out.Status)
+
+	// Attempt the GC
+	snap, err := state.Snapshot()
+	require.NoError(err)
+	core := NewCoreScheduler(s1, snap)
+	gc := s1.coreJobEval(structs.CoreJobForceGC, 1003)
+	require.NoError(core.Process(gc))
+
+	// Should still exist
+	out, err = state.JobByID(ws, job.Namespace, job.ID)
+	require.NoError(err)
+	require.NotNil(out)
+
+	// Resume the job
+	req := &structs.JobPauseRequest{
+		JobID: job.ID,
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			Namespace: job.Namespace,
+		},
+	}
+	var resp structs.JobRegisterResponse
+	require.NoError(msgpackrpc.CallWithCodec(codec, "Job.Pause", req, &resp))
+
+	out, err = state.JobByID(ws, job.Namespace, job.ID)
+	require.NoError(err)
+	require.False(out.Paused)
+	require.Equal(10, out.TaskGroups[0].Count)
+}
+
 func TestCoreScheduler_DeploymentGC(t *testing.T) {
 	t.Parallel()
 	s1 := TestServer(t, nil)
@@ -2170,3 +2235,118 @@ func TestAllocation_GCEligible(t *testing.T) {
 	alloc.ClientStatus = structs.AllocClientStatusComplete
 	require.True(allocGCEligible(alloc, nil, time.Now(), 1000))
 }
+
+func TestCoreScheduler_RootKeyRotateOrGC(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	s1 := TestServer(t, nil)
+	defer s1.Shutdown()
+	testutil.WaitForLeader(t, s1.RPC)
+
+	state := s1.fsm.State()
+	var initial *structs.RootKey
+	testutil.WaitForResult(func() (bool, error) {
+		key, err := state.ActiveRootKey(nil)
+		initial = key
+		return key != nil, err
+	}, func(err error) {
+		t.Fatalf("no active root key: %v", err)
+	})
+	require.Nil(state.DeleteRootKeys(1000, []string{initial.KeyID}))
+
+	// Insert two retired keys and an active key past the rotation threshold
+	now := time.Now().UTC()
+	var keys []*structs.RootKey
+	for _, age := range []time.Duration{3000 * time.Hour, 2000 * time.Hour, 1000 * time.Hour} {
+		key, err := newRootKey(now.Add(-age), testKeyEncryptionKey)
+		require.Nil(err)
+		keys = append(keys, key)
+	}
+	keys[2].Active = true
+	require.Nil(state.UpsertRootKeys(1001, keys))
+
+	// Encrypt a variable with the second retired key
+	v, err := encryptVariable(testKeyEncryptionKey, keys[1], &structs.VariableDecrypted{
+		VariableMetadata: structs.VariableMetadata{
+			Namespace: structs.DefaultNamespace,
+			Path:      "app/db",
+		},
+		Items: structs.VariableItems{"a": "b"},
+	})
+	require.Nil(err)
+	require.Nil(state.UpsertVariable(1002, v))
+
+	// Create a core scheduler
+	snap, err := state.Snapshot()
+	require.Nil(err)
+	core := NewCoreScheduler(s1, snap)
+
+	// Attempt the rotation and GC
+	gc := s1.coreJobEval(structs.CoreJobRootKeyRotateOrGC, 2000)
+	require.Nil(core.Process(gc))
+
+	// The first retired key is gone
+	out, err := state.RootKeyByID(nil, keys[0].KeyID)
+	require.Nil(err)
+	require.Nil(out)
+
+	// The second retired key is kept to decrypt the variable
+	out, err = state.RootKeyByID(nil, keys[1].KeyID)
+	require.Nil(err)
+	require.NotNil(out)
+
+	// The old active key is kept to verify the identities it signed
+	out, err = state.RootKeyByID(nil, keys[2].KeyID)
+	require.Nil(err)
+	require.NotNil(out)
+	require.False(out.Active)
+
+	active, err := state.ActiveRootKey(nil)
+	require.Nil(err)
+	require.NotNil(active)
+	require.NotEqual(keys[2].KeyID, active.KeyID)
+}
+
+func TestCoreScheduler_ExpiredACLTokenGC(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	s1, _ := TestACLServer(t, nil)
+	defer s1.Shutdown()
+	testutil.WaitForLeader(t, s1.RPC)
+
+	// Insert an expired local token, an expired global token and a token
+	// that has not expired yet
+	state := s1.fsm.State()
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+	expiredLocal := mock.ACLToken()
+	expiredLocal.ExpirationTime = &past
+	expiredGlobal := mock.ACLToken()
+	expiredGlobal.Global = true
+	expiredGlobal.ExpirationTime = &past
+	live := mock.ACLToken()
+	live.ExpirationTime = &future
+	require.Nil(state.UpsertACLTokens(1000, []*structs.ACLToken{expiredLocal, expiredGlobal, live}))
+
+	// Create a core scheduler
+	snap, err := state.Snapshot()
+	require.Nil(err)
+	core := NewCoreScheduler(s1, snap)
+
+	// Attempt the GC
+	gc := s1.coreJobEval(structs.CoreJobExpiredACLTokenGC, 2000)
+	require.Nil(core.Process(gc))
+
+	// Both expired tokens are gone since this is the authoritative region
+	for _, tk := range []*structs.ACLToken{expiredLocal, expiredGlobal} {
+		out, err := state.ACLTokenByAccessorID(nil, tk.AccessorID)
+		require.Nil(err)
+		require.Nil(out)
+	}
+
+	out, err := state.ACLTokenByAccessorID(nil, live.AccessorID)
+	require.Nil(err)
+	require.NotNil(out)
+}
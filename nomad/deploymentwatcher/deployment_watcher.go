@@ -246,6 +246,15 @@ func (w *deploymentWatcher) PromoteDeployment(
 	req *structs.DeploymentPromoteRequest,
 	resp *structs.DeploymentUpdateResponse) error {
 
+	// Promoting a deployment paused after a batch without canaries to promote
+	// approves the next batch
+	if d := w.getDeployment(); d.PausedAfterBatch() && !d.RequiresPromotion() {
+		return w.PauseDeployment(&structs.DeploymentPauseRequest{
+			DeploymentID: req.DeploymentID,
+			Pause:        false,
+		}, resp)
+	}
+
 	// Create the request
 	areq := &structs.ApplyDeploymentPromoteRequest{
 		DeploymentPromoteRequest: *req,
@@ -312,6 +321,12 @@ func (w *deploymentWatcher) PauseDeployment(
 	}
 	update := w.getDeploymentStatusUpdate(status, desc)
 
+	// The progress deadlines are suspended while awaiting approval of the next
+	// batch, so give the groups their full deadline again once approved
+	if !req.Pause && w.getDeployment().PausedAfterBatch() {
+		update.ProgressDeadlineStart = time.Now()
+	}
+
 	// Commit the change
 	i, err := w.upsertDeploymentStatusUpdate(update, eval, nil)
 	if err != nil {
@@ -499,8 +514,14 @@ FAIL:
 				w.logger.Error("failed to auto promote deployment", "error", err)
 			}
 
-			// Create an eval to push the deployment along
-			if res.createEval || len(res.allowReplacements) != 0 {
+			// Pause the deployment if a batch has completed, otherwise create
+			// an eval to push the deployment along
+			if res.pauseAfterBatch {
+				u := w.getDeploymentStatusUpdate(structs.DeploymentStatusPaused, structs.DeploymentStatusDescriptionPausedAfterBatch)
+				if _, err := w.upsertDeploymentStatusUpdate(u, nil, nil); err != nil {
+					w.logger.Error("failed to pause deployment after batch", "error", err)
+				}
+			} else if res.createEval || len(res.allowReplacements) != 0 {
 				w.createBatchedUpdate(res.allowReplacements, allocIndex)
 			}
 		}
@@ -544,6 +565,7 @@ type allocUpdateResult struct {
 	createEval        bool
 	failDeployment    bool
 	rollback          bool
+	pauseAfterBatch   bool
 	allowReplacements []string
 }
 
@@ -607,9 +629,56 @@ func (w *deploymentWatcher) handleAllocUpdate(allocs []*structs.AllocListStub) (
 		}
 	}
 
+	// Instead of pushing the deployment along, pause it once a batch has
+	// become healthy if its group waits for approval after each batch
+	if res.createEval && !res.failDeployment && len(res.allowReplacements) == 0 {
+		res.pauseAfterBatch = w.batchComplete(deployment, allocs)
+	}
+
 	return res, nil
 }
 
+// batchComplete returns whether all the allocations of the running deployment
+// are healthy for the groups that pause after each batch, and at least one of
+// these groups has allocations left to update. Groups waiting for their
+// canaries to be promoted are not considered.
+func (w *deploymentWatcher) batchComplete(d *structs.Deployment, allocs []*structs.AllocListStub) bool {
+	if d.Status != structs.DeploymentStatusRunning {
+		return false
+	}
+
+	healthy := make(map[string]int, len(d.TaskGroups))
+	pending := make(map[string]int, len(d.TaskGroups))
+	for _, alloc := range allocs {
+		if alloc.DesiredStatus != structs.AllocDesiredStatusRun {
+			continue
+		}
+		if alloc.DeploymentStatus.IsHealthy() {
+			healthy[alloc.TaskGroup]++
+		} else {
+			pending[alloc.TaskGroup]++
+		}
+	}
+
+	complete := false
+	for name, dstate := range d.TaskGroups {
+		tg := w.j.LookupTaskGroup(name)
+		if tg == nil || tg.Update == nil || !tg.Update.PauseAfterBatch {
+			continue
+		}
+		if dstate.DesiredCanaries != 0 && !dstate.Promoted {
+			continue
+		}
+		if pending[name] != 0 {
+			return false
+		}
+		if healthy[name] != 0 && healthy[name] < dstate.DesiredTotal {
+			complete = true
+		}
+	}
+	return complete
+}
+
 // shouldFail returns whether the job should be failed and whether it should
 // rolled back to an earlier stable version by examining the allocations in the
 // deployment.
@@ -628,6 +697,12 @@ func (w *deploymentWatcher) shouldFail() (fail, rollback bool, err error) {
 		return false, false, fmt.Errorf("deployment id not found: %q", w.deploymentID)
 	}
 
+	// The deployment isn't expected to make progress while awaiting approval
+	// of its next batch
+	if d.PausedAfterBatch() {
+		return false, false, nil
+	}
+
 	fail = false
 	for tg, state := range d.TaskGroups {
 		// If we are in a canary state we fail if there aren't enough healthy
@@ -657,9 +732,13 @@ func (w *deploymentWatcher) shouldFail() (fail, rollback bool, err error) {
 }
 
 // getDeploymentProgressCutoff returns the progress cutoff for the given
-// deployment
+// deployment. There is no cutoff while the deployment is paused after a
+// batch.
 func (w *deploymentWatcher) getDeploymentProgressCutoff(d *structs.Deployment) time.Time {
 	var next time.Time
+	if d.PausedAfterBatch() {
+		return next
+	}
 	doneTGs := w.doneGroups(d)
 	for name, state := range d.TaskGroups {
 		// This task group is done so we don't have to concern ourselves with
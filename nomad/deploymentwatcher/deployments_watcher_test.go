@@ -494,6 +494,51 @@ func TestWatcher_PromoteDeployment_UnhealthyCanaries(t *testing.T) {
 	m.AssertCalled(t, "UpdateDeploymentPromotion", mocker.MatchedBy(matcher))
 }
 
+// Test promoting a deployment that is paused after a batch resumes it
+func TestWatcher_PromoteDeployment_PausedAfterBatch(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	w, m := defaultTestDeploymentWatcher(t)
+
+	// Create a job and a deployment paused after a batch
+	j := mock.Job()
+	j.TaskGroups[0].Update = structs.DefaultUpdateStrategy.Copy()
+	j.TaskGroups[0].Update.PauseAfterBatch = true
+	d := mock.Deployment()
+	d.JobID = j.ID
+	d.Status = structs.DeploymentStatusPaused
+	d.StatusDescription = structs.DeploymentStatusDescriptionPausedAfterBatch
+	require.Nil(m.state.UpsertJob(m.nextIndex(), j), "UpsertJob")
+	require.Nil(m.state.UpsertDeployment(m.nextIndex(), d), "UpsertDeployment")
+
+	// require that we get a call to UpsertDeploymentStatusUpdate
+	matchConfig := &matchDeploymentStatusUpdateConfig{
+		DeploymentID:      d.ID,
+		Status:            structs.DeploymentStatusRunning,
+		StatusDescription: structs.DeploymentStatusDescriptionRunning,
+		Eval:              true,
+	}
+	matcher := matchDeploymentStatusUpdateRequest(matchConfig)
+	m.On("UpdateDeploymentStatus", mocker.MatchedBy(matcher)).Return(nil)
+
+	w.SetEnabled(true, m.state)
+	testutil.WaitForResult(func() (bool, error) { return 1 == watchersCount(w), nil },
+		func(err error) { require.Equal(1, watchersCount(w), "Should have 1 deployment") })
+
+	// Call PromoteDeployment
+	req := &structs.DeploymentPromoteRequest{
+		DeploymentID: d.ID,
+		All:          true,
+	}
+	var resp structs.DeploymentUpdateResponse
+	err := w.PromoteDeployment(req, &resp)
+	require.Nil(err, "PromoteDeployment")
+
+	require.Equal(1, watchersCount(w), "Deployment should still be active")
+	m.AssertCalled(t, "UpdateDeploymentStatus", mocker.MatchedBy(matcher))
+	m.AssertNotCalled(t, "UpdateDeploymentPromotion", mocker.Anything)
+}
+
 func TestWatcher_AutoPromoteDeployment(t *testing.T) {
 	t.Parallel()
 	w, m := defaultTestDeploymentWatcher(t)
@@ -935,6 +980,135 @@ func TestDeploymentWatcher_Watch_NoProgressDeadline(t *testing.T) {
 		func(err error) { require.Equal(0, watchersCount(w), "Should have no deployment") })
 }
 
+// Test that a deployment whose group pauses after each batch is paused once
+// the batch becomes healthy instead of being pushed along
+func TestDeploymentWatcher_Watch_PauseAfterBatch(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	w, m := testDeploymentWatcher(t, 1000.0, 1*time.Millisecond)
+
+	// Create a job, allocs, and a deployment
+	j := mock.Job()
+	j.TaskGroups[0].Update = structs.DefaultUpdateStrategy.Copy()
+	j.TaskGroups[0].Update.MaxParallel = 2
+	j.TaskGroups[0].Update.PauseAfterBatch = true
+	d := mock.Deployment()
+	d.JobID = j.ID
+	a1 := mock.Alloc()
+	a1.DeploymentID = d.ID
+	a2 := mock.Alloc()
+	a2.DeploymentID = d.ID
+	require.Nil(m.state.UpsertJob(m.nextIndex(), j), "UpsertJob")
+	require.Nil(m.state.UpsertDeployment(m.nextIndex(), d), "UpsertDeployment")
+	require.Nil(m.state.UpsertAllocs(m.nextIndex(), []*structs.Allocation{a1, a2}), "UpsertAllocs")
+
+	// require that we get a call to UpsertDeploymentStatusUpdate without an
+	// evaluation
+	c := &matchDeploymentStatusUpdateConfig{
+		DeploymentID:      d.ID,
+		Status:            structs.DeploymentStatusPaused,
+		StatusDescription: structs.DeploymentStatusDescriptionPausedAfterBatch,
+		Eval:              false,
+	}
+	m1 := matchDeploymentStatusUpdateRequest(c)
+	m.On("UpdateDeploymentStatus", mocker.MatchedBy(m1)).Return(nil)
+
+	w.SetEnabled(true, m.state)
+	testutil.WaitForResult(func() (bool, error) { return 1 == watchersCount(w), nil },
+		func(err error) { require.Equal(1, watchersCount(w), "Should have 1 deployment") })
+
+	// Mark the allocs healthy which should pause the deployment
+	req := &structs.ApplyDeploymentAllocHealthRequest{
+		DeploymentAllocHealthRequest: structs.DeploymentAllocHealthRequest{
+			DeploymentID:         d.ID,
+			HealthyAllocationIDs: []string{a1.ID, a2.ID},
+		},
+	}
+	require.Nil(m.state.UpdateDeploymentAllocHealth(m.nextIndex(), req), "UpsertDeploymentAllocHealth")
+
+	testutil.WaitForResult(func() (bool, error) {
+		ws := memdb.NewWatchSet()
+		dout, err := m.state.DeploymentByID(ws, d.ID)
+		if err != nil {
+			return false, err
+		}
+		if dout.Status != structs.DeploymentStatusPaused {
+			return false, fmt.Errorf("Got status %q; want %q", dout.Status, structs.DeploymentStatusPaused)
+		}
+		if dout.StatusDescription != structs.DeploymentStatusDescriptionPausedAfterBatch {
+			return false, fmt.Errorf("Got status description %q", dout.StatusDescription)
+		}
+		return true, nil
+	}, func(err error) {
+		t.Fatal(err)
+	})
+
+	// The deployment should not have been pushed along
+	evals, err := m.state.EvalsByJob(nil, j.Namespace, j.ID)
+	require.Nil(err, "EvalsByJob")
+	require.Len(evals, 0)
+	m.AssertCalled(t, "UpdateDeploymentStatus", mocker.MatchedBy(m1))
+}
+
+// Test that the progress deadline is suspended while a deployment is paused
+// after a batch and restarted once the next batch is approved
+func TestDeploymentWatcher_Watch_PauseAfterBatch_ProgressDeadline(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	w, m := testDeploymentWatcher(t, 1000.0, 1*time.Millisecond)
+
+	// Create a job and a deployment paused after a batch whose progress
+	// deadline has already passed
+	j := mock.Job()
+	j.TaskGroups[0].Update = structs.DefaultUpdateStrategy.Copy()
+	j.TaskGroups[0].Update.ProgressDeadline = time.Hour
+	j.TaskGroups[0].Update.PauseAfterBatch = true
+	j.TaskGroups[0].Update.AutoRevert = true
+	d := mock.Deployment()
+	d.JobID = j.ID
+	d.Status = structs.DeploymentStatusPaused
+	d.StatusDescription = structs.DeploymentStatusDescriptionPausedAfterBatch
+	d.TaskGroups["web"].ProgressDeadline = time.Hour
+	d.TaskGroups["web"].RequireProgressBy = time.Now().Add(-time.Minute)
+	d.TaskGroups["web"].PlacedAllocs = 2
+	d.TaskGroups["web"].HealthyAllocs = 2
+	require.Nil(m.state.UpsertJob(m.nextIndex(), j), "UpsertJob")
+	require.Nil(m.state.UpsertDeployment(m.nextIndex(), d), "UpsertDeployment")
+
+	m.On("UpdateDeploymentStatus", mocker.Anything).Return(nil)
+
+	w.SetEnabled(true, m.state)
+	testutil.WaitForResult(func() (bool, error) { return 1 == watchersCount(w), nil },
+		func(err error) { require.Equal(1, watchersCount(w), "Should have 1 deployment") })
+
+	// The deployment must not fail while awaiting approval
+	time.Sleep(100 * time.Millisecond)
+	dout, err := m.state.DeploymentByID(nil, d.ID)
+	require.Nil(err, "DeploymentByID")
+	require.Equal(structs.DeploymentStatusPaused, dout.Status)
+	m.AssertNotCalled(t, "UpdateDeploymentStatus", mocker.Anything)
+
+	// Approving the next batch restarts the progress deadline
+	now := time.Now()
+	var resp structs.DeploymentUpdateResponse
+	require.Nil(w.PauseDeployment(&structs.DeploymentPauseRequest{
+		DeploymentID: d.ID,
+		Pause:        false,
+	}, &resp), "PauseDeployment")
+
+	dout, err = m.state.DeploymentByID(nil, d.ID)
+	require.Nil(err, "DeploymentByID")
+	require.Equal(structs.DeploymentStatusRunning, dout.Status)
+	require.False(dout.TaskGroups["web"].RequireProgressBy.Before(now.Add(time.Hour)))
+
+	// The deployment keeps running towards its new deadline
+	time.Sleep(100 * time.Millisecond)
+	dout, err = m.state.DeploymentByID(nil, d.ID)
+	require.Nil(err, "DeploymentByID")
+	require.Equal(structs.DeploymentStatusRunning, dout.Status)
+	require.Equal(1, watchersCount(w), "Deployment should still be active")
+}
+
 func TestDeploymentWatcher_Watch_ProgressDeadline(t *testing.T) {
 	t.Parallel()
 	require := require.New(t)
@@ -300,7 +300,7 @@ func (r *jobResult) String() string {
 // handleJob takes the state of a draining job and returns the desired actions.
 func handleJob(snap *state.StateSnapshot, job *structs.Job, allocs []*structs.Allocation, lastHandledIndex uint64) (*jobResult, error) {
 	r := newJobResult()
-	batch := job.Type == structs.JobTypeBatch
+	batch := job.Type == structs.JobTypeBatch || job.Type == structs.JobTypeSysBatch
 	taskGroups := make(map[string]*structs.TaskGroup, len(job.TaskGroups))
 	for _, tg := range job.TaskGroups {
 		// Only capture the groups that have a migrate strategy or we are just
@@ -45,6 +45,11 @@ const (
 	ACLPolicySnapshot
 	ACLTokenSnapshot
 	SchedulerConfigSnapshot
+	RootKeySnapshot
+	VariableSnapshot
+	ACLRoleSnapshot
+	ACLAuthMethodSnapshot
+	ACLBindingRuleSnapshot
 )
 
 // LogApplier is the definition of a function that can apply a Raft log
@@ -251,6 +256,26 @@ func (n *nomadFSM) Apply(log *raft.Log) interface{} {
 		return n.applySchedulerConfigUpdate(buf[1:], log.Index)
 	case structs.NodeBatchDeregisterRequestType:
 		return n.applyDeregisterNodeBatch(buf[1:], log.Index)
+	case structs.RootKeyUpsertRequestType:
+		return n.applyRootKeyUpsert(buf[1:], log.Index)
+	case structs.RootKeyDeleteRequestType:
+		return n.applyRootKeyDelete(buf[1:], log.Index)
+	case structs.VarUpsertRequestType:
+		return n.applyVariableUpsert(buf[1:], log.Index)
+	case structs.VarDeleteRequestType:
+		return n.applyVariableDelete(buf[1:], log.Index)
+	case structs.ACLRoleUpsertRequestType:
+		return n.applyACLRoleUpsert(buf[1:], log.Index)
+	case structs.ACLRoleDeleteRequestType:
+		return n.applyACLRoleDelete(buf[1:], log.Index)
+	case structs.ACLAuthMethodUpsertRequestType:
+		return n.applyACLAuthMethodUpsert(buf[1:], log.Index)
+	case structs.ACLAuthMethodDeleteRequestType:
+		return n.applyACLAuthMethodDelete(buf[1:], log.Index)
+	case structs.ACLBindingRuleUpsertRequestType:
+		return n.applyACLBindingRuleUpsert(buf[1:], log.Index)
+	case structs.ACLBindingRuleDeleteRequestType:
+		return n.applyACLBindingRuleDelete(buf[1:], log.Index)
 	}
 
 	// Check enterprise only message types.
@@ -438,11 +463,23 @@ func (n *nomadFSM) applyUpsertJob(buf []byte, index uint64) interface{} {
 	 */
 	req.Job.Canonicalize()
 
+	// Lookup the current job to detect periodic jobs becoming active again
+	existing, err := n.state.JobByID(nil, req.Namespace, req.Job.ID)
+	if err != nil {
+		n.logger.Error("JobByID lookup failed", "error", err)
+		return err
+	}
+
 	if err := n.state.UpsertJob(index, req.Job); err != nil {
 		n.logger.Error("UpsertJob failed", "error", err)
 		return err
 	}
 
+	// Registering a stopped job may have moved it to dead.
+	n.unblockDependents(index, map[structs.NamespacedID]struct{}{
+		*req.Job.NamespacedID(): {},
+	})
+
 	// We always add the job to the periodic dispatcher because there is the
 	// possibility that the periodic spec was removed and then we should stop
 	// tracking it.
@@ -458,7 +495,9 @@ func (n *nomadFSM) applyUpsertJob(buf []byte, index uint64) interface{} {
 	// necessary for recovering during leader election. It is possible that from
 	// the time it is added to when it was suppose to launch, leader election
 	// occurs and the job was not launched. In this case, we use the insertion
-	// time to determine if a launch was missed.
+	// time to determine if a launch was missed. The same applies to a job
+	// becoming active again after being stopped, paused or disabled, so that
+	// the launches it skipped while inactive aren't caught up.
 	if req.Job.IsPeriodicActive() {
 		prevLaunch, err := n.state.PeriodicLaunchByID(ws, req.Namespace, req.Job.ID)
 		if err != nil {
@@ -466,13 +505,21 @@ func (n *nomadFSM) applyUpsertJob(buf []byte, index uint64) interface{} {
 			return err
 		}
 
+		// The submit time is part of the log, unlike the time it is applied
+		activated := time.Now()
+		if req.Job.SubmitTime != 0 {
+			activated = time.Unix(0, req.Job.SubmitTime)
+		}
+
 		// Record the insertion time as a launch. We overload the launch table
 		// such that the first entry is the insertion time.
-		if prevLaunch == nil {
+		reactivated := existing != nil && !existing.IsPeriodicActive() &&
+			prevLaunch != nil && activated.After(prevLaunch.Launch)
+		if prevLaunch == nil || reactivated {
 			launch := &structs.PeriodicLaunch{
 				ID:        req.Job.ID,
 				Namespace: req.Namespace,
-				Launch:    time.Now(),
+				Launch:    activated,
 			}
 			if err := n.state.UpsertPeriodicLaunch(index, launch); err != nil {
 				n.logger.Error("UpsertPeriodicLaunch failed", "error", err)
@@ -522,7 +569,7 @@ func (n *nomadFSM) applyDeregisterJob(buf []byte, index uint64) interface{} {
 		panic(fmt.Errorf("failed to decode request: %v", err))
 	}
 
-	return n.state.WithWriteTransaction(func(tx state.Txn) error {
+	err := n.state.WithWriteTransaction(func(tx state.Txn) error {
 		if err := n.handleJobDeregister(index, req.JobID, req.Namespace, req.Purge, tx); err != nil {
 			n.logger.Error("deregistering job failed", "error", err)
 			return err
@@ -530,6 +577,15 @@ func (n *nomadFSM) applyDeregisterJob(buf []byte, index uint64) interface{} {
 
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+
+	// Stopping the job may have moved it to dead.
+	n.unblockDependents(index, map[structs.NamespacedID]struct{}{
+		structs.NewNamespacedID(req.JobID, req.Namespace): {},
+	})
+	return nil
 }
 
 func (n *nomadFSM) applyBatchDeregisterJob(buf []byte, index uint64) interface{} {
@@ -564,6 +620,12 @@ func (n *nomadFSM) applyBatchDeregisterJob(buf []byte, index uint64) interface{}
 
 	// perform the side effects outside the transactions
 	n.handleUpsertedEvals(req.Evals)
+
+	jobs := make(map[structs.NamespacedID]struct{}, len(req.Jobs))
+	for jobNS := range req.Jobs {
+		jobs[jobNS] = struct{}{}
+	}
+	n.unblockDependents(index, jobs)
 	return nil
 }
 
@@ -626,6 +688,9 @@ func (n *nomadFSM) upsertEvals(index uint64, evals []*structs.Evaluation) error
 	}
 
 	n.handleUpsertedEvals(evals)
+
+	// Completing the evaluations may have moved their jobs to dead.
+	n.unblockDependents(index, evalJobs(evals))
 	return nil
 }
 
@@ -766,9 +831,54 @@ func (n *nomadFSM) applyAllocClientUpdate(buf []byte, index uint64) interface{}
 		}
 	}
 
+	// Unblock evals waiting on the jobs of the allocations once the jobs are
+	// dead.
+	jobs := make(map[structs.NamespacedID]struct{}, len(req.Alloc))
+	for _, alloc := range req.Alloc {
+		if !alloc.ClientTerminalStatus() {
+			continue
+		}
+
+		existing, err := n.state.AllocByID(ws, alloc.ID)
+		if err != nil || existing == nil {
+			continue
+		}
+		jobs[structs.NewNamespacedID(existing.JobID, existing.Namespace)] = struct{}{}
+	}
+	n.unblockDependents(index, jobs)
+
 	return nil
 }
 
+// unblockDependents unblocks the evaluations waiting on any of the passed
+// jobs, or on their parents, if the jobs are dead. A job's status may change
+// with any write to its allocations or evaluations, so it must be called after
+// every apply that can do so.
+func (n *nomadFSM) unblockDependents(index uint64, jobs map[structs.NamespacedID]struct{}) {
+	for id := range jobs {
+		job, err := n.state.JobByID(nil, id.Namespace, id.ID)
+		if err != nil || job == nil || job.Status != structs.JobStatusDead {
+			continue
+		}
+
+		n.blockedEvals.UnblockJob(job.ID, job.Namespace, index)
+		if job.ParentID != "" {
+			n.blockedEvals.UnblockJob(job.ParentID, job.Namespace, index)
+		}
+	}
+}
+
+// evalJobs returns the jobs of the passed evaluations.
+func evalJobs(evals []*structs.Evaluation) map[structs.NamespacedID]struct{} {
+	jobs := make(map[structs.NamespacedID]struct{}, len(evals))
+	for _, eval := range evals {
+		if eval != nil {
+			jobs[structs.NewNamespacedID(eval.JobID, eval.Namespace)] = struct{}{}
+		}
+	}
+	return jobs
+}
+
 // applyAllocUpdateDesiredTransition is used to update the desired transitions
 // of a set of allocations.
 func (n *nomadFSM) applyAllocUpdateDesiredTransition(buf []byte, index uint64) interface{} {
@@ -859,6 +969,18 @@ func (n *nomadFSM) applyPlanResults(buf []byte, index uint64) interface{} {
 
 	// Add evals for jobs that were preempted
 	n.handleUpsertedEvals(req.PreemptionEvals)
+
+	// Stopping allocations may have moved their jobs to dead.
+	jobs := make(map[structs.NamespacedID]struct{})
+	if req.Job != nil {
+		jobs[*req.Job.NamespacedID()] = struct{}{}
+	}
+	for _, alloc := range req.AllocsStopped {
+		if existing, _ := n.state.AllocByID(nil, alloc.ID); existing != nil {
+			jobs[structs.NewNamespacedID(existing.JobID, existing.Namespace)] = struct{}{}
+		}
+	}
+	n.unblockDependents(index, jobs)
 	return nil
 }
 
@@ -977,6 +1099,96 @@ func (n *nomadFSM) applyACLPolicyDelete(buf []byte, index uint64) interface{} {
 	return nil
 }
 
+// applyACLRoleUpsert is used to upsert a set of roles
+func (n *nomadFSM) applyACLRoleUpsert(buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_acl_role_upsert"}, time.Now())
+	var req structs.ACLRoleUpsertRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.UpsertACLRoles(index, req.Roles); err != nil {
+		n.logger.Error("UpsertACLRoles failed", "error", err)
+		return err
+	}
+	return nil
+}
+
+// applyACLRoleDelete is used to delete a set of roles
+func (n *nomadFSM) applyACLRoleDelete(buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_acl_role_delete"}, time.Now())
+	var req structs.ACLRoleDeleteRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.DeleteACLRoles(index, req.Names); err != nil {
+		n.logger.Error("DeleteACLRoles failed", "error", err)
+		return err
+	}
+	return nil
+}
+
+// applyACLAuthMethodUpsert is used to upsert a set of auth methods
+func (n *nomadFSM) applyACLAuthMethodUpsert(buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_acl_auth_method_upsert"}, time.Now())
+	var req structs.ACLAuthMethodUpsertRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.UpsertACLAuthMethods(index, req.AuthMethods); err != nil {
+		n.logger.Error("UpsertACLAuthMethods failed", "error", err)
+		return err
+	}
+	return nil
+}
+
+// applyACLAuthMethodDelete is used to delete a set of auth methods
+func (n *nomadFSM) applyACLAuthMethodDelete(buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_acl_auth_method_delete"}, time.Now())
+	var req structs.ACLAuthMethodDeleteRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.DeleteACLAuthMethods(index, req.Names); err != nil {
+		n.logger.Error("DeleteACLAuthMethods failed", "error", err)
+		return err
+	}
+	return nil
+}
+
+// applyACLBindingRuleUpsert is used to upsert a set of binding rules
+func (n *nomadFSM) applyACLBindingRuleUpsert(buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_acl_binding_rule_upsert"}, time.Now())
+	var req structs.ACLBindingRuleUpsertRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.UpsertACLBindingRules(index, req.BindingRules); err != nil {
+		n.logger.Error("UpsertACLBindingRules failed", "error", err)
+		return err
+	}
+	return nil
+}
+
+// applyACLBindingRuleDelete is used to delete a set of binding rules
+func (n *nomadFSM) applyACLBindingRuleDelete(buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_acl_binding_rule_delete"}, time.Now())
+	var req structs.ACLBindingRuleDeleteRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.DeleteACLBindingRules(index, req.IDs); err != nil {
+		n.logger.Error("DeleteACLBindingRules failed", "error", err)
+		return err
+	}
+	return nil
+}
+
 // applyACLTokenUpsert is used to upsert a set of policies
 func (n *nomadFSM) applyACLTokenUpsert(buf []byte, index uint64) interface{} {
 	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_acl_token_upsert"}, time.Now())
@@ -1056,6 +1268,86 @@ func (n *nomadFSM) applySchedulerConfigUpdate(buf []byte, index uint64) interfac
 	return n.state.SchedulerSetConfig(index, &req.Config)
 }
 
+// applyRootKeyUpsert is used to upsert a set of root keys
+func (n *nomadFSM) applyRootKeyUpsert(buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_root_key_upsert"}, time.Now())
+	var req structs.RootKeyUpsertRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.UpsertRootKeys(index, req.Keys); err != nil {
+		n.logger.Error("UpsertRootKeys failed", "error", err)
+		return err
+	}
+	return nil
+}
+
+// applyRootKeyDelete is used to delete a set of root keys
+func (n *nomadFSM) applyRootKeyDelete(buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_root_key_delete"}, time.Now())
+	var req structs.RootKeyDeleteRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.DeleteRootKeys(index, req.KeyIDs); err != nil {
+		n.logger.Error("DeleteRootKeys failed", "error", err)
+		return err
+	}
+	return nil
+}
+
+// applyVariableUpsert is used to upsert a variable. Check-and-set requests
+// return whether the variable was written.
+func (n *nomadFSM) applyVariableUpsert(buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_variable_upsert"}, time.Now())
+	var req structs.VarUpsertRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if req.CheckIndex != nil {
+		applied, err := n.state.CASVariable(index, *req.CheckIndex, req.Var)
+		if err != nil {
+			n.logger.Error("CASVariable failed", "error", err)
+			return err
+		}
+		return applied
+	}
+
+	if err := n.state.UpsertVariable(index, req.Var); err != nil {
+		n.logger.Error("UpsertVariable failed", "error", err)
+		return err
+	}
+	return nil
+}
+
+// applyVariableDelete is used to delete a variable. Check-and-set requests
+// return whether the variable was deleted.
+func (n *nomadFSM) applyVariableDelete(buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_variable_delete"}, time.Now())
+	var req structs.VarDeleteRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if req.CheckIndex != nil {
+		applied, err := n.state.CASDeleteVariable(index, *req.CheckIndex, req.Namespace, req.Path)
+		if err != nil {
+			n.logger.Error("CASDeleteVariable failed", "error", err)
+			return err
+		}
+		return applied
+	}
+
+	if err := n.state.DeleteVariable(index, req.Namespace, req.Path); err != nil {
+		n.logger.Error("DeleteVariable failed", "error", err)
+		return err
+	}
+	return nil
+}
+
 func (n *nomadFSM) Snapshot() (raft.FSMSnapshot, error) {
 	// Create a new snapshot
 	snap, err := n.state.Snapshot()
@@ -1255,6 +1547,51 @@ func (n *nomadFSM) Restore(old io.ReadCloser) error {
 				return err
 			}
 
+		case RootKeySnapshot:
+			key := new(structs.RootKey)
+			if err := dec.Decode(key); err != nil {
+				return err
+			}
+			if err := restore.RootKeyRestore(key); err != nil {
+				return err
+			}
+
+		case VariableSnapshot:
+			v := new(structs.VariableEncrypted)
+			if err := dec.Decode(v); err != nil {
+				return err
+			}
+			if err := restore.VariableRestore(v); err != nil {
+				return err
+			}
+
+		case ACLRoleSnapshot:
+			role := new(structs.ACLRole)
+			if err := dec.Decode(role); err != nil {
+				return err
+			}
+			if err := restore.ACLRoleRestore(role); err != nil {
+				return err
+			}
+
+		case ACLAuthMethodSnapshot:
+			method := new(structs.ACLAuthMethod)
+			if err := dec.Decode(method); err != nil {
+				return err
+			}
+			if err := restore.ACLAuthMethodRestore(method); err != nil {
+				return err
+			}
+
+		case ACLBindingRuleSnapshot:
+			rule := new(structs.ACLBindingRule)
+			if err := dec.Decode(rule); err != nil {
+				return err
+			}
+			if err := restore.ACLBindingRuleRestore(rule); err != nil {
+				return err
+			}
+
 		default:
 			// Check if this is an enterprise only object being restored
 			restorer, ok := n.enterpriseRestorers[snapType]
@@ -1527,6 +1864,26 @@ func (s *nomadSnapshot) Persist(sink raft.SnapshotSink) error {
 		sink.Cancel()
 		return err
 	}
+	if err := s.persistRootKeys(sink, encoder); err != nil {
+		sink.Cancel()
+		return err
+	}
+	if err := s.persistVariables(sink, encoder); err != nil {
+		sink.Cancel()
+		return err
+	}
+	if err := s.persistACLRoles(sink, encoder); err != nil {
+		sink.Cancel()
+		return err
+	}
+	if err := s.persistACLAuthMethods(sink, encoder); err != nil {
+		sink.Cancel()
+		return err
+	}
+	if err := s.persistACLBindingRules(sink, encoder); err != nil {
+		sink.Cancel()
+		return err
+	}
 	return nil
 }
 
@@ -1859,6 +2216,90 @@ func (s *nomadSnapshot) persistACLTokens(sink raft.SnapshotSink,
 	return nil
 }
 
+func (s *nomadSnapshot) persistACLRoles(sink raft.SnapshotSink,
+	encoder *codec.Encoder) error {
+	// Get all the roles
+	ws := memdb.NewWatchSet()
+	roles, err := s.snap.ACLRoles(ws)
+	if err != nil {
+		return err
+	}
+
+	for {
+		// Get the next item
+		raw := roles.Next()
+		if raw == nil {
+			break
+		}
+
+		// Prepare the request struct
+		role := raw.(*structs.ACLRole)
+
+		// Write out a role registration
+		sink.Write([]byte{byte(ACLRoleSnapshot)})
+		if err := encoder.Encode(role); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *nomadSnapshot) persistACLAuthMethods(sink raft.SnapshotSink,
+	encoder *codec.Encoder) error {
+	// Get all the auth methods
+	ws := memdb.NewWatchSet()
+	methods, err := s.snap.ACLAuthMethods(ws)
+	if err != nil {
+		return err
+	}
+
+	for {
+		// Get the next item
+		raw := methods.Next()
+		if raw == nil {
+			break
+		}
+
+		// Prepare the request struct
+		method := raw.(*structs.ACLAuthMethod)
+
+		// Write out an auth method registration
+		sink.Write([]byte{byte(ACLAuthMethodSnapshot)})
+		if err := encoder.Encode(method); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *nomadSnapshot) persistACLBindingRules(sink raft.SnapshotSink,
+	encoder *codec.Encoder) error {
+	// Get all the binding rules
+	ws := memdb.NewWatchSet()
+	rules, err := s.snap.ACLBindingRules(ws)
+	if err != nil {
+		return err
+	}
+
+	for {
+		// Get the next item
+		raw := rules.Next()
+		if raw == nil {
+			break
+		}
+
+		// Prepare the request struct
+		rule := raw.(*structs.ACLBindingRule)
+
+		// Write out a binding rule registration
+		sink.Write([]byte{byte(ACLBindingRuleSnapshot)})
+		if err := encoder.Encode(rule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *nomadSnapshot) persistSchedulerConfig(sink raft.SnapshotSink,
 	encoder *codec.Encoder) error {
 	// Get scheduler config
@@ -1874,6 +2315,48 @@ func (s *nomadSnapshot) persistSchedulerConfig(sink raft.SnapshotSink,
 	return nil
 }
 
+func (s *nomadSnapshot) persistRootKeys(sink raft.SnapshotSink,
+	encoder *codec.Encoder) error {
+	// Get all the root keys
+	ws := memdb.NewWatchSet()
+	keys, err := s.snap.RootKeys(ws)
+	if err != nil {
+		return err
+	}
+
+	for raw := keys.Next(); raw != nil; raw = keys.Next() {
+		key := raw.(*structs.RootKey)
+
+		// Write out a root key registration
+		sink.Write([]byte{byte(RootKeySnapshot)})
+		if err := encoder.Encode(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *nomadSnapshot) persistVariables(sink raft.SnapshotSink,
+	encoder *codec.Encoder) error {
+	// Get all the variables
+	ws := memdb.NewWatchSet()
+	vars, err := s.snap.Variables(ws)
+	if err != nil {
+		return err
+	}
+
+	for raw := vars.Next(); raw != nil; raw = vars.Next() {
+		v := raw.(*structs.VariableEncrypted)
+
+		// Write out a variable registration
+		sink.Write([]byte{byte(VariableSnapshot)})
+		if err := encoder.Encode(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Release is a no-op, as we just need to GC the pointer
 // to the state store snapshot. There is nothing to explicitly
 // cleanup.
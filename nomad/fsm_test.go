@@ -1015,6 +1015,70 @@ func TestFSM_UpdateEval_Blocked(t *testing.T) {
 	}
 }
 
+func TestFSM_UpdateEval_UnblockDependents(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	fsm := testFSM(t)
+	fsm.evalBroker.SetEnabled(true)
+	fsm.blockedEvals.SetEnabled(true)
+	state := fsm.State()
+
+	node := mock.Node()
+	require.NoError(state.UpsertNode(1, node))
+
+	// Create a batch job with a running allocation
+	job := mock.BatchJob()
+	require.NoError(state.UpsertJob(2, job))
+	alloc := mock.BatchAlloc()
+	alloc.Job = job
+	alloc.JobID = job.ID
+	alloc.NodeID = node.ID
+	alloc.ClientStatus = structs.AllocClientStatusRunning
+	require.NoError(state.UpsertAllocs(3, []*structs.Allocation{alloc}))
+
+	// Block an eval waiting on the job
+	dependent := mock.Eval()
+	dependent.Status = structs.EvalStatusBlocked
+	dependent.WaitingOnJobs = []string{job.ID}
+	dependent.SnapshotIndex = 3
+	fsm.blockedEvals.Block(dependent)
+	require.Equal(1, fsm.blockedEvals.Stats().TotalBlocked)
+
+	// Complete the allocation along with a follow up eval for the job, which
+	// leaves the job pending
+	followUp := mock.Eval()
+	followUp.JobID = job.ID
+	followUp.TriggeredBy = structs.EvalTriggerRetryFailedAlloc
+	update := alloc.Copy()
+	update.ClientStatus = structs.AllocClientStatusComplete
+	buf, err := structs.Encode(structs.AllocClientUpdateRequestType, structs.AllocUpdateRequest{
+		Alloc: []*structs.Allocation{update},
+		Evals: []*structs.Evaluation{followUp},
+	})
+	require.NoError(err)
+	require.Nil(fsm.Apply(makeLog(buf)))
+
+	out, err := state.JobByID(nil, job.Namespace, job.ID)
+	require.NoError(err)
+	require.Equal(structs.JobStatusPending, out.Status)
+	require.Equal(1, fsm.blockedEvals.Stats().TotalBlocked)
+
+	// Completing the follow up eval moves the job to dead and must unblock
+	// the dependent eval
+	completed := followUp.Copy()
+	completed.Status = structs.EvalStatusComplete
+	buf, err = structs.Encode(structs.EvalUpdateRequestType, structs.EvalUpdateRequest{
+		Evals: []*structs.Evaluation{completed},
+	})
+	require.NoError(err)
+	require.Nil(fsm.Apply(makeLog(buf)))
+
+	out, err = state.JobByID(nil, job.Namespace, job.ID)
+	require.NoError(err)
+	require.Equal(structs.JobStatusDead, out.Status)
+	require.Equal(0, fsm.blockedEvals.Stats().TotalBlocked)
+}
+
 func TestFSM_UpdateEval_Untrack(t *testing.T) {
 	t.Parallel()
 	fsm := testFSM(t)
@@ -2187,6 +2251,117 @@ func TestFSM_UpsertACLPolicies(t *testing.T) {
 	assert.NotNil(t, out)
 }
 
+func TestFSM_UpsertACLRoles(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	fsm := testFSM(t)
+
+	role := mock.ACLRole()
+	req := structs.ACLRoleUpsertRequest{
+		Roles: []*structs.ACLRole{role},
+	}
+	buf, err := structs.Encode(structs.ACLRoleUpsertRequestType, req)
+	require.NoError(err)
+	require.Nil(fsm.Apply(makeLog(buf)))
+
+	// Verify we are registered
+	out, err := fsm.State().ACLRoleByName(nil, role.Name)
+	require.NoError(err)
+	require.NotNil(out)
+}
+
+func TestFSM_DeleteACLRoles(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	fsm := testFSM(t)
+
+	role := mock.ACLRole()
+	require.NoError(fsm.State().UpsertACLRoles(1000, []*structs.ACLRole{role}))
+
+	req := structs.ACLRoleDeleteRequest{
+		Names: []string{role.Name},
+	}
+	buf, err := structs.Encode(structs.ACLRoleDeleteRequestType, req)
+	require.NoError(err)
+	require.Nil(fsm.Apply(makeLog(buf)))
+
+	// Verify we are not registered
+	out, err := fsm.State().ACLRoleByName(nil, role.Name)
+	require.NoError(err)
+	require.Nil(out)
+}
+
+func TestFSM_UpsertACLAuthMethods(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	fsm := testFSM(t)
+
+	method := mock.ACLAuthMethod()
+	req := structs.ACLAuthMethodUpsertRequest{
+		AuthMethods: []*structs.ACLAuthMethod{method},
+	}
+	buf, err := structs.Encode(structs.ACLAuthMethodUpsertRequestType, req)
+	require.NoError(err)
+	require.Nil(fsm.Apply(makeLog(buf)))
+
+	// Verify we are registered
+	out, err := fsm.State().ACLAuthMethodByName(nil, method.Name)
+	require.NoError(err)
+	require.NotNil(out)
+}
+
+func TestFSM_DeleteACLAuthMethods(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	fsm := testFSM(t)
+
+	method := mock.ACLAuthMethod()
+	require.NoError(fsm.State().UpsertACLAuthMethods(1000, []*structs.ACLAuthMethod{method}))
+
+	req := structs.ACLAuthMethodDeleteRequest{
+		Names: []string{method.Name},
+	}
+	buf, err := structs.Encode(structs.ACLAuthMethodDeleteRequestType, req)
+	require.NoError(err)
+	require.Nil(fsm.Apply(makeLog(buf)))
+
+	// Verify we are not registered
+	out, err := fsm.State().ACLAuthMethodByName(nil, method.Name)
+	require.NoError(err)
+	require.Nil(out)
+}
+
+func TestFSM_UpsertDeleteACLBindingRules(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	fsm := testFSM(t)
+
+	rule := mock.ACLBindingRule("okta")
+	req := structs.ACLBindingRuleUpsertRequest{
+		BindingRules: []*structs.ACLBindingRule{rule},
+	}
+	buf, err := structs.Encode(structs.ACLBindingRuleUpsertRequestType, req)
+	require.NoError(err)
+	require.Nil(fsm.Apply(makeLog(buf)))
+
+	// Verify we are registered
+	out, err := fsm.State().ACLBindingRuleByID(nil, rule.ID)
+	require.NoError(err)
+	require.NotNil(out)
+
+	delReq := structs.ACLBindingRuleDeleteRequest{
+		IDs: []string{rule.ID},
+	}
+	buf, err = structs.Encode(structs.ACLBindingRuleDeleteRequestType, delReq)
+	require.NoError(err)
+	require.Nil(fsm.Apply(makeLog(buf)))
+
+	// Verify we are not registered
+	out, err = fsm.State().ACLBindingRuleByID(nil, rule.ID)
+	require.NoError(err)
+	require.Nil(out)
+}
+
 func TestFSM_DeleteACLPolicies(t *testing.T) {
 	t.Parallel()
 	fsm := testFSM(t)
@@ -2313,6 +2488,87 @@ func TestFSM_DeleteACLTokens(t *testing.T) {
 	assert.Nil(t, out)
 }
 
+func TestFSM_UpsertRootKeys(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	fsm := testFSM(t)
+
+	key1, err := newRootKey(time.Now(), testKeyEncryptionKey)
+	require.Nil(err)
+	key1.Active = true
+	key2, err := newRootKey(time.Now(), testKeyEncryptionKey)
+	require.Nil(err)
+	key2.Active = true
+
+	for _, key := range []*structs.RootKey{key1, key2} {
+		req := structs.RootKeyUpsertRequest{Keys: []*structs.RootKey{key}}
+		buf, err := structs.Encode(structs.RootKeyUpsertRequestType, req)
+		require.Nil(err)
+		require.Nil(fsm.Apply(makeLog(buf)))
+	}
+
+	// Activating the second key deactivates the first
+	out, err := fsm.State().RootKeyByID(nil, key1.KeyID)
+	require.Nil(err)
+	require.False(out.Active)
+
+	active, err := fsm.State().ActiveRootKey(nil)
+	require.Nil(err)
+	require.Equal(key2.KeyID, active.KeyID)
+
+	req := structs.RootKeyDeleteRequest{KeyIDs: []string{key1.KeyID}}
+	buf, err := structs.Encode(structs.RootKeyDeleteRequestType, req)
+	require.Nil(err)
+	require.Nil(fsm.Apply(makeLog(buf)))
+
+	out, err = fsm.State().RootKeyByID(nil, key1.KeyID)
+	require.Nil(err)
+	require.Nil(out)
+}
+
+func TestFSM_UpsertDeleteVariable(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	fsm := testFSM(t)
+
+	v := &structs.VariableEncrypted{
+		VariableMetadata: structs.VariableMetadata{
+			Namespace: structs.DefaultNamespace,
+			Path:      "app/db",
+		},
+		VariableData: structs.VariableData{
+			Data:  []byte("data"),
+			KeyID: "key",
+		},
+	}
+	req := structs.VarUpsertRequest{Var: v}
+	buf, err := structs.Encode(structs.VarUpsertRequestType, req)
+	require.Nil(err)
+	require.Nil(fsm.Apply(makeLog(buf)))
+
+	out, err := fsm.State().VariableByPath(nil, v.Namespace, v.Path)
+	require.Nil(err)
+	require.NotNil(out)
+
+	// A check-and-set write with a stale index is not applied
+	checkIndex := uint64(0)
+	req = structs.VarUpsertRequest{Var: v, CheckIndex: &checkIndex}
+	buf, err = structs.Encode(structs.VarUpsertRequestType, req)
+	require.Nil(err)
+	require.Equal(false, fsm.Apply(makeLog(buf)))
+
+	// A check-and-set delete with the current index is applied
+	checkIndex = out.ModifyIndex
+	delReq := structs.VarDeleteRequest{Namespace: v.Namespace, Path: v.Path, CheckIndex: &checkIndex}
+	buf, err = structs.Encode(structs.VarDeleteRequestType, delReq)
+	require.Nil(err)
+	require.Equal(true, fsm.Apply(makeLog(buf)))
+
+	out, err = fsm.State().VariableByPath(nil, v.Namespace, v.Path)
+	require.Nil(err)
+	require.Nil(out)
+}
+
 func testSnapshotRestore(t *testing.T, fsm *nomadFSM) *nomadFSM {
 	// Snapshot
 	snap, err := fsm.Snapshot()
@@ -2659,6 +2915,43 @@ func TestFSM_SnapshotRestore_ACLPolicy(t *testing.T) {
 	assert.Equal(t, p2, out2)
 }
 
+func TestFSM_SnapshotRestore_ACLRoles(t *testing.T) {
+	t.Parallel()
+	// Add some state
+	fsm := testFSM(t)
+	state := fsm.State()
+	r1 := mock.ACLRole()
+	r2 := mock.ACLRole()
+	state.UpsertACLRoles(1000, []*structs.ACLRole{r1, r2})
+
+	// Verify the contents
+	fsm2 := testSnapshotRestore(t, fsm)
+	state2 := fsm2.State()
+	out1, _ := state2.ACLRoleByName(nil, r1.Name)
+	out2, _ := state2.ACLRoleByName(nil, r2.Name)
+	assert.Equal(t, r1, out1)
+	assert.Equal(t, r2, out2)
+}
+
+func TestFSM_SnapshotRestore_ACLAuthMethods(t *testing.T) {
+	t.Parallel()
+	// Add some state
+	fsm := testFSM(t)
+	state := fsm.State()
+	m1 := mock.ACLAuthMethod()
+	r1 := mock.ACLBindingRule(m1.Name)
+	state.UpsertACLAuthMethods(1000, []*structs.ACLAuthMethod{m1})
+	state.UpsertACLBindingRules(1001, []*structs.ACLBindingRule{r1})
+
+	// Verify the contents
+	fsm2 := testSnapshotRestore(t, fsm)
+	state2 := fsm2.State()
+	out1, _ := state2.ACLAuthMethodByName(nil, m1.Name)
+	out2, _ := state2.ACLBindingRuleByID(nil, r1.ID)
+	assert.Equal(t, m1, out1)
+	assert.Equal(t, r1, out2)
+}
+
 func TestFSM_SnapshotRestore_ACLTokens(t *testing.T) {
 	t.Parallel()
 	// Add some state
@@ -2678,6 +2971,49 @@ func TestFSM_SnapshotRestore_ACLTokens(t *testing.T) {
 	assert.Equal(t, tk2, out2)
 }
 
+func TestFSM_SnapshotRestore_RootKeys(t *testing.T) {
+	t.Parallel()
+	// Add some state
+	fsm := testFSM(t)
+	state := fsm.State()
+	key, err := newRootKey(time.Now().UTC(), testKeyEncryptionKey)
+	require.Nil(t, err)
+	key.Active = true
+	state.UpsertRootKeys(1000, []*structs.RootKey{key})
+
+	// Verify the contents
+	fsm2 := testSnapshotRestore(t, fsm)
+	state2 := fsm2.State()
+	out, err := state2.RootKeyByID(nil, key.KeyID)
+	require.Nil(t, err)
+	require.Equal(t, key, out)
+}
+
+func TestFSM_SnapshotRestore_Variables(t *testing.T) {
+	t.Parallel()
+	// Add some state
+	fsm := testFSM(t)
+	state := fsm.State()
+	v := &structs.VariableEncrypted{
+		VariableMetadata: structs.VariableMetadata{
+			Namespace: structs.DefaultNamespace,
+			Path:      "app/db",
+		},
+		VariableData: structs.VariableData{
+			Data:  []byte("data"),
+			KeyID: "key",
+		},
+	}
+	require.Nil(t, state.UpsertVariable(1000, v))
+
+	// Verify the contents
+	fsm2 := testSnapshotRestore(t, fsm)
+	state2 := fsm2.State()
+	out, err := state2.VariableByPath(nil, v.Namespace, v.Path)
+	require.Nil(t, err)
+	require.Equal(t, v, out)
+}
+
 func TestFSM_SnapshotRestore_SchedulerConfiguration(t *testing.T) {
 	t.Parallel()
 	// Add some state
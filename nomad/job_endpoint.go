@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	metrics "github.com/armon/go-metrics"
@@ -76,6 +77,13 @@ func (j *Job) Register(args *structs.JobRegisterRequest, reply *structs.JobRegis
 	}
 	defer metrics.MeasureSince([]string{"nomad", "job", "register"}, time.Now())
 
+	return j.register(args, reply, false)
+}
+
+// register registers a job. Registering a new version of a paused job keeps
+// it paused, with the counts of the new version to restore on resume, unless
+// resume is set.
+func (j *Job) register(args *structs.JobRegisterRequest, reply *structs.JobRegisterResponse, resume bool) error {
 	// Validate the arguments
 	if args.Job == nil {
 		return fmt.Errorf("missing job for registration")
@@ -178,6 +186,16 @@ func (j *Job) Register(args *structs.JobRegisterRequest, reply *structs.JobRegis
 		return err
 	}
 
+	// Ensure the jobs the job starts depending on exist
+	if err := validateJobDependencies(snap, args.RequestNamespace(), existingJob, args.Job); err != nil {
+		return err
+	}
+
+	// Keep paused jobs paused unless resuming them
+	if existingJob != nil && existingJob.Paused && !args.Job.Paused && !resume {
+		args.Job.Pause()
+	}
+
 	// Ensure that the job has permissions for the requested Vault tokens
 	policies := args.Job.VaultPolicies()
 	if len(policies) != 0 {
@@ -313,7 +331,7 @@ func (j *Job) Summary(args *structs.JobSummaryRequest,
 	// Check for read-job permissions
 	if aclObj, err := j.srv.ResolveToken(args.AuthToken); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilityReadJob) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(args.RequestNamespace(), args.JobID, acl.NamespaceCapabilityReadJob) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -460,6 +478,69 @@ func (j *Job) Revert(args *structs.JobRevertRequest, reply *structs.JobRegisterR
 	return j.Register(reg, reply)
 }
 
+// Pause is used to pause a job by scaling all its task groups down to zero, or
+// to resume a paused job by restoring the counts it had before being paused
+func (j *Job) Pause(args *structs.JobPauseRequest, reply *structs.JobRegisterResponse) error {
+	if done, err := j.srv.forward("Job.Pause", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "job", "pause"}, time.Now())
+
+	// Check for submit-job permissions
+	if aclObj, err := j.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if aclObj != nil && !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilitySubmitJob) {
+		return structs.ErrPermissionDenied
+	}
+
+	// Validate the arguments
+	if args.JobID == "" {
+		return fmt.Errorf("missing job ID for pause")
+	}
+
+	// Lookup the job
+	snap, err := j.srv.fsm.State().Snapshot()
+	if err != nil {
+		return err
+	}
+
+	ws := memdb.NewWatchSet()
+	cur, err := snap.JobByID(ws, args.RequestNamespace(), args.JobID)
+	if err != nil {
+		return err
+	}
+	if cur == nil {
+		return fmt.Errorf("job %q not found", args.JobID)
+	}
+	if cur.Stop {
+		return fmt.Errorf("job %q is stopped", args.JobID)
+	}
+	if args.Pause && cur.Paused {
+		return fmt.Errorf("job %q is already paused", args.JobID)
+	}
+	if !args.Pause && !cur.Paused {
+		return fmt.Errorf("job %q is not paused", args.JobID)
+	}
+
+	// Build the register request
+	job := cur.Copy()
+	job.VaultToken = args.VaultToken
+	if args.Pause {
+		job.Pause()
+	} else {
+		job.Resume()
+	}
+	reg := &structs.JobRegisterRequest{
+		Job:            job,
+		EnforceIndex:   true,
+		JobModifyIndex: cur.JobModifyIndex,
+		WriteRequest:   args.WriteRequest,
+	}
+
+	// Register the new version
+	return j.register(reg, reply, !args.Pause)
+}
+
 // Stable is used to mark the job version as stable
 func (j *Job) Stable(args *structs.JobStabilityRequest, reply *structs.JobStabilityResponse) error {
 	if done, err := j.srv.forward("Job.Stable", args, args, reply); done {
@@ -778,7 +859,7 @@ func (j *Job) GetJob(args *structs.JobSpecificRequest,
 	// Check for read-job permissions
 	if aclObj, err := j.srv.ResolveToken(args.AuthToken); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilityReadJob) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(args.RequestNamespace(), args.JobID, acl.NamespaceCapabilityReadJob) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -824,7 +905,7 @@ func (j *Job) GetJobVersions(args *structs.JobVersionsRequest,
 	// Check for read-job permissions
 	if aclObj, err := j.srv.ResolveToken(args.AuthToken); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilityReadJob) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(args.RequestNamespace(), args.JobID, acl.NamespaceCapabilityReadJob) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -947,7 +1028,7 @@ func (j *Job) Allocations(args *structs.JobSpecificRequest,
 	// Check for read-job permissions
 	if aclObj, err := j.srv.ResolveToken(args.AuthToken); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilityReadJob) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(args.RequestNamespace(), args.JobID, acl.NamespaceCapabilityReadJob) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -1002,7 +1083,7 @@ func (j *Job) Evaluations(args *structs.JobSpecificRequest,
 	// Check for read-job permissions
 	if aclObj, err := j.srv.ResolveToken(args.AuthToken); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilityReadJob) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(args.RequestNamespace(), args.JobID, acl.NamespaceCapabilityReadJob) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -1044,7 +1125,7 @@ func (j *Job) Deployments(args *structs.JobSpecificRequest,
 	// Check for read-job permissions
 	if aclObj, err := j.srv.ResolveToken(args.AuthToken); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilityReadJob) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(args.RequestNamespace(), args.JobID, acl.NamespaceCapabilityReadJob) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -1086,7 +1167,7 @@ func (j *Job) LatestDeployment(args *structs.JobSpecificRequest,
 	// Check for read-job permissions
 	if aclObj, err := j.srv.ResolveToken(args.AuthToken); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilityReadJob) {
+	} else if aclObj != nil && !aclObj.AllowJobOp(args.RequestNamespace(), args.JobID, acl.NamespaceCapabilityReadJob) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -1189,6 +1270,11 @@ func (j *Job) Plan(args *structs.JobPlanRequest, reply *structs.JobPlanResponse)
 	if oldJob != nil {
 		index = oldJob.JobModifyIndex
 
+		// Keep paused jobs paused as registering the job would
+		if oldJob.Paused && !args.Job.Paused {
+			args.Job.Pause()
+		}
+
 		// We want to reuse deployments where possible, so only insert the job if
 		// it has changed or the job didn't exist
 		if oldJob.SpecChanged(args.Job) {
@@ -1275,6 +1361,34 @@ func (j *Job) Plan(args *structs.JobPlanRequest, reply *structs.JobPlanResponse)
 	return nil
 }
 
+// validateJobDependencies ensures the jobs a job depends on exist. Only the
+// dependencies added by an update are checked, so the job can still be updated
+// once a job it already depended on has been garbage collected.
+func validateJobDependencies(snap *state.StateSnapshot, namespace string, old, new *structs.Job) error {
+	existing := make(map[string]struct{})
+	if old != nil {
+		for _, dep := range old.DependsOn {
+			existing[dep.JobID] = struct{}{}
+		}
+	}
+
+	var mErr multierror.Error
+	for _, dep := range new.DependsOn {
+		if _, ok := existing[dep.JobID]; ok {
+			continue
+		}
+
+		job, err := snap.JobByID(nil, namespace, dep.JobID)
+		if err != nil {
+			return err
+		}
+		if job == nil {
+			multierror.Append(&mErr, fmt.Errorf("job depends on unknown job %q", dep.JobID))
+		}
+	}
+	return mErr.ErrorOrNil()
+}
+
 // validateJobUpdate ensures updates to a job are valid.
 func validateJobUpdate(old, new *structs.Job) error {
 	// Validate Dispatch not set on new Jobs
@@ -1353,11 +1467,36 @@ func (j *Job) Dispatch(args *structs.JobDispatchRequest, reply *structs.JobDispa
 		return fmt.Errorf("Specified job %q is stopped", args.JobID)
 	}
 
+	if parameterizedJob.Paused {
+		return fmt.Errorf("Specified job %q is paused", args.JobID)
+	}
+
 	// Validate the arguments
 	if err := validateDispatchRequest(args, parameterizedJob); err != nil {
 		return err
 	}
 
+	// Return the previously dispatched job if the request is a retry. The
+	// token is locked until the dispatched job and its evaluation are
+	// committed, and the check uses a snapshot taken under the lock, so
+	// concurrent retries can't both dispatch a job.
+	if args.IdempotencyToken != "" {
+		unlock := j.srv.dispatchTokenLocks.lock(parameterizedJob.Namespace, parameterizedJob.ID, args.IdempotencyToken)
+		defer unlock()
+
+		snap, err := j.srv.fsm.State().Snapshot()
+		if err != nil {
+			return err
+		}
+		existing, err := j.dispatchedWithToken(snap, parameterizedJob, args.IdempotencyToken)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return j.existingDispatchReply(snap, existing, reply)
+		}
+	}
+
 	// Derive the child job and commit it via Raft
 	dispatchJob := parameterizedJob.Copy()
 	dispatchJob.ID = structs.DispatchedID(parameterizedJob.ID, time.Now())
@@ -1365,6 +1504,7 @@ func (j *Job) Dispatch(args *structs.JobDispatchRequest, reply *structs.JobDispa
 	dispatchJob.Name = dispatchJob.ID
 	dispatchJob.SetSubmitTime()
 	dispatchJob.Dispatched = true
+	dispatchJob.DispatchIdempotencyToken = args.IdempotencyToken
 
 	// Merge in the meta data
 	for k, v := range args.Meta {
@@ -1434,6 +1574,118 @@ func (j *Job) Dispatch(args *structs.JobDispatchRequest, reply *structs.JobDispa
 	return nil
 }
 
+// dispatchTokenLocks holds a lock per parameterized job and idempotency token.
+// Locks are removed once they are no longer held or waited on.
+type dispatchTokenLocks struct {
+	l     sync.Mutex
+	locks map[dispatchTokenKey]*dispatchTokenLock
+}
+
+type dispatchTokenKey struct {
+	namespace string
+	jobID     string
+	token     string
+}
+
+type dispatchTokenLock struct {
+	sync.Mutex
+	refs int
+}
+
+// lock acquires the lock of the parameterized job and idempotency token and
+// returns the function releasing it.
+func (d *dispatchTokenLocks) lock(namespace, jobID, token string) func() {
+	key := dispatchTokenKey{namespace: namespace, jobID: jobID, token: token}
+
+	d.l.Lock()
+	if d.locks == nil {
+		d.locks = make(map[dispatchTokenKey]*dispatchTokenLock)
+	}
+	l, ok := d.locks[key]
+	if !ok {
+		l = &dispatchTokenLock{}
+		d.locks[key] = l
+	}
+	l.refs++
+	d.l.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+
+		d.l.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(d.locks, key)
+		}
+		d.l.Unlock()
+	}
+}
+
+// dispatchedWithToken returns the most recent job dispatched from the
+// parameterized job with the given idempotency token. A job is only returned
+// if it is not terminal or was dispatched within the idempotency window.
+func (j *Job) dispatchedWithToken(snap *state.StateSnapshot, parent *structs.Job, token string) (*structs.Job, error) {
+	iter, err := snap.JobsByIDPrefix(nil, parent.Namespace, parent.ID+"/")
+	if err != nil {
+		return nil, err
+	}
+
+	cutoff := time.Now().Add(-j.srv.config.DispatchIdempotencyWindow).UnixNano()
+
+	var match *structs.Job
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		child := raw.(*structs.Job)
+		if child.ParentID != parent.ID || child.DispatchIdempotencyToken != token {
+			continue
+		}
+
+		terminal := child.Stopped() || child.Status == structs.JobStatusDead
+		if terminal && child.SubmitTime < cutoff {
+			continue
+		}
+
+		if match == nil || match.SubmitTime < child.SubmitTime {
+			match = child
+		}
+	}
+
+	return match, nil
+}
+
+// existingDispatchReply populates the dispatch reply with a previously
+// dispatched job and the evaluation created when it was dispatched.
+func (j *Job) existingDispatchReply(snap *state.StateSnapshot, job *structs.Job, reply *structs.JobDispatchResponse) error {
+	reply.DispatchedJobID = job.ID
+	reply.JobCreateIndex = job.CreateIndex
+	reply.Index = job.ModifyIndex
+
+	evals, err := snap.EvalsByJob(nil, job.Namespace, job.ID)
+	if err != nil {
+		return err
+	}
+
+	var first *structs.Evaluation
+	for _, eval := range evals {
+		if eval.TriggeredBy != structs.EvalTriggerJobRegister {
+			continue
+		}
+		if first == nil || eval.CreateIndex < first.CreateIndex {
+			first = eval
+		}
+	}
+
+	if first != nil {
+		reply.EvalID = first.ID
+		reply.EvalCreateIndex = first.CreateIndex
+		if first.CreateIndex > reply.Index {
+			reply.Index = first.CreateIndex
+		}
+	}
+
+	return nil
+}
+
 // validateDispatchRequest returns whether the request is valid given the
 // parameterized job.
 func validateDispatchRequest(req *structs.JobDispatchRequest, job *structs.Job) error {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -719,6 +720,57 @@ func TestJobEndpoint_Register_Dispatched(t *testing.T) {
 	require.Contains(err.Error(), "job can't be submitted with 'Dispatched'")
 }
 
+func TestJobEndpoint_Register_DependsOnUnknownJob(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	s1 := TestServer(t, func(c *Config) {
+		c.NumSchedulers = 0 // Prevent automatic dequeue
+	})
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	// Create the register request with a job depending on an unknown job
+	job := mock.BatchJob()
+	job.DependsOn = []*structs.JobDependency{
+		{JobID: "extract", Condition: structs.JobDependencyConditionSuccess},
+	}
+	req := &structs.JobRegisterRequest{
+		Job: job,
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			Namespace: job.Namespace,
+		},
+	}
+
+	var resp structs.JobRegisterResponse
+	err := msgpackrpc.CallWithCodec(codec, "Job.Register", req, &resp)
+	require.Error(err)
+	require.Contains(err.Error(), `job depends on unknown job "extract"`)
+
+	// Register the job depended on
+	dep := mock.BatchJob()
+	dep.ID = "extract"
+	depReq := &structs.JobRegisterRequest{
+		Job: dep,
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			Namespace: dep.Namespace,
+		},
+	}
+	require.NoError(msgpackrpc.CallWithCodec(codec, "Job.Register", depReq, &resp))
+	require.NoError(msgpackrpc.CallWithCodec(codec, "Job.Register", req, &resp))
+
+	// Once the job depended on is gone, the job can still be updated
+	state := s1.fsm.State()
+	require.NoError(state.DeleteJob(resp.Index+1, dep.Namespace, dep.ID))
+
+	job2 := job.Copy()
+	job2.Priority = 60
+	req.Job = job2
+	require.NoError(msgpackrpc.CallWithCodec(codec, "Job.Register", req, &resp))
+}
+
 func TestJobEndpoint_Register_EnforceIndex(t *testing.T) {
 	t.Parallel()
 	s1 := TestServer(t, func(c *Config) {
@@ -1705,6 +1757,148 @@ func TestJobEndpoint_Revert_ACL(t *testing.T) {
 	require.Nil(err)
 }
 
+func TestJobEndpoint_Pause(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	s1 := TestServer(t, func(c *Config) {
+		c.NumSchedulers = 0 // Prevent automatic dequeue
+	})
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	// Create the initial register request
+	job := mock.Job()
+	job.TaskGroups[0].Count = 7
+	req := &structs.JobRegisterRequest{
+		Job: job,
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			Namespace: job.Namespace,
+		},
+	}
+	var resp structs.JobRegisterResponse
+	require.NoError(msgpackrpc.CallWithCodec(codec, "Job.Register", req, &resp))
+
+	// Resuming a job which is not paused fails
+	resumeReq := &structs.JobPauseRequest{
+		JobID: job.ID,
+		Pause: false,
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			Namespace: job.Namespace,
+		},
+	}
+	var resumeResp structs.JobRegisterResponse
+	err := msgpackrpc.CallWithCodec(codec, "Job.Pause", resumeReq, &resumeResp)
+	require.Error(err)
+	require.Contains(err.Error(), "not paused")
+
+	// Pause the job
+	pauseReq := &structs.JobPauseRequest{
+		JobID: job.ID,
+		Pause: true,
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			Namespace: job.Namespace,
+		},
+	}
+	var pauseResp structs.JobRegisterResponse
+	require.NoError(msgpackrpc.CallWithCodec(codec, "Job.Pause", pauseReq, &pauseResp))
+	require.NotEmpty(pauseResp.EvalID)
+
+	state := s1.fsm.State()
+	ws := memdb.NewWatchSet()
+	out, err := state.JobByID(ws, job.Namespace, job.ID)
+	require.NoError(err)
+	require.True(out.Paused)
+	require.Equal(0, out.TaskGroups[0].Count)
+	require.Equal(map[string]int{"web": 7}, out.PausedCounts)
+	require.Equal(uint64(1), out.Version)
+
+	// Pausing it again fails
+	err = msgpackrpc.CallWithCodec(codec, "Job.Pause", pauseReq, &pauseResp)
+	require.Error(err)
+	require.Contains(err.Error(), "already paused")
+
+	// Registering a new version keeps the job paused
+	job2 := job.Copy()
+	job2.TaskGroups[0].Count = 3
+	req.Job = job2
+	require.NoError(msgpackrpc.CallWithCodec(codec, "Job.Register", req, &resp))
+
+	out, err = state.JobByID(ws, job.Namespace, job.ID)
+	require.NoError(err)
+	require.True(out.Paused)
+	require.Equal(0, out.TaskGroups[0].Count)
+	require.Equal(map[string]int{"web": 3}, out.PausedCounts)
+
+	// Resume the job
+	require.NoError(msgpackrpc.CallWithCodec(codec, "Job.Pause", resumeReq, &resumeResp))
+	require.NotEmpty(resumeResp.EvalID)
+
+	out, err = state.JobByID(ws, job.Namespace, job.ID)
+	require.NoError(err)
+	require.False(out.Paused)
+	require.Nil(out.PausedCounts)
+	require.Equal(3, out.TaskGroups[0].Count)
+
+	eval, err := state.EvalByID(ws, resumeResp.EvalID)
+	require.NoError(err)
+	require.Equal(out.JobModifyIndex, eval.JobModifyIndex)
+}
+
+func TestJobEndpoint_Pause_ACL(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	s1, root := TestACLServer(t, func(c *Config) {
+		c.NumSchedulers = 0 // Prevent automatic dequeue
+	})
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+	state := s1.fsm.State()
+
+	// Create the job
+	job := mock.Job()
+	require.NoError(state.UpsertJob(1000, job))
+
+	// Pause without a token fails
+	req := &structs.JobPauseRequest{
+		JobID: job.ID,
+		Pause: true,
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			Namespace: job.Namespace,
+		},
+	}
+	var resp structs.JobRegisterResponse
+	err := msgpackrpc.CallWithCodec(codec, "Job.Pause", req, &resp)
+	require.NotNil(err)
+	require.Contains(err.Error(), "Permission denied")
+
+	// Pause with a read-job token fails
+	invalidToken := mock.CreatePolicyAndToken(t, state, 1001, "test-invalid",
+		mock.NamespacePolicy(structs.DefaultNamespace, "", []string{acl.NamespaceCapabilityReadJob}))
+	req.AuthToken = invalidToken.SecretID
+	err = msgpackrpc.CallWithCodec(codec, "Job.Pause", req, &resp)
+	require.NotNil(err)
+	require.Contains(err.Error(), "Permission denied")
+
+	// Pause with a submit-job token succeeds
+	validToken := mock.CreatePolicyAndToken(t, state, 1003, "test-valid",
+		mock.NamespacePolicy(structs.DefaultNamespace, "", []string{acl.NamespaceCapabilitySubmitJob}))
+	req.AuthToken = validToken.SecretID
+	require.NoError(msgpackrpc.CallWithCodec(codec, "Job.Pause", req, &resp))
+
+	// Resume with the management token succeeds
+	req.AuthToken = root.SecretID
+	req.Pause = false
+	require.NoError(msgpackrpc.CallWithCodec(codec, "Job.Pause", req, &resp))
+}
+
 func TestJobEndpoint_Stable(t *testing.T) {
 	t.Parallel()
 	s1 := TestServer(t, func(c *Config) {
@@ -4853,6 +5047,10 @@ func TestJobEndpoint_Dispatch(t *testing.T) {
 	d7.ParameterizedJob = &structs.ParameterizedJobConfig{}
 	d7.Stop = true
 
+	d8 := mock.BatchJob()
+	d8.ParameterizedJob = &structs.ParameterizedJobConfig{}
+	d8.Pause()
+
 	reqNoInputNoMeta := &structs.JobDispatchRequest{}
 	reqInputDataNoMeta := &structs.JobDispatchRequest{
 		Payload: []byte("hello world"),
@@ -4981,6 +5179,13 @@ func TestJobEndpoint_Dispatch(t *testing.T) {
 			err:              true,
 			errStr:           "stopped",
 		},
+		{
+			name:             "parameterized job paused, ensure error",
+			parameterizedJob: d8,
+			dispatchReq:      reqNoInputNoMeta,
+			err:              true,
+			errStr:           "paused",
+		},
 	}
 
 	for _, tc := range cases {
@@ -5089,3 +5294,139 @@ func TestJobEndpoint_Dispatch(t *testing.T) {
 		})
 	}
 }
+
+func TestJobEndpoint_Dispatch_IdempotencyToken(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	s1 := TestServer(t, func(c *Config) {
+		c.NumSchedulers = 0 // Prevent automatic dequeue
+		c.DispatchIdempotencyWindow = 0
+	})
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	job := mock.BatchJob()
+	job.ParameterizedJob = &structs.ParameterizedJobConfig{}
+	regReq := &structs.JobRegisterRequest{
+		Job: job,
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			Namespace: job.Namespace,
+		},
+	}
+	var regResp structs.JobRegisterResponse
+	require.NoError(msgpackrpc.CallWithCodec(codec, "Job.Register", regReq, &regResp))
+
+	dispatch := func(token string) *structs.JobDispatchResponse {
+		req := &structs.JobDispatchRequest{
+			JobID:            job.ID,
+			IdempotencyToken: token,
+			WriteRequest: structs.WriteRequest{
+				Region:    "global",
+				Namespace: job.Namespace,
+			},
+		}
+		var resp structs.JobDispatchResponse
+		require.NoError(msgpackrpc.CallWithCodec(codec, "Job.Dispatch", req, &resp))
+		require.NotEmpty(resp.DispatchedJobID)
+		require.NotEmpty(resp.EvalID)
+		return &resp
+	}
+
+	// Retrying with the same token returns the running child
+	first := dispatch("foo")
+	retry := dispatch("foo")
+	require.Equal(first.DispatchedJobID, retry.DispatchedJobID)
+	require.Equal(first.EvalID, retry.EvalID)
+
+	out, err := s1.fsm.State().JobByID(nil, job.Namespace, first.DispatchedJobID)
+	require.NoError(err)
+	require.Equal("foo", out.DispatchIdempotencyToken)
+
+	// A different token dispatches a new child
+	other := dispatch("bar")
+	require.NotEqual(first.DispatchedJobID, other.DispatchedJobID)
+
+	// Once the child is terminal and outside the window a new child is
+	// dispatched
+	stopped := out.Copy()
+	stopped.Stop = true
+	require.NoError(s1.fsm.State().UpsertJob(1000, stopped))
+
+	next := dispatch("foo")
+	require.NotEqual(first.DispatchedJobID, next.DispatchedJobID)
+}
+
+func TestJobEndpoint_Dispatch_IdempotencyToken_Concurrent(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	s1 := TestServer(t, func(c *Config) {
+		c.NumSchedulers = 0 // Prevent automatic dequeue
+	})
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	job := mock.BatchJob()
+	job.ParameterizedJob = &structs.ParameterizedJobConfig{}
+	regReq := &structs.JobRegisterRequest{
+		Job: job,
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			Namespace: job.Namespace,
+		},
+	}
+	var regResp structs.JobRegisterResponse
+	require.NoError(msgpackrpc.CallWithCodec(codec, "Job.Register", regReq, &regResp))
+
+	// Retry the dispatch concurrently with the same token, connecting
+	// before starting the requests together
+	const n = 20
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	ids := make([]string, n)
+	errs := make([]error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		codec := rpcClient(t, s1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			req := &structs.JobDispatchRequest{
+				JobID:            job.ID,
+				IdempotencyToken: "foo",
+				WriteRequest: structs.WriteRequest{
+					Region:    "global",
+					Namespace: job.Namespace,
+				},
+			}
+			var resp structs.JobDispatchResponse
+			errs[i] = msgpackrpc.CallWithCodec(codec, "Job.Dispatch", req, &resp)
+			ids[i] = resp.DispatchedJobID
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	// Every request returns the single dispatched job
+	for i := 0; i < n; i++ {
+		require.NoError(errs[i])
+		require.Equal(ids[0], ids[i])
+	}
+
+	children, err := s1.fsm.State().JobsByIDPrefix(nil, job.Namespace, job.ID+"/")
+	require.NoError(err)
+	count := 0
+	for raw := children.Next(); raw != nil; raw = children.Next() {
+		count++
+	}
+	require.Equal(1, count)
+
+	// The token locks are released
+	s1.dispatchTokenLocks.l.Lock()
+	require.Empty(s1.dispatchTokenLocks.locks)
+	s1.dispatchTokenLocks.l.Unlock()
+}
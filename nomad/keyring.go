@@ -0,0 +1,358 @@
+package nomad
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// Workload identities are JSON Web Tokens signed with ES256 by the active
+// root key. The key ID is set as the "kid" header so identities signed by a
+// rotated key can still be verified until the key is garbage collected.
+//
+// Variables are encrypted with AES-256-GCM by the data key of the active root
+// key, and record the ID of the key so they can be decrypted after it is
+// rotated. Root keys are replicated through Raft, so the data key is wrapped
+// with the key encryption key (KEK) set in the local configuration of each
+// server and is never written to Raft or snapshots in plaintext. A copy of the
+// Raft data or of a snapshot alone doesn't reveal variables; an attacker also
+// needs the KEK from a server's configuration. A server, or anyone able to
+// read its configuration and data directory, can decrypt all variables.
+
+// identityHeader is the JOSE header of a workload identity.
+type identityHeader struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+	Type      string `json:"typ"`
+}
+
+const (
+	// es256Size is the size of each of the R and S values of an ES256
+	// signature.
+	es256Size = 32
+
+	// encryptionKeySize is the size of the AES-256 key encrypting variables.
+	encryptionKeySize = 32
+)
+
+// newRootKey generates a new root key. The key can only encrypt variables if
+// a key encryption key is given to wrap its data key.
+func newRootKey(now time.Time, kek []byte) (*structs.RootKey, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	rootKey := &structs.RootKey{
+		KeyID:      uuid.Generate(),
+		Algorithm:  structs.RootKeyAlgorithmES256,
+		Key:        der,
+		CreateTime: now,
+	}
+	if kek == nil {
+		return rootKey, nil
+	}
+
+	dataKey := make([]byte, encryptionKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	rootKey.WrappedEncryptionKey, err = wrapDataKey(kek, rootKey.KeyID, dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return rootKey, nil
+}
+
+// wrapDataKey encrypts the data key of the root key with the key encryption
+// key.
+func wrapDataKey(kek []byte, keyID string, dataKey []byte) ([]byte, error) {
+	aead, err := newGCM(kek)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, dataKey, []byte(keyID)), nil
+}
+
+// unwrapDataKey decrypts the data key of the root key with the key encryption
+// key.
+func unwrapDataKey(kek []byte, key *structs.RootKey) ([]byte, error) {
+	if kek == nil {
+		return nil, fmt.Errorf("variables are disabled: keyring_encryption_key is not set on this server")
+	}
+	if len(key.WrappedEncryptionKey) == 0 {
+		return nil, fmt.Errorf("root key %q can't encrypt variables", key.KeyID)
+	}
+
+	aead, err := newGCM(kek)
+	if err != nil {
+		return nil, err
+	}
+	if len(key.WrappedEncryptionKey) < aead.NonceSize() {
+		return nil, fmt.Errorf("malformed data key of root key %q", key.KeyID)
+	}
+
+	nonce, wrapped := key.WrappedEncryptionKey[:aead.NonceSize()], key.WrappedEncryptionKey[aead.NonceSize():]
+	dataKey, err := aead.Open(nil, nonce, wrapped, []byte(key.KeyID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key of root key %q, keyring_encryption_key may differ between servers: %v", key.KeyID, err)
+	}
+	return dataKey, nil
+}
+
+// parseRootKey returns the private key of the root key.
+func parseRootKey(key *structs.RootKey) (*ecdsa.PrivateKey, error) {
+	if key.Algorithm != structs.RootKeyAlgorithmES256 {
+		return nil, fmt.Errorf("unsupported root key algorithm %q", key.Algorithm)
+	}
+	return x509.ParseECPrivateKey(key.Key)
+}
+
+// rootKeyJWK returns the public key of the root key as a JWK.
+func rootKeyJWK(key *structs.RootKey) (*structs.JWK, error) {
+	pk, err := parseRootKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &structs.JWK{
+		KeyType:   "EC",
+		Use:       "sig",
+		KeyID:     key.KeyID,
+		Algorithm: key.Algorithm,
+		Curve:     "P-256",
+		X:         encodeSegment(padBytes(pk.X.Bytes(), es256Size)),
+		Y:         encodeSegment(padBytes(pk.Y.Bytes(), es256Size)),
+	}, nil
+}
+
+// signIdentity returns the claims signed by the root key.
+func signIdentity(key *structs.RootKey, claims *structs.IdentityClaims) (string, error) {
+	pk, err := parseRootKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	header, err := json.Marshal(&identityHeader{
+		Algorithm: key.Algorithm,
+		KeyID:     key.KeyID,
+		Type:      "JWT",
+	})
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signed := encodeSegment(header) + "." + encodeSegment(payload)
+	digest := sha256.Sum256([]byte(signed))
+	r, s, err := ecdsa.Sign(rand.Reader, pk, digest[:])
+	if err != nil {
+		return "", err
+	}
+
+	sig := append(padBytes(r.Bytes(), es256Size), padBytes(s.Bytes(), es256Size)...)
+	return signed + "." + encodeSegment(sig), nil
+}
+
+// isIdentityToken returns whether the token has the shape of a workload
+// identity rather than an ACL token secret ID.
+func isIdentityToken(token string) bool {
+	return strings.Count(token, ".") == 2
+}
+
+// verifyIdentity verifies the signature of the workload identity with the
+// root keys in state and returns its claims if they are valid at the given
+// time.
+func verifyIdentity(snap *state.StateSnapshot, token string, now time.Time) (*structs.IdentityClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("malformed workload identity")
+	}
+
+	var header identityHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, err
+	}
+	if header.Algorithm != structs.RootKeyAlgorithmES256 {
+		return nil, fmt.Errorf("unsupported workload identity algorithm %q", header.Algorithm)
+	}
+
+	key, err := snap.RootKeyByID(nil, header.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, fmt.Errorf("unknown root key %q", header.KeyID)
+	}
+	pk, err := parseRootKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("malformed workload identity signature: %v", err)
+	}
+	if len(sig) != 2*es256Size {
+		return nil, fmt.Errorf("malformed workload identity signature")
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	r := new(big.Int).SetBytes(sig[:es256Size])
+	s := new(big.Int).SetBytes(sig[es256Size:])
+	if !ecdsa.Verify(&pk.PublicKey, digest[:], r, s) {
+		return nil, fmt.Errorf("invalid workload identity signature")
+	}
+
+	var claims structs.IdentityClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, err
+	}
+	if err := claims.Validate(now); err != nil {
+		return nil, err
+	}
+	return &claims, nil
+}
+
+// encryptVariable encrypts the items of the variable with the root key.
+func encryptVariable(kek []byte, key *structs.RootKey, v *structs.VariableDecrypted) (*structs.VariableEncrypted, error) {
+	aead, err := variableCipher(kek, key)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := json.Marshal(v.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return &structs.VariableEncrypted{
+		VariableMetadata: v.VariableMetadata,
+		VariableData: structs.VariableData{
+			Data:  aead.Seal(nonce, nonce, plaintext, variableAdditionalData(&v.VariableMetadata)),
+			KeyID: key.KeyID,
+		},
+	}, nil
+}
+
+// decryptVariable decrypts the items of the variable with the root key that
+// encrypted them.
+func decryptVariable(kek []byte, store *state.StateStore, v *structs.VariableEncrypted) (*structs.VariableDecrypted, error) {
+	key, err := store.RootKeyByID(nil, v.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, fmt.Errorf("unknown root key %q", v.KeyID)
+	}
+
+	aead, err := variableCipher(kek, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(v.Data) < aead.NonceSize() {
+		return nil, fmt.Errorf("malformed variable data")
+	}
+
+	nonce, ciphertext := v.Data[:aead.NonceSize()], v.Data[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, variableAdditionalData(&v.VariableMetadata))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt variable: %v", err)
+	}
+
+	var items structs.VariableItems
+	if err := json.Unmarshal(plaintext, &items); err != nil {
+		return nil, fmt.Errorf("malformed variable data: %v", err)
+	}
+
+	return &structs.VariableDecrypted{
+		VariableMetadata: v.VariableMetadata,
+		Items:            items,
+	}, nil
+}
+
+// variableCipher returns the AEAD cipher encrypting variables with the data
+// key of the root key.
+func variableCipher(kek []byte, key *structs.RootKey) (cipher.AEAD, error) {
+	dataKey, err := unwrapDataKey(kek, key)
+	if err != nil {
+		return nil, err
+	}
+	return newGCM(dataKey)
+}
+
+// newGCM returns an AES-256-GCM cipher with the key.
+func newGCM(key []byte) (cipher.AEAD, error) {
+	if len(key) != encryptionKeySize {
+		return nil, fmt.Errorf("invalid key size %d, must be %d bytes", len(key), encryptionKeySize)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// variableAdditionalData binds the encrypted items of a variable to its
+// namespace and path, so they can't be moved to another variable.
+func variableAdditionalData(meta *structs.VariableMetadata) []byte {
+	return []byte(meta.Namespace + "\x00" + meta.Path)
+}
+
+func encodeSegment(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeSegment(seg string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return fmt.Errorf("malformed workload identity: %v", err)
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("malformed workload identity: %v", err)
+	}
+	return nil
+}
+
+// padBytes left pads b with zeros to the given size.
+func padBytes(b []byte, size int) []byte {
+	if len(b) >= size {
+		return b
+	}
+	padded := make([]byte, size)
+	copy(padded[size-len(b):], b)
+	return padded
+}
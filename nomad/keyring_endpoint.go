@@ -0,0 +1,195 @@
+package nomad
+
+import (
+	"fmt"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	log "github.com/hashicorp/go-hclog"
+	memdb "github.com/hashicorp/go-memdb"
+
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// Keyring endpoint is used to manage the root keys signing workload
+// identities
+type Keyring struct {
+	srv    *Server
+	logger log.Logger
+}
+
+// Rotate is used to generate a new active root key. The previously active key
+// is kept to verify the identities it signed and decrypt the variables it
+// encrypted until it is garbage collected. A full rotation re-encrypts all
+// variables with the new key.
+func (k *Keyring) Rotate(args *structs.KeyringRotateRequest, reply *structs.KeyringRotateResponse) error {
+	if done, err := k.srv.forward("Keyring.Rotate", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "keyring", "rotate"}, time.Now())
+
+	// Check management level permissions
+	if aclObj, err := k.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if aclObj != nil && !aclObj.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	key, err := newRootKey(time.Now().UTC(), k.srv.config.KeyEncryptionKey)
+	if err != nil {
+		return err
+	}
+	key.Active = true
+
+	req := &structs.RootKeyUpsertRequest{
+		Keys:         []*structs.RootKey{key},
+		WriteRequest: args.WriteRequest,
+	}
+	_, index, err := k.srv.raftApply(structs.RootKeyUpsertRequestType, req)
+	if err != nil {
+		k.logger.Error("root key rotation failed", "error", err)
+		return err
+	}
+
+	if args.Full {
+		rekeyIndex, err := k.rekeyVariables(key)
+		if err != nil {
+			k.logger.Error("variables re-encryption failed", "error", err)
+			return err
+		}
+		if rekeyIndex > index {
+			index = rekeyIndex
+		}
+	}
+
+	reply.KeyID = key.KeyID
+	reply.Index = index
+	return nil
+}
+
+// rekeyVariables re-encrypts the variables encrypted by other keys with the
+// root key, and returns the index of the last write. Variables modified
+// concurrently are skipped, as writes already encrypt with the active key.
+func (k *Keyring) rekeyVariables(key *structs.RootKey) (uint64, error) {
+	snap, err := k.srv.fsm.State().Snapshot()
+	if err != nil {
+		return 0, err
+	}
+
+	iter, err := snap.Variables(nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var index uint64
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		v := raw.(*structs.VariableEncrypted)
+		if v.KeyID == key.KeyID {
+			continue
+		}
+
+		decrypted, err := decryptVariable(k.srv.config.KeyEncryptionKey, &snap.StateStore, v)
+		if err != nil {
+			return 0, err
+		}
+		encrypted, err := encryptVariable(k.srv.config.KeyEncryptionKey, key, decrypted)
+		if err != nil {
+			return 0, err
+		}
+
+		checkIndex := v.ModifyIndex
+		req := &structs.VarUpsertRequest{
+			Var:        encrypted,
+			CheckIndex: &checkIndex,
+			WriteRequest: structs.WriteRequest{
+				Region: k.srv.config.Region,
+			},
+		}
+		resp, idx, err := k.srv.raftApply(structs.VarUpsertRequestType, req)
+		if err != nil {
+			return 0, err
+		} else if respErr, ok := resp.(error); ok {
+			return 0, respErr
+		}
+		index = idx
+	}
+	return index, nil
+}
+
+// Delete is used to delete inactive root keys
+func (k *Keyring) Delete(args *structs.RootKeyDeleteRequest, reply *structs.GenericResponse) error {
+	if done, err := k.srv.forward("Keyring.Delete", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "keyring", "delete"}, time.Now())
+
+	// Check management level permissions
+	if aclObj, err := k.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if aclObj != nil && !aclObj.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	if len(args.KeyIDs) == 0 {
+		return fmt.Errorf("must specify as least one root key")
+	}
+
+	state := k.srv.fsm.State()
+	for _, id := range args.KeyIDs {
+		key, err := state.RootKeyByID(nil, id)
+		if err != nil {
+			return err
+		}
+		if key != nil && key.Active {
+			return fmt.Errorf("can't delete active root key %q", id)
+		}
+	}
+
+	_, index, err := k.srv.raftApply(structs.RootKeyDeleteRequestType, args)
+	if err != nil {
+		k.logger.Error("root key delete failed", "error", err)
+		return err
+	}
+
+	reply.Index = index
+	return nil
+}
+
+// ListPublic is used to list the public keys verifying workload identities.
+// The public keys are not secret so no ACL token is required.
+func (k *Keyring) ListPublic(args *structs.KeyringListPublicRequest, reply *structs.KeyringListPublicResponse) error {
+	if done, err := k.srv.forward("Keyring.ListPublic", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "keyring", "list_public"}, time.Now())
+
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, state *state.StateStore) error {
+			iter, err := state.RootKeys(ws)
+			if err != nil {
+				return err
+			}
+
+			reply.Keys = nil
+			for raw := iter.Next(); raw != nil; raw = iter.Next() {
+				jwk, err := rootKeyJWK(raw.(*structs.RootKey))
+				if err != nil {
+					return err
+				}
+				reply.Keys = append(reply.Keys, jwk)
+			}
+
+			index, err := state.Index("root_keys")
+			if err != nil {
+				return err
+			}
+			reply.Index = index
+
+			k.srv.setQueryMeta(&reply.QueryMeta)
+			return nil
+		}}
+	return k.srv.blockingRPC(&opts)
+}
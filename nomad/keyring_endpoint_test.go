@@ -0,0 +1,142 @@
+package nomad
+
+import (
+	"testing"
+
+	msgpackrpc "github.com/hashicorp/net-rpc-msgpackrpc"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyringEndpoint_ListPublic(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	s1 := TestServer(t, nil)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	// The leader creates the initial root key
+	var active *structs.RootKey
+	testutil.WaitForResult(func() (bool, error) {
+		key, err := s1.fsm.State().ActiveRootKey(nil)
+		active = key
+		return key != nil, err
+	}, func(err error) {
+		t.Fatalf("no active root key: %v", err)
+	})
+
+	req := &structs.KeyringListPublicRequest{
+		QueryOptions: structs.QueryOptions{Region: "global"},
+	}
+	var resp structs.KeyringListPublicResponse
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Keyring.ListPublic", req, &resp))
+	require.Len(resp.Keys, 1)
+	require.Equal(active.KeyID, resp.Keys[0].KeyID)
+	require.NotZero(resp.Index)
+}
+
+func TestKeyringEndpoint_Rotate_ACL(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	s1, root := TestACLServer(t, nil)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	var previous *structs.RootKey
+	testutil.WaitForResult(func() (bool, error) {
+		key, err := s1.fsm.State().ActiveRootKey(nil)
+		previous = key
+		return key != nil, err
+	}, func(err error) {
+		t.Fatalf("no active root key: %v", err)
+	})
+
+	invalidToken := mock.CreatePolicyAndToken(t, s1.fsm.State(), 1003, "test-invalid",
+		mock.NamespacePolicy(structs.DefaultNamespace, "", []string{"submit-job"}))
+
+	req := &structs.KeyringRotateRequest{
+		WriteRequest: structs.WriteRequest{Region: "global"},
+	}
+
+	// Try without a token and with a non-management token
+	var resp structs.KeyringRotateResponse
+	err := msgpackrpc.CallWithCodec(codec, "Keyring.Rotate", req, &resp)
+	require.EqualError(err, structs.ErrPermissionDenied.Error())
+
+	req.AuthToken = invalidToken.SecretID
+	err = msgpackrpc.CallWithCodec(codec, "Keyring.Rotate", req, &resp)
+	require.EqualError(err, structs.ErrPermissionDenied.Error())
+
+	// Rotate with a management token
+	req.AuthToken = root.SecretID
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Keyring.Rotate", req, &resp))
+	require.NotEqual(previous.KeyID, resp.KeyID)
+
+	active, err := s1.fsm.State().ActiveRootKey(nil)
+	require.Nil(err)
+	require.Equal(resp.KeyID, active.KeyID)
+
+	// The active key can't be deleted, the previous key can
+	del := &structs.RootKeyDeleteRequest{
+		KeyIDs:       []string{resp.KeyID},
+		WriteRequest: structs.WriteRequest{Region: "global", AuthToken: root.SecretID},
+	}
+	var delResp structs.GenericResponse
+	err = msgpackrpc.CallWithCodec(codec, "Keyring.Delete", del, &delResp)
+	require.Error(err)
+	require.Contains(err.Error(), "active root key")
+
+	del.KeyIDs = []string{previous.KeyID}
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Keyring.Delete", del, &delResp))
+
+	out, err := s1.fsm.State().RootKeyByID(nil, previous.KeyID)
+	require.Nil(err)
+	require.Nil(out)
+}
+
+func TestKeyringEndpoint_Rotate_Full(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	s1 := TestServer(t, nil)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+	previous := waitForRootKey(t, s1)
+
+	upsert := &structs.VariablesUpsertRequest{
+		Var: &structs.VariableDecrypted{
+			VariableMetadata: structs.VariableMetadata{Path: "app/db"},
+			Items:            structs.VariableItems{"password": "hunter2"},
+		},
+		WriteRequest: structs.WriteRequest{Region: "global"},
+	}
+	var upsertResp structs.VariablesUpsertResponse
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Variables.Upsert", upsert, &upsertResp))
+
+	// A full rotation re-encrypts the variables with the new key
+	req := &structs.KeyringRotateRequest{
+		Full:         true,
+		WriteRequest: structs.WriteRequest{Region: "global"},
+	}
+	var resp structs.KeyringRotateResponse
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Keyring.Rotate", req, &resp))
+	require.NotEqual(previous.KeyID, resp.KeyID)
+
+	state := s1.fsm.State()
+	stored, err := state.VariableByPath(nil, structs.DefaultNamespace, "app/db")
+	require.Nil(err)
+	require.Equal(resp.KeyID, stored.KeyID)
+	require.Equal(resp.Index, stored.ModifyIndex)
+
+	out, err := decryptVariable(testKeyEncryptionKey, state, stored)
+	require.Nil(err)
+	require.Equal("hunter2", out.Items["password"])
+	require.Equal(upsertResp.Output.ModifyTime, out.ModifyTime)
+}
@@ -0,0 +1,171 @@
+package nomad
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyring_SignVerifyIdentity(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	state := state.TestStateStore(t)
+	key, err := newRootKey(time.Now(), testKeyEncryptionKey)
+	require.Nil(err)
+	key.Active = true
+	other, err := newRootKey(time.Now(), testKeyEncryptionKey)
+	require.Nil(err)
+	require.Nil(state.UpsertRootKeys(100, []*structs.RootKey{key}))
+
+	snap, err := state.Snapshot()
+	require.Nil(err)
+
+	now := time.Now()
+	alloc := mock.Alloc()
+	claims := structs.NewIdentityClaims(alloc, "web", now, time.Hour)
+	token, err := signIdentity(key, claims)
+	require.Nil(err)
+	require.True(isIdentityToken(token))
+
+	// Valid identity
+	out, err := verifyIdentity(snap, token, now)
+	require.Nil(err)
+	require.Equal(claims, out)
+
+	// Expired identity
+	_, err = verifyIdentity(snap, token, now.Add(2*time.Hour))
+	require.Error(err)
+	require.Contains(err.Error(), "expired")
+
+	// Tampered claims
+	parts := strings.Split(token, ".")
+	forged := *claims
+	forged.JobID = "other"
+	forgedToken, err := signIdentity(key, &forged)
+	require.Nil(err)
+	_, err = verifyIdentity(snap, parts[0]+"."+strings.Split(forgedToken, ".")[1]+"."+parts[2], now)
+	require.Error(err)
+	require.Contains(err.Error(), "signature")
+
+	// Identity signed by an unknown key
+	unknown, err := signIdentity(other, claims)
+	require.Nil(err)
+	_, err = verifyIdentity(snap, unknown, now)
+	require.Error(err)
+	require.Contains(err.Error(), "unknown root key")
+}
+
+func TestKeyring_RootKeyJWK(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	key, err := newRootKey(time.Now(), testKeyEncryptionKey)
+	require.Nil(err)
+
+	jwk, err := rootKeyJWK(key)
+	require.Nil(err)
+	require.Equal(key.KeyID, jwk.KeyID)
+	require.Equal("EC", jwk.KeyType)
+	require.Equal("P-256", jwk.Curve)
+	require.Equal(structs.RootKeyAlgorithmES256, jwk.Algorithm)
+	require.Len(jwk.X, 43)
+	require.Len(jwk.Y, 43)
+}
+
+func TestKeyring_EncryptDecryptVariable(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	state := state.TestStateStore(t)
+	key, err := newRootKey(time.Now(), testKeyEncryptionKey)
+	require.Nil(err)
+	key.Active = true
+	require.Nil(state.UpsertRootKeys(100, []*structs.RootKey{key}))
+
+	v := &structs.VariableDecrypted{
+		VariableMetadata: structs.VariableMetadata{
+			Namespace: structs.DefaultNamespace,
+			Path:      "app/db",
+		},
+		Items: structs.VariableItems{"password": "hunter2"},
+	}
+	encrypted, err := encryptVariable(testKeyEncryptionKey, key, v)
+	require.Nil(err)
+	require.Equal(key.KeyID, encrypted.KeyID)
+	require.NotContains(string(encrypted.Data), "hunter2")
+
+	out, err := decryptVariable(testKeyEncryptionKey, state, encrypted)
+	require.Nil(err)
+	require.Equal(v, out)
+
+	// The items can't be moved to another variable
+	moved := encrypted.Copy()
+	moved.Path = "app/web"
+	_, err = decryptVariable(testKeyEncryptionKey, state, moved)
+	require.Error(err)
+
+	// Variables encrypted by an unknown key can't be decrypted
+	encrypted.KeyID = "unknown"
+	_, err = decryptVariable(testKeyEncryptionKey, state, encrypted)
+	require.Error(err)
+	require.Contains(err.Error(), "unknown root key")
+}
+
+func TestKeyring_WrappedDataKey(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	state := state.TestStateStore(t)
+	key, err := newRootKey(time.Now(), testKeyEncryptionKey)
+	require.Nil(err)
+	key.Active = true
+	require.Nil(state.UpsertRootKeys(100, []*structs.RootKey{key}))
+
+	// The root key in state only holds the wrapped data key
+	stored, err := state.ActiveRootKey(nil)
+	require.Nil(err)
+	dataKey, err := unwrapDataKey(testKeyEncryptionKey, stored)
+	require.Nil(err)
+	require.Len(dataKey, encryptionKeySize)
+	require.NotContains(string(stored.WrappedEncryptionKey), string(dataKey))
+
+	v := &structs.VariableDecrypted{
+		VariableMetadata: structs.VariableMetadata{
+			Namespace: structs.DefaultNamespace,
+			Path:      "app/db",
+		},
+		Items: structs.VariableItems{"password": "hunter2"},
+	}
+	encrypted, err := encryptVariable(testKeyEncryptionKey, stored, v)
+	require.Nil(err)
+
+	// Variables can't be decrypted without the key encryption key
+	_, err = decryptVariable(nil, state, encrypted)
+	require.Error(err)
+	require.Contains(err.Error(), "keyring_encryption_key is not set")
+
+	other := []byte(strings.Repeat("k", encryptionKeySize))
+	_, err = decryptVariable(other, state, encrypted)
+	require.Error(err)
+	require.Contains(err.Error(), "failed to unwrap data key")
+
+	// The wrapped data key can't be moved to another root key
+	moved := stored.Copy()
+	moved.KeyID = "other"
+	_, err = unwrapDataKey(testKeyEncryptionKey, moved)
+	require.Error(err)
+
+	// Root keys created without a key encryption key only sign identities
+	signing, err := newRootKey(time.Now(), nil)
+	require.Nil(err)
+	require.Empty(signing.WrappedEncryptionKey)
+	_, err = encryptVariable(testKeyEncryptionKey, signing, v)
+	require.Error(err)
+	require.Contains(err.Error(), "can't encrypt variables")
+}
@@ -201,6 +201,9 @@ func (s *Server) establishLeadership(stopCh chan struct{}) error {
 	// Initialize scheduler configuration
 	s.getOrCreateSchedulerConfig()
 
+	// Initialize the root key signing workload identities
+	s.getOrCreateRootKey()
+
 	// Enable the plan queue, since we are now the leader
 	s.planQueue.SetEnabled(true)
 
@@ -275,6 +278,9 @@ func (s *Server) establishLeadership(stopCh chan struct{}) error {
 	// and we are not the authoritative region.
 	if s.config.ACLEnabled && s.config.Region != s.config.AuthoritativeRegion {
 		go s.replicateACLPolicies(stopCh)
+		go s.replicateACLRoles(stopCh)
+		go s.replicateACLAuthMethods(stopCh)
+		go s.replicateACLBindingRules(stopCh)
 		go s.replicateACLTokens(stopCh)
 	}
 
@@ -309,6 +315,10 @@ func (s *Server) restoreEvals() error {
 
 		if eval.ShouldEnqueue() {
 			s.evalBroker.Enqueue(eval)
+		} else if eval.ShouldBlock() && len(eval.WaitingOnJobs) != 0 {
+			// The jobs depended on may have changed while no leader was
+			// tracking them, so let the scheduler check them again.
+			s.evalBroker.Enqueue(eval)
 		} else if eval.ShouldBlock() {
 			s.blockedEvals.Block(eval)
 		}
@@ -413,25 +423,33 @@ func (s *Server) restorePeriodicDispatcher() error {
 				job.ID, job.Namespace)
 		}
 
-		// nextLaunch is the next launch that should occur.
-		nextLaunch, err := job.Periodic.Next(launch.Launch.In(job.Periodic.GetLocation()))
+		// Only launches missed while there was no leader are caught up. The
+		// previous leader launched the job until this server last heard from
+		// it, which is unknown if this server was the previous leader.
+		since := launch.Launch
+		if contact := s.raft.LastContact(); contact.After(since) {
+			since = contact
+		}
+
+		// launches are the missed launches that should be caught up according
+		// to the job's catch up policy.
+		launches, err := job.Periodic.CatchUpLaunches(since.In(job.Periodic.GetLocation()), now)
 		if err != nil {
-			logger.Error("failed to determine next periodic launch for job", "job", job.NamespacedID(), "error", err)
+			logger.Error("failed to determine missed periodic launches for job", "job", job.NamespacedID(), "error", err)
 			continue
 		}
 
-		// We skip force launching the job if  there should be no next launch
-		// (the zero case) or if the next launch time is in the future. If it is
-		// in the future, it will be handled by the periodic dispatcher.
-		if nextLaunch.IsZero() || !nextLaunch.Before(now) {
+		// We skip catching up the job if no launch was missed. Launches in the
+		// future will be handled by the periodic dispatcher.
+		if len(launches) == 0 {
 			continue
 		}
 
-		if _, err := s.periodicDispatcher.ForceRun(job.Namespace, job.ID); err != nil {
-			logger.Error("force run of periodic job failed", "job", job.NamespacedID(), "error", err)
-			return fmt.Errorf("force run of periodic job %q failed: %v", job.NamespacedID(), err)
+		if _, err := s.periodicDispatcher.CatchUp(job.Namespace, job.ID, launches); err != nil {
+			logger.Error("catch up of periodic job failed", "job", job.NamespacedID(), "error", err)
+			return fmt.Errorf("catch up of periodic job %q failed: %v", job.NamespacedID(), err)
 		}
-		logger.Debug("periodic job force runned during leadership establishment", "job", job.NamespacedID())
+		logger.Debug("periodic job caught up during leadership establishment", "job", job.NamespacedID(), "launches", len(launches))
 	}
 
 	return nil
@@ -447,6 +465,10 @@ func (s *Server) schedulePeriodic(stopCh chan struct{}) {
 	defer jobGC.Stop()
 	deploymentGC := time.NewTicker(s.config.DeploymentGCInterval)
 	defer deploymentGC.Stop()
+	rootKeyGC := time.NewTicker(s.config.RootKeyGCInterval)
+	defer rootKeyGC.Stop()
+	aclTokenGC := time.NewTicker(s.config.ACLTokenExpirationGCInterval)
+	defer aclTokenGC.Stop()
 
 	// getLatest grabs the latest index from the state store. It returns true if
 	// the index was retrieved successfully.
@@ -479,6 +501,14 @@ func (s *Server) schedulePeriodic(stopCh chan struct{}) {
 			if index, ok := getLatest(); ok {
 				s.evalBroker.Enqueue(s.coreJobEval(structs.CoreJobDeploymentGC, index))
 			}
+		case <-rootKeyGC.C:
+			if index, ok := getLatest(); ok {
+				s.evalBroker.Enqueue(s.coreJobEval(structs.CoreJobRootKeyRotateOrGC, index))
+			}
+		case <-aclTokenGC.C:
+			if index, ok := getLatest(); ok {
+				s.evalBroker.Enqueue(s.coreJobEval(structs.CoreJobExpiredACLTokenGC, index))
+			}
 		case <-stopCh:
 			return
 		}
@@ -1133,6 +1163,429 @@ func diffACLPolicies(state *state.StateStore, minIndex uint64, remoteList []*str
 	return
 }
 
+// replicateACLRoles is used to replicate ACL roles from
+// the authoritative region to this region.
+func (s *Server) replicateACLRoles(stopCh chan struct{}) {
+	req := structs.ACLRoleListRequest{
+		QueryOptions: structs.QueryOptions{
+			Region:     s.config.AuthoritativeRegion,
+			AllowStale: true,
+		},
+	}
+	limiter := rate.NewLimiter(replicationRateLimit, int(replicationRateLimit))
+	s.logger.Debug("starting ACL role replication from authoritative region", "authoritative_region", req.Region)
+
+START:
+	for {
+		select {
+		case <-stopCh:
+			return
+		default:
+			// Rate limit how often we attempt replication
+			limiter.Wait(context.Background())
+
+			// Fetch the list of roles
+			var resp structs.ACLRoleListResponse
+			req.AuthToken = s.ReplicationToken()
+			err := s.forwardRegion(s.config.AuthoritativeRegion,
+				"ACL.ListRoles", &req, &resp)
+			if err != nil {
+				s.logger.Error("failed to fetch roles from authoritative region", "error", err)
+				goto ERR_WAIT
+			}
+
+			// Perform a two-way diff
+			delete, update := diffACLRoles(s.State(), req.MinQueryIndex, resp.Roles)
+
+			// Delete roles that should not exist
+			if len(delete) > 0 {
+				args := &structs.ACLRoleDeleteRequest{
+					Names: delete,
+				}
+				_, _, err := s.raftApply(structs.ACLRoleDeleteRequestType, args)
+				if err != nil {
+					s.logger.Error("failed to delete roles", "error", err)
+					goto ERR_WAIT
+				}
+			}
+
+			// Fetch any outdated roles
+			var fetched []*structs.ACLRole
+			if len(update) > 0 {
+				req := structs.ACLRoleSetRequest{
+					Names: update,
+					QueryOptions: structs.QueryOptions{
+						Region:        s.config.AuthoritativeRegion,
+						AuthToken:     s.ReplicationToken(),
+						AllowStale:    true,
+						MinQueryIndex: resp.Index - 1,
+					},
+				}
+				var reply structs.ACLRoleSetResponse
+				if err := s.forwardRegion(s.config.AuthoritativeRegion,
+					"ACL.GetRoles", &req, &reply); err != nil {
+					s.logger.Error("failed to fetch roles from authoritative region", "error", err)
+					goto ERR_WAIT
+				}
+				for _, role := range reply.Roles {
+					fetched = append(fetched, role)
+				}
+			}
+
+			// Update local roles
+			if len(fetched) > 0 {
+				args := &structs.ACLRoleUpsertRequest{
+					Roles: fetched,
+				}
+				_, _, err := s.raftApply(structs.ACLRoleUpsertRequestType, args)
+				if err != nil {
+					s.logger.Error("failed to update roles", "error", err)
+					goto ERR_WAIT
+				}
+			}
+
+			// Update the minimum query index, blocks until there
+			// is a change.
+			req.MinQueryIndex = resp.Index
+		}
+	}
+
+ERR_WAIT:
+	select {
+	case <-time.After(s.config.ReplicationBackoff):
+		goto START
+	case <-stopCh:
+		return
+	}
+}
+
+// diffACLRoles is used to perform a two-way diff between the local
+// roles and the remote roles to determine which roles need to
+// be deleted or updated.
+func diffACLRoles(state *state.StateStore, minIndex uint64, remoteList []*structs.ACLRoleListStub) (delete []string, update []string) {
+	// Construct a set of the local and remote roles
+	local := make(map[string][]byte)
+	remote := make(map[string]struct{})
+
+	// Add all the local roles
+	iter, err := state.ACLRoles(nil)
+	if err != nil {
+		panic("failed to iterate local roles")
+	}
+	for {
+		raw := iter.Next()
+		if raw == nil {
+			break
+		}
+		role := raw.(*structs.ACLRole)
+		local[role.Name] = role.Hash
+	}
+
+	// Iterate over the remote roles
+	for _, rr := range remoteList {
+		remote[rr.Name] = struct{}{}
+
+		// Check if the role is missing locally
+		if localHash, ok := local[rr.Name]; !ok {
+			update = append(update, rr.Name)
+
+			// Check if role is newer remotely and there is a hash mis-match.
+		} else if rr.ModifyIndex > minIndex && !bytes.Equal(localHash, rr.Hash) {
+			update = append(update, rr.Name)
+		}
+	}
+
+	// Check if role should be deleted
+	for lr := range local {
+		if _, ok := remote[lr]; !ok {
+			delete = append(delete, lr)
+		}
+	}
+	return
+}
+
+// replicateACLAuthMethods is used to replicate ACL auth methods from
+// the authoritative region to this region.
+func (s *Server) replicateACLAuthMethods(stopCh chan struct{}) {
+	req := structs.ACLAuthMethodListRequest{
+		QueryOptions: structs.QueryOptions{
+			Region:     s.config.AuthoritativeRegion,
+			AllowStale: true,
+		},
+	}
+	limiter := rate.NewLimiter(replicationRateLimit, int(replicationRateLimit))
+	s.logger.Debug("starting ACL auth method replication from authoritative region", "authoritative_region", req.Region)
+
+START:
+	for {
+		select {
+		case <-stopCh:
+			return
+		default:
+			// Rate limit how often we attempt replication
+			limiter.Wait(context.Background())
+
+			// Fetch the list of auth methods
+			var resp structs.ACLAuthMethodListResponse
+			req.AuthToken = s.ReplicationToken()
+			err := s.forwardRegion(s.config.AuthoritativeRegion,
+				"ACL.ListAuthMethods", &req, &resp)
+			if err != nil {
+				s.logger.Error("failed to fetch auth methods from authoritative region", "error", err)
+				goto ERR_WAIT
+			}
+
+			// Perform a two-way diff
+			delete, update := diffACLAuthMethods(s.State(), req.MinQueryIndex, resp.AuthMethods)
+
+			// Delete auth methods that should not exist
+			if len(delete) > 0 {
+				args := &structs.ACLAuthMethodDeleteRequest{
+					Names: delete,
+				}
+				_, _, err := s.raftApply(structs.ACLAuthMethodDeleteRequestType, args)
+				if err != nil {
+					s.logger.Error("failed to delete auth methods", "error", err)
+					goto ERR_WAIT
+				}
+			}
+
+			// Fetch any outdated auth methods
+			var fetched []*structs.ACLAuthMethod
+			if len(update) > 0 {
+				req := structs.ACLAuthMethodSetRequest{
+					Names: update,
+					QueryOptions: structs.QueryOptions{
+						Region:        s.config.AuthoritativeRegion,
+						AuthToken:     s.ReplicationToken(),
+						AllowStale:    true,
+						MinQueryIndex: resp.Index - 1,
+					},
+				}
+				var reply structs.ACLAuthMethodSetResponse
+				if err := s.forwardRegion(s.config.AuthoritativeRegion,
+					"ACL.GetAuthMethods", &req, &reply); err != nil {
+					s.logger.Error("failed to fetch auth methods from authoritative region", "error", err)
+					goto ERR_WAIT
+				}
+				for _, method := range reply.AuthMethods {
+					fetched = append(fetched, method)
+				}
+			}
+
+			// Update local auth methods
+			if len(fetched) > 0 {
+				args := &structs.ACLAuthMethodUpsertRequest{
+					AuthMethods: fetched,
+				}
+				_, _, err := s.raftApply(structs.ACLAuthMethodUpsertRequestType, args)
+				if err != nil {
+					s.logger.Error("failed to update auth methods", "error", err)
+					goto ERR_WAIT
+				}
+			}
+
+			// Update the minimum query index, blocks until there
+			// is a change.
+			req.MinQueryIndex = resp.Index
+		}
+	}
+
+ERR_WAIT:
+	select {
+	case <-time.After(s.config.ReplicationBackoff):
+		goto START
+	case <-stopCh:
+		return
+	}
+}
+
+// diffACLAuthMethods is used to perform a two-way diff between the local
+// auth methods and the remote auth methods to determine which auth methods need to
+// be deleted or updated.
+func diffACLAuthMethods(state *state.StateStore, minIndex uint64, remoteList []*structs.ACLAuthMethodListStub) (delete []string, update []string) {
+	// Construct a set of the local and remote auth methods
+	local := make(map[string][]byte)
+	remote := make(map[string]struct{})
+
+	// Add all the local auth methods
+	iter, err := state.ACLAuthMethods(nil)
+	if err != nil {
+		panic("failed to iterate local auth methods")
+	}
+	for {
+		raw := iter.Next()
+		if raw == nil {
+			break
+		}
+		method := raw.(*structs.ACLAuthMethod)
+		local[method.Name] = method.Hash
+	}
+
+	// Iterate over the remote auth methods
+	for _, rr := range remoteList {
+		remote[rr.Name] = struct{}{}
+
+		// Check if the auth method is missing locally
+		if localHash, ok := local[rr.Name]; !ok {
+			update = append(update, rr.Name)
+
+			// Check if auth method is newer remotely and there is a hash mis-match.
+		} else if rr.ModifyIndex > minIndex && !bytes.Equal(localHash, rr.Hash) {
+			update = append(update, rr.Name)
+		}
+	}
+
+	// Check if auth method should be deleted
+	for lr := range local {
+		if _, ok := remote[lr]; !ok {
+			delete = append(delete, lr)
+		}
+	}
+	return
+}
+
+// replicateACLBindingRules is used to replicate ACL binding rules from
+// the authoritative region to this region.
+func (s *Server) replicateACLBindingRules(stopCh chan struct{}) {
+	req := structs.ACLBindingRuleListRequest{
+		QueryOptions: structs.QueryOptions{
+			Region:     s.config.AuthoritativeRegion,
+			AllowStale: true,
+		},
+	}
+	limiter := rate.NewLimiter(replicationRateLimit, int(replicationRateLimit))
+	s.logger.Debug("starting ACL binding rule replication from authoritative region", "authoritative_region", req.Region)
+
+START:
+	for {
+		select {
+		case <-stopCh:
+			return
+		default:
+			// Rate limit how often we attempt replication
+			limiter.Wait(context.Background())
+
+			// Fetch the list of binding rules
+			var resp structs.ACLBindingRuleListResponse
+			req.AuthToken = s.ReplicationToken()
+			err := s.forwardRegion(s.config.AuthoritativeRegion,
+				"ACL.ListBindingRules", &req, &resp)
+			if err != nil {
+				s.logger.Error("failed to fetch binding rules from authoritative region", "error", err)
+				goto ERR_WAIT
+			}
+
+			// Perform a two-way diff
+			delete, update := diffACLBindingRules(s.State(), req.MinQueryIndex, resp.BindingRules)
+
+			// Delete binding rules that should not exist
+			if len(delete) > 0 {
+				args := &structs.ACLBindingRuleDeleteRequest{
+					IDs: delete,
+				}
+				_, _, err := s.raftApply(structs.ACLBindingRuleDeleteRequestType, args)
+				if err != nil {
+					s.logger.Error("failed to delete binding rules", "error", err)
+					goto ERR_WAIT
+				}
+			}
+
+			// Fetch any outdated binding rules
+			var fetched []*structs.ACLBindingRule
+			if len(update) > 0 {
+				req := structs.ACLBindingRuleSetRequest{
+					IDs: update,
+					QueryOptions: structs.QueryOptions{
+						Region:        s.config.AuthoritativeRegion,
+						AuthToken:     s.ReplicationToken(),
+						AllowStale:    true,
+						MinQueryIndex: resp.Index - 1,
+					},
+				}
+				var reply structs.ACLBindingRuleSetResponse
+				if err := s.forwardRegion(s.config.AuthoritativeRegion,
+					"ACL.GetBindingRules", &req, &reply); err != nil {
+					s.logger.Error("failed to fetch binding rules from authoritative region", "error", err)
+					goto ERR_WAIT
+				}
+				for _, rule := range reply.BindingRules {
+					fetched = append(fetched, rule)
+				}
+			}
+
+			// Update local binding rules
+			if len(fetched) > 0 {
+				args := &structs.ACLBindingRuleUpsertRequest{
+					BindingRules: fetched,
+				}
+				_, _, err := s.raftApply(structs.ACLBindingRuleUpsertRequestType, args)
+				if err != nil {
+					s.logger.Error("failed to update binding rules", "error", err)
+					goto ERR_WAIT
+				}
+			}
+
+			// Update the minimum query index, blocks until there
+			// is a change.
+			req.MinQueryIndex = resp.Index
+		}
+	}
+
+ERR_WAIT:
+	select {
+	case <-time.After(s.config.ReplicationBackoff):
+		goto START
+	case <-stopCh:
+		return
+	}
+}
+
+// diffACLBindingRules is used to perform a two-way diff between the local
+// binding rules and the remote binding rules to determine which binding rules need to
+// be deleted or updated.
+func diffACLBindingRules(state *state.StateStore, minIndex uint64, remoteList []*structs.ACLBindingRuleListStub) (delete []string, update []string) {
+	// Construct a set of the local and remote binding rules
+	local := make(map[string][]byte)
+	remote := make(map[string]struct{})
+
+	// Add all the local binding rules
+	iter, err := state.ACLBindingRules(nil)
+	if err != nil {
+		panic("failed to iterate local binding rules")
+	}
+	for {
+		raw := iter.Next()
+		if raw == nil {
+			break
+		}
+		rule := raw.(*structs.ACLBindingRule)
+		local[rule.ID] = rule.Hash
+	}
+
+	// Iterate over the remote binding rules
+	for _, rr := range remoteList {
+		remote[rr.ID] = struct{}{}
+
+		// Check if the binding rule is missing locally
+		if localHash, ok := local[rr.ID]; !ok {
+			update = append(update, rr.ID)
+
+			// Check if binding rule is newer remotely and there is a hash mis-match.
+		} else if rr.ModifyIndex > minIndex && !bytes.Equal(localHash, rr.Hash) {
+			update = append(update, rr.ID)
+		}
+	}
+
+	// Check if binding rule should be deleted
+	for lr := range local {
+		if _, ok := remote[lr]; !ok {
+			delete = append(delete, lr)
+		}
+	}
+	return
+}
+
 // replicateACLTokens is used to replicate global ACL tokens from
 // the authoritative region to this region.
 func (s *Server) replicateACLTokens(stopCh chan struct{}) {
@@ -1327,3 +1780,34 @@ func (s *Server) getOrCreateSchedulerConfig() *structs.SchedulerConfiguration {
 
 	return config
 }
+
+// getOrCreateRootKey is used to get the active root key signing workload
+// identities and encrypting variables, creating it if it doesn't exist. If
+// the server has a key encryption key, an active key that can't encrypt
+// variables is replaced.
+func (s *Server) getOrCreateRootKey() *structs.RootKey {
+	state := s.fsm.State()
+	key, err := state.ActiveRootKey(nil)
+	if err != nil {
+		s.logger.Named("core").Error("failed to get root key", "error", err)
+		return nil
+	}
+	if key != nil && (len(key.WrappedEncryptionKey) != 0 || s.config.KeyEncryptionKey == nil) {
+		return key
+	}
+
+	key, err = newRootKey(time.Now().UTC(), s.config.KeyEncryptionKey)
+	if err != nil {
+		s.logger.Named("core").Error("failed to generate root key", "error", err)
+		return nil
+	}
+	key.Active = true
+
+	req := structs.RootKeyUpsertRequest{Keys: []*structs.RootKey{key}}
+	if _, _, err = s.raftApply(structs.RootKeyUpsertRequestType, req); err != nil {
+		s.logger.Named("core").Error("failed to initialize root key", "error", err)
+		return nil
+	}
+
+	return key
+}
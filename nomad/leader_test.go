@@ -473,6 +473,126 @@ func TestLeader_PeriodicDispatcher_Restore_Evals(t *testing.T) {
 	}
 }
 
+func TestLeader_PeriodicDispatcher_Restore_CatchUp(t *testing.T) {
+	s1 := TestServer(t, func(c *Config) {
+		c.NumSchedulers = 0
+	})
+	defer s1.Shutdown()
+	testutil.WaitForLeader(t, s1.RPC)
+	require := require.New(t)
+
+	// Inject periodic jobs that launched once in the past and missed two
+	// launches since.
+	now := time.Now().Truncate(time.Second)
+	past := now.Add(-3 * time.Second)
+	missed := []time.Time{now.Add(-2 * time.Second), now.Add(-1 * time.Second)}
+	future := now.Add(10 * time.Second)
+
+	register := func(catchUp string) *structs.Job {
+		job := testPeriodicJob(past, missed[0], missed[1], future)
+		job.Periodic.CatchUp = catchUp
+		req := structs.JobRegisterRequest{
+			Job: job,
+			WriteRequest: structs.WriteRequest{
+				Namespace: job.Namespace,
+			},
+		}
+		_, _, err := s1.raftApply(structs.JobRegisterRequestType, req)
+		require.NoError(err)
+
+		_, err = s1.periodicDispatcher.createEval(job, past)
+		require.NoError(err)
+		return job
+	}
+	none := register(structs.PeriodicCatchUpNone)
+	all := register(structs.PeriodicCatchUpAll)
+
+	// Restore the periodic dispatcher.
+	s1.periodicDispatcher.SetEnabled(false)
+	s1.periodicDispatcher.SetEnabled(true)
+	require.NoError(s1.restorePeriodicDispatcher())
+
+	children := func(job *structs.Job) int {
+		iter, err := s1.fsm.State().JobsByIDPrefix(nil, job.Namespace, job.ID+structs.PeriodicLaunchSuffix)
+		require.NoError(err)
+		n := 0
+		for raw := iter.Next(); raw != nil; raw = iter.Next() {
+			n++
+		}
+		return n
+	}
+
+	// The missed launches are skipped
+	require.Equal(1, children(none))
+	launch, err := s1.fsm.State().PeriodicLaunchByID(nil, none.Namespace, none.ID)
+	require.NoError(err)
+	require.Equal(past.Unix(), launch.Launch.Unix())
+
+	// Every missed launch is caught up
+	require.Equal(3, children(all))
+	launch, err = s1.fsm.State().PeriodicLaunchByID(nil, all.Namespace, all.ID)
+	require.NoError(err)
+	require.Equal(missed[1].Unix(), launch.Launch.Unix())
+}
+
+func TestLeader_PeriodicDispatcher_Restore_CatchUp_Resumed(t *testing.T) {
+	s1 := TestServer(t, func(c *Config) {
+		c.NumSchedulers = 0
+	})
+	defer s1.Shutdown()
+	testutil.WaitForLeader(t, s1.RPC)
+	require := require.New(t)
+
+	// Inject a periodic job that launched once in the past and was paused
+	// over the first of two launches missed since.
+	now := time.Now().Truncate(time.Second)
+	past := now.Add(-3 * time.Second)
+	missed := []time.Time{now.Add(-2 * time.Second), now.Add(-1 * time.Second)}
+	future := now.Add(10 * time.Second)
+
+	job := testPeriodicJob(past, missed[0], missed[1], future)
+	job.Periodic.CatchUp = structs.PeriodicCatchUpAll
+	register := func(job *structs.Job) {
+		req := structs.JobRegisterRequest{
+			Job: job,
+			WriteRequest: structs.WriteRequest{
+				Namespace: job.Namespace,
+			},
+		}
+		_, _, err := s1.raftApply(structs.JobRegisterRequestType, req)
+		require.NoError(err)
+	}
+	register(job)
+	_, err := s1.periodicDispatcher.createEval(job, past)
+	require.NoError(err)
+
+	paused := job.Copy()
+	paused.Pause()
+	register(paused)
+
+	resumed := paused.Copy()
+	resumed.Resume()
+	resumed.SubmitTime = missed[0].Add(500 * time.Millisecond).UnixNano()
+	register(resumed)
+
+	// Restore the periodic dispatcher.
+	s1.periodicDispatcher.SetEnabled(false)
+	s1.periodicDispatcher.SetEnabled(true)
+	require.NoError(s1.restorePeriodicDispatcher())
+
+	// Only the launch missed after the job was resumed is caught up
+	iter, err := s1.fsm.State().JobsByIDPrefix(nil, job.Namespace, job.ID+structs.PeriodicLaunchSuffix)
+	require.NoError(err)
+	var launched []string
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		launched = append(launched, raw.(*structs.Job).ID)
+	}
+	require.ElementsMatch([]string{
+		fmt.Sprintf("%s%s%d", job.ID, structs.PeriodicLaunchSuffix, past.Unix()),
+		fmt.Sprintf("%s%s%d", job.ID, structs.PeriodicLaunchSuffix, missed[1].Unix()),
+	}, launched)
+}
+
 func TestLeader_PeriodicDispatch(t *testing.T) {
 	s1 := TestServer(t, func(c *Config) {
 		c.NumSchedulers = 0
@@ -697,6 +817,178 @@ func TestLeader_DiffACLPolicies(t *testing.T) {
 	assert.Equal(t, []string{p3.Name, p4.Name}, update)
 }
 
+func TestLeader_ReplicateACLRoles(t *testing.T) {
+	t.Parallel()
+	s1, root := TestACLServer(t, func(c *Config) {
+		c.Region = "region1"
+		c.AuthoritativeRegion = "region1"
+		c.ACLEnabled = true
+	})
+	defer s1.Shutdown()
+	s2, _ := TestACLServer(t, func(c *Config) {
+		c.Region = "region2"
+		c.AuthoritativeRegion = "region1"
+		c.ACLEnabled = true
+		c.ReplicationBackoff = 20 * time.Millisecond
+		c.ReplicationToken = root.SecretID
+	})
+	defer s2.Shutdown()
+	TestJoin(t, s1, s2)
+	testutil.WaitForLeader(t, s1.RPC)
+	testutil.WaitForLeader(t, s2.RPC)
+
+	// Write a role to the authoritative region
+	r1 := mock.ACLRole()
+	if err := s1.State().UpsertACLRoles(100, []*structs.ACLRole{r1}); err != nil {
+		t.Fatalf("bad: %v", err)
+	}
+
+	// Wait for the role to replicate
+	testutil.WaitForResult(func() (bool, error) {
+		state := s2.State()
+		out, err := state.ACLRoleByName(nil, r1.Name)
+		return out != nil, err
+	}, func(err error) {
+		t.Fatalf("should replicate role")
+	})
+}
+
+func TestLeader_DiffACLRoles(t *testing.T) {
+	t.Parallel()
+
+	state := state.TestStateStore(t)
+
+	// Populate the local state
+	r1 := mock.ACLRole()
+	r2 := mock.ACLRole()
+	r3 := mock.ACLRole()
+	assert.Nil(t, state.UpsertACLRoles(100, []*structs.ACLRole{r1, r2, r3}))
+
+	// Simulate a remote list
+	r2Stub := r2.Stub()
+	r2Stub.ModifyIndex = 50 // Ignored, same index
+	r3Stub := r3.Stub()
+	r3Stub.ModifyIndex = 100 // Updated, higher index
+	r3Stub.Hash = []byte{0, 1, 2, 3}
+	r4 := mock.ACLRole()
+	remoteList := []*structs.ACLRoleListStub{
+		r2Stub,
+		r3Stub,
+		r4.Stub(),
+	}
+	delete, update := diffACLRoles(state, 50, remoteList)
+
+	// R1 does not exist on the remote side, should delete
+	assert.Equal(t, []string{r1.Name}, delete)
+
+	// R2 is un-modified - ignore. R3 modified, R4 new.
+	assert.Equal(t, []string{r3.Name, r4.Name}, update)
+}
+
+func TestLeader_ReplicateACLAuthMethodsAndBindingRules(t *testing.T) {
+	t.Parallel()
+	s1, root := TestACLServer(t, func(c *Config) {
+		c.Region = "region1"
+		c.AuthoritativeRegion = "region1"
+		c.ACLEnabled = true
+	})
+	defer s1.Shutdown()
+	s2, _ := TestACLServer(t, func(c *Config) {
+		c.Region = "region2"
+		c.AuthoritativeRegion = "region1"
+		c.ACLEnabled = true
+		c.ReplicationBackoff = 20 * time.Millisecond
+		c.ReplicationToken = root.SecretID
+	})
+	defer s2.Shutdown()
+	TestJoin(t, s1, s2)
+	testutil.WaitForLeader(t, s1.RPC)
+	testutil.WaitForLeader(t, s2.RPC)
+
+	// Write an auth method and binding rule to the authoritative region
+	m1 := mock.ACLAuthMethod()
+	r1 := mock.ACLBindingRule(m1.Name)
+	require.NoError(t, s1.State().UpsertACLAuthMethods(100, []*structs.ACLAuthMethod{m1}))
+	require.NoError(t, s1.State().UpsertACLBindingRules(101, []*structs.ACLBindingRule{r1}))
+
+	// Wait for them to replicate
+	testutil.WaitForResult(func() (bool, error) {
+		state := s2.State()
+		method, err := state.ACLAuthMethodByName(nil, m1.Name)
+		if err != nil || method == nil {
+			return false, err
+		}
+		rule, err := state.ACLBindingRuleByID(nil, r1.ID)
+		return rule != nil, err
+	}, func(err error) {
+		t.Fatalf("should replicate auth method and binding rule: %v", err)
+	})
+}
+
+func TestLeader_DiffACLAuthMethods(t *testing.T) {
+	t.Parallel()
+
+	state := state.TestStateStore(t)
+
+	// Populate the local state
+	m1 := mock.ACLAuthMethod()
+	m2 := mock.ACLAuthMethod()
+	m3 := mock.ACLAuthMethod()
+	assert.Nil(t, state.UpsertACLAuthMethods(100, []*structs.ACLAuthMethod{m1, m2, m3}))
+
+	// Simulate a remote list
+	m2Stub := m2.Stub()
+	m2Stub.ModifyIndex = 50 // Ignored, same index
+	m3Stub := m3.Stub()
+	m3Stub.ModifyIndex = 100 // Updated, higher index
+	m3Stub.Hash = []byte{0, 1, 2, 3}
+	m4 := mock.ACLAuthMethod()
+	remoteList := []*structs.ACLAuthMethodListStub{
+		m2Stub,
+		m3Stub,
+		m4.Stub(),
+	}
+	delete, update := diffACLAuthMethods(state, 50, remoteList)
+
+	// M1 does not exist on the remote side, should delete
+	assert.Equal(t, []string{m1.Name}, delete)
+
+	// M2 is un-modified - ignore. M3 modified, M4 new.
+	assert.Equal(t, []string{m3.Name, m4.Name}, update)
+}
+
+func TestLeader_DiffACLBindingRules(t *testing.T) {
+	t.Parallel()
+
+	state := state.TestStateStore(t)
+
+	// Populate the local state
+	r1 := mock.ACLBindingRule("okta")
+	r2 := mock.ACLBindingRule("okta")
+	r3 := mock.ACLBindingRule("okta")
+	assert.Nil(t, state.UpsertACLBindingRules(100, []*structs.ACLBindingRule{r1, r2, r3}))
+
+	// Simulate a remote list
+	r2Stub := r2.Stub()
+	r2Stub.ModifyIndex = 50 // Ignored, same index
+	r3Stub := r3.Stub()
+	r3Stub.ModifyIndex = 100 // Updated, higher index
+	r3Stub.Hash = []byte{0, 1, 2, 3}
+	r4 := mock.ACLBindingRule("okta")
+	remoteList := []*structs.ACLBindingRuleListStub{
+		r2Stub,
+		r3Stub,
+		r4.Stub(),
+	}
+	delete, update := diffACLBindingRules(state, 50, remoteList)
+
+	// R1 does not exist on the remote side, should delete
+	assert.Equal(t, []string{r1.ID}, delete)
+
+	// R2 is un-modified - ignore. R3 modified, R4 new.
+	assert.Equal(t, []string{r3.ID, r4.ID}, update)
+}
+
 func TestLeader_ReplicateACLTokens(t *testing.T) {
 	t.Parallel()
 	s1, root := TestACLServer(t, func(c *Config) {
@@ -525,6 +525,20 @@ func SystemJob() *structs.Job {
 	return job
 }
 
+func SysBatchJob() *structs.Job {
+	job := SystemJob()
+	job.ID = fmt.Sprintf("mock-sysbatch-%s", uuid.Generate())
+	job.Type = structs.JobTypeSysBatch
+	job.TaskGroups[0].RestartPolicy = &structs.RestartPolicy{
+		Attempts: 3,
+		Interval: 24 * time.Hour,
+		Delay:    15 * time.Second,
+		Mode:     structs.RestartPolicyModeFail,
+	}
+	job.Canonicalize()
+	return job
+}
+
 func PeriodicJob() *structs.Job {
 	job := Job()
 	job.Type = structs.JobTypeBatch
@@ -871,6 +885,54 @@ func ACLPolicy() *structs.ACLPolicy {
 	return ap
 }
 
+func ACLRole() *structs.ACLRole {
+	role := &structs.ACLRole{
+		Name:        fmt.Sprintf("role-%s", uuid.Generate()),
+		Description: "Super cool role!",
+		Policies:    []string{"foo", "bar"},
+		CreateIndex: 10,
+		ModifyIndex: 20,
+	}
+	role.SetHash()
+	return role
+}
+
+func ACLAuthMethod() *structs.ACLAuthMethod {
+	method := &structs.ACLAuthMethod{
+		Name:          fmt.Sprintf("method-%s", uuid.Generate()),
+		Type:          structs.ACLAuthMethodTypeOIDC,
+		TokenLocality: structs.ACLAuthMethodTokenLocalityLocal,
+		MaxTokenTTL:   time.Hour,
+		Config: &structs.ACLAuthMethodConfig{
+			OIDCDiscoveryURL:    "https://oidc.example.com",
+			OIDCClientID:        "nomad",
+			OIDCClientSecret:    "very-secret",
+			AllowedRedirectURIs: []string{"http://localhost:4649/oidc/callback"},
+			ClaimMappings:       map[string]string{"team": "team"},
+			ListClaimMappings:   map[string]string{"groups": "groups"},
+		},
+		CreateIndex: 10,
+		ModifyIndex: 20,
+	}
+	method.SetHash()
+	return method
+}
+
+func ACLBindingRule(method string) *structs.ACLBindingRule {
+	rule := &structs.ACLBindingRule{
+		ID:          uuid.Generate(),
+		Description: "Super cool binding rule!",
+		AuthMethod:  method,
+		Selector:    `"engineering" in list.groups`,
+		BindType:    structs.ACLBindingRuleBindTypeRole,
+		BindName:    "team-${value.team}",
+		CreateIndex: 10,
+		ModifyIndex: 20,
+	}
+	rule.SetHash()
+	return rule
+}
+
 func ACLToken() *structs.ACLToken {
 	tk := &structs.ACLToken{
 		AccessorID:  uuid.Generate(),
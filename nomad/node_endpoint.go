@@ -1065,8 +1065,10 @@ func (n *Node) UpdateAlloc(args *structs.AllocUpdateRequest, reply *structs.Gene
 	for _, alloc := range args.Alloc {
 		alloc.ModifyTime = now.UTC().UnixNano()
 
-		// Add an evaluation if this is a failed alloc that is eligible for rescheduling
-		if alloc.ClientStatus == structs.AllocClientStatusFailed {
+		// Add an evaluation if this is a failed alloc that is eligible for
+		// rescheduling, or a finished alloc of an array whose placements are
+		// throttled.
+		if alloc.ClientTerminalStatus() {
 			// Only create evaluations if this is an existing alloc,
 			// and eligible as per its task group's ReschedulePolicy
 			if existingAlloc, _ := n.srv.State().AllocByID(nil, alloc.ID); existingAlloc != nil {
@@ -1080,20 +1082,33 @@ func (n *Node) UpdateAlloc(args *structs.AllocUpdateRequest, reply *structs.Gene
 					continue
 				}
 				taskGroup := job.LookupTaskGroup(existingAlloc.TaskGroup)
-				if taskGroup != nil && existingAlloc.FollowupEvalID == "" && existingAlloc.RescheduleEligible(taskGroup.ReschedulePolicy, now) {
-					eval := &structs.Evaluation{
-						ID:          uuid.Generate(),
-						Namespace:   existingAlloc.Namespace,
-						TriggeredBy: structs.EvalTriggerRetryFailedAlloc,
-						JobID:       existingAlloc.JobID,
-						Type:        job.Type,
-						Priority:    job.Priority,
-						Status:      structs.EvalStatusPending,
-						CreateTime:  now.UTC().UnixNano(),
-						ModifyTime:  now.UTC().UnixNano(),
-					}
-					evals = append(evals, eval)
+				if taskGroup == nil {
+					continue
+				}
+
+				var triggeredBy string
+				switch {
+				case alloc.ClientStatus == structs.AllocClientStatusFailed &&
+					existingAlloc.FollowupEvalID == "" && existingAlloc.RescheduleEligible(taskGroup.ReschedulePolicy, now):
+					triggeredBy = structs.EvalTriggerRetryFailedAlloc
+				case taskGroup.Array != nil && taskGroup.Array.MaxParallel > 0 && !existingAlloc.ClientTerminalStatus():
+					triggeredBy = structs.EvalTriggerArrayAllocDone
+				default:
+					continue
 				}
+
+				eval := &structs.Evaluation{
+					ID:          uuid.Generate(),
+					Namespace:   existingAlloc.Namespace,
+					TriggeredBy: triggeredBy,
+					JobID:       existingAlloc.JobID,
+					Type:        job.Type,
+					Priority:    job.Priority,
+					Status:      structs.EvalStatusPending,
+					CreateTime:  now.UTC().UnixNano(),
+					ModifyTime:  now.UTC().UnixNano(),
+				}
+				evals = append(evals, eval)
 			}
 		}
 	}
@@ -1279,14 +1294,21 @@ func (n *Node) createNodeEvals(nodeID string, nodeIndex uint64) ([]string, uint6
 		return nil, 0, fmt.Errorf("failed to find allocs for '%s': %v", nodeID, err)
 	}
 
-	sysJobsIter, err := snap.JobsByScheduler(ws, "system")
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to find system jobs for '%s': %v", nodeID, err)
-	}
-
+	// Find the system and sysbatch jobs, which run on every node. The
+	// periodic and parameterized sysbatch jobs only run as their children.
 	var sysJobs []*structs.Job
-	for job := sysJobsIter.Next(); job != nil; job = sysJobsIter.Next() {
-		sysJobs = append(sysJobs, job.(*structs.Job))
+	for _, jobType := range []string{structs.JobTypeSystem, structs.JobTypeSysBatch} {
+		sysJobsIter, err := snap.JobsByScheduler(ws, jobType)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to find %s jobs for '%s': %v", jobType, nodeID, err)
+		}
+		for raw := sysJobsIter.Next(); raw != nil; raw = sysJobsIter.Next() {
+			job := raw.(*structs.Job)
+			if job.IsPeriodic() || job.IsParameterized() {
+				continue
+			}
+			sysJobs = append(sysJobs, job)
+		}
 	}
 
 	// Fast-path if nothing to do
@@ -1325,7 +1347,7 @@ func (n *Node) createNodeEvals(nodeID string, nodeIndex uint64) ([]string, uint6
 		evalIDs = append(evalIDs, eval.ID)
 	}
 
-	// Create an evaluation for each system job.
+	// Create an evaluation for each system and sysbatch job.
 	for _, job := range sysJobs {
 		// Still dedup on JobID as the node may already have the system job.
 		if _, ok := jobIDs[job.ID]; ok {
@@ -1619,3 +1641,256 @@ func (n *Node) EmitEvents(args *structs.EmitNodeEventsRequest, reply *structs.Em
 	reply.Index = index
 	return nil
 }
+
+// TemplateAllocations is used by clients to list the running allocations of a
+// job for the Nomad template functions of an allocation's tasks.
+func (n *Node) TemplateAllocations(args *structs.TemplateDataRequest,
+	reply *structs.TemplateAllocationsResponse) error {
+	if done, err := n.srv.forward("Node.TemplateAllocations", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "client", "template_allocations"}, time.Now())
+
+	if args.JobID == "" {
+		return fmt.Errorf("missing job ID")
+	}
+
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, state *state.StateStore) error {
+			alloc, err := templateRequestAlloc(ws, state, args)
+			if err != nil {
+				return err
+			}
+
+			allocs, err := state.AllocsByJob(ws, alloc.Namespace, args.JobID, false)
+			if err != nil {
+				return err
+			}
+
+			nodes := make(map[string]*structs.Node)
+			reply.Allocations = make([]*structs.TemplateAllocation, 0, len(allocs))
+			for _, a := range allocs {
+				if a.ClientStatus != structs.AllocClientStatusRunning || a.TerminalStatus() {
+					continue
+				}
+				if args.TaskGroup != "" && a.TaskGroup != args.TaskGroup {
+					continue
+				}
+
+				node, ok := nodes[a.NodeID]
+				if !ok {
+					node, err = state.NodeByID(ws, a.NodeID)
+					if err != nil {
+						return err
+					}
+					nodes[a.NodeID] = node
+				}
+				reply.Allocations = append(reply.Allocations, structs.NewTemplateAllocation(a, node))
+			}
+			structs.SortTemplateAllocations(reply.Allocations)
+
+			index, err := state.Index("allocs")
+			if err != nil {
+				return err
+			}
+			reply.Index = index
+
+			n.srv.setQueryMeta(&reply.QueryMeta)
+			return nil
+		}}
+	return n.srv.blockingRPC(&opts)
+}
+
+// TemplateMeta is used by clients to read the meta of a job or node for the
+// Nomad template functions of an allocation's tasks. Only the meta of the node
+// running the requesting allocation may be read.
+func (n *Node) TemplateMeta(args *structs.TemplateDataRequest,
+	reply *structs.TemplateMetaResponse) error {
+	if done, err := n.srv.forward("Node.TemplateMeta", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "client", "template_meta"}, time.Now())
+
+	if (args.JobID == "") == !args.NodeMeta {
+		return fmt.Errorf("exactly one of job ID or node meta must be requested")
+	}
+
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, state *state.StateStore) error {
+			alloc, err := templateRequestAlloc(ws, state, args)
+			if err != nil {
+				return err
+			}
+
+			reply.Meta = make(map[string]string)
+			table := "jobs"
+			if args.JobID != "" {
+				job, err := state.JobByID(ws, alloc.Namespace, args.JobID)
+				if err != nil {
+					return err
+				}
+				if job != nil {
+					for k, v := range job.Meta {
+						reply.Meta[k] = v
+					}
+					if tg := job.LookupTaskGroup(args.TaskGroup); tg != nil {
+						for k, v := range tg.Meta {
+							reply.Meta[k] = v
+						}
+					}
+				}
+			} else {
+				table = "nodes"
+				node, err := state.NodeByID(ws, alloc.NodeID)
+				if err != nil {
+					return err
+				}
+				if node != nil {
+					for k, v := range node.Meta {
+						reply.Meta[k] = v
+					}
+				}
+			}
+
+			index, err := state.Index(table)
+			if err != nil {
+				return err
+			}
+			reply.Index = index
+
+			n.srv.setQueryMeta(&reply.QueryMeta)
+			return nil
+		}}
+	return n.srv.blockingRPC(&opts)
+}
+
+// templateRequestAlloc verifies the node's secret and returns the non-terminal
+// allocation on the node which made a template data request.
+func templateRequestAlloc(ws memdb.WatchSet, state *state.StateStore, args *structs.TemplateDataRequest) (*structs.Allocation, error) {
+	if args.NodeID == "" {
+		return nil, fmt.Errorf("missing node ID")
+	}
+	if args.AllocID == "" {
+		return nil, fmt.Errorf("missing allocation ID")
+	}
+
+	node, err := state.NodeByID(ws, args.NodeID)
+	if err != nil {
+		return nil, err
+	}
+	if node == nil {
+		return nil, fmt.Errorf("Node %q does not exist", args.NodeID)
+	}
+	if node.SecretID != args.SecretID {
+		return nil, fmt.Errorf("node secret ID does not match")
+	}
+
+	alloc, err := state.AllocByID(ws, args.AllocID)
+	if err != nil {
+		return nil, err
+	}
+	if alloc == nil {
+		return nil, fmt.Errorf("Allocation %q does not exist", args.AllocID)
+	}
+	if alloc.NodeID != args.NodeID {
+		return nil, fmt.Errorf("Allocation %q not running on Node %q", args.AllocID, args.NodeID)
+	}
+	if alloc.TerminalStatus() {
+		return nil, fmt.Errorf("Can't read template data for terminal allocation")
+	}
+
+	return alloc, nil
+}
+
+// SignIdentities is used by clients to request the workload identities of
+// tasks of an allocation running on the node.
+func (n *Node) SignIdentities(args *structs.SignIdentitiesRequest,
+	reply *structs.SignIdentitiesResponse) error {
+	if done, err := n.srv.forward("Node.SignIdentities", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "client", "sign_identities"}, time.Now())
+
+	// Verify the arguments
+	if args.NodeID == "" {
+		return fmt.Errorf("missing node ID")
+	}
+	if args.AllocID == "" {
+		return fmt.Errorf("missing allocation ID")
+	}
+	if len(args.Tasks) == 0 {
+		return fmt.Errorf("no tasks specified")
+	}
+
+	snap, err := n.srv.fsm.State().Snapshot()
+	if err != nil {
+		return err
+	}
+
+	node, err := snap.NodeByID(nil, args.NodeID)
+	if err != nil {
+		return err
+	}
+	if node == nil {
+		return fmt.Errorf("Node %q does not exist", args.NodeID)
+	}
+	if node.SecretID != args.SecretID {
+		return fmt.Errorf("node secret ID does not match")
+	}
+
+	alloc, err := snap.AllocByID(nil, args.AllocID)
+	if err != nil {
+		return err
+	}
+	if alloc == nil {
+		return fmt.Errorf("Allocation %q does not exist", args.AllocID)
+	}
+	if alloc.NodeID != args.NodeID {
+		return fmt.Errorf("Allocation %q not running on Node %q", args.AllocID, args.NodeID)
+	}
+	if alloc.TerminalStatus() {
+		return fmt.Errorf("Can't sign identities for terminal allocation")
+	}
+
+	tg := alloc.Job.LookupTaskGroup(alloc.TaskGroup)
+	if tg == nil {
+		return fmt.Errorf("Allocation %q does not have a task group", args.AllocID)
+	}
+	for _, task := range args.Tasks {
+		if tg.LookupTask(task) == nil {
+			return fmt.Errorf("Task %q not found in task group %q", task, alloc.TaskGroup)
+		}
+	}
+
+	key, err := snap.ActiveRootKey(nil)
+	if err != nil {
+		return err
+	}
+	if key == nil {
+		return fmt.Errorf("no root key to sign identities")
+	}
+
+	now := time.Now().UTC()
+	reply.Identities = make(map[string]string, len(args.Tasks))
+	for _, task := range args.Tasks {
+		claims := structs.NewIdentityClaims(alloc, task, now, n.srv.config.WorkloadIdentityTTL)
+		token, err := signIdentity(key, claims)
+		if err != nil {
+			return err
+		}
+		reply.Identities[task] = token
+	}
+	reply.Expiration = now.Add(n.srv.config.WorkloadIdentityTTL)
+
+	index, err := snap.Index("root_keys")
+	if err != nil {
+		return err
+	}
+	reply.Index = index
+	n.srv.setQueryMeta(&reply.QueryMeta)
+	return nil
+}
@@ -2136,6 +2136,69 @@ func TestClientEndpoint_UpdateAlloc(t *testing.T) {
 
 }
 
+func TestClientEndpoint_UpdateAlloc_Array(t *testing.T) {
+	t.Parallel()
+	s1 := TestServer(t, func(c *Config) {
+		c.NumSchedulers = 0
+	})
+
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+	require := require.New(t)
+
+	// Create the register request
+	node := mock.Node()
+	reg := &structs.NodeRegisterRequest{
+		Node:         node,
+		WriteRequest: structs.WriteRequest{Region: "global"},
+	}
+	var resp structs.GenericResponse
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Node.Register", reg, &resp))
+
+	state := s1.fsm.State()
+
+	// Inject a batch job with a throttled array
+	job := mock.Job()
+	job.Type = structs.JobTypeBatch
+	job.TaskGroups[0].Update = nil
+	job.TaskGroups[0].Array = &structs.ArrayConfig{Size: 10, MaxParallel: 2}
+	job.TaskGroups[0].Count = 10
+	require.Nil(state.UpsertJob(101, job))
+
+	alloc := mock.Alloc()
+	alloc.Job = job
+	alloc.JobID = job.ID
+	alloc.NodeID = node.ID
+	alloc.TaskGroup = job.TaskGroups[0].Name
+	require.Nil(state.UpsertJobSummary(99, mock.JobSummary(alloc.JobID)))
+	require.Nil(state.UpsertAllocs(100, []*structs.Allocation{alloc}))
+
+	// Complete the alloc
+	clientAlloc := new(structs.Allocation)
+	*clientAlloc = *alloc
+	clientAlloc.ClientStatus = structs.AllocClientStatusComplete
+
+	update := &structs.AllocUpdateRequest{
+		Alloc:        []*structs.Allocation{clientAlloc},
+		WriteRequest: structs.WriteRequest{Region: "global"},
+	}
+	var resp2 structs.NodeAllocsResponse
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Node.UpdateAlloc", update, &resp2))
+
+	// Assert that an eval was created to place the next allocations
+	evaluations, err := state.EvalsByJob(nil, job.Namespace, job.ID)
+	require.Nil(err)
+	require.Len(evaluations, 1)
+	require.Equal(structs.EvalTriggerArrayAllocDone, evaluations[0].TriggeredBy)
+
+	// Resending the update doesn't create another eval
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Node.UpdateAlloc", update, &resp2))
+	evaluations, err = state.EvalsByJob(nil, job.Namespace, job.ID)
+	require.Nil(err)
+	require.Len(evaluations, 1)
+}
+
 func TestClientEndpoint_BatchUpdate(t *testing.T) {
 	t.Parallel()
 	s1 := TestServer(t, nil)
@@ -3031,3 +3094,230 @@ func TestClientEndpoint_EmitEvents(t *testing.T) {
 	require.Nil(err)
 	require.False(len(out.Events) < 2)
 }
+
+func TestClientEndpoint_TemplateAllocations(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	s1 := TestServer(t, nil)
+	defer s1.Shutdown()
+	state := s1.fsm.State()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	node := mock.Node()
+	require.Nil(state.UpsertNode(2, node))
+
+	// The requesting alloc and a running peer of the same job
+	alloc := mock.Alloc()
+	alloc.NodeID = node.ID
+	alloc.ClientStatus = structs.AllocClientStatusRunning
+	alloc.Name = structs.AllocName(alloc.JobID, alloc.TaskGroup, 0)
+	peer := alloc.Copy()
+	peer.ID = uuid.Generate()
+	peer.Name = structs.AllocName(alloc.JobID, alloc.TaskGroup, 1)
+	pending := alloc.Copy()
+	pending.ID = uuid.Generate()
+	pending.ClientStatus = structs.AllocClientStatusPending
+	require.Nil(state.UpsertAllocs(3, []*structs.Allocation{alloc, peer, pending}))
+
+	req := &structs.TemplateDataRequest{
+		NodeID:   node.ID,
+		SecretID: uuid.Generate(),
+		AllocID:  alloc.ID,
+		JobID:    alloc.JobID,
+		QueryOptions: structs.QueryOptions{
+			Region: "global",
+		},
+	}
+
+	// The node secret must match
+	var resp structs.TemplateAllocationsResponse
+	err := msgpackrpc.CallWithCodec(codec, "Node.TemplateAllocations", req, &resp)
+	require.Error(err)
+	require.Contains(err.Error(), "secret ID does not match")
+
+	req.SecretID = node.SecretID
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Node.TemplateAllocations", req, &resp))
+	require.EqualValues(3, resp.Index)
+	require.Len(resp.Allocations, 2)
+	require.Equal(alloc.ID, resp.Allocations[0].ID)
+	require.Equal(peer.ID, resp.Allocations[1].ID)
+	require.EqualValues(1, resp.Allocations[1].Index)
+
+	ta := resp.Allocations[0]
+	require.Equal("192.168.0.100", ta.Address)
+	require.Equal(&structs.TemplatePort{IP: "192.168.0.100", Port: 9876, Address: "192.168.0.100:9876"}, ta.Ports["http"])
+	require.Equal(5000, ta.Ports["admin"].Port)
+
+	// Unknown groups have no allocations
+	req.TaskGroup = "unknown"
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Node.TemplateAllocations", req, &resp))
+	require.Empty(resp.Allocations)
+
+	// The query blocks until the job's allocations change
+	req.TaskGroup = ""
+	req.MinQueryIndex = 3
+	time.AfterFunc(100*time.Millisecond, func() {
+		stopped := peer.Copy()
+		stopped.ClientStatus = structs.AllocClientStatusComplete
+		require.Nil(state.UpdateAllocsFromClient(4, []*structs.Allocation{stopped}))
+	})
+	start := time.Now()
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Node.TemplateAllocations", req, &resp))
+	require.True(time.Since(start) >= 100*time.Millisecond, "should block")
+	require.EqualValues(4, resp.Index)
+	require.Len(resp.Allocations, 1)
+	require.Equal(alloc.ID, resp.Allocations[0].ID)
+}
+
+func TestClientEndpoint_TemplateMeta(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	s1 := TestServer(t, nil)
+	defer s1.Shutdown()
+	state := s1.fsm.State()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	node := mock.Node()
+	require.Nil(state.UpsertNode(2, node))
+
+	alloc := mock.Alloc()
+	alloc.NodeID = node.ID
+	alloc.Job.Meta = map[string]string{"owner": "armon", "tier": "gold"}
+	alloc.Job.TaskGroups[0].Meta = map[string]string{"tier": "silver"}
+	require.Nil(state.UpsertJob(4, alloc.Job))
+	require.Nil(state.UpsertAllocs(5, []*structs.Allocation{alloc}))
+
+	req := &structs.TemplateDataRequest{
+		NodeID:   node.ID,
+		SecretID: node.SecretID,
+		AllocID:  alloc.ID,
+		JobID:    alloc.JobID,
+		QueryOptions: structs.QueryOptions{
+			Region: "global",
+		},
+	}
+
+	// Job meta
+	var resp structs.TemplateMetaResponse
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Node.TemplateMeta", req, &resp))
+	require.Equal(map[string]string{"owner": "armon", "tier": "gold"}, resp.Meta)
+
+	// Group meta overrides job meta
+	req.TaskGroup = alloc.TaskGroup
+	resp = structs.TemplateMetaResponse{}
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Node.TemplateMeta", req, &resp))
+	require.Equal(map[string]string{"owner": "armon", "tier": "silver"}, resp.Meta)
+
+	// Meta of the node running the allocation
+	req.JobID = ""
+	req.TaskGroup = ""
+	req.NodeMeta = true
+	resp = structs.TemplateMetaResponse{}
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Node.TemplateMeta", req, &resp))
+	require.Equal(node.Meta, resp.Meta)
+
+	// Job and node meta can't be requested together
+	req.JobID = alloc.JobID
+	err := msgpackrpc.CallWithCodec(codec, "Node.TemplateMeta", req, &resp)
+	require.Error(err)
+	require.Contains(err.Error(), "exactly one of")
+}
+
+func TestClientEndpoint_SignIdentities(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	s1, _ := TestACLServer(t, nil)
+	defer s1.Shutdown()
+	state := s1.fsm.State()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+	testutil.WaitForResult(func() (bool, error) {
+		key, err := state.ActiveRootKey(nil)
+		return key != nil, err
+	}, func(err error) {
+		t.Fatalf("no active root key: %v", err)
+	})
+
+	node := mock.Node()
+	require.Nil(state.UpsertNode(2, node))
+
+	alloc := mock.Alloc()
+	alloc.NodeID = node.ID
+	other := mock.Job()
+	require.Nil(state.UpsertJob(3, alloc.Job))
+	require.Nil(state.UpsertJob(4, other))
+	require.Nil(state.UpsertAllocs(5, []*structs.Allocation{alloc}))
+
+	task := alloc.Job.TaskGroups[0].Tasks[0].Name
+	req := &structs.SignIdentitiesRequest{
+		NodeID:   node.ID,
+		SecretID: uuid.Generate(),
+		AllocID:  alloc.ID,
+		Tasks:    []string{task},
+		QueryOptions: structs.QueryOptions{
+			Region: "global",
+		},
+	}
+
+	// Wrong node secret
+	var resp structs.SignIdentitiesResponse
+	err := msgpackrpc.CallWithCodec(codec, "Node.SignIdentities", req, &resp)
+	require.Error(err)
+	require.Contains(err.Error(), "secret ID")
+
+	// Unknown task
+	req.SecretID = node.SecretID
+	req.Tasks = []string{"unknown"}
+	err = msgpackrpc.CallWithCodec(codec, "Node.SignIdentities", req, &resp)
+	require.Error(err)
+	require.Contains(err.Error(), "not found")
+
+	req.Tasks = []string{task}
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Node.SignIdentities", req, &resp))
+	require.Contains(resp.Identities, task)
+	require.True(resp.Expiration.After(time.Now()))
+	token := resp.Identities[task]
+
+	// The identity can read its own job
+	get := &structs.JobSpecificRequest{
+		JobID: alloc.JobID,
+		QueryOptions: structs.QueryOptions{
+			Region:    "global",
+			Namespace: alloc.Namespace,
+			AuthToken: token,
+		},
+	}
+	var getResp structs.SingleJobResponse
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Job.GetJob", get, &getResp))
+	require.Equal(alloc.JobID, getResp.Job.ID)
+
+	// But not other jobs, nor list jobs
+	get.JobID = other.ID
+	err = msgpackrpc.CallWithCodec(codec, "Job.GetJob", get, &getResp)
+	require.EqualError(err, structs.ErrPermissionDenied.Error())
+
+	list := &structs.JobListRequest{
+		QueryOptions: structs.QueryOptions{
+			Region:    "global",
+			Namespace: alloc.Namespace,
+			AuthToken: token,
+		},
+	}
+	var listResp structs.JobListResponse
+	err = msgpackrpc.CallWithCodec(codec, "Job.List", list, &listResp)
+	require.EqualError(err, structs.ErrPermissionDenied.Error())
+
+	// The identity is revoked once the allocation is terminal
+	stopped := alloc.Copy()
+	stopped.DesiredStatus = structs.AllocDesiredStatusStop
+	require.Nil(state.UpsertAllocs(6, []*structs.Allocation{stopped}))
+
+	get.JobID = alloc.JobID
+	err = msgpackrpc.CallWithCodec(codec, "Job.GetJob", get, &getResp)
+	require.EqualError(err, structs.ErrTokenNotFound.Error())
+}
@@ -206,7 +206,7 @@ func (p *PeriodicDispatch) Add(job *structs.Job) error {
 	}
 
 	// If we were tracking a job and it has been disabled, made non-periodic,
-	// stopped or is parameterized, remove it
+	// stopped, paused or is parameterized, remove it
 	disabled := !job.IsPeriodicActive()
 
 	tuple := structs.NamespacedID{
@@ -314,6 +314,47 @@ func (p *PeriodicDispatch) ForceRun(namespace, jobID string) (*structs.Evaluatio
 	return p.createEval(job, time.Now().In(job.Periodic.GetLocation()))
 }
 
+// CatchUp launches the periodic job at each of the passed launch times and
+// returns the created evals. It is used to launch the job for launches that
+// were missed while there was no leader.
+func (p *PeriodicDispatch) CatchUp(namespace, jobID string, launches []time.Time) ([]*structs.Evaluation, error) {
+	p.l.Lock()
+
+	// Do nothing if not enabled
+	if !p.enabled {
+		p.l.Unlock()
+		return nil, fmt.Errorf("periodic dispatch disabled")
+	}
+
+	tuple := structs.NamespacedID{
+		ID:        jobID,
+		Namespace: namespace,
+	}
+	job, tracked := p.tracked[tuple]
+	if !tracked {
+		p.l.Unlock()
+		return nil, fmt.Errorf("can't catch up non-tracked job %q (%s)", jobID, namespace)
+	}
+
+	p.l.Unlock()
+
+	// The caught up launches would overlap, so only launch the latest one
+	if job.Periodic.ProhibitOverlap && len(launches) > 1 {
+		launches = launches[len(launches)-1:]
+	}
+
+	evals := make([]*structs.Evaluation, 0, len(launches))
+	for _, launch := range launches {
+		eval, err := p.createEval(job, launch.In(job.Periodic.GetLocation()))
+		if err != nil {
+			return evals, err
+		}
+		evals = append(evals, eval)
+	}
+
+	return evals, nil
+}
+
 // shouldRun returns whether the long lived run function should run.
 func (p *PeriodicDispatch) shouldRun() bool {
 	p.l.RLock()
@@ -56,6 +56,10 @@ func (p *Periodic) Force(args *structs.PeriodicForceRequest, reply *structs.Peri
 		return fmt.Errorf("can't force launch non-periodic job")
 	}
 
+	if job.Paused {
+		return fmt.Errorf("can't force launch paused job")
+	}
+
 	// Force run the job.
 	eval, err := p.srv.periodicDispatcher.ForceRun(args.RequestNamespace(), job.ID)
 	if err != nil {
@@ -173,3 +173,36 @@ func TestPeriodicEndpoint_Force_NonPeriodic(t *testing.T) {
 		t.Fatalf("Force on non-periodic job should err")
 	}
 }
+
+func TestPeriodicEndpoint_Force_Paused(t *testing.T) {
+	t.Parallel()
+	s1 := TestServer(t, func(c *Config) {
+		c.NumSchedulers = 0 // Prevent automatic dequeue
+	})
+	state := s1.fsm.State()
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	// Create and insert a paused periodic job.
+	job := mock.PeriodicJob()
+	job.Pause()
+	if err := state.UpsertJob(100, job); err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	// Force launch it.
+	req := &structs.PeriodicForceRequest{
+		JobID: job.ID,
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			Namespace: job.Namespace,
+		},
+	}
+
+	// Fetch the response
+	var resp structs.PeriodicForceResponse
+	if err := msgpackrpc.CallWithCodec(codec, "Periodic.Force", req, &resp); err == nil {
+		t.Fatalf("Force on paused job should err")
+	}
+}
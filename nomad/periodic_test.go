@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/hashicorp/nomad/testutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type MockJobEvalDispatcher struct {
@@ -384,6 +385,44 @@ func TestPeriodicDispatch_ForceRun_Tracked(t *testing.T) {
 	}
 }
 
+func TestPeriodicDispatch_CatchUp(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	p, m := testPeriodicDispatcher(t)
+
+	// Create a job that won't be evaluated for a while.
+	job := testPeriodicJob(time.Now().Add(10 * time.Second))
+	require.NoError(p.Add(job))
+
+	// Catch up two missed launches
+	now := time.Now().Truncate(time.Second)
+	missed := []time.Time{now.Add(-2 * time.Minute), now.Add(-1 * time.Minute)}
+	evals, err := p.CatchUp(job.Namespace, job.ID, missed)
+	require.NoError(err)
+	require.Len(evals, 2)
+
+	launches, err := m.LaunchTimes(p, job.Namespace, job.ID)
+	require.NoError(err)
+	require.Len(launches, 2)
+	for i, launch := range launches {
+		require.True(missed[i].Equal(launch))
+	}
+
+	// Only the latest launch is caught up if the job prohibits overlap
+	other := testPeriodicJob(time.Now().Add(10 * time.Second))
+	other.Periodic.ProhibitOverlap = true
+	require.NoError(p.Add(other))
+
+	evals, err = p.CatchUp(other.Namespace, other.ID, missed)
+	require.NoError(err)
+	require.Len(evals, 1)
+
+	launches, err = m.LaunchTimes(p, other.Namespace, other.ID)
+	require.NoError(err)
+	require.Len(launches, 1)
+	require.True(missed[1].Equal(launches[0]))
+}
+
 func TestPeriodicDispatch_Run_DisallowOverlaps(t *testing.T) {
 	t.Parallel()
 	p, m := testPeriodicDispatcher(t)
@@ -43,8 +43,13 @@ func init() {
 		vaultAccessorTableSchema,
 		aclPolicyTableSchema,
 		aclTokenTableSchema,
+		aclRoleTableSchema,
+		aclAuthMethodTableSchema,
+		aclBindingRuleTableSchema,
 		autopilotConfigTableSchema,
 		schedulerConfigTableSchema,
+		rootKeyTableSchema,
+		variablesTableSchema,
 	}...)
 }
 
@@ -240,6 +245,12 @@ func jobIsGCable(obj interface{}) (bool, error) {
 		return false, fmt.Errorf("Unexpected type: %v", obj)
 	}
 
+	// A paused job has no allocations left but is kept so it can be resumed,
+	// unless it is also stopped.
+	if j.Paused && !j.Stop {
+		return false, nil
+	}
+
 	// If the job is periodic or parameterized it is only garbage collectable if
 	// it is stopped.
 	periodic := j.Periodic != nil && j.Periodic.Enabled
@@ -258,9 +269,9 @@ func jobIsGCable(obj interface{}) (bool, error) {
 		return true, nil
 	}
 
-	// Otherwise, only batch jobs are eligible because they complete on their
-	// own without a user stopping them.
-	if j.Type != structs.JobTypeBatch {
+	// Otherwise, only batch and sysbatch jobs are eligible because they
+	// complete on their own without a user stopping them.
+	if j.Type != structs.JobTypeBatch && j.Type != structs.JobTypeSysBatch {
 		return false, nil
 	}
 
@@ -601,6 +612,69 @@ func aclTokenTableSchema() *memdb.TableSchema {
 	}
 }
 
+// aclRoleTableSchema returns the MemDB schema for the role table.
+// This table is used to store the roles which group policies for tokens
+func aclRoleTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: "acl_role",
+		Indexes: map[string]*memdb.IndexSchema{
+			"id": {
+				Name:         "id",
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.StringFieldIndex{
+					Field: "Name",
+				},
+			},
+		},
+	}
+}
+
+// aclAuthMethodTableSchema returns the MemDB schema for the auth method table.
+// This table is used to store the auth methods users log in with
+func aclAuthMethodTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: "acl_auth_method",
+		Indexes: map[string]*memdb.IndexSchema{
+			"id": {
+				Name:         "id",
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.StringFieldIndex{
+					Field: "Name",
+				},
+			},
+		},
+	}
+}
+
+// aclBindingRuleTableSchema returns the MemDB schema for the binding rule
+// table. This table is used to store the rules linking the users of auth
+// methods to roles and policies
+func aclBindingRuleTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: "acl_binding_rule",
+		Indexes: map[string]*memdb.IndexSchema{
+			"id": {
+				Name:         "id",
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.UUIDFieldIndex{
+					Field: "ID",
+				},
+			},
+			"auth_method": {
+				Name:         "auth_method",
+				AllowMissing: false,
+				Unique:       false,
+				Indexer: &memdb.StringFieldIndex{
+					Field: "AuthMethod",
+				},
+			},
+		},
+	}
+}
+
 // schedulerConfigTableSchema returns the MemDB schema for the scheduler config table.
 // This table is used to store configuration options for the scheduler
 func schedulerConfigTableSchema() *memdb.TableSchema {
@@ -619,3 +693,58 @@ func schedulerConfigTableSchema() *memdb.TableSchema {
 		},
 	}
 }
+
+// rootKeyTableSchema returns the MemDB schema for the root keys table. This
+// table is used to store the keys signing workload identities.
+func rootKeyTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: "root_keys",
+		Indexes: map[string]*memdb.IndexSchema{
+			"id": {
+				Name:         "id",
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.StringFieldIndex{
+					Field: "KeyID",
+				},
+			},
+		},
+	}
+}
+
+// variablesTableSchema returns the MemDB schema for the variables table. This
+// table is used to store the encrypted variables.
+func variablesTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: "variables",
+		Indexes: map[string]*memdb.IndexSchema{
+			"id": {
+				Name:         "id",
+				AllowMissing: false,
+				Unique:       true,
+
+				// Use a compound index so the tuple of (Namespace, Path) is
+				// uniquely identifying
+				Indexer: &memdb.CompoundIndex{
+					Indexes: []memdb.Indexer{
+						&memdb.StringFieldIndex{
+							Field: "Namespace",
+						},
+
+						&memdb.StringFieldIndex{
+							Field: "Path",
+						},
+					},
+				},
+			},
+			"key_id": {
+				Name:         "key_id",
+				AllowMissing: false,
+				Unique:       false,
+				Indexer: &memdb.StringFieldIndex{
+					Field: "KeyID",
+				},
+			},
+		},
+	}
+}
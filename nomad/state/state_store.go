@@ -1972,6 +1972,12 @@ func (s *StateStore) nestedUpdateAllocFromClient(txn *memdb.Txn, index uint64, a
 	copyAlloc.ClientDescription = alloc.ClientDescription
 	copyAlloc.TaskStates = alloc.TaskStates
 
+	// The network status is only known once the client has configured the
+	// alloc's network, so keep any previously reported value
+	if alloc.NetworkStatus != nil {
+		copyAlloc.NetworkStatus = alloc.NetworkStatus.Copy()
+	}
+
 	// The client can only set its deployment health and timestamp, so just take
 	// those
 	if copyAlloc.DeploymentStatus != nil && alloc.DeploymentStatus != nil {
@@ -2612,6 +2618,15 @@ func (s *StateStore) updateDeploymentStatusImpl(index uint64, u *structs.Deploym
 	copy.StatusDescription = u.StatusDescription
 	copy.ModifyIndex = index
 
+	// Restart the progress deadlines that have started
+	if !u.ProgressDeadlineStart.IsZero() {
+		for _, state := range copy.TaskGroups {
+			if state.ProgressDeadline != 0 && !state.RequireProgressBy.IsZero() {
+				state.RequireProgressBy = u.ProgressDeadlineStart.Add(state.ProgressDeadline)
+			}
+		}
+	}
+
 	// Insert the deployment
 	if err := txn.Insert("deployment", copy); err != nil {
 		return err
@@ -3096,6 +3111,8 @@ func (s *StateStore) ReconcileJobSummaries(index uint64) error {
 				continue
 			}
 
+			summary.UpdateNode(alloc)
+
 			tg := summary.Summary[alloc.TaskGroup]
 			switch alloc.ClientStatus {
 			case structs.AllocClientStatusFailed:
@@ -3565,6 +3582,11 @@ func (s *StateStore) updateSummaryWithAlloc(index uint64, alloc *structs.Allocat
 	}
 	jobSummary.Summary[alloc.TaskGroup] = tgSummary
 
+	// Track the completion of sysbatch jobs on each node
+	if jobSummary.UpdateNode(alloc) {
+		summaryChanged = true
+	}
+
 	if summaryChanged {
 		jobSummary.ModifyIndex = index
 
@@ -3702,6 +3724,304 @@ func (s *StateStore) ACLPolicies(ws memdb.WatchSet) (memdb.ResultIterator, error
 	return iter, nil
 }
 
+// UpsertACLRoles is used to create or update a set of ACL roles
+func (s *StateStore) UpsertACLRoles(index uint64, roles []*structs.ACLRole) error {
+	txn := s.db.Txn(true)
+	defer txn.Abort()
+
+	for _, role := range roles {
+		// Ensure the role hash is non-nil. This should be done outside the state store
+		// for performance reasons, but we check here for defense in depth.
+		if len(role.Hash) == 0 {
+			role.SetHash()
+		}
+
+		// Check if the role already exists
+		existing, err := txn.First("acl_role", "id", role.Name)
+		if err != nil {
+			return fmt.Errorf("role lookup failed: %v", err)
+		}
+
+		// Update all the indexes
+		if existing != nil {
+			role.CreateIndex = existing.(*structs.ACLRole).CreateIndex
+			role.ModifyIndex = index
+		} else {
+			role.CreateIndex = index
+			role.ModifyIndex = index
+		}
+
+		// Update the role
+		if err := txn.Insert("acl_role", role); err != nil {
+			return fmt.Errorf("upserting role failed: %v", err)
+		}
+	}
+
+	// Update the indexes table
+	if err := txn.Insert("index", &IndexEntry{"acl_role", index}); err != nil {
+		return fmt.Errorf("index update failed: %v", err)
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// DeleteACLRoles deletes the roles with the given names
+func (s *StateStore) DeleteACLRoles(index uint64, names []string) error {
+	txn := s.db.Txn(true)
+	defer txn.Abort()
+
+	// Delete the role
+	for _, name := range names {
+		if _, err := txn.DeleteAll("acl_role", "id", name); err != nil {
+			return fmt.Errorf("deleting acl role failed: %v", err)
+		}
+	}
+	if err := txn.Insert("index", &IndexEntry{"acl_role", index}); err != nil {
+		return fmt.Errorf("index update failed: %v", err)
+	}
+	txn.Commit()
+	return nil
+}
+
+// ACLRoleByName is used to lookup a role by name
+func (s *StateStore) ACLRoleByName(ws memdb.WatchSet, name string) (*structs.ACLRole, error) {
+	txn := s.db.Txn(false)
+
+	watchCh, existing, err := txn.FirstWatch("acl_role", "id", name)
+	if err != nil {
+		return nil, fmt.Errorf("acl role lookup failed: %v", err)
+	}
+	ws.Add(watchCh)
+
+	if existing != nil {
+		return existing.(*structs.ACLRole), nil
+	}
+	return nil, nil
+}
+
+// ACLRoleByNamePrefix is used to lookup roles by prefix
+func (s *StateStore) ACLRoleByNamePrefix(ws memdb.WatchSet, prefix string) (memdb.ResultIterator, error) {
+	txn := s.db.Txn(false)
+
+	iter, err := txn.Get("acl_role", "id_prefix", prefix)
+	if err != nil {
+		return nil, fmt.Errorf("acl role lookup failed: %v", err)
+	}
+	ws.Add(iter.WatchCh())
+
+	return iter, nil
+}
+
+// ACLRoles returns an iterator over all the acl roles
+func (s *StateStore) ACLRoles(ws memdb.WatchSet) (memdb.ResultIterator, error) {
+	txn := s.db.Txn(false)
+
+	// Walk the entire table
+	iter, err := txn.Get("acl_role", "id")
+	if err != nil {
+		return nil, err
+	}
+	ws.Add(iter.WatchCh())
+	return iter, nil
+}
+
+// UpsertACLAuthMethods is used to create or update a set of auth methods
+func (s *StateStore) UpsertACLAuthMethods(index uint64, methods []*structs.ACLAuthMethod) error {
+	txn := s.db.Txn(true)
+	defer txn.Abort()
+
+	for _, method := range methods {
+		// Ensure the method hash is non-nil. This should be done outside the state store
+		// for performance reasons, but we check here for defense in depth.
+		if len(method.Hash) == 0 {
+			method.SetHash()
+		}
+
+		// Check if the method already exists
+		existing, err := txn.First("acl_auth_method", "id", method.Name)
+		if err != nil {
+			return fmt.Errorf("auth method lookup failed: %v", err)
+		}
+
+		// Update all the indexes
+		if existing != nil {
+			method.CreateIndex = existing.(*structs.ACLAuthMethod).CreateIndex
+			method.ModifyIndex = index
+		} else {
+			method.CreateIndex = index
+			method.ModifyIndex = index
+		}
+
+		// Update the method
+		if err := txn.Insert("acl_auth_method", method); err != nil {
+			return fmt.Errorf("upserting auth method failed: %v", err)
+		}
+	}
+
+	// Update the indexes table
+	if err := txn.Insert("index", &IndexEntry{"acl_auth_method", index}); err != nil {
+		return fmt.Errorf("index update failed: %v", err)
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// DeleteACLAuthMethods deletes the auth methods with the given names, and
+// their binding rules
+func (s *StateStore) DeleteACLAuthMethods(index uint64, names []string) error {
+	txn := s.db.Txn(true)
+	defer txn.Abort()
+
+	for _, name := range names {
+		if _, err := txn.DeleteAll("acl_auth_method", "id", name); err != nil {
+			return fmt.Errorf("deleting acl auth method failed: %v", err)
+		}
+		if _, err := txn.DeleteAll("acl_binding_rule", "auth_method", name); err != nil {
+			return fmt.Errorf("deleting acl binding rules failed: %v", err)
+		}
+	}
+	if err := txn.Insert("index", &IndexEntry{"acl_auth_method", index}); err != nil {
+		return fmt.Errorf("index update failed: %v", err)
+	}
+	if err := txn.Insert("index", &IndexEntry{"acl_binding_rule", index}); err != nil {
+		return fmt.Errorf("index update failed: %v", err)
+	}
+	txn.Commit()
+	return nil
+}
+
+// ACLAuthMethodByName is used to lookup an auth method by name
+func (s *StateStore) ACLAuthMethodByName(ws memdb.WatchSet, name string) (*structs.ACLAuthMethod, error) {
+	txn := s.db.Txn(false)
+
+	watchCh, existing, err := txn.FirstWatch("acl_auth_method", "id", name)
+	if err != nil {
+		return nil, fmt.Errorf("acl auth method lookup failed: %v", err)
+	}
+	ws.Add(watchCh)
+
+	if existing != nil {
+		return existing.(*structs.ACLAuthMethod), nil
+	}
+	return nil, nil
+}
+
+// ACLAuthMethods returns an iterator over all the acl auth methods
+func (s *StateStore) ACLAuthMethods(ws memdb.WatchSet) (memdb.ResultIterator, error) {
+	txn := s.db.Txn(false)
+
+	// Walk the entire table
+	iter, err := txn.Get("acl_auth_method", "id")
+	if err != nil {
+		return nil, err
+	}
+	ws.Add(iter.WatchCh())
+	return iter, nil
+}
+
+// UpsertACLBindingRules is used to create or update a set of binding rules
+func (s *StateStore) UpsertACLBindingRules(index uint64, rules []*structs.ACLBindingRule) error {
+	txn := s.db.Txn(true)
+	defer txn.Abort()
+
+	for _, rule := range rules {
+		// Ensure the rule hash is non-nil. This should be done outside the state store
+		// for performance reasons, but we check here for defense in depth.
+		if len(rule.Hash) == 0 {
+			rule.SetHash()
+		}
+
+		// Check if the rule already exists
+		existing, err := txn.First("acl_binding_rule", "id", rule.ID)
+		if err != nil {
+			return fmt.Errorf("binding rule lookup failed: %v", err)
+		}
+
+		// Update all the indexes
+		if existing != nil {
+			rule.CreateIndex = existing.(*structs.ACLBindingRule).CreateIndex
+			rule.ModifyIndex = index
+		} else {
+			rule.CreateIndex = index
+			rule.ModifyIndex = index
+		}
+
+		// Update the rule
+		if err := txn.Insert("acl_binding_rule", rule); err != nil {
+			return fmt.Errorf("upserting binding rule failed: %v", err)
+		}
+	}
+
+	// Update the indexes table
+	if err := txn.Insert("index", &IndexEntry{"acl_binding_rule", index}); err != nil {
+		return fmt.Errorf("index update failed: %v", err)
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// DeleteACLBindingRules deletes the binding rules with the given IDs
+func (s *StateStore) DeleteACLBindingRules(index uint64, ids []string) error {
+	txn := s.db.Txn(true)
+	defer txn.Abort()
+
+	for _, id := range ids {
+		if _, err := txn.DeleteAll("acl_binding_rule", "id", id); err != nil {
+			return fmt.Errorf("deleting acl binding rule failed: %v", err)
+		}
+	}
+	if err := txn.Insert("index", &IndexEntry{"acl_binding_rule", index}); err != nil {
+		return fmt.Errorf("index update failed: %v", err)
+	}
+	txn.Commit()
+	return nil
+}
+
+// ACLBindingRuleByID is used to lookup a binding rule by ID
+func (s *StateStore) ACLBindingRuleByID(ws memdb.WatchSet, id string) (*structs.ACLBindingRule, error) {
+	txn := s.db.Txn(false)
+
+	watchCh, existing, err := txn.FirstWatch("acl_binding_rule", "id", id)
+	if err != nil {
+		return nil, fmt.Errorf("acl binding rule lookup failed: %v", err)
+	}
+	ws.Add(watchCh)
+
+	if existing != nil {
+		return existing.(*structs.ACLBindingRule), nil
+	}
+	return nil, nil
+}
+
+// ACLBindingRulesByAuthMethod returns an iterator over the binding rules of
+// an auth method
+func (s *StateStore) ACLBindingRulesByAuthMethod(ws memdb.WatchSet, method string) (memdb.ResultIterator, error) {
+	txn := s.db.Txn(false)
+
+	iter, err := txn.Get("acl_binding_rule", "auth_method", method)
+	if err != nil {
+		return nil, fmt.Errorf("acl binding rule lookup failed: %v", err)
+	}
+	ws.Add(iter.WatchCh())
+	return iter, nil
+}
+
+// ACLBindingRules returns an iterator over all the acl binding rules
+func (s *StateStore) ACLBindingRules(ws memdb.WatchSet) (memdb.ResultIterator, error) {
+	txn := s.db.Txn(false)
+
+	// Walk the entire table
+	iter, err := txn.Get("acl_binding_rule", "id")
+	if err != nil {
+		return nil, err
+	}
+	ws.Add(iter.WatchCh())
+	return iter, nil
+}
+
 // UpsertACLTokens is used to create or update a set of ACL tokens
 func (s *StateStore) UpsertACLTokens(index uint64, tokens []*structs.ACLToken) error {
 	txn := s.db.Txn(true)
@@ -3726,10 +4046,11 @@ func (s *StateStore) UpsertACLTokens(index uint64, tokens []*structs.ACLToken) e
 			token.CreateIndex = existTK.CreateIndex
 			token.ModifyIndex = index
 
-			// Do not allow SecretID or create time to change
+			// Do not allow SecretID, create time or expiration to change
 			token.SecretID = existTK.SecretID
 			token.CreateTime = existTK.CreateTime
-
+			token.ExpirationTime = existTK.ExpirationTime
+			token.ExpirationTTL = existTK.ExpirationTTL
 		} else {
 			token.CreateIndex = index
 			token.ModifyIndex = index
@@ -3894,6 +4215,311 @@ func (s *StateStore) BootstrapACLTokens(index, resetIndex uint64, token *structs
 	return nil
 }
 
+// UpsertRootKeys is used to create or update root keys. When one of the keys
+// is active, all other keys are made inactive.
+func (s *StateStore) UpsertRootKeys(index uint64, keys []*structs.RootKey) error {
+	txn := s.db.Txn(true)
+	defer txn.Abort()
+
+	activating := false
+	for _, key := range keys {
+		activating = activating || key.Active
+
+		existing, err := txn.First("root_keys", "id", key.KeyID)
+		if err != nil {
+			return fmt.Errorf("root key lookup failed: %v", err)
+		}
+
+		if existing != nil {
+			key.CreateIndex = existing.(*structs.RootKey).CreateIndex
+			key.ModifyIndex = index
+		} else {
+			key.CreateIndex = index
+			key.ModifyIndex = index
+		}
+
+		if err := txn.Insert("root_keys", key); err != nil {
+			return fmt.Errorf("upserting root key failed: %v", err)
+		}
+	}
+
+	if activating {
+		upserted := make(map[string]struct{}, len(keys))
+		for _, key := range keys {
+			upserted[key.KeyID] = struct{}{}
+		}
+
+		iter, err := txn.Get("root_keys", "id")
+		if err != nil {
+			return fmt.Errorf("root key lookup failed: %v", err)
+		}
+
+		var deactivated []*structs.RootKey
+		for raw := iter.Next(); raw != nil; raw = iter.Next() {
+			key := raw.(*structs.RootKey)
+			if _, ok := upserted[key.KeyID]; ok || !key.Active {
+				continue
+			}
+
+			key = key.Copy()
+			key.Active = false
+			key.ModifyIndex = index
+			deactivated = append(deactivated, key)
+		}
+
+		for _, key := range deactivated {
+			if err := txn.Insert("root_keys", key); err != nil {
+				return fmt.Errorf("upserting root key failed: %v", err)
+			}
+		}
+	}
+
+	if err := txn.Insert("index", &IndexEntry{"root_keys", index}); err != nil {
+		return fmt.Errorf("index update failed: %v", err)
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// DeleteRootKeys deletes the root keys with the given IDs
+func (s *StateStore) DeleteRootKeys(index uint64, keyIDs []string) error {
+	txn := s.db.Txn(true)
+	defer txn.Abort()
+
+	for _, id := range keyIDs {
+		if _, err := txn.DeleteAll("root_keys", "id", id); err != nil {
+			return fmt.Errorf("deleting root key failed: %v", err)
+		}
+	}
+	if err := txn.Insert("index", &IndexEntry{"root_keys", index}); err != nil {
+		return fmt.Errorf("index update failed: %v", err)
+	}
+	txn.Commit()
+	return nil
+}
+
+// RootKeyByID is used to lookup a root key by ID
+func (s *StateStore) RootKeyByID(ws memdb.WatchSet, id string) (*structs.RootKey, error) {
+	txn := s.db.Txn(false)
+
+	watchCh, existing, err := txn.FirstWatch("root_keys", "id", id)
+	if err != nil {
+		return nil, fmt.Errorf("root key lookup failed: %v", err)
+	}
+	ws.Add(watchCh)
+
+	if existing != nil {
+		return existing.(*structs.RootKey), nil
+	}
+	return nil, nil
+}
+
+// RootKeys returns an iterator over all the root keys
+func (s *StateStore) RootKeys(ws memdb.WatchSet) (memdb.ResultIterator, error) {
+	txn := s.db.Txn(false)
+
+	iter, err := txn.Get("root_keys", "id")
+	if err != nil {
+		return nil, err
+	}
+	ws.Add(iter.WatchCh())
+	return iter, nil
+}
+
+// ActiveRootKey returns the root key used to sign workload identities, or nil
+// if there is none.
+func (s *StateStore) ActiveRootKey(ws memdb.WatchSet) (*structs.RootKey, error) {
+	iter, err := s.RootKeys(ws)
+	if err != nil {
+		return nil, err
+	}
+
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		if key := raw.(*structs.RootKey); key.Active {
+			return key, nil
+		}
+	}
+	return nil, nil
+}
+
+// UpsertVariable is used to create or update a variable
+func (s *StateStore) UpsertVariable(index uint64, v *structs.VariableEncrypted) error {
+	txn := s.db.Txn(true)
+	defer txn.Abort()
+
+	if err := s.upsertVariableTxn(index, txn, v); err != nil {
+		return err
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// CASVariable is used to create or update a variable only if its current
+// modify index matches the check index. A check index of zero requires the
+// variable to not exist. Returns whether the variable was written.
+func (s *StateStore) CASVariable(index, checkIndex uint64, v *structs.VariableEncrypted) (bool, error) {
+	txn := s.db.Txn(true)
+	defer txn.Abort()
+
+	existing, err := txn.First("variables", "id", v.Namespace, v.Path)
+	if err != nil {
+		return false, fmt.Errorf("variable lookup failed: %v", err)
+	}
+	if !variableIndexMatches(existing, checkIndex) {
+		return false, nil
+	}
+
+	if err := s.upsertVariableTxn(index, txn, v); err != nil {
+		return false, err
+	}
+
+	txn.Commit()
+	return true, nil
+}
+
+func (s *StateStore) upsertVariableTxn(index uint64, txn *memdb.Txn, v *structs.VariableEncrypted) error {
+	existing, err := txn.First("variables", "id", v.Namespace, v.Path)
+	if err != nil {
+		return fmt.Errorf("variable lookup failed: %v", err)
+	}
+
+	if existing != nil {
+		exist := existing.(*structs.VariableEncrypted)
+		v.CreateIndex = exist.CreateIndex
+		v.CreateTime = exist.CreateTime
+	} else {
+		v.CreateIndex = index
+	}
+	v.ModifyIndex = index
+
+	if err := txn.Insert("variables", v); err != nil {
+		return fmt.Errorf("upserting variable failed: %v", err)
+	}
+	if err := txn.Insert("index", &IndexEntry{"variables", index}); err != nil {
+		return fmt.Errorf("index update failed: %v", err)
+	}
+	return nil
+}
+
+// DeleteVariable is used to delete a variable
+func (s *StateStore) DeleteVariable(index uint64, namespace, path string) error {
+	txn := s.db.Txn(true)
+	defer txn.Abort()
+
+	if err := s.deleteVariableTxn(index, txn, namespace, path); err != nil {
+		return err
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// CASDeleteVariable is used to delete a variable only if its current modify
+// index matches the check index. Returns whether the variable was deleted.
+func (s *StateStore) CASDeleteVariable(index, checkIndex uint64, namespace, path string) (bool, error) {
+	txn := s.db.Txn(true)
+	defer txn.Abort()
+
+	existing, err := txn.First("variables", "id", namespace, path)
+	if err != nil {
+		return false, fmt.Errorf("variable lookup failed: %v", err)
+	}
+	if !variableIndexMatches(existing, checkIndex) {
+		return false, nil
+	}
+
+	if err := s.deleteVariableTxn(index, txn, namespace, path); err != nil {
+		return false, err
+	}
+
+	txn.Commit()
+	return true, nil
+}
+
+func (s *StateStore) deleteVariableTxn(index uint64, txn *memdb.Txn, namespace, path string) error {
+	existing, err := txn.First("variables", "id", namespace, path)
+	if err != nil {
+		return fmt.Errorf("variable lookup failed: %v", err)
+	}
+	if existing == nil {
+		return nil
+	}
+
+	if err := txn.Delete("variables", existing); err != nil {
+		return fmt.Errorf("deleting variable failed: %v", err)
+	}
+	if err := txn.Insert("index", &IndexEntry{"variables", index}); err != nil {
+		return fmt.Errorf("index update failed: %v", err)
+	}
+	return nil
+}
+
+// variableIndexMatches returns whether the modify index of an existing
+// variable matches the check index, where a check index of zero matches a
+// missing variable.
+func variableIndexMatches(existing interface{}, checkIndex uint64) bool {
+	if existing == nil {
+		return checkIndex == 0
+	}
+	return existing.(*structs.VariableEncrypted).ModifyIndex == checkIndex
+}
+
+// VariableByPath is used to lookup a variable by its namespace and path
+func (s *StateStore) VariableByPath(ws memdb.WatchSet, namespace, path string) (*structs.VariableEncrypted, error) {
+	txn := s.db.Txn(false)
+
+	watchCh, existing, err := txn.FirstWatch("variables", "id", namespace, path)
+	if err != nil {
+		return nil, fmt.Errorf("variable lookup failed: %v", err)
+	}
+	ws.Add(watchCh)
+
+	if existing != nil {
+		return existing.(*structs.VariableEncrypted), nil
+	}
+	return nil, nil
+}
+
+// VariablesByPathPrefix returns an iterator over the variables of a namespace
+// whose path starts with the given prefix
+func (s *StateStore) VariablesByPathPrefix(ws memdb.WatchSet, namespace, prefix string) (memdb.ResultIterator, error) {
+	txn := s.db.Txn(false)
+
+	iter, err := txn.Get("variables", "id_prefix", namespace, prefix)
+	if err != nil {
+		return nil, fmt.Errorf("variable lookup failed: %v", err)
+	}
+	ws.Add(iter.WatchCh())
+	return iter, nil
+}
+
+// VariablesByKeyID returns an iterator over the variables encrypted by the
+// given root key
+func (s *StateStore) VariablesByKeyID(ws memdb.WatchSet, keyID string) (memdb.ResultIterator, error) {
+	txn := s.db.Txn(false)
+
+	iter, err := txn.Get("variables", "key_id", keyID)
+	if err != nil {
+		return nil, fmt.Errorf("variable lookup failed: %v", err)
+	}
+	ws.Add(iter.WatchCh())
+	return iter, nil
+}
+
+// Variables returns an iterator over all the variables
+func (s *StateStore) Variables(ws memdb.WatchSet) (memdb.ResultIterator, error) {
+	txn := s.db.Txn(false)
+
+	iter, err := txn.Get("variables", "id")
+	if err != nil {
+		return nil, err
+	}
+	ws.Add(iter.WatchCh())
+	return iter, nil
+}
+
 // SchedulerConfig is used to get the current Scheduler configuration.
 func (s *StateStore) SchedulerConfig() (uint64, *structs.SchedulerConfiguration, error) {
 	tx := s.db.Txn(false)
@@ -4182,6 +4808,46 @@ func (r *StateRestore) ACLTokenRestore(token *structs.ACLToken) error {
 	return nil
 }
 
+// ACLRoleRestore is used to restore an ACL role
+func (r *StateRestore) ACLRoleRestore(role *structs.ACLRole) error {
+	if err := r.txn.Insert("acl_role", role); err != nil {
+		return fmt.Errorf("inserting acl role failed: %v", err)
+	}
+	return nil
+}
+
+// ACLAuthMethodRestore is used to restore an ACL auth method
+func (r *StateRestore) ACLAuthMethodRestore(method *structs.ACLAuthMethod) error {
+	if err := r.txn.Insert("acl_auth_method", method); err != nil {
+		return fmt.Errorf("inserting acl auth method failed: %v", err)
+	}
+	return nil
+}
+
+// ACLBindingRuleRestore is used to restore an ACL binding rule
+func (r *StateRestore) ACLBindingRuleRestore(rule *structs.ACLBindingRule) error {
+	if err := r.txn.Insert("acl_binding_rule", rule); err != nil {
+		return fmt.Errorf("inserting acl binding rule failed: %v", err)
+	}
+	return nil
+}
+
+// RootKeyRestore is used to restore a root key
+func (r *StateRestore) RootKeyRestore(key *structs.RootKey) error {
+	if err := r.txn.Insert("root_keys", key); err != nil {
+		return fmt.Errorf("inserting root key failed: %v", err)
+	}
+	return nil
+}
+
+// VariableRestore is used to restore a variable
+func (r *StateRestore) VariableRestore(v *structs.VariableEncrypted) error {
+	if err := r.txn.Insert("variables", v); err != nil {
+		return fmt.Errorf("inserting variable failed: %v", err)
+	}
+	return nil
+}
+
 func (r *StateRestore) SchedulerConfigRestore(schedConfig *structs.SchedulerConfiguration) error {
 	if err := r.txn.Insert("scheduler_config", schedConfig); err != nil {
 		return fmt.Errorf("inserting scheduler config failed: %s", err)
@@ -2311,6 +2311,31 @@ func TestStateStore_JobsByGC(t *testing.T) {
 
 	}
 
+	// Paused batch jobs are only eligible once they are stopped
+	for i := 0; i < 20; i += 2 {
+		job := mock.Job()
+		job.Type = structs.JobTypeBatch
+		job.Pause()
+		if i%4 == 0 {
+			job.Stop = true
+			gc[job.ID] = struct{}{}
+		} else {
+			nonGc[job.ID] = struct{}{}
+		}
+
+		if err := state.UpsertJob(3000+uint64(i), job); err != nil {
+			t.Fatalf("err: %v", err)
+		}
+
+		// Create an eval for it
+		eval := mock.Eval()
+		eval.JobID = job.ID
+		eval.Status = structs.EvalStatusComplete
+		if err := state.UpsertEvals(3000+uint64(i+1), []*structs.Evaluation{eval}); err != nil {
+			t.Fatalf("err: %v", err)
+		}
+	}
+
 	ws := memdb.NewWatchSet()
 	iter, err := state.JobsByGC(ws, true)
 	if err != nil {
@@ -4488,6 +4513,81 @@ func TestStateStore_ReconcileJobSummary(t *testing.T) {
 	}
 }
 
+func TestStateStore_JobSummary_SysBatchNodes(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	state := testStateStore(t)
+
+	job := mock.SysBatchJob()
+	require.NoError(state.UpsertJob(100, job))
+
+	// Place the job on three nodes
+	var allocs []*structs.Allocation
+	for i := 0; i < 3; i++ {
+		alloc := mock.Alloc()
+		alloc.Job = job
+		alloc.JobID = job.ID
+		alloc.TaskGroup = job.TaskGroups[0].Name
+		alloc.NodeID = fmt.Sprintf("node-%d", i)
+		allocs = append(allocs, alloc)
+	}
+	require.NoError(state.UpsertAllocs(110, allocs))
+
+	// The job completes on the first node, fails on the second and keeps
+	// running on the third
+	updates := make([]*structs.Allocation, 3)
+	for i, status := range []string{
+		structs.AllocClientStatusComplete,
+		structs.AllocClientStatusFailed,
+		structs.AllocClientStatusRunning,
+	} {
+		updates[i] = allocs[i].Copy()
+		updates[i].ClientStatus = status
+	}
+	require.NoError(state.UpdateAllocsFromClient(120, updates))
+
+	// The failed node is retried
+	retry := mock.Alloc()
+	retry.Job = job
+	retry.JobID = job.ID
+	retry.TaskGroup = job.TaskGroups[0].Name
+	retry.NodeID = "node-1"
+	require.NoError(state.UpsertAllocs(130, []*structs.Allocation{retry}))
+
+	status := func(summary *structs.JobSummary) map[string]string {
+		out := make(map[string]string, len(summary.Nodes))
+		for id, node := range summary.Nodes {
+			out[id] = node.Status()
+		}
+		return out
+	}
+	expected := map[string]string{
+		"node-0": structs.AllocClientStatusComplete,
+		"node-1": structs.AllocClientStatusPending,
+		"node-2": structs.AllocClientStatusRunning,
+	}
+
+	summary, err := state.JobSummaryByID(nil, job.Namespace, job.ID)
+	require.NoError(err)
+	require.Equal(expected, status(summary))
+	require.Equal(retry.ID, summary.Nodes["node-1"].Allocs[retry.TaskGroup].ID)
+
+	// Reconciling the summary rebuilds the same node summaries
+	require.NoError(state.DeleteJobSummary(140, job.Namespace, job.ID))
+	require.NoError(state.ReconcileJobSummaries(150))
+	summary, err = state.JobSummaryByID(nil, job.Namespace, job.ID)
+	require.NoError(err)
+	require.Equal(expected, status(summary))
+
+	// Only sysbatch jobs track their nodes
+	alloc := mock.Alloc()
+	require.NoError(state.UpsertJob(160, alloc.Job))
+	require.NoError(state.UpsertAllocs(170, []*structs.Allocation{alloc}))
+	summary, err = state.JobSummaryByID(nil, alloc.Namespace, alloc.JobID)
+	require.NoError(err)
+	require.Nil(summary.Nodes)
+}
+
 func TestStateStore_ReconcileParentJobSummary(t *testing.T) {
 	t.Parallel()
 	require := require.New(t)
@@ -6728,6 +6828,253 @@ func TestStateStore_ACLPolicyByNamePrefix(t *testing.T) {
 	assert.Equal(t, expect, out)
 }
 
+func TestStateStore_UpsertACLRoles(t *testing.T) {
+	require := require.New(t)
+	state := testStateStore(t)
+	role := mock.ACLRole()
+	role2 := mock.ACLRole()
+
+	ws := memdb.NewWatchSet()
+	_, err := state.ACLRoleByName(ws, role.Name)
+	require.NoError(err)
+
+	require.NoError(state.UpsertACLRoles(1000, []*structs.ACLRole{role, role2}))
+	require.True(watchFired(ws))
+
+	ws = memdb.NewWatchSet()
+	out, err := state.ACLRoleByName(ws, role.Name)
+	require.NoError(err)
+	require.Equal(role, out)
+
+	iter, err := state.ACLRoles(ws)
+	require.NoError(err)
+	count := 0
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		count++
+	}
+	require.Equal(2, count)
+
+	iter, err = state.ACLRoleByNamePrefix(ws, "role-")
+	require.NoError(err)
+	count = 0
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		count++
+	}
+	require.Equal(2, count)
+
+	// Update the role and ensure the create index is kept
+	updated := new(structs.ACLRole)
+	*updated = *role
+	updated.Policies = []string{"baz"}
+	updated.SetHash()
+	require.NoError(state.UpsertACLRoles(1001, []*structs.ACLRole{updated}))
+
+	out, err = state.ACLRoleByName(nil, role.Name)
+	require.NoError(err)
+	require.Equal([]string{"baz"}, out.Policies)
+	require.Equal(uint64(1000), out.CreateIndex)
+	require.Equal(uint64(1001), out.ModifyIndex)
+
+	index, err := state.Index("acl_role")
+	require.NoError(err)
+	require.Equal(uint64(1001), index)
+}
+
+func TestStateStore_DeleteACLRoles(t *testing.T) {
+	require := require.New(t)
+	state := testStateStore(t)
+	role := mock.ACLRole()
+	role2 := mock.ACLRole()
+
+	require.NoError(state.UpsertACLRoles(1000, []*structs.ACLRole{role, role2}))
+
+	ws := memdb.NewWatchSet()
+	_, err := state.ACLRoleByName(ws, role.Name)
+	require.NoError(err)
+
+	require.NoError(state.DeleteACLRoles(1001, []string{role.Name}))
+	require.True(watchFired(ws))
+
+	out, err := state.ACLRoleByName(nil, role.Name)
+	require.NoError(err)
+	require.Nil(out)
+
+	out, err = state.ACLRoleByName(nil, role2.Name)
+	require.NoError(err)
+	require.NotNil(out)
+
+	index, err := state.Index("acl_role")
+	require.NoError(err)
+	require.Equal(uint64(1001), index)
+}
+
+func TestStateStore_RestoreACLRole(t *testing.T) {
+	require := require.New(t)
+	state := testStateStore(t)
+	role := mock.ACLRole()
+
+	restore, err := state.Restore()
+	require.NoError(err)
+	require.NoError(restore.ACLRoleRestore(role))
+	restore.Commit()
+
+	out, err := state.ACLRoleByName(nil, role.Name)
+	require.NoError(err)
+	require.Equal(role, out)
+}
+
+func TestStateStore_UpsertACLAuthMethods(t *testing.T) {
+	require := require.New(t)
+	state := testStateStore(t)
+	method := mock.ACLAuthMethod()
+	method2 := mock.ACLAuthMethod()
+
+	ws := memdb.NewWatchSet()
+	_, err := state.ACLAuthMethodByName(ws, method.Name)
+	require.NoError(err)
+
+	require.NoError(state.UpsertACLAuthMethods(1000, []*structs.ACLAuthMethod{method, method2}))
+	require.True(watchFired(ws))
+
+	out, err := state.ACLAuthMethodByName(nil, method.Name)
+	require.NoError(err)
+	require.Equal(method, out)
+
+	iter, err := state.ACLAuthMethods(nil)
+	require.NoError(err)
+	count := 0
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		count++
+	}
+	require.Equal(2, count)
+
+	// Update the auth method and ensure the create index is kept
+	updated := method.Copy()
+	updated.MaxTokenTTL = time.Minute
+	updated.SetHash()
+	require.NoError(state.UpsertACLAuthMethods(1001, []*structs.ACLAuthMethod{updated}))
+
+	out, err = state.ACLAuthMethodByName(nil, method.Name)
+	require.NoError(err)
+	require.Equal(time.Minute, out.MaxTokenTTL)
+	require.Equal(uint64(1000), out.CreateIndex)
+	require.Equal(uint64(1001), out.ModifyIndex)
+
+	index, err := state.Index("acl_auth_method")
+	require.NoError(err)
+	require.Equal(uint64(1001), index)
+}
+
+func TestStateStore_DeleteACLAuthMethods(t *testing.T) {
+	require := require.New(t)
+	state := testStateStore(t)
+	method := mock.ACLAuthMethod()
+	method2 := mock.ACLAuthMethod()
+	rule := mock.ACLBindingRule(method.Name)
+	rule2 := mock.ACLBindingRule(method2.Name)
+
+	require.NoError(state.UpsertACLAuthMethods(1000, []*structs.ACLAuthMethod{method, method2}))
+	require.NoError(state.UpsertACLBindingRules(1001, []*structs.ACLBindingRule{rule, rule2}))
+
+	ws := memdb.NewWatchSet()
+	_, err := state.ACLBindingRuleByID(ws, rule.ID)
+	require.NoError(err)
+
+	// Deleting the auth method deletes its binding rules
+	require.NoError(state.DeleteACLAuthMethods(1002, []string{method.Name}))
+	require.True(watchFired(ws))
+
+	out, err := state.ACLAuthMethodByName(nil, method.Name)
+	require.NoError(err)
+	require.Nil(out)
+
+	outRule, err := state.ACLBindingRuleByID(nil, rule.ID)
+	require.NoError(err)
+	require.Nil(outRule)
+
+	outRule, err = state.ACLBindingRuleByID(nil, rule2.ID)
+	require.NoError(err)
+	require.NotNil(outRule)
+
+	for _, table := range []string{"acl_auth_method", "acl_binding_rule"} {
+		index, err := state.Index(table)
+		require.NoError(err)
+		require.Equal(uint64(1002), index, table)
+	}
+}
+
+func TestStateStore_UpsertACLBindingRules(t *testing.T) {
+	require := require.New(t)
+	state := testStateStore(t)
+	rule := mock.ACLBindingRule("okta")
+	rule2 := mock.ACLBindingRule("okta")
+	rule3 := mock.ACLBindingRule("auth0")
+
+	require.NoError(state.UpsertACLBindingRules(1000, []*structs.ACLBindingRule{rule, rule2, rule3}))
+
+	out, err := state.ACLBindingRuleByID(nil, rule.ID)
+	require.NoError(err)
+	require.Equal(rule, out)
+
+	iter, err := state.ACLBindingRulesByAuthMethod(nil, "okta")
+	require.NoError(err)
+	count := 0
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		require.Equal("okta", raw.(*structs.ACLBindingRule).AuthMethod)
+		count++
+	}
+	require.Equal(2, count)
+
+	// Update the binding rule and ensure the create index is kept
+	updated := new(structs.ACLBindingRule)
+	*updated = *rule
+	updated.BindName = "ops"
+	updated.SetHash()
+	require.NoError(state.UpsertACLBindingRules(1001, []*structs.ACLBindingRule{updated}))
+
+	out, err = state.ACLBindingRuleByID(nil, rule.ID)
+	require.NoError(err)
+	require.Equal("ops", out.BindName)
+	require.Equal(uint64(1000), out.CreateIndex)
+	require.Equal(uint64(1001), out.ModifyIndex)
+
+	// Delete a binding rule
+	require.NoError(state.DeleteACLBindingRules(1002, []string{rule2.ID}))
+
+	iter, err = state.ACLBindingRules(nil)
+	require.NoError(err)
+	count = 0
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		count++
+	}
+	require.Equal(2, count)
+
+	index, err := state.Index("acl_binding_rule")
+	require.NoError(err)
+	require.Equal(uint64(1002), index)
+}
+
+func TestStateStore_RestoreACLAuthMethodsAndBindingRules(t *testing.T) {
+	require := require.New(t)
+	state := testStateStore(t)
+	method := mock.ACLAuthMethod()
+	rule := mock.ACLBindingRule(method.Name)
+
+	restore, err := state.Restore()
+	require.NoError(err)
+	require.NoError(restore.ACLAuthMethodRestore(method))
+	require.NoError(restore.ACLBindingRuleRestore(rule))
+	restore.Commit()
+
+	out, err := state.ACLAuthMethodByName(nil, method.Name)
+	require.NoError(err)
+	require.Equal(method, out)
+
+	outRule, err := state.ACLBindingRuleByID(nil, rule.ID)
+	require.NoError(err)
+	require.Equal(rule, outRule)
+}
+
 func TestStateStore_BootstrapACLTokens(t *testing.T) {
 	state := testStateStore(t)
 	tk1 := mock.ACLToken()
@@ -6879,6 +7226,31 @@ func TestStateStore_UpsertACLTokens(t *testing.T) {
 	}
 }
 
+func TestStateStore_UpsertACLTokens_Expiration(t *testing.T) {
+	require := require.New(t)
+	state := testStateStore(t)
+	tk := mock.ACLToken()
+	expiry := tk.CreateTime.Add(time.Hour)
+	tk.ExpirationTime = &expiry
+	tk.ExpirationTTL = time.Hour
+	require.NoError(state.UpsertACLTokens(1000, []*structs.ACLToken{tk}))
+
+	// Updating the token must not change its expiration
+	updated := new(structs.ACLToken)
+	*updated = *tk
+	updated.Name = "updated"
+	updated.ExpirationTime = nil
+	updated.ExpirationTTL = 0
+	require.NoError(state.UpsertACLTokens(1001, []*structs.ACLToken{updated}))
+
+	out, err := state.ACLTokenByAccessorID(nil, tk.AccessorID)
+	require.NoError(err)
+	require.Equal("updated", out.Name)
+	require.NotNil(out.ExpirationTime)
+	require.True(expiry.Equal(*out.ExpirationTime))
+	require.Equal(time.Hour, out.ExpirationTTL)
+}
+
 func TestStateStore_DeleteACLTokens(t *testing.T) {
 	state := testStateStore(t)
 	tk1 := mock.ACLToken()
@@ -7098,6 +7470,105 @@ func TestStateStore_SchedulerConfig(t *testing.T) {
 	require.Equal(schedConfig, out)
 }
 
+func TestStateStore_Variables(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	state := testStateStore(t)
+
+	newVar := func(path, keyID string) *structs.VariableEncrypted {
+		return &structs.VariableEncrypted{
+			VariableMetadata: structs.VariableMetadata{
+				Namespace:  structs.DefaultNamespace,
+				Path:       path,
+				CreateTime: 10,
+				ModifyTime: 10,
+			},
+			VariableData: structs.VariableData{
+				Data:  []byte("data"),
+				KeyID: keyID,
+			},
+		}
+	}
+
+	ws := memdb.NewWatchSet()
+	_, err := state.VariableByPath(ws, structs.DefaultNamespace, "app/db")
+	require.NoError(err)
+
+	require.NoError(state.UpsertVariable(1000, newVar("app/db", "key1")))
+	require.NoError(state.UpsertVariable(1001, newVar("app/web", "key2")))
+	require.NoError(state.UpsertVariable(1002, newVar("other", "key2")))
+	require.True(watchFired(ws))
+
+	// Updates keep the create index and time
+	update := newVar("app/db", "key2")
+	update.ModifyTime = 20
+	require.NoError(state.UpsertVariable(1003, update))
+
+	out, err := state.VariableByPath(nil, structs.DefaultNamespace, "app/db")
+	require.NoError(err)
+	require.EqualValues(1000, out.CreateIndex)
+	require.EqualValues(1003, out.ModifyIndex)
+	require.EqualValues(10, out.CreateTime)
+	require.EqualValues(20, out.ModifyTime)
+
+	index, err := state.Index("variables")
+	require.NoError(err)
+	require.EqualValues(1003, index)
+
+	// Prefix and key ID lookups
+	iter, err := state.VariablesByPathPrefix(nil, structs.DefaultNamespace, "app/")
+	require.NoError(err)
+	var paths []string
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		paths = append(paths, raw.(*structs.VariableEncrypted).Path)
+	}
+	require.Equal([]string{"app/db", "app/web"}, paths)
+
+	iter, err = state.VariablesByKeyID(nil, "key1")
+	require.NoError(err)
+	require.Nil(iter.Next())
+
+	iter, err = state.VariablesByPathPrefix(nil, "other-namespace", "")
+	require.NoError(err)
+	require.Nil(iter.Next())
+
+	// Check-and-set writes require a matching modify index
+	applied, err := state.CASVariable(1004, 1000, newVar("app/db", "key2"))
+	require.NoError(err)
+	require.False(applied)
+	applied, err = state.CASVariable(1004, 0, newVar("app/db", "key2"))
+	require.NoError(err)
+	require.False(applied)
+	applied, err = state.CASVariable(1004, 1003, newVar("app/db", "key2"))
+	require.NoError(err)
+	require.True(applied)
+	applied, err = state.CASVariable(1005, 0, newVar("app/new", "key2"))
+	require.NoError(err)
+	require.True(applied)
+
+	// Check-and-set deletes require a matching modify index
+	applied, err = state.CASDeleteVariable(1006, 1003, structs.DefaultNamespace, "app/db")
+	require.NoError(err)
+	require.False(applied)
+	applied, err = state.CASDeleteVariable(1006, 1004, structs.DefaultNamespace, "app/db")
+	require.NoError(err)
+	require.True(applied)
+
+	require.NoError(state.DeleteVariable(1007, structs.DefaultNamespace, "other"))
+
+	iter, err = state.Variables(nil)
+	require.NoError(err)
+	paths = nil
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		paths = append(paths, raw.(*structs.VariableEncrypted).Path)
+	}
+	require.Equal([]string{"app/new", "app/web"}, paths)
+
+	index, err = state.Index("variables")
+	require.NoError(err)
+	require.EqualValues(1007, index)
+}
+
 func TestStateStore_Abandon(t *testing.T) {
 	s := testStateStore(t)
 	abandonCh := s.AbandonCh()
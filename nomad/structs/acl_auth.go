@@ -0,0 +1,669 @@
+package structs
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/nomad/helper"
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	// ACLAuthMethodTypeOIDC is the type of auth methods that log in users
+	// with an OpenID Connect provider.
+	ACLAuthMethodTypeOIDC = "OIDC"
+
+	// ACLAuthMethodTokenLocalityLocal and ACLAuthMethodTokenLocalityGlobal
+	// set whether the tokens created by an auth method are local to the
+	// region of the login or replicated to all regions.
+	ACLAuthMethodTokenLocalityLocal  = "local"
+	ACLAuthMethodTokenLocalityGlobal = "global"
+
+	// ACLBindingRuleBindTypeRole and ACLBindingRuleBindTypePolicy are the
+	// types of ACL objects a binding rule can link to the tokens it creates.
+	ACLBindingRuleBindTypeRole   = "role"
+	ACLBindingRuleBindTypePolicy = "policy"
+
+	// ACLAuthMethodSigningAlgRS256 is the default algorithm of the ID tokens
+	// of an OIDC provider.
+	ACLAuthMethodSigningAlgRS256 = "RS256"
+	ACLAuthMethodSigningAlgES256 = "ES256"
+)
+
+var (
+	// validBindName matches the interpolated names of roles and policies
+	// in binding rules
+	validBindName = regexp.MustCompile(`\$\{value\.([^}]+)\}`)
+)
+
+// ACLAuthMethod is used to log in users with an external identity provider,
+// and create ACL tokens for them.
+type ACLAuthMethod struct {
+	Name          string
+	Type          string
+	TokenLocality string        // Locality of the created tokens, local or global
+	MaxTokenTTL   time.Duration // Time-to-live of the created tokens
+	Default       bool          // Used by the login command if no method is given
+	Config        *ACLAuthMethodConfig
+
+	Hash        []byte
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// ACLAuthMethodConfig is the configuration of an OIDC auth method.
+type ACLAuthMethodConfig struct {
+	// OIDCDiscoveryURL is the issuer URL of the provider, under which the
+	// OpenID configuration is discovered.
+	OIDCDiscoveryURL string
+	OIDCClientID     string
+	OIDCClientSecret string
+
+	// OIDCScopes are requested in addition to the "openid" scope.
+	OIDCScopes []string
+
+	// BoundAudiences are the audiences accepted in ID tokens. The client ID
+	// is used if empty.
+	BoundAudiences []string
+
+	// AllowedRedirectURIs are the callback URIs the provider may redirect
+	// users to.
+	AllowedRedirectURIs []string
+
+	// DiscoveryCaPem are PEM encoded CA certificates used to verify the
+	// provider's TLS certificate.
+	DiscoveryCaPem []string
+
+	// SigningAlgs are the accepted algorithms of ID tokens, RS256 if empty.
+	SigningAlgs []string
+
+	// ClaimMappings and ListClaimMappings map the names of ID token claims to
+	// the names binding rule selectors use for them.
+	ClaimMappings     map[string]string
+	ListClaimMappings map[string]string
+}
+
+// SetHash is used to compute and set the hash of the auth method
+func (a *ACLAuthMethod) SetHash() []byte {
+	// Initialize a 256bit Blake2 hash (32 bytes)
+	hash, err := blake2b.New256(nil)
+	if err != nil {
+		panic(err)
+	}
+
+	// Write all the user set fields
+	hash.Write([]byte(a.Name))
+	hash.Write([]byte(a.Type))
+	hash.Write([]byte(a.TokenLocality))
+	hash.Write([]byte(a.MaxTokenTTL.String()))
+	if a.Default {
+		hash.Write([]byte("default"))
+	}
+	if a.Config != nil {
+		// The encoding of maps is sorted by key, so it is deterministic
+		config, err := json.Marshal(a.Config)
+		if err != nil {
+			panic(err)
+		}
+		hash.Write(config)
+	}
+
+	// Finalize the hash
+	hashVal := hash.Sum(nil)
+
+	// Set and return the hash
+	a.Hash = hashVal
+	return hashVal
+}
+
+// Copy returns a deep copy of the auth method
+func (a *ACLAuthMethod) Copy() *ACLAuthMethod {
+	if a == nil {
+		return nil
+	}
+	c := new(ACLAuthMethod)
+	*c = *a
+	c.Hash = append([]byte(nil), a.Hash...)
+	if a.Config != nil {
+		config := *a.Config
+		config.OIDCScopes = helper.CopySliceString(a.Config.OIDCScopes)
+		config.BoundAudiences = helper.CopySliceString(a.Config.BoundAudiences)
+		config.AllowedRedirectURIs = helper.CopySliceString(a.Config.AllowedRedirectURIs)
+		config.DiscoveryCaPem = helper.CopySliceString(a.Config.DiscoveryCaPem)
+		config.SigningAlgs = helper.CopySliceString(a.Config.SigningAlgs)
+		config.ClaimMappings = helper.CopyMapStringString(a.Config.ClaimMappings)
+		config.ListClaimMappings = helper.CopyMapStringString(a.Config.ListClaimMappings)
+		c.Config = &config
+	}
+	return c
+}
+
+func (a *ACLAuthMethod) Stub() *ACLAuthMethodListStub {
+	return &ACLAuthMethodListStub{
+		Name:        a.Name,
+		Type:        a.Type,
+		Default:     a.Default,
+		Hash:        a.Hash,
+		CreateIndex: a.CreateIndex,
+		ModifyIndex: a.ModifyIndex,
+	}
+}
+
+// TokenLocalityIsGlobal returns whether the tokens created by the auth
+// method are global
+func (a *ACLAuthMethod) TokenLocalityIsGlobal() bool {
+	return a.TokenLocality == ACLAuthMethodTokenLocalityGlobal
+}
+
+// Validate is used to sanity check an auth method
+func (a *ACLAuthMethod) Validate() error {
+	var mErr multierror.Error
+	if !validPolicyName.MatchString(a.Name) {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid name '%s'", a.Name))
+	}
+	if a.Type != ACLAuthMethodTypeOIDC {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid type '%s'", a.Type))
+	}
+	switch a.TokenLocality {
+	case ACLAuthMethodTokenLocalityLocal, ACLAuthMethodTokenLocalityGlobal:
+	default:
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("token locality must be local or global"))
+	}
+	if a.MaxTokenTTL <= 0 {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("max token TTL must be positive"))
+	}
+
+	c := a.Config
+	if c == nil {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("missing config"))
+		return mErr.ErrorOrNil()
+	}
+	if c.OIDCDiscoveryURL == "" {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("missing OIDC discovery URL"))
+	}
+	if c.OIDCClientID == "" {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("missing OIDC client ID"))
+	}
+	if c.OIDCClientSecret == "" {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("missing OIDC client secret"))
+	}
+	if len(c.AllowedRedirectURIs) == 0 {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("missing allowed redirect URIs"))
+	}
+	for _, alg := range c.SigningAlgs {
+		switch alg {
+		case ACLAuthMethodSigningAlgRS256, ACLAuthMethodSigningAlgES256:
+		default:
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("unsupported signing algorithm '%s'", alg))
+		}
+	}
+	return mErr.ErrorOrNil()
+}
+
+// ACLAuthMethodListStub is used to for listing auth methods
+type ACLAuthMethodListStub struct {
+	Name        string
+	Type        string
+	Default     bool
+	Hash        []byte
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// ACLAuthMethodListRequest is used to request a list of auth methods
+type ACLAuthMethodListRequest struct {
+	QueryOptions
+}
+
+// ACLAuthMethodSpecificRequest is used to query a specific auth method
+type ACLAuthMethodSpecificRequest struct {
+	Name string
+	QueryOptions
+}
+
+// ACLAuthMethodSetRequest is used to query a set of auth methods
+type ACLAuthMethodSetRequest struct {
+	Names []string
+	QueryOptions
+}
+
+// ACLAuthMethodListResponse is used for a list request
+type ACLAuthMethodListResponse struct {
+	AuthMethods []*ACLAuthMethodListStub
+	QueryMeta
+}
+
+// SingleACLAuthMethodResponse is used to return a single auth method
+type SingleACLAuthMethodResponse struct {
+	AuthMethod *ACLAuthMethod
+	QueryMeta
+}
+
+// ACLAuthMethodSetResponse is used to return a set of auth methods
+type ACLAuthMethodSetResponse struct {
+	AuthMethods map[string]*ACLAuthMethod
+	QueryMeta
+}
+
+// ACLAuthMethodDeleteRequest is used to delete a set of auth methods
+type ACLAuthMethodDeleteRequest struct {
+	Names []string
+	WriteRequest
+}
+
+// ACLAuthMethodUpsertRequest is used to upsert a set of auth methods
+type ACLAuthMethodUpsertRequest struct {
+	AuthMethods []*ACLAuthMethod
+	WriteRequest
+}
+
+// ACLBindingRule links the users of an auth method whose claims match its
+// selector to a role or policy.
+type ACLBindingRule struct {
+	ID          string
+	Description string
+	AuthMethod  string
+
+	// Selector is an expression over the mapped claims of a user, such as
+	// `"engineering" in list.groups and value.team == "ops"`. An empty
+	// selector matches all users.
+	Selector string
+
+	// BindType is the type of ACL object, role or policy, named BindName.
+	// BindName may interpolate claims, such as "team-${value.team}".
+	BindType string
+	BindName string
+
+	Hash        []byte
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// SetHash is used to compute and set the hash of the binding rule
+func (b *ACLBindingRule) SetHash() []byte {
+	// Initialize a 256bit Blake2 hash (32 bytes)
+	hash, err := blake2b.New256(nil)
+	if err != nil {
+		panic(err)
+	}
+
+	// Write all the user set fields
+	hash.Write([]byte(b.ID))
+	hash.Write([]byte(b.Description))
+	hash.Write([]byte(b.AuthMethod))
+	hash.Write([]byte(b.Selector))
+	hash.Write([]byte(b.BindType))
+	hash.Write([]byte(b.BindName))
+
+	// Finalize the hash
+	hashVal := hash.Sum(nil)
+
+	// Set and return the hash
+	b.Hash = hashVal
+	return hashVal
+}
+
+func (b *ACLBindingRule) Stub() *ACLBindingRuleListStub {
+	return &ACLBindingRuleListStub{
+		ID:          b.ID,
+		Description: b.Description,
+		AuthMethod:  b.AuthMethod,
+		Hash:        b.Hash,
+		CreateIndex: b.CreateIndex,
+		ModifyIndex: b.ModifyIndex,
+	}
+}
+
+// Validate is used to sanity check a binding rule
+func (b *ACLBindingRule) Validate() error {
+	var mErr multierror.Error
+	if b.AuthMethod == "" {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("missing auth method"))
+	}
+	if len(b.Description) > maxPolicyDescriptionLength {
+		err := fmt.Errorf("description longer than %d", maxPolicyDescriptionLength)
+		mErr.Errors = append(mErr.Errors, err)
+	}
+	switch b.BindType {
+	case ACLBindingRuleBindTypeRole, ACLBindingRuleBindTypePolicy:
+	default:
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("bind type must be role or policy"))
+	}
+	if b.BindName == "" {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("missing bind name"))
+	}
+	if _, err := parseACLSelector(b.Selector); err != nil {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid selector: %v", err))
+	}
+	return mErr.ErrorOrNil()
+}
+
+// Bind returns the name of the role or policy the rule links a user with the
+// given claims to. It returns false if the selector doesn't match the claims
+// or a claim interpolated in the bind name is missing.
+func (b *ACLBindingRule) Bind(claims *ACLAuthClaims) (string, bool, error) {
+	selector, err := parseACLSelector(b.Selector)
+	if err != nil {
+		return "", false, err
+	}
+	if !selector.matches(claims) {
+		return "", false, nil
+	}
+
+	missing := false
+	name := validBindName.ReplaceAllStringFunc(b.BindName, func(s string) string {
+		v, ok := claims.Value[validBindName.FindStringSubmatch(s)[1]]
+		if !ok {
+			missing = true
+		}
+		return v
+	})
+	if missing || name == "" {
+		return "", false, nil
+	}
+	return name, true, nil
+}
+
+// ACLBindingRuleListStub is used to for listing binding rules
+type ACLBindingRuleListStub struct {
+	ID          string
+	Description string
+	AuthMethod  string
+	Hash        []byte
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// ACLBindingRuleListRequest is used to request a list of binding rules
+type ACLBindingRuleListRequest struct {
+	QueryOptions
+}
+
+// ACLBindingRuleSpecificRequest is used to query a specific binding rule
+type ACLBindingRuleSpecificRequest struct {
+	ID string
+	QueryOptions
+}
+
+// ACLBindingRuleSetRequest is used to query a set of binding rules
+type ACLBindingRuleSetRequest struct {
+	IDs []string
+	QueryOptions
+}
+
+// ACLBindingRuleListResponse is used for a list request
+type ACLBindingRuleListResponse struct {
+	BindingRules []*ACLBindingRuleListStub
+	QueryMeta
+}
+
+// SingleACLBindingRuleResponse is used to return a single binding rule
+type SingleACLBindingRuleResponse struct {
+	BindingRule *ACLBindingRule
+	QueryMeta
+}
+
+// ACLBindingRuleSetResponse is used to return a set of binding rules
+type ACLBindingRuleSetResponse struct {
+	BindingRules map[string]*ACLBindingRule
+	QueryMeta
+}
+
+// ACLBindingRuleDeleteRequest is used to delete a set of binding rules
+type ACLBindingRuleDeleteRequest struct {
+	IDs []string
+	WriteRequest
+}
+
+// ACLBindingRuleUpsertRequest is used to upsert a set of binding rules
+type ACLBindingRuleUpsertRequest struct {
+	BindingRules []*ACLBindingRule
+	WriteRequest
+}
+
+// ACLBindingRuleUpsertResponse is used to return from an
+// ACLBindingRuleUpsertRequest, with the IDs of created rules
+type ACLBindingRuleUpsertResponse struct {
+	BindingRules []*ACLBindingRule
+	WriteMeta
+}
+
+// ACLAuthClaims are the claims of a user mapped by an auth method, which
+// binding rules select on.
+type ACLAuthClaims struct {
+	Value map[string]string
+	List  map[string][]string
+}
+
+// ACLOIDCAuthURLRequest is used to start an OIDC login
+type ACLOIDCAuthURLRequest struct {
+	AuthMethodName string
+
+	// RedirectURI is the callback URI the provider redirects the user to.
+	RedirectURI string
+
+	// ClientNonce is a random value kept by the client to complete the
+	// login, so a leaked authorization code can't be used by another party.
+	ClientNonce string
+
+	WriteRequest
+}
+
+// Validate checks the request has all the required fields
+func (r *ACLOIDCAuthURLRequest) Validate() error {
+	var mErr multierror.Error
+	if r.AuthMethodName == "" {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("missing auth method name"))
+	}
+	if r.RedirectURI == "" {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("missing redirect URI"))
+	}
+	if r.ClientNonce == "" {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("missing client nonce"))
+	}
+	return mErr.ErrorOrNil()
+}
+
+// ACLOIDCAuthURLResponse returns the URL the user logs in at
+type ACLOIDCAuthURLResponse struct {
+	AuthURL string
+	WriteMeta
+}
+
+// ACLOIDCCompleteAuthRequest is used to complete an OIDC login with the
+// parameters the provider redirected the user with
+type ACLOIDCCompleteAuthRequest struct {
+	AuthMethodName string
+	ClientNonce    string
+	State          string
+	Code           string
+	RedirectURI    string
+	WriteRequest
+}
+
+// Validate checks the request has all the required fields
+func (r *ACLOIDCCompleteAuthRequest) Validate() error {
+	var mErr multierror.Error
+	if r.AuthMethodName == "" {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("missing auth method name"))
+	}
+	if r.ClientNonce == "" {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("missing client nonce"))
+	}
+	if r.State == "" {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("missing state"))
+	}
+	if r.Code == "" {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("missing code"))
+	}
+	if r.RedirectURI == "" {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("missing redirect URI"))
+	}
+	return mErr.ErrorOrNil()
+}
+
+// ACLLoginResponse returns the token created by a login
+type ACLLoginResponse struct {
+	ACLToken *ACLToken
+	WriteMeta
+}
+
+// aclSelector is a parsed binding rule selector. It matches if all of its
+// clauses match.
+type aclSelector struct {
+	clauses []aclSelectorClause
+}
+
+// aclSelectorClause is either `value.<claim> (==|!=) "<string>"` or
+// `"<string>" (in|not in) list.<claim>`.
+type aclSelectorClause struct {
+	claim  string
+	value  string
+	list   bool
+	negate bool
+}
+
+func (s *aclSelector) matches(claims *ACLAuthClaims) bool {
+	for _, c := range s.clauses {
+		var match bool
+		if c.list {
+			for _, v := range claims.List[c.claim] {
+				if v == c.value {
+					match = true
+					break
+				}
+			}
+		} else {
+			v, ok := claims.Value[c.claim]
+			match = ok && v == c.value
+		}
+		if match == c.negate {
+			return false
+		}
+	}
+	return true
+}
+
+// parseACLSelector parses a binding rule selector. Clauses are joined with
+// "and".
+func parseACLSelector(selector string) (*aclSelector, error) {
+	tokens, err := scanACLSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &aclSelector{}
+	for len(tokens) > 0 {
+		if len(s.clauses) > 0 {
+			if tokens[0] != "and" {
+				return nil, fmt.Errorf("expected \"and\", found %q", tokens[0])
+			}
+			tokens = tokens[1:]
+		}
+
+		var clause aclSelectorClause
+		var n int
+		clause, n, err = parseACLSelectorClause(tokens)
+		if err != nil {
+			return nil, err
+		}
+		s.clauses = append(s.clauses, clause)
+		tokens = tokens[n:]
+	}
+	return s, nil
+}
+
+func parseACLSelectorClause(tokens []string) (aclSelectorClause, int, error) {
+	var c aclSelectorClause
+	if len(tokens) < 3 {
+		return c, 0, fmt.Errorf("incomplete expression")
+	}
+
+	// `value.<claim> (==|!=) "<string>"`
+	if strings.HasPrefix(tokens[0], "value.") {
+		c.claim = strings.TrimPrefix(tokens[0], "value.")
+		switch tokens[1] {
+		case "==":
+		case "!=":
+			c.negate = true
+		default:
+			return c, 0, fmt.Errorf("expected \"==\" or \"!=\", found %q", tokens[1])
+		}
+		v, err := unquoteACLSelector(tokens[2])
+		if err != nil {
+			return c, 0, err
+		}
+		c.value = v
+		return c, 3, nil
+	}
+
+	// `"<string>" (in|not in) list.<claim>`
+	v, err := unquoteACLSelector(tokens[0])
+	if err != nil {
+		return c, 0, err
+	}
+	c.value = v
+	c.list = true
+	n := 1
+	if tokens[n] == "not" {
+		c.negate = true
+		n++
+	}
+	if n >= len(tokens) || tokens[n] != "in" {
+		return c, 0, fmt.Errorf("expected \"in\" after %s", tokens[0])
+	}
+	n++
+	if n >= len(tokens) || !strings.HasPrefix(tokens[n], "list.") {
+		return c, 0, fmt.Errorf("expected a list claim")
+	}
+	c.claim = strings.TrimPrefix(tokens[n], "list.")
+	return c, n + 1, nil
+}
+
+func unquoteACLSelector(token string) (string, error) {
+	if !strings.HasPrefix(token, `"`) {
+		return "", fmt.Errorf("expected a quoted string, found %q", token)
+	}
+	return strconv.Unquote(token)
+}
+
+// scanACLSelector splits a selector into quoted strings, operators and words.
+func scanACLSelector(selector string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(selector); {
+		switch c := selector[i]; {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+		case c == '"':
+			j := i + 1
+			for ; j < len(selector) && selector[j] != '"'; j++ {
+				if selector[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(selector) {
+				return nil, fmt.Errorf("unterminated string")
+			}
+			tokens = append(tokens, selector[i:j+1])
+			i = j + 1
+		case c == '=' || c == '!':
+			if i+1 >= len(selector) || selector[i+1] != '=' {
+				return nil, fmt.Errorf("unexpected %q", c)
+			}
+			tokens = append(tokens, selector[i:i+2])
+			i += 2
+		default:
+			j := i
+			for ; j < len(selector) && !strings.ContainsRune(" \t\n\"=!", rune(selector[j])); j++ {
+			}
+			tokens = append(tokens, selector[i:j])
+			i = j
+		}
+	}
+	return tokens, nil
+}
@@ -0,0 +1,209 @@
+package structs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestACLAuthMethod_Validate(t *testing.T) {
+	method := &ACLAuthMethod{
+		Name:          "okta",
+		Type:          ACLAuthMethodTypeOIDC,
+		TokenLocality: ACLAuthMethodTokenLocalityGlobal,
+		MaxTokenTTL:   time.Hour,
+		Config: &ACLAuthMethodConfig{
+			OIDCDiscoveryURL:    "https://example.okta.com",
+			OIDCClientID:        "nomad",
+			OIDCClientSecret:    "secret",
+			AllowedRedirectURIs: []string{"http://localhost:4649/oidc/callback"},
+		},
+	}
+	require.NoError(t, method.Validate())
+	require.True(t, method.TokenLocalityIsGlobal())
+
+	method.Type = "LDAP"
+	method.TokenLocality = "nowhere"
+	method.MaxTokenTTL = 0
+	method.Config.OIDCClientSecret = ""
+	method.Config.SigningAlgs = []string{"HS256"}
+	err := method.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid type")
+	require.Contains(t, err.Error(), "token locality")
+	require.Contains(t, err.Error(), "max token TTL")
+	require.Contains(t, err.Error(), "client secret")
+	require.Contains(t, err.Error(), "HS256")
+
+	method.Config = nil
+	require.Contains(t, method.Validate().Error(), "missing config")
+}
+
+func TestACLAuthMethod_SetHash(t *testing.T) {
+	method := &ACLAuthMethod{
+		Name:   "okta",
+		Type:   ACLAuthMethodTypeOIDC,
+		Config: &ACLAuthMethodConfig{OIDCClientID: "nomad"},
+	}
+	out1 := method.SetHash()
+	require.NotEmpty(t, out1)
+	require.Equal(t, out1, method.Hash)
+
+	method.Config.OIDCClientID = "other"
+	out2 := method.SetHash()
+	require.NotEqual(t, out1, out2)
+}
+
+func TestACLBindingRule_Validate(t *testing.T) {
+	rule := &ACLBindingRule{
+		AuthMethod: "okta",
+		Selector:   `"ops" in list.groups and value.team != "infra"`,
+		BindType:   ACLBindingRuleBindTypeRole,
+		BindName:   "ops",
+	}
+	require.NoError(t, rule.Validate())
+
+	rule.AuthMethod = ""
+	rule.BindType = "user"
+	rule.BindName = ""
+	rule.Selector = `value.team = "ops"`
+	err := rule.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "missing auth method")
+	require.Contains(t, err.Error(), "bind type")
+	require.Contains(t, err.Error(), "missing bind name")
+	require.Contains(t, err.Error(), "invalid selector")
+}
+
+func TestACLBindingRule_Bind(t *testing.T) {
+	claims := &ACLAuthClaims{
+		Value: map[string]string{"team": "ops", "email": "jane@example.com"},
+		List:  map[string][]string{"groups": {"engineering", "admins"}},
+	}
+
+	cases := []struct {
+		Name     string
+		Selector string
+		BindName string
+		Expected string
+		Bound    bool
+		Err      string
+	}{
+		{
+			Name:     "empty selector",
+			BindName: "dev",
+			Expected: "dev",
+			Bound:    true,
+		},
+		{
+			Name:     "value equal",
+			Selector: `value.team == "ops"`,
+			BindName: "dev",
+			Expected: "dev",
+			Bound:    true,
+		},
+		{
+			Name:     "value not equal",
+			Selector: `value.team != "ops"`,
+			BindName: "dev",
+		},
+		{
+			Name:     "missing value",
+			Selector: `value.region == "eu"`,
+			BindName: "dev",
+		},
+		{
+			Name:     "list membership",
+			Selector: `"admins" in list.groups and "contractors" not in list.groups`,
+			BindName: "admin",
+			Expected: "admin",
+			Bound:    true,
+		},
+		{
+			Name:     "list non-membership",
+			Selector: `"admins" not in list.groups`,
+			BindName: "admin",
+		},
+		{
+			Name:     "interpolated",
+			Selector: `"engineering" in list.groups`,
+			BindName: "team-${value.team}",
+			Expected: "team-ops",
+			Bound:    true,
+		},
+		{
+			Name:     "interpolated missing claim",
+			BindName: "team-${value.region}",
+		},
+		{
+			Name:     "invalid selector",
+			Selector: `"admins" in groups`,
+			BindName: "admin",
+			Err:      "expected a list claim",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			rule := &ACLBindingRule{
+				Selector: c.Selector,
+				BindType: ACLBindingRuleBindTypeRole,
+				BindName: c.BindName,
+			}
+			name, bound, err := rule.Bind(claims)
+			if c.Err != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), c.Err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.Bound, bound)
+			require.Equal(t, c.Expected, name)
+		})
+	}
+}
+
+func TestParseACLSelector(t *testing.T) {
+	s, err := parseACLSelector(`value.email == "a \"quoted\" b" and "x y" not in list.groups`)
+	require.NoError(t, err)
+	require.Equal(t, []aclSelectorClause{
+		{claim: "email", value: `a "quoted" b`},
+		{claim: "groups", value: "x y", list: true, negate: true},
+	}, s.clauses)
+
+	for _, bad := range []string{
+		`value.team`,
+		`value.team == ops`,
+		`value.team == "ops" or value.team == "dev"`,
+		`"ops" at list.groups`,
+		`value.team == "ops`,
+		`value.team =! "ops"`,
+	} {
+		_, err := parseACLSelector(bad)
+		require.Error(t, err, bad)
+	}
+}
+
+func TestACLOIDCRequests_Validate(t *testing.T) {
+	authURL := &ACLOIDCAuthURLRequest{}
+	err := authURL.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "auth method name")
+	require.Contains(t, err.Error(), "redirect URI")
+	require.Contains(t, err.Error(), "client nonce")
+
+	complete := &ACLOIDCCompleteAuthRequest{
+		AuthMethodName: "okta",
+		ClientNonce:    "nonce",
+		RedirectURI:    "http://localhost:4649/oidc/callback",
+	}
+	err = complete.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "missing state")
+	require.Contains(t, err.Error(), "missing code")
+
+	complete.State = "state"
+	complete.Code = "code"
+	require.NoError(t, complete.Validate())
+}
@@ -115,6 +115,17 @@ func (j *Job) Diff(other *Job, contextual bool) (*JobDiff, error) {
 		diff.Objects = append(diff.Objects, affinitiesDiff...)
 	}
 
+	// Dependencies diff
+	depDiff := primitiveObjectSetDiff(
+		interfaceSlice(j.DependsOn),
+		interfaceSlice(other.DependsOn),
+		nil,
+		"DependsOn",
+		contextual)
+	if depDiff != nil {
+		diff.Objects = append(diff.Objects, depDiff...)
+	}
+
 	// Task groups diff
 	tgs, err := taskGroupDiffs(j.TaskGroups, other.TaskGroups, contextual)
 	if err != nil {
@@ -123,7 +134,7 @@ func (j *Job) Diff(other *Job, contextual bool) (*JobDiff, error) {
 	diff.TaskGroups = tgs
 
 	// Periodic diff
-	if pDiff := primitiveObjectDiff(j.Periodic, other.Periodic, nil, "Periodic", contextual); pDiff != nil {
+	if pDiff := periodicDiff(j.Periodic, other.Periodic, contextual); pDiff != nil {
 		diff.Objects = append(diff.Objects, pDiff)
 	}
 
@@ -273,6 +284,11 @@ func (tg *TaskGroup) Diff(other *TaskGroup, contextual bool) (*TaskGroupDiff, er
 		diff.Objects = append(diff.Objects, uDiff)
 	}
 
+	// Array diff
+	if aDiff := primitiveObjectDiff(tg.Array, other.Array, nil, "Array", contextual); aDiff != nil {
+		diff.Objects = append(diff.Objects, aDiff)
+	}
+
 	// Network Resources diff
 	if nDiffs := networkResourceDiffs(tg.Networks, other.Networks, contextual); nDiffs != nil {
 		diff.Objects = append(diff.Objects, nDiffs...)
@@ -451,6 +467,12 @@ func (t *Task) Diff(other *Task, contextual bool) (*TaskDiff, error) {
 		diff.Objects = append(diff.Objects, dDiff)
 	}
 
+	// Identity diff
+	iDiff := primitiveObjectDiff(t.Identity, other.Identity, nil, "Identity", contextual)
+	if iDiff != nil {
+		diff.Objects = append(diff.Objects, iDiff)
+	}
+
 	// Artifacts diff
 	diffs := primitiveObjectSetDiff(
 		interfaceSlice(t.Artifacts),
@@ -474,13 +496,7 @@ func (t *Task) Diff(other *Task, contextual bool) (*TaskDiff, error) {
 	}
 
 	// Template diff
-	tmplDiffs := primitiveObjectSetDiff(
-		interfaceSlice(t.Templates),
-		interfaceSlice(other.Templates),
-		nil,
-		"Template",
-		contextual)
-	if tmplDiffs != nil {
+	if tmplDiffs := templateDiffs(t.Templates, other.Templates, contextual); tmplDiffs != nil {
 		diff.Objects = append(diff.Objects, tmplDiffs...)
 	}
 
@@ -943,6 +959,108 @@ func vaultDiff(old, new *Vault, contextual bool) *ObjectDiff {
 	return diff
 }
 
+// templateDiff returns the diff of two template objects. If contextual diff is
+// enabled, all fields will be returned, even if no diff occurred.
+func templateDiff(old, new *Template, contextual bool) *ObjectDiff {
+	diff := &ObjectDiff{Type: DiffTypeNone, Name: "Template"}
+	var oldPrimitiveFlat, newPrimitiveFlat map[string]string
+
+	if reflect.DeepEqual(old, new) {
+		return nil
+	} else if old == nil {
+		old = &Template{}
+		diff.Type = DiffTypeAdded
+		newPrimitiveFlat = flatmap.Flatten(new, nil, true)
+	} else if new == nil {
+		new = &Template{}
+		diff.Type = DiffTypeDeleted
+		oldPrimitiveFlat = flatmap.Flatten(old, nil, true)
+	} else {
+		diff.Type = DiffTypeEdited
+		oldPrimitiveFlat = flatmap.Flatten(old, nil, true)
+		newPrimitiveFlat = flatmap.Flatten(new, nil, true)
+	}
+
+	// Diff the primitive fields.
+	diff.Fields = fieldDiffs(oldPrimitiveFlat, newPrimitiveFlat, contextual)
+
+	// ChangeScript diff
+	if csDiff := changeScriptDiff(old.ChangeScript, new.ChangeScript, contextual); csDiff != nil {
+		diff.Objects = append(diff.Objects, csDiff)
+	}
+
+	return diff
+}
+
+// templateDiffs diffs a set of templates, matching them by their destination.
+// If contextual diff is enabled, unchanged fields within objects nested in the
+// templates will be returned.
+func templateDiffs(old, new []*Template, contextual bool) []*ObjectDiff {
+	oldMap := make(map[string]*Template, len(old))
+	newMap := make(map[string]*Template, len(new))
+	for _, o := range old {
+		oldMap[o.DestPath] = o
+	}
+	for _, n := range new {
+		newMap[n.DestPath] = n
+	}
+
+	var diffs []*ObjectDiff
+	for dest, oldTmpl := range oldMap {
+		// Diff the same, deleted and edited
+		if diff := templateDiff(oldTmpl, newMap[dest], contextual); diff != nil {
+			diffs = append(diffs, diff)
+		}
+	}
+
+	for dest, newTmpl := range newMap {
+		// Diff the added
+		if old, ok := oldMap[dest]; !ok {
+			if diff := templateDiff(old, newTmpl, contextual); diff != nil {
+				diffs = append(diffs, diff)
+			}
+		}
+	}
+
+	sort.Sort(ObjectDiffs(diffs))
+	return diffs
+}
+
+// changeScriptDiff returns the diff of two change script objects. If
+// contextual diff is enabled, all fields will be returned, even if no diff
+// occurred.
+func changeScriptDiff(old, new *ChangeScript, contextual bool) *ObjectDiff {
+	diff := &ObjectDiff{Type: DiffTypeNone, Name: "ChangeScript"}
+	var oldFlat, newFlat map[string]string
+
+	// The args are ordered so each of them is diffed as a field
+	flatten := func(cs *ChangeScript) map[string]string {
+		flat := flatmap.Flatten(cs, nil, true)
+		for i, arg := range cs.Args {
+			flat[fmt.Sprintf("Args[%d]", i)] = arg
+		}
+		return flat
+	}
+
+	if reflect.DeepEqual(old, new) {
+		return nil
+	} else if old == nil {
+		diff.Type = DiffTypeAdded
+		newFlat = flatten(new)
+	} else if new == nil {
+		diff.Type = DiffTypeDeleted
+		oldFlat = flatten(old)
+	} else {
+		diff.Type = DiffTypeEdited
+		oldFlat = flatten(old)
+		newFlat = flatten(new)
+	}
+
+	// Diff the fields.
+	diff.Fields = fieldDiffs(oldFlat, newFlat, contextual)
+	return diff
+}
+
 // parameterizedJobDiff returns the diff of two parameterized job objects. If
 // contextual diff is enabled, all fields will be returned, even if no diff
 // occurred.
@@ -981,6 +1099,37 @@ func parameterizedJobDiff(old, new *ParameterizedJobConfig, contextual bool) *Ob
 	return diff
 }
 
+// periodicDiff returns the diff of two periodic configs. If contextual diff is
+// enabled, all fields will be returned, even if no diff occurred.
+func periodicDiff(old, new *PeriodicConfig, contextual bool) *ObjectDiff {
+	diff := primitiveObjectDiff(old, new, nil, "Periodic", contextual)
+
+	var oldSpecs, newSpecs, oldExclusions, newExclusions []string
+	if old != nil {
+		oldSpecs, oldExclusions = old.Specs, old.Exclusions
+	}
+	if new != nil {
+		newSpecs, newExclusions = new.Specs, new.Exclusions
+	}
+
+	var objects []*ObjectDiff
+	if setDiff := stringSetDiff(oldSpecs, newSpecs, "Specs", contextual); setDiff != nil && setDiff.Type != DiffTypeNone {
+		objects = append(objects, setDiff)
+	}
+	if setDiff := stringSetDiff(oldExclusions, newExclusions, "Exclusions", contextual); setDiff != nil && setDiff.Type != DiffTypeNone {
+		objects = append(objects, setDiff)
+	}
+	if len(objects) == 0 {
+		return diff
+	}
+
+	if diff == nil {
+		diff = &ObjectDiff{Type: DiffTypeEdited, Name: "Periodic"}
+	}
+	diff.Objects = append(diff.Objects, objects...)
+	return diff
+}
+
 // Diff returns a diff of two resource objects. If contextual diff is enabled,
 // non-changed fields will still be returned.
 func (r *Resources) Diff(other *Resources, contextual bool) *ObjectDiff {
@@ -1055,6 +1204,41 @@ func (r *NetworkResource) Diff(other *NetworkResource, contextual bool) *ObjectD
 		diff.Objects = append(diff.Objects, dynPorts...)
 	}
 
+	// DNS diff
+	if dnsDiff := r.DNS.Diff(other.DNS, contextual); dnsDiff != nil {
+		diff.Objects = append(diff.Objects, dnsDiff)
+	}
+
+	return diff
+}
+
+// Diff returns a diff of two DNS configurations. If contextual diff is
+// enabled, non-changed fields will still be returned.
+func (d *DNSConfig) Diff(other *DNSConfig, contextual bool) *ObjectDiff {
+	diff := &ObjectDiff{Type: DiffTypeNone, Name: "DNS"}
+
+	if reflect.DeepEqual(d, other) {
+		return nil
+	} else if d == nil {
+		d = &DNSConfig{}
+		diff.Type = DiffTypeAdded
+	} else if other == nil {
+		other = &DNSConfig{}
+		diff.Type = DiffTypeDeleted
+	} else {
+		diff.Type = DiffTypeEdited
+	}
+
+	if setDiff := stringSetDiff(d.Servers, other.Servers, "Servers", contextual); setDiff != nil {
+		diff.Objects = append(diff.Objects, setDiff)
+	}
+	if setDiff := stringSetDiff(d.Searches, other.Searches, "Searches", contextual); setDiff != nil {
+		diff.Objects = append(diff.Objects, setDiff)
+	}
+	if setDiff := stringSetDiff(d.Options, other.Options, "Options", contextual); setDiff != nil {
+		diff.Objects = append(diff.Objects, setDiff)
+	}
+
 	return diff
 }
 
@@ -1114,10 +1298,11 @@ func portDiffs(old, new []Port, dynamic bool, contextual bool) []*ObjectDiff {
 	oldPorts := makeSet(old)
 	newPorts := makeSet(new)
 
-	var filter []string
+	// The host IP is set by the scheduler
+	filter := []string{"HostIP"}
 	name := "Static Port"
 	if dynamic {
-		filter = []string{"Value"}
+		filter = append(filter, "Value")
 		name = "Dynamic Port"
 	}
 
@@ -168,6 +168,12 @@ func TestJobDiff(t *testing.T) {
 						Old:  "foo",
 						New:  "",
 					},
+					{
+						Type: DiffTypeDeleted,
+						Name: "Paused",
+						Old:  "false",
+						New:  "",
+					},
 					{
 						Type: DiffTypeDeleted,
 						Name: "Priority",
@@ -237,6 +243,12 @@ func TestJobDiff(t *testing.T) {
 						Old:  "",
 						New:  "foo",
 					},
+					{
+						Type: DiffTypeAdded,
+						Name: "Paused",
+						Old:  "",
+						New:  "false",
+					},
 					{
 						Type: DiffTypeAdded,
 						Name: "Priority",
@@ -264,6 +276,35 @@ func TestJobDiff(t *testing.T) {
 				},
 			},
 		},
+		{
+			// Paused job
+			Old: &Job{
+				Paused: false,
+			},
+			New: &Job{
+				Paused: true,
+				PausedCounts: map[string]int{
+					"web": 3,
+				},
+			},
+			Expected: &JobDiff{
+				Type: DiffTypeEdited,
+				Fields: []*FieldDiff{
+					{
+						Type: DiffTypeEdited,
+						Name: "Paused",
+						Old:  "false",
+						New:  "true",
+					},
+					{
+						Type: DiffTypeAdded,
+						Name: "PausedCounts[web]",
+						Old:  "",
+						New:  "3",
+					},
+				},
+			},
+		},
 		{
 			// Map diff
 			Old: &Job{
@@ -457,6 +498,12 @@ func TestJobDiff(t *testing.T) {
 								Old:  "",
 								New:  "false",
 							},
+							{
+								Type: DiffTypeAdded,
+								Name: "MaxCatchUp",
+								Old:  "",
+								New:  "0",
+							},
 							{
 								Type: DiffTypeAdded,
 								Name: "ProhibitOverlap",
@@ -511,6 +558,12 @@ func TestJobDiff(t *testing.T) {
 								Old:  "false",
 								New:  "",
 							},
+							{
+								Type: DiffTypeDeleted,
+								Name: "MaxCatchUp",
+								Old:  "0",
+								New:  "",
+							},
 							{
 								Type: DiffTypeDeleted,
 								Name: "ProhibitOverlap",
@@ -602,6 +655,66 @@ func TestJobDiff(t *testing.T) {
 				},
 			},
 		},
+		{
+			// Periodic specs and exclusions edited
+			Old: &Job{
+				Periodic: &PeriodicConfig{
+					Enabled:    true,
+					SpecType:   "cron",
+					Specs:      []string{"0 9 * * 1-5", "0 12 * * 6"},
+					Exclusions: []string{"2019-12-25"},
+				},
+			},
+			New: &Job{
+				Periodic: &PeriodicConfig{
+					Enabled:    true,
+					SpecType:   "cron",
+					Specs:      []string{"0 9 * * 1-5", "0 10 * * 6"},
+					Exclusions: []string{"2019-12-25", "2020-01-01"},
+				},
+			},
+			Expected: &JobDiff{
+				Type: DiffTypeEdited,
+				Objects: []*ObjectDiff{
+					{
+						Type: DiffTypeEdited,
+						Name: "Periodic",
+						Objects: []*ObjectDiff{
+							{
+								Type: DiffTypeEdited,
+								Name: "Specs",
+								Fields: []*FieldDiff{
+									{
+										Type: DiffTypeAdded,
+										Name: "Specs",
+										Old:  "",
+										New:  "0 10 * * 6",
+									},
+									{
+										Type: DiffTypeDeleted,
+										Name: "Specs",
+										Old:  "0 12 * * 6",
+										New:  "",
+									},
+								},
+							},
+							{
+								Type: DiffTypeAdded,
+								Name: "Exclusions",
+								Fields: []*FieldDiff{
+									{
+										Type: DiffTypeAdded,
+										Name: "Exclusions",
+										Old:  "",
+										New:  "2020-01-01",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			// Periodic edited with context
 			Contextual: true,
@@ -630,12 +743,24 @@ func TestJobDiff(t *testing.T) {
 						Type: DiffTypeEdited,
 						Name: "Periodic",
 						Fields: []*FieldDiff{
+							{
+								Type: DiffTypeNone,
+								Name: "CatchUp",
+								Old:  "",
+								New:  "",
+							},
 							{
 								Type: DiffTypeEdited,
 								Name: "Enabled",
 								Old:  "false",
 								New:  "true",
 							},
+							{
+								Type: DiffTypeNone,
+								Name: "MaxCatchUp",
+								Old:  "0",
+								New:  "0",
+							},
 							{
 								Type: DiffTypeNone,
 								Name: "ProhibitOverlap",
@@ -1962,6 +2087,38 @@ func TestTaskGroupDiff(t *testing.T) {
 				},
 			},
 		},
+		{
+			// Array edited
+			Old: &TaskGroup{
+				Array: &ArrayConfig{
+					Size:        10,
+					MaxParallel: 2,
+				},
+			},
+			New: &TaskGroup{
+				Array: &ArrayConfig{
+					Size:        20,
+					MaxParallel: 2,
+				},
+			},
+			Expected: &TaskGroupDiff{
+				Type: DiffTypeEdited,
+				Objects: []*ObjectDiff{
+					{
+						Type: DiffTypeEdited,
+						Name: "Array",
+						Fields: []*FieldDiff{
+							{
+								Type: DiffTypeEdited,
+								Name: "Size",
+								Old:  "10",
+								New:  "20",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			// Update strategy deleted
 			Old: &TaskGroup{
@@ -2013,6 +2170,12 @@ func TestTaskGroupDiff(t *testing.T) {
 								Old:  "0",
 								New:  "",
 							},
+							{
+								Type: DiffTypeDeleted,
+								Name: "PauseAfterBatch",
+								Old:  "false",
+								New:  "",
+							},
 							{
 								Type: DiffTypeDeleted,
 								Name: "ProgressDeadline",
@@ -2075,6 +2238,12 @@ func TestTaskGroupDiff(t *testing.T) {
 								Old:  "",
 								New:  "0",
 							},
+							{
+								Type: DiffTypeAdded,
+								Name: "PauseAfterBatch",
+								Old:  "",
+								New:  "false",
+							},
 							{
 								Type: DiffTypeAdded,
 								Name: "ProgressDeadline",
@@ -2248,6 +2417,12 @@ func TestTaskGroupDiff(t *testing.T) {
 								Old:  "1000000000",
 								New:  "1000000000",
 							},
+							{
+								Type: DiffTypeNone,
+								Name: "PauseAfterBatch",
+								Old:  "false",
+								New:  "false",
+							},
 							{
 								Type: DiffTypeNone,
 								Name: "ProgressDeadline",
@@ -2830,6 +3005,12 @@ func TestTaskGroupDiff(t *testing.T) {
 								Type: DiffTypeAdded,
 								Name: "Dynamic Port",
 								Fields: []*FieldDiff{
+									{
+										Type: DiffTypeNone,
+										Name: "HostNetwork",
+										Old:  "",
+										New:  "",
+									},
 									{
 										Type: DiffTypeAdded,
 										Name: "Label",
@@ -2868,6 +3049,12 @@ func TestTaskGroupDiff(t *testing.T) {
 								Type: DiffTypeDeleted,
 								Name: "Static Port",
 								Fields: []*FieldDiff{
+									{
+										Type: DiffTypeNone,
+										Name: "HostNetwork",
+										Old:  "",
+										New:  "",
+									},
 									{
 										Type: DiffTypeDeleted,
 										Name: "Label",
@@ -4359,6 +4546,18 @@ func TestTaskDiff(t *testing.T) {
 								Old:  "2",
 								New:  "2",
 							},
+							{
+								Type: DiffTypeNone,
+								Name: "boom.HostIP",
+								Old:  "",
+								New:  "",
+							},
+							{
+								Type: DiffTypeNone,
+								Name: "boom.HostNetwork",
+								Old:  "",
+								New:  "",
+							},
 							{
 								Type: DiffTypeNone,
 								Name: "boom.Label",
@@ -5759,6 +5958,226 @@ func TestTaskDiff(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "Template change script added",
+			Old: &Task{
+				Templates: []*Template{
+					{
+						DestPath:     "bar",
+						EmbeddedTmpl: "baz",
+						ChangeMode:   "restart",
+					},
+				},
+			},
+			New: &Task{
+				Templates: []*Template{
+					{
+						DestPath:     "bar",
+						EmbeddedTmpl: "baz",
+						ChangeMode:   "script",
+						ChangeScript: &ChangeScript{
+							Command:     "/bin/reload",
+							Args:        []string{"-v"},
+							Timeout:     5 * time.Second,
+							FailOnError: true,
+						},
+					},
+				},
+			},
+			Expected: &TaskDiff{
+				Type: DiffTypeEdited,
+				Objects: []*ObjectDiff{
+					{
+						Type: DiffTypeEdited,
+						Name: "Template",
+						Fields: []*FieldDiff{
+							{
+								Type: DiffTypeEdited,
+								Name: "ChangeMode",
+								Old:  "restart",
+								New:  "script",
+							},
+						},
+						Objects: []*ObjectDiff{
+							{
+								Type: DiffTypeAdded,
+								Name: "ChangeScript",
+								Fields: []*FieldDiff{
+									{
+										Type: DiffTypeAdded,
+										Name: "Args[0]",
+										Old:  "",
+										New:  "-v",
+									},
+									{
+										Type: DiffTypeAdded,
+										Name: "Command",
+										Old:  "",
+										New:  "/bin/reload",
+									},
+									{
+										Type: DiffTypeAdded,
+										Name: "FailOnError",
+										Old:  "",
+										New:  "true",
+									},
+									{
+										Type: DiffTypeAdded,
+										Name: "Timeout",
+										Old:  "",
+										New:  "5000000000",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "Template change script edited",
+			Old: &Task{
+				Templates: []*Template{
+					{
+						DestPath:   "bar",
+						ChangeMode: "script",
+						ChangeScript: &ChangeScript{
+							Command:     "/bin/reload",
+							Args:        []string{"-v"},
+							Timeout:     5 * time.Second,
+							FailOnError: true,
+						},
+					},
+				},
+			},
+			New: &Task{
+				Templates: []*Template{
+					{
+						DestPath:   "bar",
+						ChangeMode: "script",
+						ChangeScript: &ChangeScript{
+							Command:     "/bin/refresh",
+							Args:        []string{"-q", "-f"},
+							Timeout:     10 * time.Second,
+							FailOnError: false,
+						},
+					},
+				},
+			},
+			Expected: &TaskDiff{
+				Type: DiffTypeEdited,
+				Objects: []*ObjectDiff{
+					{
+						Type: DiffTypeEdited,
+						Name: "Template",
+						Objects: []*ObjectDiff{
+							{
+								Type: DiffTypeEdited,
+								Name: "ChangeScript",
+								Fields: []*FieldDiff{
+									{
+										Type: DiffTypeEdited,
+										Name: "Args[0]",
+										Old:  "-v",
+										New:  "-q",
+									},
+									{
+										Type: DiffTypeAdded,
+										Name: "Args[1]",
+										Old:  "",
+										New:  "-f",
+									},
+									{
+										Type: DiffTypeEdited,
+										Name: "Command",
+										Old:  "/bin/reload",
+										New:  "/bin/refresh",
+									},
+									{
+										Type: DiffTypeEdited,
+										Name: "FailOnError",
+										Old:  "true",
+										New:  "false",
+									},
+									{
+										Type: DiffTypeEdited,
+										Name: "Timeout",
+										Old:  "5000000000",
+										New:  "10000000000",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "Template change script deleted",
+			Old: &Task{
+				Templates: []*Template{
+					{
+						DestPath:   "bar",
+						ChangeMode: "script",
+						ChangeScript: &ChangeScript{
+							Command: "/bin/reload",
+							Timeout: 5 * time.Second,
+						},
+					},
+				},
+			},
+			New: &Task{
+				Templates: []*Template{
+					{
+						DestPath:   "bar",
+						ChangeMode: "noop",
+					},
+				},
+			},
+			Expected: &TaskDiff{
+				Type: DiffTypeEdited,
+				Objects: []*ObjectDiff{
+					{
+						Type: DiffTypeEdited,
+						Name: "Template",
+						Fields: []*FieldDiff{
+							{
+								Type: DiffTypeEdited,
+								Name: "ChangeMode",
+								Old:  "script",
+								New:  "noop",
+							},
+						},
+						Objects: []*ObjectDiff{
+							{
+								Type: DiffTypeDeleted,
+								Name: "ChangeScript",
+								Fields: []*FieldDiff{
+									{
+										Type: DiffTypeDeleted,
+										Name: "Command",
+										Old:  "/bin/reload",
+										New:  "",
+									},
+									{
+										Type: DiffTypeDeleted,
+										Name: "FailOnError",
+										Old:  "false",
+										New:  "",
+									},
+									{
+										Type: DiffTypeDeleted,
+										Name: "Timeout",
+										Old:  "5000000000",
+										New:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			Name: "DispatchPayload added",
 			Old:  &Task{},
@@ -108,7 +108,7 @@ func TestAllocsFit_PortsOvercommitted_Old(t *testing.T) {
 						Device:        "eth0",
 						IP:            "10.0.0.1",
 						MBits:         50,
-						ReservedPorts: []Port{{"main", 8000, 80}},
+						ReservedPorts: []Port{{"main", 8000, 80, "", ""}},
 					},
 				},
 			},
@@ -160,7 +160,7 @@ func TestAllocsFit_Old(t *testing.T) {
 					Device:        "eth0",
 					IP:            "10.0.0.1",
 					MBits:         50,
-					ReservedPorts: []Port{{"main", 80, 0}},
+					ReservedPorts: []Port{{"main", 80, 0, "", ""}},
 				},
 			},
 		},
@@ -176,7 +176,7 @@ func TestAllocsFit_Old(t *testing.T) {
 					Device:        "eth0",
 					IP:            "10.0.0.1",
 					MBits:         50,
-					ReservedPorts: []Port{{"main", 8000, 80}},
+					ReservedPorts: []Port{{"main", 8000, 80, "", ""}},
 				},
 			},
 		},
@@ -227,7 +227,7 @@ func TestAllocsFit_TerminalAlloc_Old(t *testing.T) {
 					Device:        "eth0",
 					IP:            "10.0.0.1",
 					MBits:         50,
-					ReservedPorts: []Port{{"main", 80, 0}},
+					ReservedPorts: []Port{{"main", 80, 0, "", ""}},
 				},
 			},
 		},
@@ -243,7 +243,7 @@ func TestAllocsFit_TerminalAlloc_Old(t *testing.T) {
 					Device:        "eth0",
 					IP:            "10.0.0.1",
 					MBits:         50,
-					ReservedPorts: []Port{{"main", 8000, 0}},
+					ReservedPorts: []Port{{"main", 8000, 0, "", ""}},
 				},
 			},
 		},
@@ -323,7 +323,7 @@ func TestAllocsFit(t *testing.T) {
 							Device:        "eth0",
 							IP:            "10.0.0.1",
 							MBits:         50,
-							ReservedPorts: []Port{{"main", 8000, 0}},
+							ReservedPorts: []Port{{"main", 8000, 0, "", ""}},
 						},
 					},
 				},
@@ -407,7 +407,7 @@ func TestAllocsFit_TerminalAlloc(t *testing.T) {
 							Device:        "eth0",
 							IP:            "10.0.0.1",
 							MBits:         50,
-							ReservedPorts: []Port{{"main", 8000, 80}},
+							ReservedPorts: []Port{{"main", 8000, 80, "", ""}},
 						},
 					},
 				},
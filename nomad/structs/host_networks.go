@@ -0,0 +1,124 @@
+package structs
+
+// ClientHostNetworkConfig is used to configure a named host network on a Nomad
+// Client. The network is identified by an interface name, a CIDR, or both.
+type ClientHostNetworkConfig struct {
+	Name          string `hcl:",key"`
+	CIDR          string `hcl:"cidr"`
+	Interface     string `hcl:"interface"`
+	ReservedPorts string `hcl:"reserved_ports"`
+}
+
+func (p *ClientHostNetworkConfig) Copy() *ClientHostNetworkConfig {
+	if p == nil {
+		return nil
+	}
+
+	c := new(ClientHostNetworkConfig)
+	*c = *p
+	return c
+}
+
+func CopySliceClientHostNetworkConfig(s []*ClientHostNetworkConfig) []*ClientHostNetworkConfig {
+	l := len(s)
+	if l == 0 {
+		return nil
+	}
+
+	ns := make([]*ClientHostNetworkConfig, l)
+	for idx, cfg := range s {
+		ns[idx] = cfg.Copy()
+	}
+
+	return ns
+}
+
+func HostNetworkSliceMerge(a, b []*ClientHostNetworkConfig) []*ClientHostNetworkConfig {
+	n := make([]*ClientHostNetworkConfig, len(a))
+	seenKeys := make(map[string]int, len(a))
+
+	for i, config := range a {
+		n[i] = config.Copy()
+		seenKeys[config.Name] = i
+	}
+
+	for _, config := range b {
+		if fIndex, ok := seenKeys[config.Name]; ok {
+			n[fIndex] = config.Copy()
+			continue
+		}
+
+		n = append(n, config.Copy())
+	}
+
+	return n
+}
+
+// NodeHostNetwork is a named host network fingerprinted on a client. Ports
+// requesting the host network are bound on its IP.
+type NodeHostNetwork struct {
+	Name          string
+	Device        string
+	IP            string
+	ReservedPorts string
+}
+
+func (n *NodeHostNetwork) Copy() *NodeHostNetwork {
+	if n == nil {
+		return nil
+	}
+
+	c := new(NodeHostNetwork)
+	*c = *n
+	return c
+}
+
+func (n *NodeHostNetwork) Equals(o *NodeHostNetwork) bool {
+	if n == nil || o == nil {
+		return n == o
+	}
+	return *n == *o
+}
+
+// HostNetworks is a collection of host networks
+type HostNetworks []*NodeHostNetwork
+
+func (h HostNetworks) Copy() HostNetworks {
+	if len(h) == 0 {
+		return nil
+	}
+
+	c := make(HostNetworks, len(h))
+	for i, n := range h {
+		c[i] = n.Copy()
+	}
+	return c
+}
+
+// Equals equates HostNetworks as a set
+func (h HostNetworks) Equals(o HostNetworks) bool {
+	if len(h) != len(o) {
+		return false
+	}
+SETEQUALS:
+	for _, hn := range h {
+		for _, on := range o {
+			if hn.Equals(on) {
+				continue SETEQUALS
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// Lookup returns the host network with the given name or nil if the node
+// does not have it.
+func (h HostNetworks) Lookup(name string) *NodeHostNetwork {
+	for _, n := range h {
+		if n.Name == name {
+			return n
+		}
+	}
+	return nil
+}
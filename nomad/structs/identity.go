@@ -0,0 +1,223 @@
+package structs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// RootKeyAlgorithmES256 is the ECDSA P-256 signing algorithm used for
+	// workload identities.
+	RootKeyAlgorithmES256 = "ES256"
+
+	// WorkloadIdentityFile is the name of the file in a task's secrets
+	// directory the workload identity is written to.
+	WorkloadIdentityFile = "nomad_token"
+
+	// WorkloadIdentityEnv is the environment variable a task's workload
+	// identity is exposed in.
+	WorkloadIdentityEnv = "NOMAD_TOKEN"
+)
+
+// RootKey is a key used by the servers to sign workload identities and
+// encrypt variables. Only the active key signs new identities and encrypts
+// new variables; inactive keys are kept so identities they signed can still
+// be verified until they expire, and variables they encrypted can still be
+// decrypted.
+type RootKey struct {
+	// KeyID is the ID of the key, set as the "kid" header of the identities
+	// it signs.
+	KeyID string
+
+	// Algorithm is the signing algorithm of the key.
+	Algorithm string
+
+	// Key is the DER encoded private key.
+	Key []byte
+
+	// WrappedEncryptionKey is the AES-256 data key encrypting variables,
+	// itself encrypted with the key encryption key of the servers. It is
+	// empty if the key can't encrypt variables.
+	WrappedEncryptionKey []byte
+
+	// Active marks the key used to sign new identities.
+	Active bool
+
+	// CreateTime is the time the key was created, used to rotate and
+	// garbage collect keys.
+	CreateTime time.Time
+
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// Copy returns a copy of the root key.
+func (k *RootKey) Copy() *RootKey {
+	if k == nil {
+		return nil
+	}
+
+	nk := new(RootKey)
+	*nk = *k
+	nk.Key = make([]byte, len(k.Key))
+	copy(nk.Key, k.Key)
+	nk.WrappedEncryptionKey = make([]byte, len(k.WrappedEncryptionKey))
+	copy(nk.WrappedEncryptionKey, k.WrappedEncryptionKey)
+	return nk
+}
+
+// RootKeyUpsertRequest is used to upsert root keys. At most one of the keys
+// may be active, in which case all other keys are made inactive.
+type RootKeyUpsertRequest struct {
+	Keys []*RootKey
+	WriteRequest
+}
+
+// RootKeyDeleteRequest is used to delete root keys.
+type RootKeyDeleteRequest struct {
+	KeyIDs []string
+	WriteRequest
+}
+
+// KeyringRotateRequest is used to generate a new active root key.
+type KeyringRotateRequest struct {
+	// Full re-encrypts all variables with the new key, so that the previous
+	// keys can be garbage collected.
+	Full bool
+
+	WriteRequest
+}
+
+// KeyringRotateResponse returns the ID of the new active root key.
+type KeyringRotateResponse struct {
+	KeyID string
+	WriteMeta
+}
+
+// KeyringListPublicRequest is used to list the public keys verifying workload
+// identities.
+type KeyringListPublicRequest struct {
+	QueryOptions
+}
+
+// KeyringListPublicResponse is used to return the public keys verifying
+// workload identities.
+type KeyringListPublicResponse struct {
+	Keys []*JWK
+	QueryMeta
+}
+
+// JWK is a public key in JSON Web Key format.
+type JWK struct {
+	KeyType   string `json:"kty"`
+	Use       string `json:"use"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	Y         string `json:"y"`
+}
+
+// JWKS is a JSON Web Key Set.
+type JWKS struct {
+	Keys []*JWK `json:"keys"`
+}
+
+// IdentityClaims are the claims of a workload identity.
+type IdentityClaims struct {
+	Namespace string `json:"nomad_namespace"`
+	JobID     string `json:"nomad_job_id"`
+	TaskGroup string `json:"nomad_task_group"`
+	Task      string `json:"nomad_task"`
+	AllocID   string `json:"nomad_allocation_id"`
+
+	Subject   string `json:"sub"`
+	IssuedAt  int64  `json:"iat"`
+	NotBefore int64  `json:"nbf"`
+	Expiry    int64  `json:"exp"`
+}
+
+// NewIdentityClaims returns the claims of the identity of a task of the
+// allocation, valid from now for the given TTL.
+func NewIdentityClaims(alloc *Allocation, task string, now time.Time, ttl time.Duration) *IdentityClaims {
+	return &IdentityClaims{
+		Namespace: alloc.Namespace,
+		JobID:     alloc.JobID,
+		TaskGroup: alloc.TaskGroup,
+		Task:      task,
+		AllocID:   alloc.ID,
+		Subject:   strings.Join([]string{alloc.Namespace, alloc.JobID, alloc.TaskGroup, task}, ":"),
+		IssuedAt:  now.Unix(),
+		NotBefore: now.Unix(),
+		Expiry:    now.Add(ttl).Unix(),
+	}
+}
+
+// Validate checks the claims are complete and valid at the given time.
+func (c *IdentityClaims) Validate(now time.Time) error {
+	if c.Namespace == "" || c.JobID == "" || c.TaskGroup == "" || c.Task == "" || c.AllocID == "" {
+		return fmt.Errorf("incomplete workload identity claims")
+	}
+	if now.Unix() < c.NotBefore {
+		return fmt.Errorf("workload identity not yet valid")
+	}
+	if now.Unix() >= c.Expiry {
+		return fmt.Errorf("workload identity expired")
+	}
+	return nil
+}
+
+// SignIdentitiesRequest is used by clients to request the workload
+// identities of tasks of an allocation.
+type SignIdentitiesRequest struct {
+	// NodeID, SecretID and AllocID identify the client and the allocation
+	// running the tasks.
+	NodeID   string
+	SecretID string
+	AllocID  string
+
+	// Tasks are the names of the tasks to sign identities for.
+	Tasks []string
+
+	QueryOptions
+}
+
+// SignIdentitiesResponse returns the signed workload identities by task name.
+type SignIdentitiesResponse struct {
+	Identities map[string]string
+
+	// Expiration is the time the identities expire.
+	Expiration time.Time
+
+	QueryMeta
+}
+
+// WorkloadIdentity configures how a task's workload identity is exposed to
+// it.
+type WorkloadIdentity struct {
+	// Env exposes the identity in the NOMAD_TOKEN environment variable.
+	Env bool
+
+	// File writes the identity to the nomad_token file of the task's
+	// secrets directory.
+	File bool
+}
+
+// DefaultWorkloadIdentity returns how the workload identity of tasks without
+// an identity block is exposed.
+func DefaultWorkloadIdentity() *WorkloadIdentity {
+	return &WorkloadIdentity{
+		File: true,
+	}
+}
+
+func (w *WorkloadIdentity) Copy() *WorkloadIdentity {
+	if w == nil {
+		return nil
+	}
+
+	nw := new(WorkloadIdentity)
+	*nw = *w
+	return nw
+}
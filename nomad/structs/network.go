@@ -33,16 +33,18 @@ var (
 // NetworkIndex is used to index the available network resources
 // and the used network resources on a machine given allocations
 type NetworkIndex struct {
-	AvailNetworks  []*NetworkResource // List of available networks
-	AvailBandwidth map[string]int     // Bandwidth by device
-	UsedPorts      map[string]Bitmap  // Ports by IP
-	UsedBandwidth  map[string]int     // Bandwidth by device
+	AvailNetworks  []*NetworkResource          // List of available networks
+	AvailBandwidth map[string]int              // Bandwidth by device
+	HostNetworks   map[string]*NodeHostNetwork // Host networks by name
+	UsedPorts      map[string]Bitmap           // Ports by IP
+	UsedBandwidth  map[string]int              // Bandwidth by device
 }
 
 // NewNetworkIndex is used to construct a new network index
 func NewNetworkIndex() *NetworkIndex {
 	return &NetworkIndex{
 		AvailBandwidth: make(map[string]int),
+		HostNetworks:   make(map[string]*NodeHostNetwork),
 		UsedPorts:      make(map[string]Bitmap),
 		UsedBandwidth:  make(map[string]int),
 	}
@@ -88,6 +90,15 @@ func (idx *NetworkIndex) SetNode(node *Node) (collide bool) {
 		}
 	}
 
+	// Add the host networks, creating their bitmaps so that the node wide
+	// reserved ports below apply to them as well
+	if node.NodeResources != nil {
+		for _, hn := range node.NodeResources.HostNetworks {
+			idx.HostNetworks[hn.Name] = hn
+			idx.usedPortsFor(hn.IP)
+		}
+	}
+
 	// COMPAT(0.11): Remove in 0.11
 	// Handle reserving ports, handling both new and old
 	if node.ReservedResources != nil && node.ReservedResources.Networks.ReservedHostPorts != "" {
@@ -100,6 +111,16 @@ func (idx *NetworkIndex) SetNode(node *Node) (collide bool) {
 		}
 	}
 
+	// Reserve the ports reserved on each host network
+	for _, hn := range idx.HostNetworks {
+		if hn.ReservedPorts == "" {
+			continue
+		}
+		if idx.addReservedPorts(idx.UsedPorts[hn.IP], hn.ReservedPorts) {
+			collide = true
+		}
+	}
+
 	return
 }
 
@@ -150,26 +171,20 @@ func (idx *NetworkIndex) AddAllocs(allocs []*Allocation) (collide bool) {
 // AddReserved is used to add a reserved network usage, returns true
 // if there is a port collision
 func (idx *NetworkIndex) AddReserved(n *NetworkResource) (collide bool) {
-	// Add the port usage
-	used := idx.UsedPorts[n.IP]
-	if used == nil {
-		// Try to get a bitmap from the pool, else create
-		raw := bitmapPool.Get()
-		if raw != nil {
-			used = raw.(Bitmap)
-			used.Clear()
-		} else {
-			used, _ = NewBitmap(maxValidPort)
-		}
-		idx.UsedPorts[n.IP] = used
-	}
-
 	for _, ports := range [][]Port{n.ReservedPorts, n.DynamicPorts} {
 		for _, port := range ports {
 			// Guard against invalid port
 			if port.Value < 0 || port.Value >= maxValidPort {
 				return true
 			}
+
+			// Add the port usage on the IP the port is bound on. Fall back to
+			// the network's IP if the node no longer has the host network.
+			ip, err := idx.portIP(n.IP, port)
+			if err != nil {
+				ip = n.IP
+			}
+			used := idx.usedPortsFor(ip)
 			if used.Check(uint(port.Value)) {
 				collide = true
 			} else {
@@ -195,37 +210,77 @@ func (idx *NetworkIndex) AddReservedPortRange(ports string) (collide bool) {
 
 	// Ensure we create a bitmap for each available network
 	for _, n := range idx.AvailNetworks {
-		used := idx.UsedPorts[n.IP]
-		if used == nil {
-			// Try to get a bitmap from the pool, else create
-			raw := bitmapPool.Get()
-			if raw != nil {
-				used = raw.(Bitmap)
-				used.Clear()
-			} else {
-				used, _ = NewBitmap(maxValidPort)
-			}
-			idx.UsedPorts[n.IP] = used
-		}
+		idx.usedPortsFor(n.IP)
 	}
 
 	for _, used := range idx.UsedPorts {
-		for _, port := range resPorts {
-			// Guard against invalid port
-			if port < 0 || port >= maxValidPort {
-				return true
-			}
-			if used.Check(uint(port)) {
-				collide = true
-			} else {
-				used.Set(uint(port))
-			}
+		if idx.reservePorts(used, resPorts) {
+			collide = true
 		}
 	}
 
 	return
 }
 
+// addReservedPorts marks the ports given in the port range format as used in
+// the given bitmap
+func (idx *NetworkIndex) addReservedPorts(used Bitmap, ports string) (collide bool) {
+	resPorts, err := ParsePortRanges(ports)
+	if err != nil {
+		return
+	}
+	return idx.reservePorts(used, resPorts)
+}
+
+// reservePorts marks the ports as used in the given bitmap, returning true if
+// any of them were already used
+func (idx *NetworkIndex) reservePorts(used Bitmap, ports []uint64) (collide bool) {
+	for _, port := range ports {
+		// Guard against invalid port
+		if port >= maxValidPort {
+			return true
+		}
+		if used.Check(uint(port)) {
+			collide = true
+		} else {
+			used.Set(uint(port))
+		}
+	}
+	return
+}
+
+// usedPortsFor returns the bitmap of used ports for the IP, creating it if
+// it does not exist
+func (idx *NetworkIndex) usedPortsFor(ip string) Bitmap {
+	used := idx.UsedPorts[ip]
+	if used == nil {
+		// Try to get a bitmap from the pool, else create
+		raw := bitmapPool.Get()
+		if raw != nil {
+			used = raw.(Bitmap)
+			used.Clear()
+		} else {
+			used, _ = NewBitmap(maxValidPort)
+		}
+		idx.UsedPorts[ip] = used
+	}
+	return used
+}
+
+// portIP returns the IP the port is bound on. Ports requesting a host network
+// are bound on its IP, others on the given default IP. An error is returned
+// if the node does not have the requested host network.
+func (idx *NetworkIndex) portIP(defaultIP string, port Port) (string, error) {
+	if port.HostNetwork == "" {
+		return defaultIP, nil
+	}
+	hn, ok := idx.HostNetworks[port.HostNetwork]
+	if !ok {
+		return "", fmt.Errorf("missing host network %q", port.HostNetwork)
+	}
+	return hn.IP, nil
+}
+
 // yieldIP is used to iteratively invoke the callback with
 // an available IP
 func (idx *NetworkIndex) yieldIP(cb func(net *NetworkResource, ip net.IP) bool) {
@@ -267,8 +322,6 @@ func (idx *NetworkIndex) AssignNetwork(ask *NetworkResource) (out *NetworkResour
 			return
 		}
 
-		used := idx.UsedPorts[ipStr]
-
 		// Check if any of the reserved ports are in use
 		for _, port := range ask.ReservedPorts {
 			// Guard against invalid port
@@ -277,8 +330,16 @@ func (idx *NetworkIndex) AssignNetwork(ask *NetworkResource) (out *NetworkResour
 				return
 			}
 
+			// The node lacks a requested host network so no IP can satisfy
+			// the ask
+			portIP, hnErr := idx.portIP(ipStr, port)
+			if hnErr != nil {
+				err = hnErr
+				return true
+			}
+
 			// Check if in use
-			if used != nil && used.Check(uint(port.Value)) {
+			if used := idx.UsedPorts[portIP]; used != nil && used.Check(uint(port.Value)) {
 				err = fmt.Errorf("reserved port collision")
 				return
 			}
@@ -294,23 +355,12 @@ func (idx *NetworkIndex) AssignNetwork(ask *NetworkResource) (out *NetworkResour
 			DynamicPorts:  ask.DynamicPorts,
 		}
 
-		// Try to stochastically pick the dynamic ports as it is faster and
-		// lower memory usage.
-		var dynPorts []int
-		var dynErr error
-		dynPorts, dynErr = getDynamicPortsStochastic(used, ask)
-		if dynErr == nil {
-			goto BUILD_OFFER
-		}
-
-		// Fall back to the precise method if the random sampling failed.
-		dynPorts, dynErr = getDynamicPortsPrecise(used, ask)
+		dynPorts, dynErr := idx.getDynamicPorts(ipStr, ask)
 		if dynErr != nil {
 			err = dynErr
 			return
 		}
 
-	BUILD_OFFER:
 		for i, port := range dynPorts {
 			offer.DynamicPorts[i].Value = port
 
@@ -321,6 +371,15 @@ func (idx *NetworkIndex) AssignNetwork(ask *NetworkResource) (out *NetworkResour
 			}
 		}
 
+		// Record the IP of ports bound on a host network so the client
+		// binds them on it. The host networks were validated above.
+		for i, port := range offer.ReservedPorts {
+			offer.ReservedPorts[i].HostIP, _ = idx.portIP("", port)
+		}
+		for i, port := range offer.DynamicPorts {
+			offer.DynamicPorts[i].HostIP, _ = idx.portIP("", port)
+		}
+
 		// Stop, we have an offer!
 		out = offer
 		err = nil
@@ -329,6 +388,56 @@ func (idx *NetworkIndex) AssignNetwork(ask *NetworkResource) (out *NetworkResour
 	return
 }
 
+// getDynamicPorts returns a port for each of the ask's dynamic ports. The
+// ports are grouped by the IP they are bound on, either ip or the address of
+// their host network, and each group is picked from that IP's free ports.
+func (idx *NetworkIndex) getDynamicPorts(ip string, ask *NetworkResource) ([]int, error) {
+	reserved := make(map[string][]Port)
+	for _, port := range ask.ReservedPorts {
+		portIP, err := idx.portIP(ip, port)
+		if err != nil {
+			return nil, err
+		}
+		reserved[portIP] = append(reserved[portIP], port)
+	}
+
+	// Indexes into the ask's dynamic ports by IP
+	dynamic := make(map[string][]int)
+	for i, port := range ask.DynamicPorts {
+		portIP, err := idx.portIP(ip, port)
+		if err != nil {
+			return nil, err
+		}
+		dynamic[portIP] = append(dynamic[portIP], i)
+	}
+
+	ports := make([]int, len(ask.DynamicPorts))
+	for portIP, indexes := range dynamic {
+		used := idx.UsedPorts[portIP]
+		groupAsk := &NetworkResource{
+			ReservedPorts: reserved[portIP],
+			DynamicPorts:  make([]Port, len(indexes)),
+		}
+
+		// Try to stochastically pick the dynamic ports as it is faster and
+		// lower memory usage.
+		picked, err := getDynamicPortsStochastic(used, groupAsk)
+		if err != nil {
+			// Fall back to the precise method if the random sampling failed.
+			picked, err = getDynamicPortsPrecise(used, groupAsk)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for i, port := range picked {
+			ports[indexes[i]] = port
+		}
+	}
+
+	return ports, nil
+}
+
 // getDynamicPortsPrecise takes the nodes used port bitmap which may be nil if
 // no ports have been allocated yet, the network ask and returns a set of unused
 // ports to fullfil the ask's DynamicPorts or an error if it failed. An error
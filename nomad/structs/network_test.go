@@ -17,7 +17,7 @@ func TestNetworkIndex_Overcommitted(t *testing.T) {
 		Device:        "eth0",
 		IP:            "192.168.0.100",
 		MBits:         505,
-		ReservedPorts: []Port{{"one", 8000, 0}, {"two", 9000, 0}},
+		ReservedPorts: []Port{{"one", 8000, 0, "", ""}, {"two", 9000, 0, "", ""}},
 	}
 	collide := idx.AddReserved(reserved)
 	if collide {
@@ -98,7 +98,7 @@ func TestNetworkIndex_AddAllocs(t *testing.T) {
 								Device:        "eth0",
 								IP:            "192.168.0.100",
 								MBits:         20,
-								ReservedPorts: []Port{{"one", 8000, 0}, {"two", 9000, 0}},
+								ReservedPorts: []Port{{"one", 8000, 0, "", ""}, {"two", 9000, 0, "", ""}},
 							},
 						},
 					},
@@ -114,7 +114,7 @@ func TestNetworkIndex_AddAllocs(t *testing.T) {
 								Device:        "eth0",
 								IP:            "192.168.0.100",
 								MBits:         50,
-								ReservedPorts: []Port{{"one", 10000, 0}},
+								ReservedPorts: []Port{{"one", 10000, 0, "", ""}},
 							},
 						},
 					},
@@ -148,7 +148,7 @@ func TestNetworkIndex_AddReserved(t *testing.T) {
 		Device:        "eth0",
 		IP:            "192.168.0.100",
 		MBits:         20,
-		ReservedPorts: []Port{{"one", 8000, 0}, {"two", 9000, 0}},
+		ReservedPorts: []Port{{"one", 8000, 0, "", ""}, {"two", 9000, 0, "", ""}},
 	}
 	collide := idx.AddReserved(reserved)
 	if collide {
@@ -226,7 +226,7 @@ func TestNetworkIndex_AssignNetwork(t *testing.T) {
 							Device:        "eth0",
 							IP:            "192.168.0.100",
 							MBits:         20,
-							ReservedPorts: []Port{{"one", 8000, 0}, {"two", 9000, 0}},
+							ReservedPorts: []Port{{"one", 8000, 0, "", ""}, {"two", 9000, 0, "", ""}},
 						},
 					},
 				},
@@ -240,7 +240,7 @@ func TestNetworkIndex_AssignNetwork(t *testing.T) {
 							Device:        "eth0",
 							IP:            "192.168.0.100",
 							MBits:         50,
-							ReservedPorts: []Port{{"main", 10000, 0}},
+							ReservedPorts: []Port{{"main", 10000, 0, "", ""}},
 						},
 					},
 				},
@@ -251,19 +251,19 @@ func TestNetworkIndex_AssignNetwork(t *testing.T) {
 
 	// Ask for a reserved port
 	ask := &NetworkResource{
-		ReservedPorts: []Port{{"main", 8000, 0}},
+		ReservedPorts: []Port{{"main", 8000, 0, "", ""}},
 	}
 	offer, err := idx.AssignNetwork(ask)
 	require.NoError(t, err)
 	require.NotNil(t, offer)
 	require.Equal(t, "192.168.0.101", offer.IP)
-	rp := Port{"main", 8000, 0}
+	rp := Port{"main", 8000, 0, "", ""}
 	require.Len(t, offer.ReservedPorts, 1)
 	require.Exactly(t, rp, offer.ReservedPorts[0])
 
 	// Ask for dynamic ports
 	ask = &NetworkResource{
-		DynamicPorts: []Port{{"http", 0, 80}, {"https", 0, 443}, {"admin", 0, -1}},
+		DynamicPorts: []Port{{"http", 0, 80, "", ""}, {"https", 0, 443, "", ""}, {"admin", 0, -1, "", ""}},
 	}
 	offer, err = idx.AssignNetwork(ask)
 	require.NoError(t, err)
@@ -281,15 +281,15 @@ func TestNetworkIndex_AssignNetwork(t *testing.T) {
 
 	// Ask for reserved + dynamic ports
 	ask = &NetworkResource{
-		ReservedPorts: []Port{{"main", 2345, 0}},
-		DynamicPorts:  []Port{{"http", 0, 80}, {"https", 0, 443}, {"admin", 0, 8080}},
+		ReservedPorts: []Port{{"main", 2345, 0, "", ""}},
+		DynamicPorts:  []Port{{"http", 0, 80, "", ""}, {"https", 0, 443, "", ""}, {"admin", 0, 8080, "", ""}},
 	}
 	offer, err = idx.AssignNetwork(ask)
 	require.NoError(t, err)
 	require.NotNil(t, offer)
 	require.Equal(t, "192.168.0.100", offer.IP)
 
-	rp = Port{"main", 2345, 0}
+	rp = Port{"main", 2345, 0, "", ""}
 	require.Len(t, offer.ReservedPorts, 1)
 	require.Exactly(t, rp, offer.ReservedPorts[0])
 
@@ -330,7 +330,7 @@ func TestNetworkIndex_AssignNetwork_Dynamic_Contention(t *testing.T) {
 
 	// Ask for dynamic ports
 	ask := &NetworkResource{
-		DynamicPorts: []Port{{"http", 0, 80}},
+		DynamicPorts: []Port{{"http", 0, 80, "", ""}},
 	}
 	offer, err := idx.AssignNetwork(ask)
 	if err != nil {
@@ -351,6 +351,104 @@ func TestNetworkIndex_AssignNetwork_Dynamic_Contention(t *testing.T) {
 }
 
 // COMPAT(0.11): Remove in 0.11
+func TestNetworkIndex_HostNetworks(t *testing.T) {
+	idx := NewNetworkIndex()
+	n := &Node{
+		NodeResources: &NodeResources{
+			Networks: []*NetworkResource{
+				{
+					Device: "eth0",
+					CIDR:   "192.168.0.100/32",
+					IP:     "192.168.0.100",
+					MBits:  1000,
+				},
+			},
+			HostNetworks: []*NodeHostNetwork{
+				{
+					Name:          "public",
+					Device:        "eth1",
+					IP:            "10.0.0.5",
+					ReservedPorts: "443",
+				},
+			},
+		},
+		ReservedResources: &NodeReservedResources{
+			Networks: NodeReservedNetworkResources{
+				ReservedHostPorts: "22",
+			},
+		},
+	}
+	require.False(t, idx.SetNode(n))
+
+	// Node wide reserved ports apply to host networks as well
+	require.True(t, idx.UsedPorts["10.0.0.5"].Check(22))
+	require.True(t, idx.UsedPorts["10.0.0.5"].Check(443))
+	require.False(t, idx.UsedPorts["192.168.0.100"].Check(443))
+
+	// The same static port may be used on both networks
+	allocs := []*Allocation{
+		{
+			AllocatedResources: &AllocatedResources{
+				Shared: AllocatedSharedResources{
+					Networks: []*NetworkResource{
+						{
+							Device:        "eth0",
+							IP:            "192.168.0.100",
+							MBits:         10,
+							ReservedPorts: []Port{{"http", 80, 0, "public", ""}, {"admin", 8080, 0, "", ""}},
+						},
+					},
+				},
+			},
+		},
+	}
+	require.False(t, idx.AddAllocs(allocs))
+	require.True(t, idx.UsedPorts["10.0.0.5"].Check(80))
+	require.False(t, idx.UsedPorts["192.168.0.100"].Check(80))
+	require.True(t, idx.UsedPorts["192.168.0.100"].Check(8080))
+
+	ask := &NetworkResource{
+		ReservedPorts: []Port{{"http", 80, 0, "", ""}},
+	}
+	offer, err := idx.AssignNetwork(ask)
+	require.NoError(t, err)
+	require.Equal(t, "192.168.0.100", offer.IP)
+
+	ask = &NetworkResource{
+		ReservedPorts: []Port{{"http", 80, 0, "public", ""}},
+	}
+	_, err = idx.AssignNetwork(ask)
+	require.EqualError(t, err, "reserved port collision")
+
+	// Dynamic ports are picked from the ports free on their host network
+	ask = &NetworkResource{
+		ReservedPorts: []Port{{"https", 8443, 0, "public", ""}},
+		DynamicPorts:  []Port{{"web", 0, 0, "public", ""}, {"internal", 0, 0, "", ""}},
+	}
+	offer, err = idx.AssignNetwork(ask)
+	require.NoError(t, err)
+	require.Len(t, offer.DynamicPorts, 2)
+	for _, port := range offer.DynamicPorts {
+		require.True(t, port.Value >= MinDynamicPort && port.Value <= MaxDynamicPort)
+	}
+	require.Equal(t, "public", offer.DynamicPorts[0].HostNetwork)
+
+	// Ports on a host network record its IP
+	require.Equal(t, "10.0.0.5", offer.ReservedPorts[0].HostIP)
+	require.Equal(t, "10.0.0.5", offer.DynamicPorts[0].HostIP)
+	require.Empty(t, offer.DynamicPorts[1].HostIP)
+	require.Equal(t, "10.0.0.5", offer.PortIP(offer.DynamicPorts[0]))
+	require.Equal(t, offer.IP, offer.PortIP(offer.DynamicPorts[1]))
+
+	// Asking for a host network the node lacks fails
+	ask = &NetworkResource{
+		DynamicPorts: []Port{{"web", 0, 0, "private", ""}},
+	}
+	offer, err = idx.AssignNetwork(ask)
+	require.EqualError(t, err, `missing host network "private"`)
+	require.Nil(t, offer)
+}
+
 func TestNetworkIndex_Overcommitted_Old(t *testing.T) {
 	idx := NewNetworkIndex()
 
@@ -359,7 +457,7 @@ func TestNetworkIndex_Overcommitted_Old(t *testing.T) {
 		Device:        "eth0",
 		IP:            "192.168.0.100",
 		MBits:         505,
-		ReservedPorts: []Port{{"one", 8000, 0}, {"two", 9000, 0}},
+		ReservedPorts: []Port{{"one", 8000, 0, "", ""}, {"two", 9000, 0, "", ""}},
 	}
 	collide := idx.AddReserved(reserved)
 	if collide {
@@ -411,7 +509,7 @@ func TestNetworkIndex_SetNode_Old(t *testing.T) {
 				{
 					Device:        "eth0",
 					IP:            "192.168.0.100",
-					ReservedPorts: []Port{{"ssh", 22, 0}},
+					ReservedPorts: []Port{{"ssh", 22, 0, "", ""}},
 					MBits:         1,
 				},
 			},
@@ -448,7 +546,7 @@ func TestNetworkIndex_AddAllocs_Old(t *testing.T) {
 							Device:        "eth0",
 							IP:            "192.168.0.100",
 							MBits:         20,
-							ReservedPorts: []Port{{"one", 8000, 0}, {"two", 9000, 0}},
+							ReservedPorts: []Port{{"one", 8000, 0, "", ""}, {"two", 9000, 0, "", ""}},
 						},
 					},
 				},
@@ -462,7 +560,7 @@ func TestNetworkIndex_AddAllocs_Old(t *testing.T) {
 							Device:        "eth0",
 							IP:            "192.168.0.100",
 							MBits:         50,
-							ReservedPorts: []Port{{"one", 10000, 0}},
+							ReservedPorts: []Port{{"one", 10000, 0, "", ""}},
 						},
 					},
 				},
@@ -506,7 +604,7 @@ func TestNetworkIndex_yieldIP_Old(t *testing.T) {
 				{
 					Device:        "eth0",
 					IP:            "192.168.0.100",
-					ReservedPorts: []Port{{"ssh", 22, 0}},
+					ReservedPorts: []Port{{"ssh", 22, 0, "", ""}},
 					MBits:         1,
 				},
 			},
@@ -545,7 +643,7 @@ func TestNetworkIndex_AssignNetwork_Old(t *testing.T) {
 				{
 					Device:        "eth0",
 					IP:            "192.168.0.100",
-					ReservedPorts: []Port{{"ssh", 22, 0}},
+					ReservedPorts: []Port{{"ssh", 22, 0, "", ""}},
 					MBits:         1,
 				},
 			},
@@ -562,7 +660,7 @@ func TestNetworkIndex_AssignNetwork_Old(t *testing.T) {
 							Device:        "eth0",
 							IP:            "192.168.0.100",
 							MBits:         20,
-							ReservedPorts: []Port{{"one", 8000, 0}, {"two", 9000, 0}},
+							ReservedPorts: []Port{{"one", 8000, 0, "", ""}, {"two", 9000, 0, "", ""}},
 						},
 					},
 				},
@@ -576,7 +674,7 @@ func TestNetworkIndex_AssignNetwork_Old(t *testing.T) {
 							Device:        "eth0",
 							IP:            "192.168.0.100",
 							MBits:         50,
-							ReservedPorts: []Port{{"main", 10000, 0}},
+							ReservedPorts: []Port{{"main", 10000, 0, "", ""}},
 						},
 					},
 				},
@@ -587,7 +685,7 @@ func TestNetworkIndex_AssignNetwork_Old(t *testing.T) {
 
 	// Ask for a reserved port
 	ask := &NetworkResource{
-		ReservedPorts: []Port{{"main", 8000, 0}},
+		ReservedPorts: []Port{{"main", 8000, 0, "", ""}},
 	}
 	offer, err := idx.AssignNetwork(ask)
 	if err != nil {
@@ -599,14 +697,14 @@ func TestNetworkIndex_AssignNetwork_Old(t *testing.T) {
 	if offer.IP != "192.168.0.101" {
 		t.Fatalf("bad: %#v", offer)
 	}
-	rp := Port{"main", 8000, 0}
+	rp := Port{"main", 8000, 0, "", ""}
 	if len(offer.ReservedPorts) != 1 || offer.ReservedPorts[0] != rp {
 		t.Fatalf("bad: %#v", offer)
 	}
 
 	// Ask for dynamic ports
 	ask = &NetworkResource{
-		DynamicPorts: []Port{{"http", 0, 80}, {"https", 0, 443}, {"admin", 0, 8080}},
+		DynamicPorts: []Port{{"http", 0, 80, "", ""}, {"https", 0, 443, "", ""}, {"admin", 0, 8080, "", ""}},
 	}
 	offer, err = idx.AssignNetwork(ask)
 	if err != nil {
@@ -629,8 +727,8 @@ func TestNetworkIndex_AssignNetwork_Old(t *testing.T) {
 
 	// Ask for reserved + dynamic ports
 	ask = &NetworkResource{
-		ReservedPorts: []Port{{"main", 2345, 0}},
-		DynamicPorts:  []Port{{"http", 0, 80}, {"https", 0, 443}, {"admin", 0, 8080}},
+		ReservedPorts: []Port{{"main", 2345, 0, "", ""}},
+		DynamicPorts:  []Port{{"http", 0, 80, "", ""}, {"https", 0, 443, "", ""}, {"admin", 0, 8080, "", ""}},
 	}
 	offer, err = idx.AssignNetwork(ask)
 	if err != nil {
@@ -643,7 +741,7 @@ func TestNetworkIndex_AssignNetwork_Old(t *testing.T) {
 		t.Fatalf("bad: %#v", offer)
 	}
 
-	rp = Port{"main", 2345, 0}
+	rp = Port{"main", 2345, 0, "", ""}
 	if len(offer.ReservedPorts) != 1 || offer.ReservedPorts[0] != rp {
 		t.Fatalf("bad: %#v", offer)
 	}
@@ -696,7 +794,7 @@ func TestNetworkIndex_AssignNetwork_Dynamic_Contention_Old(t *testing.T) {
 
 	// Ask for dynamic ports
 	ask := &NetworkResource{
-		DynamicPorts: []Port{{"http", 0, 80}},
+		DynamicPorts: []Port{{"http", 0, 80, "", ""}},
 	}
 	offer, err := idx.AssignNetwork(ask)
 	if err != nil {
@@ -69,7 +69,7 @@ func (n Node) HashIncludeMap(field string, k, v interface{}) (bool, error) {
 // included in the computed node class.
 func (n NodeResources) HashInclude(field string, v interface{}) (bool, error) {
 	switch field {
-	case "Devices":
+	case "Devices", "HostNetworks":
 		return true, nil
 	default:
 		return false, nil
@@ -87,6 +87,18 @@ func (n NodeDeviceResource) HashInclude(field string, v interface{}) (bool, erro
 	}
 }
 
+// HashInclude is used to blacklist uniquely identifying node fields from being
+// included in the computed node class. Only the names of host networks are
+// included as their addresses are unique to the node.
+func (n NodeHostNetwork) HashInclude(field string, v interface{}) (bool, error) {
+	switch field {
+	case "Name":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
 // HashIncludeMap is used to blacklist uniquely identifying node map keys from being
 // included in the computed node class.
 func (n NodeDeviceResource) HashIncludeMap(field string, k, v interface{}) (bool, error) {
@@ -82,6 +82,16 @@ const (
 	BatchNodeUpdateDrainRequestType
 	SchedulerConfigRequestType
 	NodeBatchDeregisterRequestType
+	RootKeyUpsertRequestType
+	RootKeyDeleteRequestType
+	VarUpsertRequestType
+	VarDeleteRequestType
+	ACLRoleUpsertRequestType
+	ACLRoleDeleteRequestType
+	ACLAuthMethodUpsertRequestType
+	ACLAuthMethodDeleteRequestType
+	ACLBindingRuleUpsertRequestType
+	ACLBindingRuleDeleteRequestType
 )
 
 const (
@@ -501,6 +511,23 @@ type JobRegisterRequest struct {
 	WriteRequest
 }
 
+// JobPauseRequest is used for Job.Pause endpoint to pause a job or resume a
+// paused job.
+type JobPauseRequest struct {
+	JobID string
+
+	// Pause is set to pause the job and unset to resume it
+	Pause bool
+
+	// VaultToken is the Vault token that proves the submitter of the job
+	// resume has access to any Vault policies specified in the job. This field
+	// is only used to transfer the token and is not stored after the Job
+	// resume.
+	VaultToken string
+
+	WriteRequest
+}
+
 // JobDeregisterRequest is used for Job.Deregister endpoint
 // to deregister a job as being a schedulable entity.
 type JobDeregisterRequest struct {
@@ -579,6 +606,10 @@ type JobDispatchRequest struct {
 	JobID   string
 	Payload []byte
 	Meta    map[string]string
+
+	// IdempotencyToken, if set, returns the job previously dispatched with
+	// the same token instead of dispatching a new one.
+	IdempotencyToken string
 	WriteRequest
 }
 
@@ -1866,6 +1897,13 @@ func (r *Resources) Validate() error {
 		}
 	}
 
+	// DNS is configured for the whole group network
+	for _, n := range r.Networks {
+		if n.DNS != nil {
+			mErr.Errors = append(mErr.Errors, errors.New("Task can't configure DNS, it has to be specified in the task group network."))
+		}
+	}
+
 	return mErr.ErrorOrNil()
 }
 
@@ -2050,18 +2088,69 @@ type Port struct {
 	Label string
 	Value int
 	To    int
+
+	// HostNetwork is the name of the node's host network the port is bound
+	// on. The node's default network is used when it is empty.
+	HostNetwork string
+
+	// HostIP is the IP of the host network the port was allocated on. It is
+	// set by the scheduler and empty for ports on the node's default network.
+	HostIP string
+}
+
+// DNSConfig is the DNS configuration applied to the tasks of a group
+// network. Tasks inherit the client's DNS configuration when it is unset.
+type DNSConfig struct {
+	Servers  []string
+	Searches []string
+	Options  []string
+}
+
+func (d *DNSConfig) Copy() *DNSConfig {
+	if d == nil {
+		return nil
+	}
+	return &DNSConfig{
+		Servers:  helper.CopySliceString(d.Servers),
+		Searches: helper.CopySliceString(d.Searches),
+		Options:  helper.CopySliceString(d.Options),
+	}
+}
+
+func (d *DNSConfig) Equals(other *DNSConfig) bool {
+	if d == nil || other == nil {
+		return d == other
+	}
+	return helper.SliceStringEquals(d.Servers, other.Servers) &&
+		helper.SliceStringEquals(d.Searches, other.Searches) &&
+		helper.SliceStringEquals(d.Options, other.Options)
+}
+
+// Validate returns an error if any of the DNS servers is not an IP address
+func (d *DNSConfig) Validate() error {
+	if d == nil {
+		return nil
+	}
+	var mErr multierror.Error
+	for _, server := range d.Servers {
+		if net.ParseIP(server) == nil {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("DNS server %q is not a valid IP address", server))
+		}
+	}
+	return mErr.ErrorOrNil()
 }
 
 // NetworkResource is used to represent available network
 // resources
 type NetworkResource struct {
-	Mode          string // Mode of the network
-	Device        string // Name of the device
-	CIDR          string // CIDR block of addresses
-	IP            string // Host IP address
-	MBits         int    // Throughput
-	ReservedPorts []Port // Host Reserved ports
-	DynamicPorts  []Port // Host Dynamically assigned ports
+	Mode          string     // Mode of the network
+	Device        string     // Name of the device
+	CIDR          string     // CIDR block of addresses
+	IP            string     // Host IP address
+	MBits         int        // Throughput
+	DNS           *DNSConfig // DNS configuration applied to the group's tasks
+	ReservedPorts []Port     // Host Reserved ports
+	DynamicPorts  []Port     // Host Dynamically assigned ports
 }
 
 func (nr *NetworkResource) Equals(other *NetworkResource) bool {
@@ -2085,6 +2174,10 @@ func (nr *NetworkResource) Equals(other *NetworkResource) bool {
 		return false
 	}
 
+	if !nr.DNS.Equals(other.DNS) {
+		return false
+	}
+
 	if len(nr.ReservedPorts) != len(other.ReservedPorts) {
 		return false
 	}
@@ -2141,6 +2234,7 @@ func (n *NetworkResource) Copy() *NetworkResource {
 	}
 	newR := new(NetworkResource)
 	*newR = *n
+	newR.DNS = n.DNS.Copy()
 	if n.ReservedPorts != nil {
 		newR.ReservedPorts = make([]Port, len(n.ReservedPorts))
 		copy(newR.ReservedPorts, n.ReservedPorts)
@@ -2167,6 +2261,15 @@ func (n *NetworkResource) GoString() string {
 }
 
 // PortLabels returns a map of port labels to their assigned host ports.
+// PortIP returns the IP the port is bound on: the IP of its host network if
+// it was allocated on one, else the network's IP.
+func (n *NetworkResource) PortIP(p Port) string {
+	if p.HostIP != "" {
+		return p.HostIP
+	}
+	return n.IP
+}
+
 func (n *NetworkResource) PortLabels() map[string]int {
 	num := len(n.ReservedPorts) + len(n.DynamicPorts)
 	labelValues := make(map[string]int, num)
@@ -2217,12 +2320,12 @@ func (ns Networks) Port(label string) (string, int) {
 	for _, n := range ns {
 		for _, p := range n.ReservedPorts {
 			if p.Label == label {
-				return n.IP, p.Value
+				return n.PortIP(p), p.Value
 			}
 		}
 		for _, p := range n.DynamicPorts {
 			if p.Label == label {
-				return n.IP, p.Value
+				return n.PortIP(p), p.Value
 			}
 		}
 	}
@@ -2348,11 +2451,12 @@ func (r *RequestedDevice) Validate() error {
 
 // NodeResources is used to define the resources available on a client node.
 type NodeResources struct {
-	Cpu      NodeCpuResources
-	Memory   NodeMemoryResources
-	Disk     NodeDiskResources
-	Networks Networks
-	Devices  []*NodeDeviceResource
+	Cpu          NodeCpuResources
+	Memory       NodeMemoryResources
+	Disk         NodeDiskResources
+	Networks     Networks
+	HostNetworks HostNetworks
+	Devices      []*NodeDeviceResource
 }
 
 func (n *NodeResources) Copy() *NodeResources {
@@ -2365,6 +2469,7 @@ func (n *NodeResources) Copy() *NodeResources {
 
 	// Copy the networks
 	newN.Networks = n.Networks.Copy()
+	newN.HostNetworks = n.HostNetworks.Copy()
 
 	// Copy the devices
 	if n.Devices != nil {
@@ -2415,6 +2520,10 @@ func (n *NodeResources) Merge(o *NodeResources) {
 		n.Networks = o.Networks
 	}
 
+	if len(o.HostNetworks) != 0 {
+		n.HostNetworks = o.HostNetworks
+	}
+
 	if len(o.Devices) != 0 {
 		n.Devices = o.Devices
 	}
@@ -2441,6 +2550,9 @@ func (n *NodeResources) Equals(o *NodeResources) bool {
 	if !n.Networks.Equals(&o.Networks) {
 		return false
 	}
+	if !n.HostNetworks.Equals(o.HostNetworks) {
+		return false
+	}
 
 	// Check the devices
 	if !DevicesEquals(n.Devices, o.Devices) {
@@ -3245,10 +3357,11 @@ func (c *ComparableResources) NetIndex(n *NetworkResource) int {
 const (
 	// JobTypeNomad is reserved for internal system tasks and is
 	// always handled by the CoreScheduler.
-	JobTypeCore    = "_core"
-	JobTypeService = "service"
-	JobTypeBatch   = "batch"
-	JobTypeSystem  = "system"
+	JobTypeCore     = "_core"
+	JobTypeService  = "service"
+	JobTypeBatch    = "batch"
+	JobTypeSystem   = "system"
+	JobTypeSysBatch = "sysbatch"
 )
 
 const (
@@ -3289,6 +3402,12 @@ type Job struct {
 	// queried and the job to be inspected as it is being killed.
 	Stop bool
 
+	// Paused marks whether the user has paused the job. A paused job has the
+	// count of all its task groups set to zero, blocks periodic launches and
+	// dispatches, and keeps the counts to restore on resume in PausedCounts.
+	Paused       bool
+	PausedCounts map[string]int
+
 	// Region is the Nomad region that handles scheduling this job
 	Region string
 
@@ -3351,10 +3470,18 @@ type Job struct {
 	// for dispatching.
 	ParameterizedJob *ParameterizedJobConfig
 
+	// DependsOn holds the evaluation of the job until the jobs it depends on
+	// meet their conditions.
+	DependsOn []*JobDependency
+
 	// Dispatched is used to identify if the Job has been dispatched from a
 	// parameterized job.
 	Dispatched bool
 
+	// DispatchIdempotencyToken is the idempotency token the job was
+	// dispatched with.
+	DispatchIdempotencyToken string
+
 	// Payload is the payload supplied when the job was dispatched.
 	Payload []byte
 
@@ -3461,6 +3588,8 @@ func (j *Job) Copy() *Job {
 	nj.Periodic = nj.Periodic.Copy()
 	nj.Meta = helper.CopyMapStringString(nj.Meta)
 	nj.ParameterizedJob = nj.ParameterizedJob.Copy()
+	nj.DependsOn = CopySliceJobDependencies(nj.DependsOn)
+	nj.PausedCounts = helper.CopyMapStringInt(nj.PausedCounts)
 	return nj
 }
 
@@ -3483,7 +3612,7 @@ func (j *Job) Validate() error {
 		mErr.Errors = append(mErr.Errors, errors.New("Job must be in a namespace"))
 	}
 	switch j.Type {
-	case JobTypeCore, JobTypeService, JobTypeBatch, JobTypeSystem:
+	case JobTypeCore, JobTypeService, JobTypeBatch, JobTypeSystem, JobTypeSysBatch:
 	case "":
 		mErr.Errors = append(mErr.Errors, errors.New("Missing job type"))
 	default:
@@ -3510,7 +3639,7 @@ func (j *Job) Validate() error {
 			mErr.Errors = append(mErr.Errors, outer)
 		}
 	}
-	if j.Type == JobTypeSystem {
+	if j.Type == JobTypeSystem || j.Type == JobTypeSysBatch {
 		if j.Affinities != nil {
 			mErr.Errors = append(mErr.Errors, fmt.Errorf("System jobs may not have an affinity stanza"))
 		}
@@ -3523,7 +3652,7 @@ func (j *Job) Validate() error {
 		}
 	}
 
-	if j.Type == JobTypeSystem {
+	if j.Type == JobTypeSystem || j.Type == JobTypeSysBatch {
 		if j.Spreads != nil {
 			mErr.Errors = append(mErr.Errors, fmt.Errorf("System jobs may not have a spread stanza"))
 		}
@@ -3547,10 +3676,10 @@ func (j *Job) Validate() error {
 			taskGroups[tg.Name] = idx
 		}
 
-		if j.Type == "system" && tg.Count > 1 {
+		if (j.Type == JobTypeSystem || j.Type == JobTypeSysBatch) && tg.Count > 1 {
 			mErr.Errors = append(mErr.Errors,
-				fmt.Errorf("Job task group %s has count %d. Count cannot exceed 1 with system scheduler",
-					tg.Name, tg.Count))
+				fmt.Errorf("Job task group %s has count %d. Count cannot exceed 1 with %s scheduler",
+					tg.Name, tg.Count, j.Type))
 		}
 	}
 
@@ -3562,11 +3691,11 @@ func (j *Job) Validate() error {
 		}
 	}
 
-	// Validate periodic is only used with batch jobs.
+	// Validate periodic is only used with batch and sysbatch jobs.
 	if j.IsPeriodic() && j.Periodic.Enabled {
-		if j.Type != JobTypeBatch {
+		if j.Type != JobTypeBatch && j.Type != JobTypeSysBatch {
 			mErr.Errors = append(mErr.Errors,
-				fmt.Errorf("Periodic can only be used with %q scheduler", JobTypeBatch))
+				fmt.Errorf("Periodic can only be used with %q or %q scheduler", JobTypeBatch, JobTypeSysBatch))
 		}
 
 		if err := j.Periodic.Validate(); err != nil {
@@ -3575,9 +3704,9 @@ func (j *Job) Validate() error {
 	}
 
 	if j.IsParameterized() {
-		if j.Type != JobTypeBatch {
+		if j.Type != JobTypeBatch && j.Type != JobTypeSysBatch {
 			mErr.Errors = append(mErr.Errors,
-				fmt.Errorf("Parameterized job can only be used with %q scheduler", JobTypeBatch))
+				fmt.Errorf("Parameterized job can only be used with %q or %q scheduler", JobTypeBatch, JobTypeSysBatch))
 		}
 
 		if err := j.ParameterizedJob.Validate(); err != nil {
@@ -3585,6 +3714,24 @@ func (j *Job) Validate() error {
 		}
 	}
 
+	if len(j.DependsOn) != 0 {
+		if j.Type != JobTypeBatch {
+			mErr.Errors = append(mErr.Errors,
+				fmt.Errorf("Dependencies can only be used with %q scheduler", JobTypeBatch))
+		}
+
+		for idx, dep := range j.DependsOn {
+			if dep.JobID == j.ID {
+				mErr.Errors = append(mErr.Errors, fmt.Errorf("Dependency %d can't reference the job itself", idx+1))
+				continue
+			}
+			if err := dep.Validate(); err != nil {
+				outer := fmt.Errorf("Dependency %d validation failed: %s", idx+1, err)
+				mErr.Errors = append(mErr.Errors, outer)
+			}
+		}
+	}
+
 	return mErr.ErrorOrNil()
 }
 
@@ -3707,7 +3854,30 @@ func (j *Job) IsPeriodic() bool {
 // IsPeriodicActive returns whether the job is an active periodic job that will
 // create child jobs
 func (j *Job) IsPeriodicActive() bool {
-	return j.IsPeriodic() && j.Periodic.Enabled && !j.Stopped() && !j.IsParameterized()
+	return j.IsPeriodic() && j.Periodic.Enabled && !j.Stopped() && !j.Paused && !j.IsParameterized()
+}
+
+// Pause scales all the task groups of the job down to zero and records their
+// counts so they can be restored when the job is resumed.
+func (j *Job) Pause() {
+	j.Paused = true
+	j.PausedCounts = make(map[string]int, len(j.TaskGroups))
+	for _, tg := range j.TaskGroups {
+		j.PausedCounts[tg.Name] = tg.Count
+		tg.Count = 0
+	}
+}
+
+// Resume restores the counts the task groups of a paused job had before it
+// was paused.
+func (j *Job) Resume() {
+	for _, tg := range j.TaskGroups {
+		if count, ok := j.PausedCounts[tg.Name]; ok {
+			tg.Count = count
+		}
+	}
+	j.Paused = false
+	j.PausedCounts = nil
 }
 
 // IsParameterized returns whether a job is parameterized job.
@@ -3855,6 +4025,10 @@ type JobSummary struct {
 	// Children contains a summary for the children of this job.
 	Children *JobChildrenSummary
 
+	// Nodes contains the completion of a sysbatch job on each node it was
+	// placed on, keyed by node ID.
+	Nodes map[string]*JobNodeSummary
+
 	// Raft Indexes
 	CreateIndex uint64
 	ModifyIndex uint64
@@ -3870,9 +4044,93 @@ func (js *JobSummary) Copy() *JobSummary {
 	}
 	newJobSummary.Summary = newTGSummary
 	newJobSummary.Children = newJobSummary.Children.Copy()
+	if js.Nodes != nil {
+		newJobSummary.Nodes = make(map[string]*JobNodeSummary, len(js.Nodes))
+		for k, v := range js.Nodes {
+			newJobSummary.Nodes[k] = v.Copy()
+		}
+	}
 	return newJobSummary
 }
 
+// UpdateNode records the client status of a sysbatch allocation in the
+// summary of its node, unless a more recent allocation of its task group was
+// placed on the node. It returns whether the summary changed.
+func (js *JobSummary) UpdateNode(alloc *Allocation) bool {
+	if alloc.Job == nil || alloc.Job.Type != JobTypeSysBatch || alloc.NodeID == "" {
+		return false
+	}
+
+	if js.Nodes == nil {
+		js.Nodes = make(map[string]*JobNodeSummary)
+	}
+	node, ok := js.Nodes[alloc.NodeID]
+	if !ok {
+		node = &JobNodeSummary{Allocs: make(map[string]JobNodeAlloc)}
+		js.Nodes[alloc.NodeID] = node
+	}
+
+	current, ok := node.Allocs[alloc.TaskGroup]
+	if ok && (current.CreateIndex > alloc.CreateIndex ||
+		current.ID == alloc.ID && current.ClientStatus == alloc.ClientStatus) {
+		return false
+	}
+
+	node.Allocs[alloc.TaskGroup] = JobNodeAlloc{
+		ID:           alloc.ID,
+		ClientStatus: alloc.ClientStatus,
+		CreateIndex:  alloc.CreateIndex,
+	}
+	return true
+}
+
+// JobNodeSummary summarizes the allocations of a sysbatch job on a node.
+type JobNodeSummary struct {
+	// Allocs is the most recent allocation of each task group placed on the
+	// node, keyed by task group.
+	Allocs map[string]JobNodeAlloc
+}
+
+// JobNodeAlloc is an allocation of a JobNodeSummary.
+type JobNodeAlloc struct {
+	ID           string
+	ClientStatus string
+	CreateIndex  uint64
+}
+
+// Copy returns a new copy of a JobNodeSummary
+func (n *JobNodeSummary) Copy() *JobNodeSummary {
+	if n == nil {
+		return nil
+	}
+
+	nn := &JobNodeSummary{Allocs: make(map[string]JobNodeAlloc, len(n.Allocs))}
+	for k, v := range n.Allocs {
+		nn.Allocs[k] = v
+	}
+	return nn
+}
+
+// Status returns the completion of the job on the node. It is failed if any
+// allocation failed or was lost, complete once all allocations completed, and
+// otherwise running if any allocation is running or pending.
+func (n *JobNodeSummary) Status() string {
+	status := AllocClientStatusComplete
+	for _, alloc := range n.Allocs {
+		switch alloc.ClientStatus {
+		case AllocClientStatusFailed, AllocClientStatusLost:
+			return AllocClientStatusFailed
+		case AllocClientStatusRunning:
+			status = AllocClientStatusRunning
+		case AllocClientStatusPending:
+			if status == AllocClientStatusComplete {
+				status = AllocClientStatusPending
+			}
+		}
+	}
+	return status
+}
+
 // JobChildrenSummary contains the summary of children job statuses
 type JobChildrenSummary struct {
 	Pending int64
@@ -3930,6 +4188,7 @@ var (
 		AutoRevert:       false,
 		AutoPromote:      false,
 		Canary:           0,
+		PauseAfterBatch:  false,
 	}
 )
 
@@ -3974,6 +4233,11 @@ type UpdateStrategy struct {
 	// Canary is the number of canaries to deploy when a change to the task
 	// group is detected.
 	Canary int
+
+	// PauseAfterBatch declares that the deployment should be paused each
+	// time a batch of MaxParallel allocations becomes healthy, waiting for
+	// the deployment to be resumed before updating the next batch.
+	PauseAfterBatch bool
 }
 
 func (u *UpdateStrategy) Copy() *UpdateStrategy {
@@ -4052,6 +4316,21 @@ const (
 	PeriodicSpecTest = "_internal_test"
 )
 
+const (
+	// PeriodicCatchUpNone skips launches missed while there was no leader.
+	PeriodicCatchUpNone = "none"
+
+	// PeriodicCatchUpLatest launches only the most recent missed launch.
+	PeriodicCatchUpLatest = "latest"
+
+	// PeriodicCatchUpAll launches every missed launch, up to MaxCatchUp.
+	PeriodicCatchUpAll = "all"
+
+	// DefaultPeriodicMaxCatchUp is the number of missed launches caught up
+	// with the PeriodicCatchUpAll policy if MaxCatchUp isn't set.
+	DefaultPeriodicMaxCatchUp = 10
+)
+
 // Periodic defines the interval a job should be run at.
 type PeriodicConfig struct {
 	// Enabled determines if the job should be run periodically.
@@ -4061,6 +4340,11 @@ type PeriodicConfig struct {
 	// on the SpecType.
 	Spec string
 
+	// Specs specifies multiple cron specs to run the job at. The job is
+	// launched at the earliest next launch across the specs. It can't be set
+	// together with Spec.
+	Specs []string
+
 	// SpecType defines the format of the spec.
 	SpecType string
 
@@ -4074,6 +4358,20 @@ type PeriodicConfig struct {
 	// Reference: https://www.iana.org/time-zones
 	TimeZone string
 
+	// CatchUp is the policy applied to launches that were missed while there
+	// was no leader to launch them.
+	CatchUp string
+
+	// MaxCatchUp limits the number of missed launches that are caught up
+	// when the policy is to catch up all of them. Zero uses
+	// DefaultPeriodicMaxCatchUp.
+	MaxCatchUp int
+
+	// Exclusions are dates ("2006-01-02") or inclusive date ranges
+	// ("2006-01-02/2006-01-05") in the job's time zone on which the job is
+	// not launched.
+	Exclusions []string
+
 	// location is the time zone to evaluate the launch time against
 	location *time.Location
 }
@@ -4084,6 +4382,8 @@ func (p *PeriodicConfig) Copy() *PeriodicConfig {
 	}
 	np := new(PeriodicConfig)
 	*np = *p
+	np.Specs = helper.CopySliceString(p.Specs)
+	np.Exclusions = helper.CopySliceString(p.Exclusions)
 	return np
 }
 
@@ -4093,8 +4393,10 @@ func (p *PeriodicConfig) Validate() error {
 	}
 
 	var mErr multierror.Error
-	if p.Spec == "" {
+	if p.Spec == "" && len(p.Specs) == 0 {
 		multierror.Append(&mErr, fmt.Errorf("Must specify a spec"))
+	} else if p.Spec != "" && len(p.Specs) != 0 {
+		multierror.Append(&mErr, fmt.Errorf("Only one of spec or specs may be specified"))
 	}
 
 	// Check if we got a valid time zone
@@ -4106,9 +4408,11 @@ func (p *PeriodicConfig) Validate() error {
 
 	switch p.SpecType {
 	case PeriodicSpecCron:
-		// Validate the cron spec
-		if _, err := cronexpr.Parse(p.Spec); err != nil {
-			multierror.Append(&mErr, fmt.Errorf("Invalid cron spec %q: %v", p.Spec, err))
+		// Validate the cron specs
+		for _, spec := range p.cronSpecs() {
+			if _, err := cronexpr.Parse(spec); err != nil {
+				multierror.Append(&mErr, fmt.Errorf("Invalid cron spec %q: %v", spec, err))
+			}
 		}
 	case PeriodicSpecTest:
 		// No-op
@@ -4116,9 +4420,58 @@ func (p *PeriodicConfig) Validate() error {
 		multierror.Append(&mErr, fmt.Errorf("Unknown periodic specification type %q", p.SpecType))
 	}
 
+	switch p.CatchUp {
+	case "", PeriodicCatchUpNone, PeriodicCatchUpLatest, PeriodicCatchUpAll:
+	default:
+		multierror.Append(&mErr, fmt.Errorf("Unknown catch up policy %q", p.CatchUp))
+	}
+
+	if p.MaxCatchUp < 0 {
+		multierror.Append(&mErr, fmt.Errorf("Max catch up must be non-negative: %d", p.MaxCatchUp))
+	}
+
+	for _, exclusion := range p.Exclusions {
+		if _, _, err := parsePeriodicExclusion(exclusion, time.UTC); err != nil {
+			multierror.Append(&mErr, fmt.Errorf("Invalid exclusion %q: %v", exclusion, err))
+		}
+	}
+
 	return mErr.ErrorOrNil()
 }
 
+// cronSpecs returns the cron specs the job is launched at.
+func (p *PeriodicConfig) cronSpecs() []string {
+	if p.Spec != "" {
+		return []string{p.Spec}
+	}
+	return p.Specs
+}
+
+// parsePeriodicExclusion parses an excluded date or inclusive date range and
+// returns the start and the exclusive end of the exclusion in the location.
+func parsePeriodicExclusion(exclusion string, loc *time.Location) (time.Time, time.Time, error) {
+	const layout = "2006-01-02"
+
+	split := strings.SplitN(exclusion, "/", 2)
+	start, err := time.ParseInLocation(layout, split[0], loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("failed to parse start date: %v", err)
+	}
+
+	last := start
+	if len(split) == 2 {
+		last, err = time.ParseInLocation(layout, split[1], loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("failed to parse end date: %v", err)
+		}
+		if last.Before(start) {
+			return time.Time{}, time.Time{}, fmt.Errorf("end date is before start date")
+		}
+	}
+
+	return start, last.AddDate(0, 0, 1), nil
+}
+
 func (p *PeriodicConfig) Canonicalize() {
 	// Load the location
 	l, err := time.LoadLocation(p.TimeZone)
@@ -4142,16 +4495,70 @@ func CronParseNext(e *cronexpr.Expression, fromTime time.Time, spec string) (t t
 	return e.Next(fromTime), nil
 }
 
-// Next returns the closest time instant matching the spec that is after the
-// passed time. If no matching instance exists, the zero value of time.Time is
-// returned. The `time.Location` of the returned value matches that of the
-// passed time.
+// Next returns the closest time instant matching any of the specs that is
+// after the passed time and not excluded. If no matching instance exists, the
+// zero value of time.Time is returned. The `time.Location` of the returned
+// value matches that of the passed time.
 func (p *PeriodicConfig) Next(fromTime time.Time) (time.Time, error) {
+	// Each excluded launch moves the search past the end of an exclusion, so
+	// there can't be more excluded launches than exclusions.
+	for i := 0; i <= len(p.Exclusions); i++ {
+		next, err := p.next(fromTime)
+		if err != nil || next.IsZero() {
+			return next, err
+		}
+
+		end, excluded := p.excludedUntil(next)
+		if !excluded {
+			return next, nil
+		}
+
+		// Launches are strictly after the passed time, so search from just
+		// before the end of the exclusion.
+		fromTime = end.Add(-time.Nanosecond).In(fromTime.Location())
+	}
+
+	return time.Time{}, nil
+}
+
+// excludedUntil returns whether the launch time is excluded and, if so, the
+// end of the exclusion.
+func (p *PeriodicConfig) excludedUntil(launch time.Time) (time.Time, bool) {
+	var until time.Time
+	for _, exclusion := range p.Exclusions {
+		start, end, err := parsePeriodicExclusion(exclusion, p.GetLocation())
+		if err != nil {
+			continue
+		}
+		if !launch.Before(start) && launch.Before(end) && end.After(until) {
+			until = end
+		}
+	}
+	return until, !until.IsZero()
+}
+
+// next returns the closest time instant matching the spec that is after the
+// passed time, ignoring exclusions.
+func (p *PeriodicConfig) next(fromTime time.Time) (time.Time, error) {
 	switch p.SpecType {
 	case PeriodicSpecCron:
-		if e, err := cronexpr.Parse(p.Spec); err == nil {
-			return CronParseNext(e, fromTime, p.Spec)
+		// Find the earliest launch across the specs
+		var earliest time.Time
+		for _, spec := range p.cronSpecs() {
+			e, err := cronexpr.Parse(spec)
+			if err != nil {
+				continue
+			}
+
+			next, err := CronParseNext(e, fromTime, spec)
+			if err != nil {
+				return time.Time{}, err
+			}
+			if !next.IsZero() && (earliest.IsZero() || next.Before(earliest)) {
+				earliest = next
+			}
 		}
+		return earliest, nil
 	case PeriodicSpecTest:
 		split := strings.Split(p.Spec, ",")
 		if len(split) == 1 && split[0] == "" {
@@ -4180,6 +4587,43 @@ func (p *PeriodicConfig) Next(fromTime time.Time) (time.Time, error) {
 	return time.Time{}, nil
 }
 
+// CatchUpLaunches returns the launch times after the given time and before
+// now that should be launched according to the catch up policy. The times are
+// returned in ascending order.
+func (p *PeriodicConfig) CatchUpLaunches(last, now time.Time) ([]time.Time, error) {
+	if p.CatchUp == PeriodicCatchUpNone {
+		return nil, nil
+	}
+
+	// Only keep the most recent launches that will be caught up
+	limit := 1
+	if p.CatchUp == PeriodicCatchUpAll {
+		limit = p.MaxCatchUp
+		if limit == 0 {
+			limit = DefaultPeriodicMaxCatchUp
+		}
+	}
+
+	var missed []time.Time
+	for next := last; ; {
+		var err error
+		next, err = p.Next(next)
+		if err != nil {
+			return nil, err
+		}
+		if next.IsZero() || !next.Before(now) {
+			break
+		}
+
+		missed = append(missed, next)
+		if len(missed) > limit {
+			missed = missed[1:]
+		}
+	}
+
+	return missed, nil
+}
+
 // GetLocation returns the location to use for determining the time zone to run
 // the periodic job against.
 func (p *PeriodicConfig) GetLocation() *time.Location {
@@ -4271,6 +4715,63 @@ func DispatchedID(templateID string, t time.Time) string {
 	return fmt.Sprintf("%s%s%d-%s", templateID, DispatchLaunchSuffix, t.Unix(), u)
 }
 
+const (
+	// JobDependencyConditionComplete is met once the job is dead.
+	JobDependencyConditionComplete = "complete"
+
+	// JobDependencyConditionSuccess is met once the job is dead and the latest
+	// allocation of every index completed successfully.
+	JobDependencyConditionSuccess = "success"
+)
+
+// JobDependency is used to hold the evaluation of a job until another job, in
+// the same namespace, meets a condition. If the job depended on is periodic or
+// parameterized, the condition must be met by all of its children.
+type JobDependency struct {
+	// JobID is the ID of the job depended on.
+	JobID string
+
+	// Condition is the condition the job depended on must meet.
+	Condition string
+}
+
+func (d *JobDependency) Copy() *JobDependency {
+	if d == nil {
+		return nil
+	}
+	nd := new(JobDependency)
+	*nd = *d
+	return nd
+}
+
+func (d *JobDependency) Validate() error {
+	var mErr multierror.Error
+	if d.JobID == "" {
+		multierror.Append(&mErr, fmt.Errorf("Missing job ID"))
+	}
+
+	switch d.Condition {
+	case JobDependencyConditionComplete, JobDependencyConditionSuccess:
+	default:
+		multierror.Append(&mErr, fmt.Errorf("Unknown condition %q", d.Condition))
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+func CopySliceJobDependencies(s []*JobDependency) []*JobDependency {
+	l := len(s)
+	if l == 0 {
+		return nil
+	}
+
+	c := make([]*JobDependency, l)
+	for i, v := range s {
+		c[i] = v.Copy()
+	}
+	return c
+}
+
 // DispatchPayloadConfig configures how a task gets its input from a job dispatch
 type DispatchPayloadConfig struct {
 	// File specifies a relative path to where the input data should be written
@@ -4405,7 +4906,7 @@ func NewRestartPolicy(jobType string) *RestartPolicy {
 	case JobTypeService, JobTypeSystem:
 		rp := DefaultServiceJobRestartPolicy
 		return &rp
-	case JobTypeBatch:
+	case JobTypeBatch, JobTypeSysBatch:
 		rp := DefaultBatchJobRestartPolicy
 		return &rp
 	}
@@ -4681,6 +5182,40 @@ func (m *MigrateStrategy) Validate() error {
 	return mErr.ErrorOrNil()
 }
 
+// ArrayConfig is used to run the task group of a batch job as an array of
+// indexed allocations.
+type ArrayConfig struct {
+	// Size is the number of indexed allocations in the array.
+	Size int
+
+	// MaxParallel is the maximum number of allocations of the array running at
+	// once. Zero means there is no limit.
+	MaxParallel int
+}
+
+func (a *ArrayConfig) Copy() *ArrayConfig {
+	if a == nil {
+		return nil
+	}
+	na := new(ArrayConfig)
+	*na = *a
+	return na
+}
+
+func (a *ArrayConfig) Validate() error {
+	var mErr multierror.Error
+
+	if a.Size < 1 {
+		multierror.Append(&mErr, fmt.Errorf("Size must be > 0 but found %d", a.Size))
+	}
+
+	if a.MaxParallel < 0 {
+		multierror.Append(&mErr, fmt.Errorf("MaxParallel must be >= 0 but found %d", a.MaxParallel))
+	}
+
+	return mErr.ErrorOrNil()
+}
+
 // TaskGroup is an atomic unit of placement. Each task group belongs to
 // a job and may contain any number of tasks. A task group support running
 // in many replicas using the same configuration..
@@ -4692,6 +5227,10 @@ type TaskGroup struct {
 	// be scheduled.
 	Count int
 
+	// Array is used to run the task group of a batch job as an array of
+	// indexed allocations
+	Array *ArrayConfig
+
 	// Update is used to control the update strategy for this task group
 	Update *UpdateStrategy
 
@@ -4745,6 +5284,7 @@ func (tg *TaskGroup) Copy() *TaskGroup {
 	ntg := new(TaskGroup)
 	*ntg = *tg
 	ntg.Update = ntg.Update.Copy()
+	ntg.Array = ntg.Array.Copy()
 	ntg.Constraints = CopySliceConstraints(ntg.Constraints)
 	ntg.RestartPolicy = ntg.RestartPolicy.Copy()
 	ntg.ReschedulePolicy = ntg.ReschedulePolicy.Copy()
@@ -4843,7 +5383,7 @@ func (tg *TaskGroup) Validate(j *Job) error {
 			mErr.Errors = append(mErr.Errors, outer)
 		}
 	}
-	if j.Type == JobTypeSystem {
+	if j.Type == JobTypeSystem || j.Type == JobTypeSysBatch {
 		if tg.Affinities != nil {
 			mErr.Errors = append(mErr.Errors, fmt.Errorf("System jobs may not have an affinity stanza"))
 		}
@@ -4864,7 +5404,7 @@ func (tg *TaskGroup) Validate(j *Job) error {
 		mErr.Errors = append(mErr.Errors, fmt.Errorf("Task Group %v should have a restart policy", tg.Name))
 	}
 
-	if j.Type == JobTypeSystem {
+	if j.Type == JobTypeSystem || j.Type == JobTypeSysBatch {
 		if tg.Spreads != nil {
 			mErr.Errors = append(mErr.Errors, fmt.Errorf("System jobs may not have a spread stanza"))
 		}
@@ -4877,7 +5417,7 @@ func (tg *TaskGroup) Validate(j *Job) error {
 		}
 	}
 
-	if j.Type == JobTypeSystem {
+	if j.Type == JobTypeSystem || j.Type == JobTypeSysBatch {
 		if tg.ReschedulePolicy != nil {
 			mErr.Errors = append(mErr.Errors, fmt.Errorf("System jobs should not have a reschedule policy"))
 		}
@@ -4911,6 +5451,19 @@ func (tg *TaskGroup) Validate(j *Job) error {
 		}
 	}
 
+	// Validate the array configuration. The count of a paused job is zero.
+	if a := tg.Array; a != nil {
+		if j.Type != JobTypeBatch {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("Job type %q does not allow array block", j.Type))
+		}
+		if err := a.Validate(); err != nil {
+			mErr.Errors = append(mErr.Errors, err)
+		}
+		if tg.Count != a.Size && !j.Paused {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("Task group count (%d) must match the array size (%d)", tg.Count, a.Size))
+		}
+	}
+
 	// Validate the migration strategy
 	switch j.Type {
 	case JobTypeService:
@@ -5001,6 +5554,10 @@ func (tg *TaskGroup) validateNetworks() error {
 	mappedPorts := make(map[int]string)
 
 	for _, net := range tg.Networks {
+		if err := net.DNS.Validate(); err != nil {
+			mErr.Errors = append(mErr.Errors, err)
+		}
+
 		for _, port := range append(net.ReservedPorts, net.DynamicPorts...) {
 			if other, ok := portLabels[port.Label]; ok {
 				mErr.Errors = append(mErr.Errors, fmt.Errorf("Port label %s already in use by %s", port.Label, other))
@@ -5129,8 +5686,9 @@ func (tg *TaskGroup) Warnings(j *Job) error {
 
 	// Validate the update strategy
 	if u := tg.Update; u != nil {
-		// Check the counts are appropriate
-		if u.MaxParallel > tg.Count {
+		// Check the counts are appropriate. System jobs run a single
+		// allocation of a group per node, and paused jobs have no allocations.
+		if u.MaxParallel > tg.Count && j.Type != JobTypeSystem && !j.Paused {
 			mErr.Errors = append(mErr.Errors,
 				fmt.Errorf("Update max parallel count is greater than task group count (%d > %d). "+
 					"A destructive change would result in the simultaneous replacement of all allocations.", u.MaxParallel, tg.Count))
@@ -5284,6 +5842,10 @@ type Task struct {
 	// have access to.
 	Vault *Vault
 
+	// Identity configures how the task's workload identity is exposed to
+	// it. DefaultWorkloadIdentity is used when unset.
+	Identity *WorkloadIdentity
+
 	// Templates are the set of templates to be rendered for the task.
 	Templates []*Template
 
@@ -5360,6 +5922,7 @@ func (t *Task) Copy() *Task {
 	nt.VolumeMounts = CopySliceVolumeMount(nt.VolumeMounts)
 
 	nt.Vault = nt.Vault.Copy()
+	nt.Identity = nt.Identity.Copy()
 	nt.Resources = nt.Resources.Copy()
 	nt.LogConfig = nt.LogConfig.Copy()
 	nt.Meta = helper.CopyMapStringString(nt.Meta)
@@ -5481,7 +6044,7 @@ func (t *Task) Validate(ephemeralDisk *EphemeralDisk, jobType string, tgServices
 		}
 	}
 
-	if jobType == JobTypeSystem {
+	if jobType == JobTypeSystem || jobType == JobTypeSysBatch {
 		if t.Affinities != nil {
 			mErr.Errors = append(mErr.Errors, fmt.Errorf("System jobs may not have an affinity stanza"))
 		}
@@ -5772,14 +6335,65 @@ const (
 	// TemplateChangeModeRestart marks that the task should be restarted if the
 	// template is re-rendered
 	TemplateChangeModeRestart = "restart"
+
+	// TemplateChangeModeScript marks that ChangeScript should be executed in
+	// the task if the template is re-rendered
+	TemplateChangeModeScript = "script"
 )
 
 var (
 	// TemplateChangeModeInvalidError is the error for when an invalid change
 	// mode is given
-	TemplateChangeModeInvalidError = errors.New("Invalid change mode. Must be one of the following: noop, signal, restart")
+	TemplateChangeModeInvalidError = errors.New("Invalid change mode. Must be one of the following: noop, signal, restart, script")
 )
 
+// ChangeScript is the script executed inside the task when a template with
+// change mode script is re-rendered.
+type ChangeScript struct {
+	// Command is the full path to the script
+	Command string
+
+	// Args is a slice of arguments passed to the script
+	Args []string
+
+	// Timeout is the amount of time the script may run before being killed
+	Timeout time.Duration
+
+	// FailOnError indicates whether the task should be killed if the script
+	// fails or exits with a non-zero exit code
+	FailOnError bool
+}
+
+// DefaultChangeScript returns a change script with the default timeout.
+func DefaultChangeScript() *ChangeScript {
+	return &ChangeScript{
+		Timeout: 5 * time.Second,
+	}
+}
+
+func (cs *ChangeScript) Copy() *ChangeScript {
+	if cs == nil {
+		return nil
+	}
+	ncs := new(ChangeScript)
+	*ncs = *cs
+	ncs.Args = helper.CopySliceString(cs.Args)
+	return ncs
+}
+
+func (cs *ChangeScript) Validate() error {
+	var mErr multierror.Error
+
+	if cs.Command == "" {
+		multierror.Append(&mErr, fmt.Errorf("Must specify a command for the change script"))
+	}
+	if cs.Timeout <= 0 {
+		multierror.Append(&mErr, fmt.Errorf("Change script timeout must be positive"))
+	}
+
+	return mErr.ErrorOrNil()
+}
+
 // Template represents a template configuration to be rendered for a given task
 type Template struct {
 	// SourcePath is the path to the template to be rendered
@@ -5799,6 +6413,10 @@ type Template struct {
 	// requires it.
 	ChangeSignal string
 
+	// ChangeScript is the script that should be executed if the change mode
+	// is script.
+	ChangeScript *ChangeScript
+
 	// Splay is used to avoid coordinated restarts of processes by applying a
 	// random wait between 0 and the given splay value before signalling the
 	// application of a change
@@ -5845,6 +6463,7 @@ func (t *Template) Copy() *Template {
 	}
 	copy := new(Template)
 	*copy = *t
+	copy.ChangeScript = t.ChangeScript.Copy()
 	return copy
 }
 
@@ -5885,6 +6504,12 @@ func (t *Template) Validate() error {
 		if t.Envvars {
 			multierror.Append(&mErr, fmt.Errorf("cannot use signals with env var templates"))
 		}
+	case TemplateChangeModeScript:
+		if t.ChangeScript == nil {
+			multierror.Append(&mErr, fmt.Errorf("Must specify change script when change mode is script"))
+		} else if err := t.ChangeScript.Validate(); err != nil {
+			multierror.Append(&mErr, err)
+		}
 	default:
 		multierror.Append(&mErr, TemplateChangeModeInvalidError)
 	}
@@ -6044,6 +6669,10 @@ const (
 	// exceeded the requested disk resources.
 	TaskDiskExceeded = "Disk Resources Exceeded"
 
+	// TaskDiskUsageWarning indicates that the allocation's disk usage is
+	// approaching the requested disk resources.
+	TaskDiskUsageWarning = "Disk Usage Warning"
+
 	// TaskSiblingFailed indicates that a sibling task in the task group has
 	// failed.
 	TaskSiblingFailed = "Sibling Task Failed"
@@ -6059,6 +6688,9 @@ const (
 	// TaskHookFailed indicates that one of the hooks for a task failed.
 	TaskHookFailed = "Task hook failed"
 
+	// TaskHookMessage is an informational event message emitted by hooks
+	TaskHookMessage = "Task hook message"
+
 	// TaskRestoreFailed indicates Nomad was unable to reattach to a
 	// restored task.
 	TaskRestoreFailed = "Failed Restoring Task"
@@ -6271,6 +6903,20 @@ func (event *TaskEvent) PopulateEventDisplayMessage() {
 		desc = event.DriverMessage
 	case TaskLeaderDead:
 		desc = "Leader Task in Group dead"
+	case TaskDiskExceeded:
+		if event.Message != "" {
+			desc = event.Message
+		} else if event.DiskLimit != 0 {
+			desc = fmt.Sprintf("Allocation exceeded its ephemeral disk limit of %d MB", event.DiskLimit)
+		} else {
+			desc = "Allocation exceeded its ephemeral disk limit"
+		}
+	case TaskDiskUsageWarning:
+		if event.Message != "" {
+			desc = event.Message
+		} else {
+			desc = "Allocation is approaching its ephemeral disk limit"
+		}
 	default:
 		desc = event.Message
 	}
@@ -7098,6 +7744,7 @@ const (
 	DeploymentStatusDescriptionRunningNeedsPromotion = "Deployment is running but requires manual promotion"
 	DeploymentStatusDescriptionRunningAutoPromotion  = "Deployment is running pending automatic promotion"
 	DeploymentStatusDescriptionPaused                = "Deployment is paused"
+	DeploymentStatusDescriptionPausedAfterBatch      = "Deployment is paused awaiting approval of the next batch"
 	DeploymentStatusDescriptionSuccessful            = "Deployment completed successfully"
 	DeploymentStatusDescriptionStoppedJob            = "Cancelled because job is stopped"
 	DeploymentStatusDescriptionNewerJob              = "Cancelled due to newer version of job"
@@ -7212,6 +7859,13 @@ func (d *Deployment) Active() bool {
 	}
 }
 
+// PausedAfterBatch returns whether the deployment is paused awaiting approval
+// of its next batch.
+func (d *Deployment) PausedAfterBatch() bool {
+	return d != nil && d.Status == DeploymentStatusPaused &&
+		d.StatusDescription == DeploymentStatusDescriptionPausedAfterBatch
+}
+
 // GetID is a helper for getting the ID when the object may be nil
 func (d *Deployment) GetID() string {
 	if d == nil {
@@ -7339,6 +7993,12 @@ type DeploymentStatusUpdate struct {
 
 	// StatusDescription is the new status description of the deployment.
 	StatusDescription string
+
+	// ProgressDeadlineStart restarts the progress deadlines the task groups
+	// are tracking from the given time if set. It is used when resuming a
+	// deployment paused after a batch, so that the time waiting for approval
+	// doesn't count towards the deadlines.
+	ProgressDeadlineStart time.Time
 }
 
 // RescheduleTracker encapsulates previous reschedule events
@@ -7550,6 +8210,10 @@ type Allocation struct {
 	// given deployment
 	DeploymentStatus *AllocDeploymentStatus
 
+	// NetworkStatus captures the address assigned to the allocation's network
+	// namespace by the client
+	NetworkStatus *AllocNetworkStatus
+
 	// RescheduleTrackers captures details of previous reschedule attempts of the allocation
 	RescheduleTracker *RescheduleTracker
 
@@ -7630,6 +8294,7 @@ func (a *Allocation) copyImpl(job bool) *Allocation {
 
 	na.Metrics = na.Metrics.Copy()
 	na.DeploymentStatus = na.DeploymentStatus.Copy()
+	na.NetworkStatus = na.NetworkStatus.Copy()
 
 	if a.TaskStates != nil {
 		ts := make(map[string]*TaskState, len(na.TaskStates))
@@ -8292,6 +8957,27 @@ func (a *AllocDeploymentStatus) Copy() *AllocDeploymentStatus {
 	return c
 }
 
+// AllocNetworkStatus captures the status of an allocation's network namespace
+// as configured by the client.
+type AllocNetworkStatus struct {
+	// InterfaceName is the name of the interface inside the namespace which
+	// was assigned the address
+	InterfaceName string
+
+	// Address is the IP address assigned to the allocation
+	Address string
+}
+
+func (a *AllocNetworkStatus) Copy() *AllocNetworkStatus {
+	if a == nil {
+		return nil
+	}
+
+	c := new(AllocNetworkStatus)
+	*c = *a
+	return c
+}
+
 const (
 	EvalStatusBlocked   = "blocked"
 	EvalStatusPending   = "pending"
@@ -8315,6 +9001,7 @@ const (
 	EvalTriggerRetryFailedAlloc  = "alloc-failure"
 	EvalTriggerQueuedAllocs      = "queued-allocs"
 	EvalTriggerPreemption        = "preemption"
+	EvalTriggerArrayAllocDone    = "array-alloc-done"
 )
 
 const (
@@ -8340,6 +9027,16 @@ const (
 	// check if they are terminal. If so, we delete these out of the system.
 	CoreJobDeploymentGC = "deployment-gc"
 
+	// CoreJobRootKeyRotateOrGC is used to rotate the root key used to sign
+	// workload identities once it reaches the rotation threshold, and to
+	// garbage collect inactive keys that can no longer verify live
+	// identities.
+	CoreJobRootKeyRotateOrGC = "root-key-rotate-gc"
+
+	// CoreJobExpiredACLTokenGC is used to delete ACL tokens which have
+	// expired. Global tokens are only deleted by the authoritative region.
+	CoreJobExpiredACLTokenGC = "expired-acl-token-gc"
+
 	// CoreJobForceGC is used to force garbage collection of all GCable objects.
 	CoreJobForceGC = "force-gc"
 )
@@ -8437,6 +9134,11 @@ type Evaluation struct {
 	// captured by computed node classes.
 	EscapedComputedClass bool
 
+	// WaitingOnJobs is the set of job IDs, in the evaluation's namespace,
+	// whose dependency conditions were not met when the evaluation was
+	// blocked.
+	WaitingOnJobs []string
+
 	// AnnotatePlan triggers the scheduler to provide additional annotations
 	// during the evaluation. This should not be set during normal operations.
 	AnnotatePlan bool
@@ -8487,6 +9189,7 @@ func (e *Evaluation) Copy() *Evaluation {
 	}
 	ne := new(Evaluation)
 	*ne = *e
+	ne.WaitingOnJobs = helper.CopySliceString(e.WaitingOnJobs)
 
 	// Copy ClassEligibility
 	if e.ClassEligibility != nil {
@@ -9175,20 +9878,142 @@ type ACLPolicyUpsertRequest struct {
 	WriteRequest
 }
 
-// ACLToken represents a client token which is used to Authenticate
-type ACLToken struct {
-	AccessorID  string   // Public Accessor ID (UUID)
-	SecretID    string   // Secret ID, private (UUID)
-	Name        string   // Human friendly name
-	Type        string   // Client or Management
-	Policies    []string // Policies this token ties to
-	Global      bool     // Global or Region local
+// ACLRole is used to represent a named set of ACL policies which can be
+// referenced by tokens
+type ACLRole struct {
+	Name        string   // Unique name
+	Description string   // Human readable
+	Policies    []string // Policies this role ties to
+	Hash        []byte
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// SetHash is used to compute and set the hash of the ACL role
+func (r *ACLRole) SetHash() []byte {
+	// Initialize a 256bit Blake2 hash (32 bytes)
+	hash, err := blake2b.New256(nil)
+	if err != nil {
+		panic(err)
+	}
+
+	// Write all the user set fields
+	hash.Write([]byte(r.Name))
+	hash.Write([]byte(r.Description))
+	for _, policyName := range r.Policies {
+		hash.Write([]byte(policyName))
+	}
+
+	// Finalize the hash
+	hashVal := hash.Sum(nil)
+
+	// Set and return the hash
+	r.Hash = hashVal
+	return hashVal
+}
+
+func (r *ACLRole) Stub() *ACLRoleListStub {
+	return &ACLRoleListStub{
+		Name:        r.Name,
+		Description: r.Description,
+		Policies:    r.Policies,
+		Hash:        r.Hash,
+		CreateIndex: r.CreateIndex,
+		ModifyIndex: r.ModifyIndex,
+	}
+}
+
+// Validate is used to sanity check a role
+func (r *ACLRole) Validate() error {
+	var mErr multierror.Error
+	if !validPolicyName.MatchString(r.Name) {
+		err := fmt.Errorf("invalid name '%s'", r.Name)
+		mErr.Errors = append(mErr.Errors, err)
+	}
+	if len(r.Description) > maxPolicyDescriptionLength {
+		err := fmt.Errorf("description longer than %d", maxPolicyDescriptionLength)
+		mErr.Errors = append(mErr.Errors, err)
+	}
+	if len(r.Policies) == 0 {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("role missing policies"))
+	}
+	return mErr.ErrorOrNil()
+}
+
+// ACLRoleListStub is used to for listing ACL roles
+type ACLRoleListStub struct {
+	Name        string
+	Description string
+	Policies    []string
 	Hash        []byte
-	CreateTime  time.Time // Time of creation
 	CreateIndex uint64
 	ModifyIndex uint64
 }
 
+// ACLRoleListRequest is used to request a list of roles
+type ACLRoleListRequest struct {
+	QueryOptions
+}
+
+// ACLRoleSpecificRequest is used to query a specific role
+type ACLRoleSpecificRequest struct {
+	Name string
+	QueryOptions
+}
+
+// ACLRoleSetRequest is used to query a set of roles
+type ACLRoleSetRequest struct {
+	Names []string
+	QueryOptions
+}
+
+// ACLRoleListResponse is used for a list request
+type ACLRoleListResponse struct {
+	Roles []*ACLRoleListStub
+	QueryMeta
+}
+
+// SingleACLRoleResponse is used to return a single role
+type SingleACLRoleResponse struct {
+	Role *ACLRole
+	QueryMeta
+}
+
+// ACLRoleSetResponse is used to return a set of roles
+type ACLRoleSetResponse struct {
+	Roles map[string]*ACLRole
+	QueryMeta
+}
+
+// ACLRoleDeleteRequest is used to delete a set of roles
+type ACLRoleDeleteRequest struct {
+	Names []string
+	WriteRequest
+}
+
+// ACLRoleUpsertRequest is used to upsert a set of roles
+type ACLRoleUpsertRequest struct {
+	Roles []*ACLRole
+	WriteRequest
+}
+
+// ACLToken represents a client token which is used to Authenticate
+type ACLToken struct {
+	AccessorID     string   // Public Accessor ID (UUID)
+	SecretID       string   // Secret ID, private (UUID)
+	Name           string   // Human friendly name
+	Type           string   // Client or Management
+	Policies       []string // Policies this token ties to
+	Roles          []string // Roles this token ties to
+	Global         bool     // Global or Region local
+	Hash           []byte
+	CreateTime     time.Time     // Time of creation
+	ExpirationTime *time.Time    // Time of expiration, nil if the token never expires
+	ExpirationTTL  time.Duration // Used to compute the expiration time on creation
+	CreateIndex    uint64
+	ModifyIndex    uint64
+}
+
 var (
 	// AnonymousACLToken is used no SecretID is provided, and the
 	// request is made anonymously.
@@ -9202,15 +10027,17 @@ var (
 )
 
 type ACLTokenListStub struct {
-	AccessorID  string
-	Name        string
-	Type        string
-	Policies    []string
-	Global      bool
-	Hash        []byte
-	CreateTime  time.Time
-	CreateIndex uint64
-	ModifyIndex uint64
+	AccessorID     string
+	Name           string
+	Type           string
+	Policies       []string
+	Roles          []string
+	Global         bool
+	Hash           []byte
+	CreateTime     time.Time
+	ExpirationTime *time.Time
+	CreateIndex    uint64
+	ModifyIndex    uint64
 }
 
 // SetHash is used to compute and set the hash of the ACL token
@@ -9227,6 +10054,12 @@ func (a *ACLToken) SetHash() []byte {
 	for _, policyName := range a.Policies {
 		hash.Write([]byte(policyName))
 	}
+	for _, roleName := range a.Roles {
+		hash.Write([]byte(roleName))
+	}
+	if a.ExpirationTime != nil {
+		hash.Write([]byte(a.ExpirationTime.UTC().Format(time.RFC3339Nano)))
+	}
 	if a.Global {
 		hash.Write([]byte("global"))
 	} else {
@@ -9243,15 +10076,17 @@ func (a *ACLToken) SetHash() []byte {
 
 func (a *ACLToken) Stub() *ACLTokenListStub {
 	return &ACLTokenListStub{
-		AccessorID:  a.AccessorID,
-		Name:        a.Name,
-		Type:        a.Type,
-		Policies:    a.Policies,
-		Global:      a.Global,
-		Hash:        a.Hash,
-		CreateTime:  a.CreateTime,
-		CreateIndex: a.CreateIndex,
-		ModifyIndex: a.ModifyIndex,
+		AccessorID:     a.AccessorID,
+		Name:           a.Name,
+		Type:           a.Type,
+		Policies:       a.Policies,
+		Roles:          a.Roles,
+		Global:         a.Global,
+		Hash:           a.Hash,
+		CreateTime:     a.CreateTime,
+		ExpirationTime: a.ExpirationTime,
+		CreateIndex:    a.CreateIndex,
+		ModifyIndex:    a.ModifyIndex,
 	}
 }
 
@@ -9263,19 +10098,30 @@ func (a *ACLToken) Validate() error {
 	}
 	switch a.Type {
 	case ACLClientToken:
-		if len(a.Policies) == 0 {
-			mErr.Errors = append(mErr.Errors, fmt.Errorf("client token missing policies"))
+		if len(a.Policies) == 0 && len(a.Roles) == 0 {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("client token missing policies or roles"))
 		}
 	case ACLManagementToken:
 		if len(a.Policies) != 0 {
 			mErr.Errors = append(mErr.Errors, fmt.Errorf("management token cannot be associated with policies"))
 		}
+		if len(a.Roles) != 0 {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("management token cannot be associated with roles"))
+		}
 	default:
 		mErr.Errors = append(mErr.Errors, fmt.Errorf("token type must be client or management"))
 	}
+	if a.ExpirationTTL < 0 {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("expiration TTL cannot be negative"))
+	}
 	return mErr.ErrorOrNil()
 }
 
+// IsExpired returns whether the token has expired at the given time
+func (a *ACLToken) IsExpired(now time.Time) bool {
+	return a.ExpirationTime != nil && !now.Before(*a.ExpirationTime)
+}
+
 // PolicySubset checks if a given set of policies is a subset of the token
 func (a *ACLToken) PolicySubset(policies []string) bool {
 	// Hot-path the management tokens, superset of all policies.
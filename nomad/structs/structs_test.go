@@ -375,6 +375,16 @@ func TestJob_IsPeriodicActive(t *testing.T) {
 			},
 			active: false,
 		},
+		{
+			job: &Job{
+				Type: JobTypeService,
+				Periodic: &PeriodicConfig{
+					Enabled: true,
+				},
+				Paused: true,
+			},
+			active: false,
+		},
 		{
 			job: &Job{
 				Type: JobTypeService,
@@ -394,6 +404,30 @@ func TestJob_IsPeriodicActive(t *testing.T) {
 	}
 }
 
+func TestJob_PauseResume(t *testing.T) {
+	require := require.New(t)
+
+	j := testJob()
+	j.TaskGroups[0].Count = 3
+	tg := j.TaskGroups[0].Copy()
+	tg.Name = "other"
+	tg.Count = 5
+	j.TaskGroups = append(j.TaskGroups, tg)
+
+	j.Pause()
+	require.True(j.Paused)
+	require.Equal(map[string]int{"web": 3, "other": 5}, j.PausedCounts)
+	for _, tg := range j.TaskGroups {
+		require.Zero(tg.Count)
+	}
+
+	j.Resume()
+	require.False(j.Paused)
+	require.Nil(j.PausedCounts)
+	require.Equal(3, j.TaskGroups[0].Count)
+	require.Equal(5, j.TaskGroups[1].Count)
+}
+
 func TestJob_SystemJob_Validate(t *testing.T) {
 	j := testJob()
 	j.Type = JobTypeSystem
@@ -452,6 +486,36 @@ func TestJob_SystemJob_Validate(t *testing.T) {
 
 }
 
+func TestJob_SysBatchJob_Validate(t *testing.T) {
+	j := testJob()
+	j.Type = JobTypeSysBatch
+	j.TaskGroups[0].ReschedulePolicy = nil
+	j.TaskGroups[0].Update = nil
+	j.Update = UpdateStrategy{}
+	j.Canonicalize()
+
+	err := j.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Count cannot exceed 1 with sysbatch scheduler")
+
+	j.TaskGroups[0].Count = 1
+	require.NoError(t, j.Validate())
+
+	// Periodic sysbatch jobs are allowed
+	j.Periodic = &PeriodicConfig{
+		Enabled:  true,
+		SpecType: PeriodicSpecCron,
+		Spec:     "*/5 * * * *",
+	}
+	require.NoError(t, j.Validate())
+
+	// Reschedule policies are not
+	j.TaskGroups[0].ReschedulePolicy = &ReschedulePolicy{Attempts: 1, Interval: time.Hour}
+	err = j.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "should not have a reschedule policy")
+}
+
 func TestJob_VaultPolicies(t *testing.T) {
 	j0 := &Job{}
 	e0 := make(map[string]map[string]*Vault, 0)
@@ -850,7 +914,7 @@ func TestTaskGroup_Validate(t *testing.T) {
 	tg = &TaskGroup{
 		Networks: []*NetworkResource{
 			{
-				DynamicPorts: []Port{{"http", 0, 80}},
+				DynamicPorts: []Port{{"http", 0, 80, "", ""}},
 			},
 		},
 		Tasks: []*Task{
@@ -858,7 +922,7 @@ func TestTaskGroup_Validate(t *testing.T) {
 				Resources: &Resources{
 					Networks: []*NetworkResource{
 						{
-							DynamicPorts: []Port{{"http", 0, 80}},
+							DynamicPorts: []Port{{"http", 0, 80, "", ""}},
 						},
 					},
 				},
@@ -869,6 +933,26 @@ func TestTaskGroup_Validate(t *testing.T) {
 	require.Contains(t, err.Error(), "Port label http already in use")
 	require.Contains(t, err.Error(), "Port mapped to 80 already in use")
 
+	tg = &TaskGroup{
+		Networks: []*NetworkResource{
+			{
+				Mode: "bridge",
+				DNS: &DNSConfig{
+					Servers: []string{"1.1.1.1", "not-an-ip"},
+				},
+			},
+		},
+		Tasks: []*Task{
+			{
+				Name:      "task-a",
+				Resources: &Resources{},
+			},
+		},
+	}
+	err = tg.Validate(j)
+	require.Contains(t, err.Error(), `DNS server "not-an-ip" is not a valid IP address`)
+	require.NotContains(t, err.Error(), "1.1.1.1")
+
 	tg = &TaskGroup{
 		Volumes: map[string]*VolumeRequest{
 			"foo": {
@@ -963,6 +1047,19 @@ func TestTaskGroup_Validate(t *testing.T) {
 	expected = `Check check-a invalid: only script and gRPC checks should have tasks`
 	require.Contains(t, err.Error(), expected)
 
+	tg = &TaskGroup{
+		Name:  "group-a",
+		Count: 3,
+		Array: &ArrayConfig{Size: 2, MaxParallel: -1},
+		Tasks: []*Task{taskA},
+	}
+	err = tg.Validate(&Job{Type: JobTypeService})
+	require.Contains(t, err.Error(), `Job type "service" does not allow array block`)
+	require.Contains(t, err.Error(), `MaxParallel must be >= 0 but found -1`)
+	require.Contains(t, err.Error(), `Task group count (3) must match the array size (2)`)
+
+	err = tg.Validate(&Job{Type: JobTypeBatch})
+	require.NotContains(t, err.Error(), `does not allow array block`)
 }
 
 func TestTask_Validate(t *testing.T) {
@@ -1752,6 +1849,56 @@ func TestTemplate_Validate(t *testing.T) {
 				"specify signal value",
 			},
 		},
+		{
+			Tmpl: &Template{
+				SourcePath: "foo",
+				DestPath:   "local/foo",
+				ChangeMode: "script",
+			},
+			Fail: true,
+			ContainsErrs: []string{
+				"specify change script",
+			},
+		},
+		{
+			Tmpl: &Template{
+				SourcePath:   "foo",
+				DestPath:     "local/foo",
+				ChangeMode:   "script",
+				ChangeScript: &ChangeScript{Timeout: -1},
+			},
+			Fail: true,
+			ContainsErrs: []string{
+				"specify a command",
+				"timeout must be positive",
+			},
+		},
+		{
+			Tmpl: &Template{
+				SourcePath: "foo",
+				DestPath:   "local/foo",
+				ChangeMode: "script",
+				ChangeScript: &ChangeScript{
+					Command: "/bin/foo",
+				},
+			},
+			Fail: true,
+			ContainsErrs: []string{
+				"timeout must be positive",
+			},
+		},
+		{
+			Tmpl: &Template{
+				SourcePath: "foo",
+				DestPath:   "local/foo",
+				ChangeMode: "script",
+				ChangeScript: &ChangeScript{
+					Command: "/bin/foo",
+					Timeout: 5 * time.Second,
+				},
+			},
+			Fail: false,
+		},
 		{
 			Tmpl: &Template{
 				SourcePath: "foo",
@@ -2091,7 +2238,7 @@ func TestResource_Add(t *testing.T) {
 			{
 				CIDR:          "10.0.0.0/8",
 				MBits:         100,
-				ReservedPorts: []Port{{"ssh", 22, 0}},
+				ReservedPorts: []Port{{"ssh", 22, 0, "", ""}},
 			},
 		},
 	}
@@ -2103,7 +2250,7 @@ func TestResource_Add(t *testing.T) {
 			{
 				IP:            "10.0.0.1",
 				MBits:         50,
-				ReservedPorts: []Port{{"web", 80, 0}},
+				ReservedPorts: []Port{{"web", 80, 0, "", ""}},
 			},
 		},
 	}
@@ -2121,7 +2268,7 @@ func TestResource_Add(t *testing.T) {
 			{
 				CIDR:          "10.0.0.0/8",
 				MBits:         150,
-				ReservedPorts: []Port{{"ssh", 22, 0}, {"web", 80, 0}},
+				ReservedPorts: []Port{{"ssh", 22, 0, "", ""}, {"web", 80, 0, "", ""}},
 			},
 		},
 	}
@@ -2137,7 +2284,7 @@ func TestResource_Add_Network(t *testing.T) {
 		Networks: []*NetworkResource{
 			{
 				MBits:        50,
-				DynamicPorts: []Port{{"http", 0, 80}, {"https", 0, 443}},
+				DynamicPorts: []Port{{"http", 0, 80, "", ""}, {"https", 0, 443, "", ""}},
 			},
 		},
 	}
@@ -2145,7 +2292,7 @@ func TestResource_Add_Network(t *testing.T) {
 		Networks: []*NetworkResource{
 			{
 				MBits:        25,
-				DynamicPorts: []Port{{"admin", 0, 8080}},
+				DynamicPorts: []Port{{"admin", 0, 8080, "", ""}},
 			},
 		},
 	}
@@ -2163,7 +2310,7 @@ func TestResource_Add_Network(t *testing.T) {
 		Networks: []*NetworkResource{
 			{
 				MBits:        75,
-				DynamicPorts: []Port{{"http", 0, 80}, {"https", 0, 443}, {"admin", 0, 8080}},
+				DynamicPorts: []Port{{"http", 0, 80, "", ""}, {"https", 0, 443, "", ""}, {"admin", 0, 8080, "", ""}},
 			},
 		},
 	}
@@ -2186,7 +2333,7 @@ func TestComparableResources_Subtract(t *testing.T) {
 				{
 					CIDR:          "10.0.0.0/8",
 					MBits:         100,
-					ReservedPorts: []Port{{"ssh", 22, 0}},
+					ReservedPorts: []Port{{"ssh", 22, 0, "", ""}},
 				},
 			},
 		},
@@ -2207,7 +2354,7 @@ func TestComparableResources_Subtract(t *testing.T) {
 				{
 					CIDR:          "10.0.0.0/8",
 					MBits:         20,
-					ReservedPorts: []Port{{"ssh", 22, 0}},
+					ReservedPorts: []Port{{"ssh", 22, 0, "", ""}},
 				},
 			},
 		},
@@ -2229,7 +2376,7 @@ func TestComparableResources_Subtract(t *testing.T) {
 				{
 					CIDR:          "10.0.0.0/8",
 					MBits:         100,
-					ReservedPorts: []Port{{"ssh", 22, 0}},
+					ReservedPorts: []Port{{"ssh", 22, 0, "", ""}},
 				},
 			},
 		},
@@ -2748,6 +2895,125 @@ func TestPeriodicConfig_DST(t *testing.T) {
 	require.Equal(e2, n2.UTC())
 }
 
+func TestPeriodicConfig_Specs(t *testing.T) {
+	require := require.New(t)
+
+	p := &PeriodicConfig{Enabled: true, SpecType: PeriodicSpecCron, Spec: "@hourly", Specs: []string{"@daily"}}
+	p.Canonicalize()
+	err := p.Validate()
+	require.Error(err)
+	require.Contains(err.Error(), "Only one of spec or specs")
+
+	p.Spec = ""
+	p.Specs = []string{"@daily", "bad"}
+	err = p.Validate()
+	require.Error(err)
+	require.Contains(err.Error(), `Invalid cron spec "bad"`)
+
+	p.Exclusions = []string{"2019-12-25", "2019-12-31/2019-12-30", "tomorrow"}
+	err = p.Validate()
+	require.Error(err)
+	require.Contains(err.Error(), `Invalid exclusion "2019-12-31/2019-12-30"`)
+	require.Contains(err.Error(), `Invalid exclusion "tomorrow"`)
+
+	p.Specs = []string{"0 9 * * 1-5", "0 12 * * 6"}
+	p.Exclusions = []string{"2019-12-25", "2019-12-31/2020-01-01"}
+	require.NoError(p.Validate())
+}
+
+func TestPeriodicConfig_NextSpecs(t *testing.T) {
+	require := require.New(t)
+
+	// Weekdays at 9:00 and Saturdays at 12:00, except holidays
+	p := &PeriodicConfig{
+		Enabled:    true,
+		SpecType:   PeriodicSpecCron,
+		Specs:      []string{"0 9 * * 1-5", "0 12 * * 6"},
+		Exclusions: []string{"2019-12-25", "2019-12-31/2020-01-01"},
+		TimeZone:   "America/New_York",
+	}
+	p.Canonicalize()
+	loc := p.GetLocation()
+
+	cases := []struct {
+		from     time.Time
+		expected time.Time
+	}{
+		// Friday evening launches on Saturday at noon
+		{time.Date(2019, 12, 20, 18, 0, 0, 0, loc), time.Date(2019, 12, 21, 12, 0, 0, 0, loc)},
+		// Saturday afternoon launches on Monday morning
+		{time.Date(2019, 12, 21, 13, 0, 0, 0, loc), time.Date(2019, 12, 23, 9, 0, 0, 0, loc)},
+		// Christmas is skipped
+		{time.Date(2019, 12, 24, 10, 0, 0, 0, loc), time.Date(2019, 12, 26, 9, 0, 0, 0, loc)},
+		// The new year's range is skipped
+		{time.Date(2019, 12, 30, 10, 0, 0, 0, loc), time.Date(2020, 1, 2, 9, 0, 0, 0, loc)},
+	}
+
+	for _, tc := range cases {
+		next, err := p.Next(tc.from)
+		require.NoError(err)
+		require.True(tc.expected.Equal(next), "from %v: got %v; want %v", tc.from, next, tc.expected)
+	}
+}
+
+func TestPeriodicConfig_InvalidCatchUp(t *testing.T) {
+	p := &PeriodicConfig{Enabled: true, SpecType: PeriodicSpecCron, Spec: "@hourly", CatchUp: "some", MaxCatchUp: -1}
+	p.Canonicalize()
+	err := p.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Unknown catch up policy")
+	require.Contains(t, err.Error(), "Max catch up must be non-negative")
+}
+
+func TestPeriodicConfig_CatchUpLaunches(t *testing.T) {
+	last := time.Date(2019, time.November, 10, 10, 0, 0, 0, time.UTC)
+	now := last.Add(5*time.Hour + 30*time.Minute)
+	hour := func(h int) time.Time { return last.Add(time.Duration(h) * time.Hour) }
+
+	cases := []struct {
+		name       string
+		catchUp    string
+		maxCatchUp int
+		expected   []time.Time
+	}{
+		{"none", PeriodicCatchUpNone, 0, nil},
+		{"default", "", 0, []time.Time{hour(5)}},
+		{"latest", PeriodicCatchUpLatest, 3, []time.Time{hour(5)}},
+		{"all", PeriodicCatchUpAll, 0, []time.Time{hour(1), hour(2), hour(3), hour(4), hour(5)}},
+		{"all limited", PeriodicCatchUpAll, 2, []time.Time{hour(4), hour(5)}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &PeriodicConfig{
+				Enabled:    true,
+				SpecType:   PeriodicSpecCron,
+				Spec:       "@hourly",
+				CatchUp:    tc.catchUp,
+				MaxCatchUp: tc.maxCatchUp,
+			}
+			p.Canonicalize()
+
+			launches, err := p.CatchUpLaunches(last, now)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, launches)
+		})
+	}
+
+	// Catching up all launches is bounded by default
+	p := &PeriodicConfig{
+		Enabled:  true,
+		SpecType: PeriodicSpecCron,
+		Spec:     "@hourly",
+		CatchUp:  PeriodicCatchUpAll,
+	}
+	p.Canonicalize()
+	launches, err := p.CatchUpLaunches(last, last.Add(30*24*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, launches, DefaultPeriodicMaxCatchUp)
+	require.Equal(t, last.Add(30*24*time.Hour-time.Hour), launches[DefaultPeriodicMaxCatchUp-1])
+}
+
 func TestRestartPolicy_Validate(t *testing.T) {
 	// Policy with acceptable restart options passes
 	p := &RestartPolicy{
@@ -4185,6 +4451,39 @@ func TestParameterizedJobConfig_Canonicalize(t *testing.T) {
 	}
 }
 
+func TestJobDependency_Validate(t *testing.T) {
+	d := &JobDependency{
+		Condition: "finished",
+	}
+
+	err := d.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Missing job ID")
+	require.Contains(t, err.Error(), "Unknown condition")
+
+	d.JobID = "extract"
+	d.Condition = JobDependencyConditionComplete
+	require.NoError(t, d.Validate())
+}
+
+func TestJobDependency_Validate_Job(t *testing.T) {
+	job := testJob()
+	job.Type = JobTypeBatch
+	job.DependsOn = []*JobDependency{
+		{JobID: job.ID, Condition: JobDependencyConditionSuccess},
+	}
+
+	err := job.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "can't reference the job itself")
+
+	job.Type = JobTypeService
+	job.DependsOn[0].JobID = "extract"
+	err = job.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "only be used with")
+}
+
 func TestDispatchPayloadConfig_Validate(t *testing.T) {
 	d := &DispatchPayloadConfig{
 		File: "foo",
@@ -4269,6 +4568,56 @@ func TestACLTokenValidate(t *testing.T) {
 	tk.Name = "foo"
 	err = tk.Validate()
 	assert.Nil(t, err)
+
+	// Management tokens cannot have roles
+	tk.Roles = []string{"foo"}
+	err = tk.Validate()
+	assert.NotNil(t, err)
+	if !strings.Contains(err.Error(), "associated with roles") {
+		t.Fatalf("bad: %v", err)
+	}
+
+	// Client tokens may have only roles
+	tk.Type = ACLClientToken
+	err = tk.Validate()
+	assert.Nil(t, err)
+
+	// Negative TTL
+	tk.ExpirationTTL = -1 * time.Hour
+	err = tk.Validate()
+	assert.NotNil(t, err)
+	if !strings.Contains(err.Error(), "cannot be negative") {
+		t.Fatalf("bad: %v", err)
+	}
+}
+
+func TestACLTokenIsExpired(t *testing.T) {
+	now := time.Now()
+	tk := &ACLToken{}
+	assert.False(t, tk.IsExpired(now))
+
+	expiry := now.Add(time.Minute)
+	tk.ExpirationTime = &expiry
+	assert.False(t, tk.IsExpired(now))
+	assert.True(t, tk.IsExpired(expiry))
+	assert.True(t, tk.IsExpired(now.Add(time.Hour)))
+}
+
+func TestACLRoleValidate(t *testing.T) {
+	role := &ACLRole{}
+
+	err := role.Validate()
+	assert.NotNil(t, err)
+	if !strings.Contains(err.Error(), "invalid name") {
+		t.Fatalf("bad: %v", err)
+	}
+	if !strings.Contains(err.Error(), "missing policies") {
+		t.Fatalf("bad: %v", err)
+	}
+
+	role.Name = "ops"
+	role.Policies = []string{"foo"}
+	assert.Nil(t, role.Validate())
 }
 
 func TestACLTokenPolicySubset(t *testing.T) {
@@ -4316,6 +4665,21 @@ func TestACLTokenSetHash(t *testing.T) {
 	assert.NotEqual(t, out1, out2)
 }
 
+func TestACLRoleSetHash(t *testing.T) {
+	role := &ACLRole{
+		Name:     "foo",
+		Policies: []string{"foo", "bar"},
+	}
+	out1 := role.SetHash()
+	assert.NotNil(t, out1)
+	assert.Equal(t, out1, role.Hash)
+
+	role.Policies = []string{"foo"}
+	out2 := role.SetHash()
+	assert.Equal(t, out2, role.Hash)
+	assert.NotEqual(t, out1, out2)
+}
+
 func TestACLPolicySetHash(t *testing.T) {
 	ap := &ACLPolicy{
 		Name:        "foo",
@@ -4399,12 +4763,12 @@ func TestNetworkResourcesEquals(t *testing.T) {
 				{
 					IP:            "10.0.0.1",
 					MBits:         50,
-					ReservedPorts: []Port{{"web", 80, 0}},
+					ReservedPorts: []Port{{"web", 80, 0, "", ""}},
 				},
 				{
 					IP:            "10.0.0.1",
 					MBits:         50,
-					ReservedPorts: []Port{{"web", 80, 0}},
+					ReservedPorts: []Port{{"web", 80, 0, "", ""}},
 				},
 			},
 			true,
@@ -4415,12 +4779,12 @@ func TestNetworkResourcesEquals(t *testing.T) {
 				{
 					IP:            "10.0.0.0",
 					MBits:         50,
-					ReservedPorts: []Port{{"web", 80, 0}},
+					ReservedPorts: []Port{{"web", 80, 0, "", ""}},
 				},
 				{
 					IP:            "10.0.0.1",
 					MBits:         50,
-					ReservedPorts: []Port{{"web", 80, 0}},
+					ReservedPorts: []Port{{"web", 80, 0, "", ""}},
 				},
 			},
 			false,
@@ -4431,12 +4795,12 @@ func TestNetworkResourcesEquals(t *testing.T) {
 				{
 					IP:            "10.0.0.1",
 					MBits:         40,
-					ReservedPorts: []Port{{"web", 80, 0}},
+					ReservedPorts: []Port{{"web", 80, 0, "", ""}},
 				},
 				{
 					IP:            "10.0.0.1",
 					MBits:         50,
-					ReservedPorts: []Port{{"web", 80, 0}},
+					ReservedPorts: []Port{{"web", 80, 0, "", ""}},
 				},
 			},
 			false,
@@ -4447,12 +4811,12 @@ func TestNetworkResourcesEquals(t *testing.T) {
 				{
 					IP:            "10.0.0.1",
 					MBits:         50,
-					ReservedPorts: []Port{{"web", 80, 0}},
+					ReservedPorts: []Port{{"web", 80, 0, "", ""}},
 				},
 				{
 					IP:            "10.0.0.1",
 					MBits:         50,
-					ReservedPorts: []Port{{"web", 80, 0}, {"web", 80, 0}},
+					ReservedPorts: []Port{{"web", 80, 0, "", ""}, {"web", 80, 0, "", ""}},
 				},
 			},
 			false,
@@ -4463,7 +4827,7 @@ func TestNetworkResourcesEquals(t *testing.T) {
 				{
 					IP:            "10.0.0.1",
 					MBits:         50,
-					ReservedPorts: []Port{{"web", 80, 0}},
+					ReservedPorts: []Port{{"web", 80, 0, "", ""}},
 				},
 				{
 					IP:            "10.0.0.1",
@@ -4479,12 +4843,12 @@ func TestNetworkResourcesEquals(t *testing.T) {
 				{
 					IP:            "10.0.0.1",
 					MBits:         50,
-					ReservedPorts: []Port{{"web", 80, 0}},
+					ReservedPorts: []Port{{"web", 80, 0, "", ""}},
 				},
 				{
 					IP:            "10.0.0.1",
 					MBits:         50,
-					ReservedPorts: []Port{{"notweb", 80, 0}},
+					ReservedPorts: []Port{{"notweb", 80, 0, "", ""}},
 				},
 			},
 			false,
@@ -4495,12 +4859,12 @@ func TestNetworkResourcesEquals(t *testing.T) {
 				{
 					IP:           "10.0.0.1",
 					MBits:        50,
-					DynamicPorts: []Port{{"web", 80, 0}},
+					DynamicPorts: []Port{{"web", 80, 0, "", ""}},
 				},
 				{
 					IP:           "10.0.0.1",
 					MBits:        50,
-					DynamicPorts: []Port{{"web", 80, 0}, {"web", 80, 0}},
+					DynamicPorts: []Port{{"web", 80, 0, "", ""}, {"web", 80, 0, "", ""}},
 				},
 			},
 			false,
@@ -4511,7 +4875,7 @@ func TestNetworkResourcesEquals(t *testing.T) {
 				{
 					IP:           "10.0.0.1",
 					MBits:        50,
-					DynamicPorts: []Port{{"web", 80, 0}},
+					DynamicPorts: []Port{{"web", 80, 0, "", ""}},
 				},
 				{
 					IP:           "10.0.0.1",
@@ -4527,12 +4891,12 @@ func TestNetworkResourcesEquals(t *testing.T) {
 				{
 					IP:           "10.0.0.1",
 					MBits:        50,
-					DynamicPorts: []Port{{"web", 80, 0}},
+					DynamicPorts: []Port{{"web", 80, 0, "", ""}},
 				},
 				{
 					IP:           "10.0.0.1",
 					MBits:        50,
-					DynamicPorts: []Port{{"notweb", 80, 0}},
+					DynamicPorts: []Port{{"notweb", 80, 0, "", ""}},
 				},
 			},
 			false,
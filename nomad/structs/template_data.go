@@ -0,0 +1,139 @@
+package structs
+
+import (
+	"net"
+	"sort"
+	"strconv"
+)
+
+// TemplateDataRequest is used by clients to read the cluster data available to
+// the Nomad template functions of an allocation's tasks. Data is restricted to
+// the namespace of the requesting allocation.
+type TemplateDataRequest struct {
+	// NodeID, SecretID and AllocID identify the client and the allocation
+	// rendering the template.
+	NodeID   string
+	SecretID string
+	AllocID  string
+
+	// JobID selects the job whose allocations or meta are read. TaskGroup
+	// optionally restricts the request to one of the job's groups.
+	JobID     string
+	TaskGroup string
+
+	// NodeMeta reads the meta of the node running the allocation instead of
+	// the meta of a job.
+	NodeMeta bool
+
+	QueryOptions
+}
+
+// TemplateAllocationsResponse is used to return the running allocations of a
+// job for rendering templates.
+type TemplateAllocationsResponse struct {
+	Allocations []*TemplateAllocation
+	QueryMeta
+}
+
+// TemplateMetaResponse is used to return job or node meta for rendering
+// templates.
+type TemplateMetaResponse struct {
+	Meta map[string]string
+	QueryMeta
+}
+
+// TemplateAllocation is the view of an allocation available to templates.
+type TemplateAllocation struct {
+	ID        string
+	Name      string
+	Index     uint
+	JobID     string
+	TaskGroup string
+	NodeID    string
+
+	// Address is the IP address of the allocation's first network.
+	Address string
+
+	// Ports are the allocation's ports keyed by label.
+	Ports map[string]*TemplatePort
+}
+
+// TemplatePort is the host address a port of an allocation is reachable on.
+type TemplatePort struct {
+	IP      string
+	Port    int
+	Address string
+}
+
+// NewTemplateAllocation returns the template view of the allocation. The node
+// the allocation is running on is used to resolve the address of ports bound
+// to host networks and may be nil.
+func NewTemplateAllocation(alloc *Allocation, node *Node) *TemplateAllocation {
+	ta := &TemplateAllocation{
+		ID:        alloc.ID,
+		Name:      alloc.Name,
+		Index:     alloc.Index(),
+		JobID:     alloc.JobID,
+		TaskGroup: alloc.TaskGroup,
+		NodeID:    alloc.NodeID,
+		Ports:     make(map[string]*TemplatePort),
+	}
+
+	var hostNets HostNetworks
+	if node != nil && node.NodeResources != nil {
+		hostNets = node.NodeResources.HostNetworks
+	}
+
+	for _, nw := range allocNetworks(alloc) {
+		if ta.Address == "" {
+			ta.Address = nw.IP
+		}
+
+		for _, ports := range [][]Port{nw.ReservedPorts, nw.DynamicPorts} {
+			for _, p := range ports {
+				ip := nw.PortIP(p)
+				if hn := hostNets.Lookup(p.HostNetwork); p.HostIP == "" && p.HostNetwork != "" && hn != nil {
+					ip = hn.IP
+				}
+				ta.Ports[p.Label] = &TemplatePort{
+					IP:      ip,
+					Port:    p.Value,
+					Address: net.JoinHostPort(ip, strconv.Itoa(p.Value)),
+				}
+			}
+		}
+	}
+
+	return ta
+}
+
+// allocNetworks returns the group and task networks of the allocation, with
+// task networks sorted by task name so the result is stable.
+func allocNetworks(a *Allocation) Networks {
+	if a.AllocatedResources == nil {
+		return nil
+	}
+
+	networks := a.AllocatedResources.Shared.Networks.Copy()
+
+	tasks := make([]string, 0, len(a.AllocatedResources.Tasks))
+	for name := range a.AllocatedResources.Tasks {
+		tasks = append(tasks, name)
+	}
+	sort.Strings(tasks)
+
+	for _, name := range tasks {
+		networks = append(networks, a.AllocatedResources.Tasks[name].Networks...)
+	}
+	return networks
+}
+
+// SortTemplateAllocations sorts the allocations by name and ID.
+func SortTemplateAllocations(allocs []*TemplateAllocation) {
+	sort.Slice(allocs, func(i, j int) bool {
+		if allocs[i].Name != allocs[j].Name {
+			return allocs[i].Name < allocs[j].Name
+		}
+		return allocs[i].ID < allocs[j].ID
+	})
+}
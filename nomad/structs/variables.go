@@ -0,0 +1,205 @@
+package structs
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// VariableMaxSize is the maximum size of the encoded items of a variable.
+	VariableMaxSize = 64 * 1024
+)
+
+var (
+	// validVariablePath is used to validate the path of a variable
+	validVariablePath = regexp.MustCompile("^[a-zA-Z0-9-_~/]{1,128}$")
+)
+
+// VariableMetadata is the metadata of a variable, which is readable by anyone
+// allowed to list variables at its path.
+type VariableMetadata struct {
+	Namespace string
+	Path      string
+
+	CreateIndex uint64
+	CreateTime  int64
+	ModifyIndex uint64
+	ModifyTime  int64
+}
+
+// VariableItems are the key/value pairs stored in a variable.
+type VariableItems map[string]string
+
+// VariableDecrypted is a variable with its items in plaintext. It is only
+// held in memory and never written to the state store.
+type VariableDecrypted struct {
+	VariableMetadata
+	Items VariableItems
+}
+
+// Copy returns a copy of the variable.
+func (v *VariableDecrypted) Copy() *VariableDecrypted {
+	if v == nil {
+		return nil
+	}
+
+	nv := new(VariableDecrypted)
+	*nv = *v
+	if v.Items != nil {
+		nv.Items = make(VariableItems, len(v.Items))
+		for k, val := range v.Items {
+			nv.Items[k] = val
+		}
+	}
+	return nv
+}
+
+// Validate checks the variable has a valid path and items.
+func (v *VariableDecrypted) Validate() error {
+	if err := ValidateVariablePath(v.Path); err != nil {
+		return err
+	}
+	if len(v.Items) == 0 {
+		return fmt.Errorf("variable must contain at least one item")
+	}
+	for k := range v.Items {
+		if k == "" {
+			return fmt.Errorf("variable item keys must not be empty")
+		}
+	}
+
+	encoded, err := json.Marshal(v.Items)
+	if err != nil {
+		return err
+	}
+	if len(encoded) > VariableMaxSize {
+		return fmt.Errorf("variable items exceed the maximum size of %d bytes", VariableMaxSize)
+	}
+	return nil
+}
+
+// ValidateVariablePath checks the path is a valid variable path.
+func ValidateVariablePath(path string) error {
+	if !validVariablePath.MatchString(path) {
+		return fmt.Errorf("invalid variable path %q: must be 1-128 characters of letters, numbers, '-', '_', '~' and '/'", path)
+	}
+	if strings.HasPrefix(path, "/") || strings.HasSuffix(path, "/") || strings.Contains(path, "//") {
+		return fmt.Errorf("invalid variable path %q: must not start or end with '/' or contain empty segments", path)
+	}
+	return nil
+}
+
+// VariableData is the encrypted items of a variable.
+type VariableData struct {
+	// Data is the nonce and ciphertext of the encoded items.
+	Data []byte
+
+	// KeyID is the ID of the root key that encrypted the items.
+	KeyID string
+}
+
+// VariableEncrypted is a variable with its items encrypted at rest, as
+// stored in the state store.
+type VariableEncrypted struct {
+	VariableMetadata
+	VariableData
+}
+
+// Copy returns a copy of the variable.
+func (v *VariableEncrypted) Copy() *VariableEncrypted {
+	if v == nil {
+		return nil
+	}
+
+	nv := new(VariableEncrypted)
+	*nv = *v
+	nv.Data = make([]byte, len(v.Data))
+	copy(nv.Data, v.Data)
+	return nv
+}
+
+// VarUpsertRequest is used to upsert an encrypted variable in the state
+// store.
+type VarUpsertRequest struct {
+	Var *VariableEncrypted
+
+	// CheckIndex enables check-and-set semantics: the variable is only
+	// written if its current modify index equals the check index, where zero
+	// requires the variable to not exist.
+	CheckIndex *uint64
+
+	WriteRequest
+}
+
+// VarDeleteRequest is used to delete a variable from the state store.
+type VarDeleteRequest struct {
+	Namespace string
+	Path      string
+
+	// CheckIndex enables check-and-set semantics: the variable is only
+	// deleted if its current modify index equals the check index.
+	CheckIndex *uint64
+
+	WriteRequest
+}
+
+// VariablesUpsertRequest is used to create or update a variable.
+type VariablesUpsertRequest struct {
+	Var *VariableDecrypted
+
+	// CheckIndex enables check-and-set semantics, see VarUpsertRequest.
+	CheckIndex *uint64
+
+	WriteRequest
+}
+
+// VariablesUpsertResponse returns the written variable, or the current
+// variable when a check-and-set conflict prevented the write.
+type VariablesUpsertResponse struct {
+	Output   *VariableDecrypted
+	Conflict *VariableDecrypted
+	WriteMeta
+}
+
+// VariablesDeleteRequest is used to delete a variable.
+type VariablesDeleteRequest struct {
+	Path string
+
+	// CheckIndex enables check-and-set semantics, see VarDeleteRequest.
+	CheckIndex *uint64
+
+	WriteRequest
+}
+
+// VariablesDeleteResponse returns the current variable when a check-and-set
+// conflict prevented the delete.
+type VariablesDeleteResponse struct {
+	Conflict *VariableDecrypted
+	WriteMeta
+}
+
+// VariablesReadRequest is used to read a variable.
+type VariablesReadRequest struct {
+	Path string
+	QueryOptions
+}
+
+// VariablesReadResponse is used to return a variable.
+type VariablesReadResponse struct {
+	Data *VariableDecrypted
+	QueryMeta
+}
+
+// VariablesListRequest is used to list the variables of a namespace whose
+// paths start with the query prefix.
+type VariablesListRequest struct {
+	QueryOptions
+}
+
+// VariablesListResponse is used to return the metadata of variables.
+type VariablesListResponse struct {
+	Data []*VariableMetadata
+	QueryMeta
+}
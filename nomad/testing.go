@@ -21,6 +21,9 @@ import (
 
 var (
 	nodeNumber uint32 = 0
+
+	// testKeyEncryptionKey is the key encryption key of test servers.
+	testKeyEncryptionKey = []byte("nomad-test-key-encryption-key-32")
 )
 
 func TestACLServer(t testing.T, cb func(*Config)) (*Server, *structs.ACLToken) {
@@ -65,6 +68,9 @@ func TestServer(t testing.T, cb func(*Config)) *Server {
 	f := false
 	config.VaultConfig.Enabled = &f
 
+	// Share the key encryption key between the servers of a test cluster
+	config.KeyEncryptionKey = testKeyEncryptionKey
+
 	// Squelch output when -v isn't specified
 	config.LogOutput = testlog.NewWriter(t)
 
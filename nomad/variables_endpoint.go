@@ -0,0 +1,265 @@
+package nomad
+
+import (
+	"fmt"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	log "github.com/hashicorp/go-hclog"
+	memdb "github.com/hashicorp/go-memdb"
+
+	"github.com/hashicorp/nomad/acl"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// Variables endpoint is used to manage the variables of namespaces. The
+// items of variables are encrypted by the active root key before they are
+// written to the state store.
+type Variables struct {
+	srv    *Server
+	logger log.Logger
+}
+
+// Upsert is used to create or update a variable
+func (v *Variables) Upsert(args *structs.VariablesUpsertRequest, reply *structs.VariablesUpsertResponse) error {
+	if done, err := v.srv.forward("Variables.Upsert", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "variables", "upsert"}, time.Now())
+
+	if args.Var == nil {
+		return fmt.Errorf("missing variable for upsert")
+	}
+	args.Var.Namespace = args.RequestNamespace()
+
+	// Check write permissions on the path
+	aclObj, err := v.srv.ResolveToken(args.AuthToken)
+	if err != nil {
+		return err
+	} else if aclObj != nil && !aclObj.AllowVariableOperation(args.Var.Namespace, args.Var.Path, acl.VariablesCapabilityWrite) {
+		return structs.ErrPermissionDenied
+	}
+
+	if err := args.Var.Validate(); err != nil {
+		return err
+	}
+
+	state := v.srv.fsm.State()
+	key, err := state.ActiveRootKey(nil)
+	if err != nil {
+		return err
+	}
+	if key == nil {
+		return fmt.Errorf("no active root key to encrypt variable")
+	}
+
+	// The create time is kept by the state store on updates
+	now := time.Now().UnixNano()
+	args.Var.CreateTime = now
+	args.Var.ModifyTime = now
+
+	encrypted, err := encryptVariable(v.srv.config.KeyEncryptionKey, key, args.Var)
+	if err != nil {
+		return err
+	}
+
+	req := &structs.VarUpsertRequest{
+		Var:          encrypted,
+		CheckIndex:   args.CheckIndex,
+		WriteRequest: args.WriteRequest,
+	}
+	resp, index, err := v.srv.raftApply(structs.VarUpsertRequestType, req)
+	if err != nil {
+		v.logger.Error("variable upsert failed", "error", err)
+		return err
+	} else if respErr, ok := resp.(error); ok {
+		return respErr
+	}
+	reply.Index = index
+
+	// Return the current variable if the check-and-set failed
+	if applied, ok := resp.(bool); ok && !applied {
+		reply.Conflict, err = v.conflict(aclObj, args.Var.Namespace, args.Var.Path)
+		return err
+	}
+
+	out, err := state.VariableByPath(nil, args.Var.Namespace, args.Var.Path)
+	if err != nil {
+		return err
+	}
+	if out != nil {
+		reply.Output, err = decryptVariable(v.srv.config.KeyEncryptionKey, state, out)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete is used to delete a variable
+func (v *Variables) Delete(args *structs.VariablesDeleteRequest, reply *structs.VariablesDeleteResponse) error {
+	if done, err := v.srv.forward("Variables.Delete", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "variables", "delete"}, time.Now())
+
+	ns := args.RequestNamespace()
+
+	// Check destroy permissions on the path
+	aclObj, err := v.srv.ResolveToken(args.AuthToken)
+	if err != nil {
+		return err
+	} else if aclObj != nil && !aclObj.AllowVariableOperation(ns, args.Path, acl.VariablesCapabilityDestroy) {
+		return structs.ErrPermissionDenied
+	}
+
+	if err := structs.ValidateVariablePath(args.Path); err != nil {
+		return err
+	}
+
+	req := &structs.VarDeleteRequest{
+		Namespace:    ns,
+		Path:         args.Path,
+		CheckIndex:   args.CheckIndex,
+		WriteRequest: args.WriteRequest,
+	}
+	resp, index, err := v.srv.raftApply(structs.VarDeleteRequestType, req)
+	if err != nil {
+		v.logger.Error("variable delete failed", "error", err)
+		return err
+	} else if respErr, ok := resp.(error); ok {
+		return respErr
+	}
+	reply.Index = index
+
+	// Return the current variable if the check-and-set failed
+	if applied, ok := resp.(bool); ok && !applied {
+		reply.Conflict, err = v.conflict(aclObj, ns, args.Path)
+		return err
+	}
+	return nil
+}
+
+// conflict returns the current variable at the path after a failed
+// check-and-set. Its items are only returned if the token may read them.
+func (v *Variables) conflict(aclObj *acl.ACL, ns, path string) (*structs.VariableDecrypted, error) {
+	state := v.srv.fsm.State()
+	current, err := state.VariableByPath(nil, ns, path)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return &structs.VariableDecrypted{
+			VariableMetadata: structs.VariableMetadata{
+				Namespace: ns,
+				Path:      path,
+			},
+		}, nil
+	}
+
+	if aclObj != nil && !aclObj.AllowVariableOperation(ns, path, acl.VariablesCapabilityRead) {
+		return &structs.VariableDecrypted{VariableMetadata: current.VariableMetadata}, nil
+	}
+	return decryptVariable(v.srv.config.KeyEncryptionKey, state, current)
+}
+
+// Read is used to read a variable
+func (v *Variables) Read(args *structs.VariablesReadRequest, reply *structs.VariablesReadResponse) error {
+	if done, err := v.srv.forward("Variables.Read", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "variables", "read"}, time.Now())
+
+	ns := args.RequestNamespace()
+
+	// Check read permissions on the path
+	aclObj, err := v.srv.ResolveToken(args.AuthToken)
+	if err != nil {
+		return err
+	} else if aclObj != nil && !aclObj.AllowVariableOperation(ns, args.Path, acl.VariablesCapabilityRead) {
+		return structs.ErrPermissionDenied
+	}
+
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, state *state.StateStore) error {
+			out, err := state.VariableByPath(ws, ns, args.Path)
+			if err != nil {
+				return err
+			}
+
+			// Setup the output
+			reply.Data = nil
+			if out != nil {
+				reply.Data, err = decryptVariable(v.srv.config.KeyEncryptionKey, state, out)
+				if err != nil {
+					return err
+				}
+				reply.Index = out.ModifyIndex
+			} else {
+				// Use the last index that affected the variables table
+				index, err := state.Index("variables")
+				if err != nil {
+					return err
+				}
+				reply.Index = index
+			}
+
+			// Set the query response
+			v.srv.setQueryMeta(&reply.QueryMeta)
+			return nil
+		}}
+	return v.srv.blockingRPC(&opts)
+}
+
+// List is used to list the metadata of the variables of a namespace whose
+// path starts with the query prefix. Only the variables the token may list
+// are returned.
+func (v *Variables) List(args *structs.VariablesListRequest, reply *structs.VariablesListResponse) error {
+	if done, err := v.srv.forward("Variables.List", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "variables", "list"}, time.Now())
+
+	ns := args.RequestNamespace()
+
+	aclObj, err := v.srv.ResolveToken(args.AuthToken)
+	if err != nil {
+		return err
+	}
+
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, state *state.StateStore) error {
+			iter, err := state.VariablesByPathPrefix(ws, ns, args.Prefix)
+			if err != nil {
+				return err
+			}
+
+			reply.Data = nil
+			for raw := iter.Next(); raw != nil; raw = iter.Next() {
+				out := raw.(*structs.VariableEncrypted)
+				if aclObj != nil && !aclObj.AllowVariableOperation(ns, out.Path, acl.VariablesCapabilityList) {
+					continue
+				}
+
+				meta := out.VariableMetadata
+				reply.Data = append(reply.Data, &meta)
+			}
+
+			// Use the last index that affected the variables table
+			index, err := state.Index("variables")
+			if err != nil {
+				return err
+			}
+			reply.Index = index
+
+			// Set the query response
+			v.srv.setQueryMeta(&reply.QueryMeta)
+			return nil
+		}}
+	return v.srv.blockingRPC(&opts)
+}
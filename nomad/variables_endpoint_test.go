@@ -0,0 +1,219 @@
+package nomad
+
+import (
+	"testing"
+
+	msgpackrpc "github.com/hashicorp/net-rpc-msgpackrpc"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// waitForRootKey waits for the leader to create the initial root key
+func waitForRootKey(t *testing.T, s *Server) *structs.RootKey {
+	var active *structs.RootKey
+	testutil.WaitForResult(func() (bool, error) {
+		key, err := s.fsm.State().ActiveRootKey(nil)
+		active = key
+		return key != nil, err
+	}, func(err error) {
+		t.Fatalf("no active root key: %v", err)
+	})
+	return active
+}
+
+func TestVariablesEndpoint_CRUD(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	s1 := TestServer(t, nil)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+	key := waitForRootKey(t, s1)
+
+	// Create a variable
+	upsert := &structs.VariablesUpsertRequest{
+		Var: &structs.VariableDecrypted{
+			VariableMetadata: structs.VariableMetadata{Path: "app/db"},
+			Items:            structs.VariableItems{"password": "hunter2"},
+		},
+		WriteRequest: structs.WriteRequest{Region: "global"},
+	}
+	var upsertResp structs.VariablesUpsertResponse
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Variables.Upsert", upsert, &upsertResp))
+	require.Nil(upsertResp.Conflict)
+	require.NotNil(upsertResp.Output)
+	require.Equal(structs.DefaultNamespace, upsertResp.Output.Namespace)
+	require.Equal(upsertResp.Index, upsertResp.Output.ModifyIndex)
+	require.NotZero(upsertResp.Output.CreateTime)
+
+	// The items are encrypted at rest with the active key
+	stored, err := s1.fsm.State().VariableByPath(nil, structs.DefaultNamespace, "app/db")
+	require.Nil(err)
+	require.Equal(key.KeyID, stored.KeyID)
+	require.NotContains(string(stored.Data), "hunter2")
+
+	// Read it back
+	read := &structs.VariablesReadRequest{
+		Path:         "app/db",
+		QueryOptions: structs.QueryOptions{Region: "global"},
+	}
+	var readResp structs.VariablesReadResponse
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Variables.Read", read, &readResp))
+	require.Equal("hunter2", readResp.Data.Items["password"])
+	require.Equal(upsertResp.Index, readResp.Index)
+
+	// A stale check-and-set write returns the current variable
+	checkIndex := uint64(0)
+	upsert.CheckIndex = &checkIndex
+	upsert.Var.Items = structs.VariableItems{"password": "changed"}
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Variables.Upsert", upsert, &upsertResp))
+	require.NotNil(upsertResp.Conflict)
+	require.Equal("hunter2", upsertResp.Conflict.Items["password"])
+
+	checkIndex = upsertResp.Conflict.ModifyIndex
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Variables.Upsert", upsert, &upsertResp))
+	require.Nil(upsertResp.Conflict)
+	require.Equal("changed", upsertResp.Output.Items["password"])
+
+	// List by prefix
+	upsert.CheckIndex = nil
+	upsert.Var = &structs.VariableDecrypted{
+		VariableMetadata: structs.VariableMetadata{Path: "other"},
+		Items:            structs.VariableItems{"a": "b"},
+	}
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Variables.Upsert", upsert, &upsertResp))
+
+	list := &structs.VariablesListRequest{
+		QueryOptions: structs.QueryOptions{Region: "global", Prefix: "app/"},
+	}
+	var listResp structs.VariablesListResponse
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Variables.List", list, &listResp))
+	require.Len(listResp.Data, 1)
+	require.Equal("app/db", listResp.Data[0].Path)
+
+	// Delete with a stale index and then the current index
+	checkIndex = 1
+	del := &structs.VariablesDeleteRequest{
+		Path:         "app/db",
+		CheckIndex:   &checkIndex,
+		WriteRequest: structs.WriteRequest{Region: "global"},
+	}
+	var delResp structs.VariablesDeleteResponse
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Variables.Delete", del, &delResp))
+	require.NotNil(delResp.Conflict)
+
+	del.CheckIndex = nil
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Variables.Delete", del, &delResp))
+	require.Nil(delResp.Conflict)
+
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Variables.Read", read, &readResp))
+	require.Nil(readResp.Data)
+}
+
+func TestVariablesEndpoint_Validate(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	s1 := TestServer(t, nil)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+	waitForRootKey(t, s1)
+
+	upsert := &structs.VariablesUpsertRequest{
+		Var: &structs.VariableDecrypted{
+			VariableMetadata: structs.VariableMetadata{Path: "/app"},
+			Items:            structs.VariableItems{"a": "b"},
+		},
+		WriteRequest: structs.WriteRequest{Region: "global"},
+	}
+	var resp structs.VariablesUpsertResponse
+	err := msgpackrpc.CallWithCodec(codec, "Variables.Upsert", upsert, &resp)
+	require.Error(err)
+	require.Contains(err.Error(), "invalid variable path")
+
+	upsert.Var.Path = "app"
+	upsert.Var.Items = nil
+	err = msgpackrpc.CallWithCodec(codec, "Variables.Upsert", upsert, &resp)
+	require.Error(err)
+	require.Contains(err.Error(), "at least one item")
+}
+
+func TestVariablesEndpoint_ACL(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	s1, root := TestACLServer(t, nil)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+	waitForRootKey(t, s1)
+
+	token := mock.CreatePolicyAndToken(t, s1.fsm.State(), 1003, "test-vars", `
+namespace "default" {
+	variables {
+		path "app/*" {
+			capabilities = ["write", "list"]
+		}
+	}
+}`)
+
+	// Create variables with a management token
+	upsert := &structs.VariablesUpsertRequest{
+		Var: &structs.VariableDecrypted{
+			VariableMetadata: structs.VariableMetadata{Path: "app/db"},
+			Items:            structs.VariableItems{"password": "hunter2"},
+		},
+		WriteRequest: structs.WriteRequest{Region: "global", AuthToken: root.SecretID},
+	}
+	var upsertResp structs.VariablesUpsertResponse
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Variables.Upsert", upsert, &upsertResp))
+	upsert.Var.Path = "secret"
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Variables.Upsert", upsert, &upsertResp))
+
+	// Writes are checked against the path
+	upsert.AuthToken = token.SecretID
+	err := msgpackrpc.CallWithCodec(codec, "Variables.Upsert", upsert, &upsertResp)
+	require.EqualError(err, structs.ErrPermissionDenied.Error())
+
+	upsert.Var.Path = "app/db"
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Variables.Upsert", upsert, &upsertResp))
+
+	// Conflicts only return the items the token may read
+	checkIndex := uint64(0)
+	upsert.CheckIndex = &checkIndex
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Variables.Upsert", upsert, &upsertResp))
+	require.NotNil(upsertResp.Conflict)
+	require.NotZero(upsertResp.Conflict.ModifyIndex)
+	require.Nil(upsertResp.Conflict.Items)
+
+	// Reads require the read capability
+	read := &structs.VariablesReadRequest{
+		Path:         "app/db",
+		QueryOptions: structs.QueryOptions{Region: "global", AuthToken: token.SecretID},
+	}
+	var readResp structs.VariablesReadResponse
+	err = msgpackrpc.CallWithCodec(codec, "Variables.Read", read, &readResp)
+	require.EqualError(err, structs.ErrPermissionDenied.Error())
+
+	// Deletes require the destroy capability
+	del := &structs.VariablesDeleteRequest{
+		Path:         "app/db",
+		WriteRequest: structs.WriteRequest{Region: "global", AuthToken: token.SecretID},
+	}
+	var delResp structs.VariablesDeleteResponse
+	err = msgpackrpc.CallWithCodec(codec, "Variables.Delete", del, &delResp)
+	require.EqualError(err, structs.ErrPermissionDenied.Error())
+
+	// Lists are filtered by the list capability
+	list := &structs.VariablesListRequest{
+		QueryOptions: structs.QueryOptions{Region: "global", AuthToken: token.SecretID},
+	}
+	var listResp structs.VariablesListResponse
+	require.Nil(msgpackrpc.CallWithCodec(codec, "Variables.List", list, &listResp))
+	require.Len(listResp.Data, 1)
+	require.Equal("app/db", listResp.Data[0].Path)
+}
@@ -14,7 +14,14 @@ type MemoryStats = cstructs.MemoryStats
 // CpuStats holds cpu usage related stats
 type CpuStats = cstructs.CpuStats
 
-// ResourceUsage holds information related to cpu and memory stats
+// NetworkStats holds network usage related stats
+type NetworkStats = cstructs.NetworkStats
+
+// BlockIOStats holds block device io related stats
+type BlockIOStats = cstructs.BlockIOStats
+
+// ResourceUsage holds information related to cpu, memory, network and block
+// io stats
 type ResourceUsage = cstructs.ResourceUsage
 
 // TaskResourceUsage holds aggregated resource usage of all processes in a Task
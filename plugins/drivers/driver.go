@@ -197,6 +197,25 @@ type NetworkIsolationSpec struct {
 	Labels map[string]string
 }
 
+// DNSConfig is the DNS configuration to apply to a task. It mirrors the dns
+// block of the group network.
+type DNSConfig struct {
+	Servers  []string
+	Searches []string
+	Options  []string
+}
+
+func (c *DNSConfig) Copy() *DNSConfig {
+	if c == nil {
+		return nil
+	}
+	return &DNSConfig{
+		Servers:  helper.CopySliceString(c.Servers),
+		Searches: helper.CopySliceString(c.Searches),
+		Options:  helper.CopySliceString(c.Options),
+	}
+}
+
 type TerminalSize struct {
 	Height int
 	Width  int
@@ -219,6 +238,7 @@ type TaskConfig struct {
 	StderrPath       string
 	AllocID          string
 	NetworkIsolation *NetworkIsolationSpec
+	DNS              *DNSConfig
 }
 
 func (tc *TaskConfig) Copy() *TaskConfig {
@@ -247,6 +267,8 @@ func (tc *TaskConfig) Copy() *TaskConfig {
 		c.Mounts = mc
 	}
 
+	c.DNS = tc.DNS.Copy()
+
 	return c
 }
 
@@ -192,7 +192,7 @@ func (x CPUUsage_Fields) String() string {
 	return proto.EnumName(CPUUsage_Fields_name, int32(x))
 }
 func (CPUUsage_Fields) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_driver_8edefdede9e0ed2d, []int{51, 0}
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{52, 0}
 }
 
 type MemoryUsage_Fields int32
@@ -230,7 +230,65 @@ func (x MemoryUsage_Fields) String() string {
 	return proto.EnumName(MemoryUsage_Fields_name, int32(x))
 }
 func (MemoryUsage_Fields) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_driver_8edefdede9e0ed2d, []int{52, 0}
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{53, 0}
+}
+
+type NetworkUsage_Fields int32
+
+const (
+	NetworkUsage_RX_BYTES   NetworkUsage_Fields = 0
+	NetworkUsage_TX_BYTES   NetworkUsage_Fields = 1
+	NetworkUsage_RX_PACKETS NetworkUsage_Fields = 2
+	NetworkUsage_TX_PACKETS NetworkUsage_Fields = 3
+)
+
+var NetworkUsage_Fields_name = map[int32]string{
+	0: "RX_BYTES",
+	1: "TX_BYTES",
+	2: "RX_PACKETS",
+	3: "TX_PACKETS",
+}
+var NetworkUsage_Fields_value = map[string]int32{
+	"RX_BYTES":   0,
+	"TX_BYTES":   1,
+	"RX_PACKETS": 2,
+	"TX_PACKETS": 3,
+}
+
+func (x NetworkUsage_Fields) String() string {
+	return proto.EnumName(NetworkUsage_Fields_name, int32(x))
+}
+func (NetworkUsage_Fields) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{54, 0}
+}
+
+type BlockIOUsage_Fields int32
+
+const (
+	BlockIOUsage_READ_BYTES  BlockIOUsage_Fields = 0
+	BlockIOUsage_WRITE_BYTES BlockIOUsage_Fields = 1
+	BlockIOUsage_READ_OPS    BlockIOUsage_Fields = 2
+	BlockIOUsage_WRITE_OPS   BlockIOUsage_Fields = 3
+)
+
+var BlockIOUsage_Fields_name = map[int32]string{
+	0: "READ_BYTES",
+	1: "WRITE_BYTES",
+	2: "READ_OPS",
+	3: "WRITE_OPS",
+}
+var BlockIOUsage_Fields_value = map[string]int32{
+	"READ_BYTES":  0,
+	"WRITE_BYTES": 1,
+	"READ_OPS":    2,
+	"WRITE_OPS":   3,
+}
+
+func (x BlockIOUsage_Fields) String() string {
+	return proto.EnumName(BlockIOUsage_Fields_name, int32(x))
+}
+func (BlockIOUsage_Fields) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{55, 0}
 }
 
 type TaskConfigSchemaRequest struct {
@@ -1876,6 +1934,60 @@ func (m *NetworkIsolationSpec) GetLabels() map[string]string {
 	return nil
 }
 
+type DNSConfig struct {
+	Servers              []string `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	Searches             []string `protobuf:"bytes,2,rep,name=searches,proto3" json:"searches,omitempty"`
+	Options              []string `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DNSConfig) Reset()         { *m = DNSConfig{} }
+func (m *DNSConfig) String() string { return proto.CompactTextString(m) }
+func (*DNSConfig) ProtoMessage()    {}
+func (*DNSConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{34}
+}
+func (m *DNSConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DNSConfig.Unmarshal(m, b)
+}
+func (m *DNSConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DNSConfig.Marshal(b, m, deterministic)
+}
+func (dst *DNSConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DNSConfig.Merge(dst, src)
+}
+func (m *DNSConfig) XXX_Size() int {
+	return xxx_messageInfo_DNSConfig.Size(m)
+}
+func (m *DNSConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_DNSConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DNSConfig proto.InternalMessageInfo
+
+func (m *DNSConfig) GetServers() []string {
+	if m != nil {
+		return m.Servers
+	}
+	return nil
+}
+
+func (m *DNSConfig) GetSearches() []string {
+	if m != nil {
+		return m.Searches
+	}
+	return nil
+}
+
+func (m *DNSConfig) GetOptions() []string {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
 type TaskConfig struct {
 	// Id of the task, recommended to the globally unique, must be unique to the driver.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1915,16 +2027,19 @@ type TaskConfig struct {
 	// NetworkIsolationSpec specifies the configuration for the network namespace
 	// to use for the task. *Only supported on Linux
 	NetworkIsolationSpec *NetworkIsolationSpec `protobuf:"bytes,16,opt,name=network_isolation_spec,json=networkIsolationSpec,proto3" json:"network_isolation_spec,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	// DNS is the DNS configuration to apply to the task's network, if nil
+	// the driver should use its default DNS configuration
+	Dns                  *DNSConfig `protobuf:"bytes,17,opt,name=dns,proto3" json:"dns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *TaskConfig) Reset()         { *m = TaskConfig{} }
 func (m *TaskConfig) String() string { return proto.CompactTextString(m) }
 func (*TaskConfig) ProtoMessage()    {}
 func (*TaskConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_driver_8edefdede9e0ed2d, []int{34}
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{35}
 }
 func (m *TaskConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TaskConfig.Unmarshal(m, b)
@@ -2056,6 +2171,13 @@ func (m *TaskConfig) GetNetworkIsolationSpec() *NetworkIsolationSpec {
 	return nil
 }
 
+func (m *TaskConfig) GetDns() *DNSConfig {
+	if m != nil {
+		return m.Dns
+	}
+	return nil
+}
+
 type Resources struct {
 	// AllocatedResources are the resources set for the task
 	AllocatedResources *AllocatedTaskResources `protobuf:"bytes,1,opt,name=allocated_resources,json=allocatedResources,proto3" json:"allocated_resources,omitempty"`
@@ -2070,7 +2192,7 @@ func (m *Resources) Reset()         { *m = Resources{} }
 func (m *Resources) String() string { return proto.CompactTextString(m) }
 func (*Resources) ProtoMessage()    {}
 func (*Resources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_driver_8edefdede9e0ed2d, []int{35}
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{36}
 }
 func (m *Resources) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Resources.Unmarshal(m, b)
@@ -2117,7 +2239,7 @@ func (m *AllocatedTaskResources) Reset()         { *m = AllocatedTaskResources{}
 func (m *AllocatedTaskResources) String() string { return proto.CompactTextString(m) }
 func (*AllocatedTaskResources) ProtoMessage()    {}
 func (*AllocatedTaskResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_driver_8edefdede9e0ed2d, []int{36}
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{37}
 }
 func (m *AllocatedTaskResources) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AllocatedTaskResources.Unmarshal(m, b)
@@ -2169,7 +2291,7 @@ func (m *AllocatedCpuResources) Reset()         { *m = AllocatedCpuResources{} }
 func (m *AllocatedCpuResources) String() string { return proto.CompactTextString(m) }
 func (*AllocatedCpuResources) ProtoMessage()    {}
 func (*AllocatedCpuResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_driver_8edefdede9e0ed2d, []int{37}
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{38}
 }
 func (m *AllocatedCpuResources) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AllocatedCpuResources.Unmarshal(m, b)
@@ -2207,7 +2329,7 @@ func (m *AllocatedMemoryResources) Reset()         { *m = AllocatedMemoryResourc
 func (m *AllocatedMemoryResources) String() string { return proto.CompactTextString(m) }
 func (*AllocatedMemoryResources) ProtoMessage()    {}
 func (*AllocatedMemoryResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_driver_8edefdede9e0ed2d, []int{38}
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{39}
 }
 func (m *AllocatedMemoryResources) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AllocatedMemoryResources.Unmarshal(m, b)
@@ -2250,7 +2372,7 @@ func (m *NetworkResource) Reset()         { *m = NetworkResource{} }
 func (m *NetworkResource) String() string { return proto.CompactTextString(m) }
 func (*NetworkResource) ProtoMessage()    {}
 func (*NetworkResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_driver_8edefdede9e0ed2d, []int{39}
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{40}
 }
 func (m *NetworkResource) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkResource.Unmarshal(m, b)
@@ -2315,6 +2437,7 @@ func (m *NetworkResource) GetDynamicPorts() []*NetworkPort {
 type NetworkPort struct {
 	Label                string   `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
 	Value                int32    `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	HostIp               string   `protobuf:"bytes,3,opt,name=host_ip,json=hostIp,proto3" json:"host_ip,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2324,7 +2447,7 @@ func (m *NetworkPort) Reset()         { *m = NetworkPort{} }
 func (m *NetworkPort) String() string { return proto.CompactTextString(m) }
 func (*NetworkPort) ProtoMessage()    {}
 func (*NetworkPort) Descriptor() ([]byte, []int) {
-	return fileDescriptor_driver_8edefdede9e0ed2d, []int{40}
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{41}
 }
 func (m *NetworkPort) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkPort.Unmarshal(m, b)
@@ -2358,6 +2481,13 @@ func (m *NetworkPort) GetValue() int32 {
 	return 0
 }
 
+func (m *NetworkPort) GetHostIp() string {
+	if m != nil {
+		return m.HostIp
+	}
+	return ""
+}
+
 type LinuxResources struct {
 	// CPU CFS (Completely Fair Scheduler) period. Default: 0 (not specified)
 	CpuPeriod int64 `protobuf:"varint,1,opt,name=cpu_period,json=cpuPeriod,proto3" json:"cpu_period,omitempty"`
@@ -2384,7 +2514,7 @@ func (m *LinuxResources) Reset()         { *m = LinuxResources{} }
 func (m *LinuxResources) String() string { return proto.CompactTextString(m) }
 func (*LinuxResources) ProtoMessage()    {}
 func (*LinuxResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_driver_8edefdede9e0ed2d, []int{41}
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{42}
 }
 func (m *LinuxResources) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LinuxResources.Unmarshal(m, b)
@@ -2476,7 +2606,7 @@ func (m *Mount) Reset()         { *m = Mount{} }
 func (m *Mount) String() string { return proto.CompactTextString(m) }
 func (*Mount) ProtoMessage()    {}
 func (*Mount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_driver_8edefdede9e0ed2d, []int{42}
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{43}
 }
 func (m *Mount) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Mount.Unmarshal(m, b)
@@ -2539,7 +2669,7 @@ func (m *Device) Reset()         { *m = Device{} }
 func (m *Device) String() string { return proto.CompactTextString(m) }
 func (*Device) ProtoMessage()    {}
 func (*Device) Descriptor() ([]byte, []int) {
-	return fileDescriptor_driver_8edefdede9e0ed2d, []int{43}
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{44}
 }
 func (m *Device) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Device.Unmarshal(m, b)
@@ -2600,7 +2730,7 @@ func (m *TaskHandle) Reset()         { *m = TaskHandle{} }
 func (m *TaskHandle) String() string { return proto.CompactTextString(m) }
 func (*TaskHandle) ProtoMessage()    {}
 func (*TaskHandle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_driver_8edefdede9e0ed2d, []int{44}
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{45}
 }
 func (m *TaskHandle) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TaskHandle.Unmarshal(m, b)
@@ -2667,7 +2797,7 @@ func (m *NetworkOverride) Reset()         { *m = NetworkOverride{} }
 func (m *NetworkOverride) String() string { return proto.CompactTextString(m) }
 func (*NetworkOverride) ProtoMessage()    {}
 func (*NetworkOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_driver_8edefdede9e0ed2d, []int{45}
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{46}
 }
 func (m *NetworkOverride) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkOverride.Unmarshal(m, b)
@@ -2725,7 +2855,7 @@ func (m *ExitResult) Reset()         { *m = ExitResult{} }
 func (m *ExitResult) String() string { return proto.CompactTextString(m) }
 func (*ExitResult) ProtoMessage()    {}
 func (*ExitResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_driver_8edefdede9e0ed2d, []int{46}
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{47}
 }
 func (m *ExitResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExitResult.Unmarshal(m, b)
@@ -2788,7 +2918,7 @@ func (m *TaskStatus) Reset()         { *m = TaskStatus{} }
 func (m *TaskStatus) String() string { return proto.CompactTextString(m) }
 func (*TaskStatus) ProtoMessage()    {}
 func (*TaskStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_driver_8edefdede9e0ed2d, []int{47}
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{48}
 }
 func (m *TaskStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TaskStatus.Unmarshal(m, b)
@@ -2863,7 +2993,7 @@ func (m *TaskDriverStatus) Reset()         { *m = TaskDriverStatus{} }
 func (m *TaskDriverStatus) String() string { return proto.CompactTextString(m) }
 func (*TaskDriverStatus) ProtoMessage()    {}
 func (*TaskDriverStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_driver_8edefdede9e0ed2d, []int{48}
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{49}
 }
 func (m *TaskDriverStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TaskDriverStatus.Unmarshal(m, b)
@@ -2908,7 +3038,7 @@ func (m *TaskStats) Reset()         { *m = TaskStats{} }
 func (m *TaskStats) String() string { return proto.CompactTextString(m) }
 func (*TaskStats) ProtoMessage()    {}
 func (*TaskStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_driver_8edefdede9e0ed2d, []int{49}
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{50}
 }
 func (m *TaskStats) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TaskStats.Unmarshal(m, b)
@@ -2960,17 +3090,21 @@ type TaskResourceUsage struct {
 	// CPU usage stats
 	Cpu *CPUUsage `protobuf:"bytes,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
 	// Memory usage stats
-	Memory               *MemoryUsage `protobuf:"bytes,2,opt,name=memory,proto3" json:"memory,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Memory *MemoryUsage `protobuf:"bytes,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	// Network usage stats
+	Network *NetworkUsage `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	// Block IO usage stats
+	BlockIo              *BlockIOUsage `protobuf:"bytes,4,opt,name=block_io,json=blockIo,proto3" json:"block_io,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *TaskResourceUsage) Reset()         { *m = TaskResourceUsage{} }
 func (m *TaskResourceUsage) String() string { return proto.CompactTextString(m) }
 func (*TaskResourceUsage) ProtoMessage()    {}
 func (*TaskResourceUsage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_driver_8edefdede9e0ed2d, []int{50}
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{51}
 }
 func (m *TaskResourceUsage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TaskResourceUsage.Unmarshal(m, b)
@@ -3004,6 +3138,20 @@ func (m *TaskResourceUsage) GetMemory() *MemoryUsage {
 	return nil
 }
 
+func (m *TaskResourceUsage) GetNetwork() *NetworkUsage {
+	if m != nil {
+		return m.Network
+	}
+	return nil
+}
+
+func (m *TaskResourceUsage) GetBlockIo() *BlockIOUsage {
+	if m != nil {
+		return m.BlockIo
+	}
+	return nil
+}
+
 type CPUUsage struct {
 	SystemMode       float64 `protobuf:"fixed64,1,opt,name=system_mode,json=systemMode,proto3" json:"system_mode,omitempty"`
 	UserMode         float64 `protobuf:"fixed64,2,opt,name=user_mode,json=userMode,proto3" json:"user_mode,omitempty"`
@@ -3022,7 +3170,7 @@ func (m *CPUUsage) Reset()         { *m = CPUUsage{} }
 func (m *CPUUsage) String() string { return proto.CompactTextString(m) }
 func (*CPUUsage) ProtoMessage()    {}
 func (*CPUUsage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_driver_8edefdede9e0ed2d, []int{51}
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{52}
 }
 func (m *CPUUsage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CPUUsage.Unmarshal(m, b)
@@ -3110,7 +3258,7 @@ func (m *MemoryUsage) Reset()         { *m = MemoryUsage{} }
 func (m *MemoryUsage) String() string { return proto.CompactTextString(m) }
 func (*MemoryUsage) ProtoMessage()    {}
 func (*MemoryUsage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_driver_8edefdede9e0ed2d, []int{52}
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{53}
 }
 func (m *MemoryUsage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MemoryUsage.Unmarshal(m, b)
@@ -3186,6 +3334,148 @@ func (m *MemoryUsage) GetMeasuredFields() []MemoryUsage_Fields {
 	return nil
 }
 
+type NetworkUsage struct {
+	RxBytes   uint64 `protobuf:"varint,1,opt,name=rx_bytes,json=rxBytes,proto3" json:"rx_bytes,omitempty"`
+	TxBytes   uint64 `protobuf:"varint,2,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	RxPackets uint64 `protobuf:"varint,3,opt,name=rx_packets,json=rxPackets,proto3" json:"rx_packets,omitempty"`
+	TxPackets uint64 `protobuf:"varint,4,opt,name=tx_packets,json=txPackets,proto3" json:"tx_packets,omitempty"`
+	// MeasuredFields indicates which fields were actually sampled
+	MeasuredFields       []NetworkUsage_Fields `protobuf:"varint,5,rep,packed,name=measured_fields,json=measuredFields,proto3,enum=hashicorp.nomad.plugins.drivers.proto.NetworkUsage_Fields" json:"measured_fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *NetworkUsage) Reset()         { *m = NetworkUsage{} }
+func (m *NetworkUsage) String() string { return proto.CompactTextString(m) }
+func (*NetworkUsage) ProtoMessage()    {}
+func (*NetworkUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{54}
+}
+func (m *NetworkUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetworkUsage.Unmarshal(m, b)
+}
+func (m *NetworkUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetworkUsage.Marshal(b, m, deterministic)
+}
+func (dst *NetworkUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkUsage.Merge(dst, src)
+}
+func (m *NetworkUsage) XXX_Size() int {
+	return xxx_messageInfo_NetworkUsage.Size(m)
+}
+func (m *NetworkUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkUsage proto.InternalMessageInfo
+
+func (m *NetworkUsage) GetRxBytes() uint64 {
+	if m != nil {
+		return m.RxBytes
+	}
+	return 0
+}
+
+func (m *NetworkUsage) GetTxBytes() uint64 {
+	if m != nil {
+		return m.TxBytes
+	}
+	return 0
+}
+
+func (m *NetworkUsage) GetRxPackets() uint64 {
+	if m != nil {
+		return m.RxPackets
+	}
+	return 0
+}
+
+func (m *NetworkUsage) GetTxPackets() uint64 {
+	if m != nil {
+		return m.TxPackets
+	}
+	return 0
+}
+
+func (m *NetworkUsage) GetMeasuredFields() []NetworkUsage_Fields {
+	if m != nil {
+		return m.MeasuredFields
+	}
+	return nil
+}
+
+type BlockIOUsage struct {
+	ReadBytes  uint64 `protobuf:"varint,1,opt,name=read_bytes,json=readBytes,proto3" json:"read_bytes,omitempty"`
+	WriteBytes uint64 `protobuf:"varint,2,opt,name=write_bytes,json=writeBytes,proto3" json:"write_bytes,omitempty"`
+	ReadOps    uint64 `protobuf:"varint,3,opt,name=read_ops,json=readOps,proto3" json:"read_ops,omitempty"`
+	WriteOps   uint64 `protobuf:"varint,4,opt,name=write_ops,json=writeOps,proto3" json:"write_ops,omitempty"`
+	// MeasuredFields indicates which fields were actually sampled
+	MeasuredFields       []BlockIOUsage_Fields `protobuf:"varint,5,rep,packed,name=measured_fields,json=measuredFields,proto3,enum=hashicorp.nomad.plugins.drivers.proto.BlockIOUsage_Fields" json:"measured_fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *BlockIOUsage) Reset()         { *m = BlockIOUsage{} }
+func (m *BlockIOUsage) String() string { return proto.CompactTextString(m) }
+func (*BlockIOUsage) ProtoMessage()    {}
+func (*BlockIOUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{55}
+}
+func (m *BlockIOUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockIOUsage.Unmarshal(m, b)
+}
+func (m *BlockIOUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockIOUsage.Marshal(b, m, deterministic)
+}
+func (dst *BlockIOUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockIOUsage.Merge(dst, src)
+}
+func (m *BlockIOUsage) XXX_Size() int {
+	return xxx_messageInfo_BlockIOUsage.Size(m)
+}
+func (m *BlockIOUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockIOUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockIOUsage proto.InternalMessageInfo
+
+func (m *BlockIOUsage) GetReadBytes() uint64 {
+	if m != nil {
+		return m.ReadBytes
+	}
+	return 0
+}
+
+func (m *BlockIOUsage) GetWriteBytes() uint64 {
+	if m != nil {
+		return m.WriteBytes
+	}
+	return 0
+}
+
+func (m *BlockIOUsage) GetReadOps() uint64 {
+	if m != nil {
+		return m.ReadOps
+	}
+	return 0
+}
+
+func (m *BlockIOUsage) GetWriteOps() uint64 {
+	if m != nil {
+		return m.WriteOps
+	}
+	return 0
+}
+
+func (m *BlockIOUsage) GetMeasuredFields() []BlockIOUsage_Fields {
+	if m != nil {
+		return m.MeasuredFields
+	}
+	return nil
+}
+
 type DriverTaskEvent struct {
 	// TaskId is the id of the task for the event
 	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
@@ -3208,7 +3498,7 @@ func (m *DriverTaskEvent) Reset()         { *m = DriverTaskEvent{} }
 func (m *DriverTaskEvent) String() string { return proto.CompactTextString(m) }
 func (*DriverTaskEvent) ProtoMessage()    {}
 func (*DriverTaskEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_driver_8edefdede9e0ed2d, []int{53}
+	return fileDescriptor_driver_8edefdede9e0ed2d, []int{56}
 }
 func (m *DriverTaskEvent) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DriverTaskEvent.Unmarshal(m, b)
@@ -3309,6 +3599,7 @@ func init() {
 	proto.RegisterType((*DriverCapabilities)(nil), "hashicorp.nomad.plugins.drivers.proto.DriverCapabilities")
 	proto.RegisterType((*NetworkIsolationSpec)(nil), "hashicorp.nomad.plugins.drivers.proto.NetworkIsolationSpec")
 	proto.RegisterMapType((map[string]string)(nil), "hashicorp.nomad.plugins.drivers.proto.NetworkIsolationSpec.LabelsEntry")
+	proto.RegisterType((*DNSConfig)(nil), "hashicorp.nomad.plugins.drivers.proto.DNSConfig")
 	proto.RegisterType((*TaskConfig)(nil), "hashicorp.nomad.plugins.drivers.proto.TaskConfig")
 	proto.RegisterMapType((map[string]string)(nil), "hashicorp.nomad.plugins.drivers.proto.TaskConfig.DeviceEnvEntry")
 	proto.RegisterMapType((map[string]string)(nil), "hashicorp.nomad.plugins.drivers.proto.TaskConfig.EnvEntry")
@@ -3333,6 +3624,8 @@ func init() {
 	proto.RegisterType((*TaskResourceUsage)(nil), "hashicorp.nomad.plugins.drivers.proto.TaskResourceUsage")
 	proto.RegisterType((*CPUUsage)(nil), "hashicorp.nomad.plugins.drivers.proto.CPUUsage")
 	proto.RegisterType((*MemoryUsage)(nil), "hashicorp.nomad.plugins.drivers.proto.MemoryUsage")
+	proto.RegisterType((*NetworkUsage)(nil), "hashicorp.nomad.plugins.drivers.proto.NetworkUsage")
+	proto.RegisterType((*BlockIOUsage)(nil), "hashicorp.nomad.plugins.drivers.proto.BlockIOUsage")
 	proto.RegisterType((*DriverTaskEvent)(nil), "hashicorp.nomad.plugins.drivers.proto.DriverTaskEvent")
 	proto.RegisterMapType((map[string]string)(nil), "hashicorp.nomad.plugins.drivers.proto.DriverTaskEvent.AnnotationsEntry")
 	proto.RegisterEnum("hashicorp.nomad.plugins.drivers.proto.TaskState", TaskState_name, TaskState_value)
@@ -3342,6 +3635,8 @@ func init() {
 	proto.RegisterEnum("hashicorp.nomad.plugins.drivers.proto.NetworkIsolationSpec_NetworkIsolationMode", NetworkIsolationSpec_NetworkIsolationMode_name, NetworkIsolationSpec_NetworkIsolationMode_value)
 	proto.RegisterEnum("hashicorp.nomad.plugins.drivers.proto.CPUUsage_Fields", CPUUsage_Fields_name, CPUUsage_Fields_value)
 	proto.RegisterEnum("hashicorp.nomad.plugins.drivers.proto.MemoryUsage_Fields", MemoryUsage_Fields_name, MemoryUsage_Fields_value)
+	proto.RegisterEnum("hashicorp.nomad.plugins.drivers.proto.NetworkUsage_Fields", NetworkUsage_Fields_name, NetworkUsage_Fields_value)
+	proto.RegisterEnum("hashicorp.nomad.plugins.drivers.proto.BlockIOUsage_Fields", BlockIOUsage_Fields_name, BlockIOUsage_Fields_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4102,226 +4397,244 @@ func init() {
 }
 
 var fileDescriptor_driver_8edefdede9e0ed2d = []byte{
-	// 3525 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0x4f, 0x6f, 0x23, 0x47,
-	0x76, 0x57, 0xf3, 0x9f, 0xc8, 0x47, 0x89, 0x6a, 0x95, 0xa4, 0x31, 0x87, 0x4e, 0xe2, 0xd9, 0x06,
-	0x36, 0x10, 0x76, 0x6d, 0xca, 0xd6, 0x22, 0x1e, 0xcb, 0x3b, 0xde, 0x31, 0x4d, 0x71, 0x24, 0x79,
-	0x24, 0x4a, 0x29, 0x52, 0x98, 0x9d, 0x38, 0xeb, 0x4e, 0xab, 0xbb, 0x86, 0xec, 0x11, 0xfb, 0x8f,
-	0xbb, 0x8b, 0x1a, 0x69, 0x83, 0x20, 0xc1, 0x06, 0x08, 0x36, 0x40, 0x82, 0xe4, 0xe2, 0xec, 0x25,
-	0x87, 0x60, 0x73, 0x4c, 0x3e, 0x40, 0x90, 0x60, 0xcf, 0xf9, 0x10, 0xc9, 0x25, 0xb7, 0x5c, 0x72,
-	0xc8, 0x37, 0x58, 0xd4, 0x9f, 0x6e, 0x76, 0x8b, 0x1c, 0x4f, 0x93, 0x9a, 0x53, 0x77, 0xbd, 0xaa,
-	0xfa, 0xd5, 0xab, 0xf7, 0x5e, 0xd5, 0x7b, 0x55, 0xf5, 0x40, 0xf3, 0x47, 0xe3, 0x81, 0xed, 0x86,
-	0x3b, 0x56, 0x60, 0x5f, 0x91, 0x20, 0xdc, 0xf1, 0x03, 0x8f, 0x7a, 0xb2, 0xd4, 0xe4, 0x05, 0xf4,
-	0xfd, 0xa1, 0x11, 0x0e, 0x6d, 0xd3, 0x0b, 0xfc, 0xa6, 0xeb, 0x39, 0x86, 0xd5, 0x94, 0x7d, 0x9a,
-	0xb2, 0x8f, 0x68, 0xd6, 0xf8, 0xbd, 0x81, 0xe7, 0x0d, 0x46, 0x44, 0x20, 0x5c, 0x8c, 0x5f, 0xec,
-	0x58, 0xe3, 0xc0, 0xa0, 0xb6, 0xe7, 0xca, 0xfa, 0xf7, 0x6e, 0xd7, 0x53, 0xdb, 0x21, 0x21, 0x35,
-	0x1c, 0x5f, 0x36, 0xf8, 0x7c, 0x60, 0xd3, 0xe1, 0xf8, 0xa2, 0x69, 0x7a, 0xce, 0x4e, 0x3c, 0xe4,
-	0x0e, 0x1f, 0x72, 0x27, 0x62, 0x33, 0x1c, 0x1a, 0x01, 0xb1, 0x76, 0x86, 0xe6, 0x28, 0xf4, 0x89,
-	0xc9, 0xbe, 0x3a, 0xfb, 0x91, 0x08, 0x07, 0xd9, 0x11, 0x42, 0x1a, 0x8c, 0x4d, 0x1a, 0xcd, 0xd7,
-	0xa0, 0x34, 0xb0, 0x2f, 0xc6, 0x94, 0x08, 0x20, 0xed, 0x3e, 0xbc, 0xd3, 0x37, 0xc2, 0xcb, 0xb6,
-	0xe7, 0xbe, 0xb0, 0x07, 0x3d, 0x73, 0x48, 0x1c, 0x03, 0x93, 0x6f, 0xc6, 0x24, 0xa4, 0xda, 0x1f,
-	0x43, 0x7d, 0xba, 0x2a, 0xf4, 0x3d, 0x37, 0x24, 0xe8, 0x73, 0x28, 0x30, 0x6e, 0xea, 0xca, 0x03,
-	0x65, 0xbb, 0xba, 0xfb, 0x7e, 0xf3, 0x75, 0x82, 0x13, 0x3c, 0x34, 0xe5, 0x2c, 0x9a, 0x3d, 0x9f,
-	0x98, 0x98, 0xf7, 0xd4, 0xb6, 0x60, 0xa3, 0x6d, 0xf8, 0xc6, 0x85, 0x3d, 0xb2, 0xa9, 0x4d, 0xc2,
-	0x68, 0xd0, 0x31, 0x6c, 0xa6, 0xc9, 0x72, 0xc0, 0x9f, 0xc1, 0x8a, 0x99, 0xa0, 0xcb, 0x81, 0xf7,
-	0x9a, 0x99, 0x34, 0xd6, 0xdc, 0xe7, 0xa5, 0x14, 0x70, 0x0a, 0x4e, 0xdb, 0x04, 0xf4, 0xc4, 0x76,
-	0x07, 0x24, 0xf0, 0x03, 0xdb, 0xa5, 0x11, 0x33, 0xbf, 0xc9, 0xc3, 0x46, 0x8a, 0x2c, 0x99, 0x79,
-	0x09, 0x10, 0xcb, 0x91, 0xb1, 0x92, 0xdf, 0xae, 0xee, 0x7e, 0x99, 0x91, 0x95, 0x19, 0x78, 0xcd,
-	0x56, 0x0c, 0xd6, 0x71, 0x69, 0x70, 0x83, 0x13, 0xe8, 0xe8, 0x6b, 0x28, 0x0d, 0x89, 0x31, 0xa2,
-	0xc3, 0x7a, 0xee, 0x81, 0xb2, 0x5d, 0xdb, 0x7d, 0x72, 0x87, 0x71, 0x0e, 0x39, 0x50, 0x8f, 0x1a,
-	0x94, 0x60, 0x89, 0x8a, 0x3e, 0x00, 0x24, 0xfe, 0x74, 0x8b, 0x84, 0x66, 0x60, 0xfb, 0xcc, 0x90,
-	0xeb, 0xf9, 0x07, 0xca, 0x76, 0x05, 0xaf, 0x8b, 0x9a, 0xfd, 0x49, 0x45, 0xc3, 0x87, 0xb5, 0x5b,
-	0xdc, 0x22, 0x15, 0xf2, 0x97, 0xe4, 0x86, 0x6b, 0xa4, 0x82, 0xd9, 0x2f, 0x3a, 0x80, 0xe2, 0x95,
-	0x31, 0x1a, 0x13, 0xce, 0x72, 0x75, 0xf7, 0xa3, 0x37, 0x99, 0x87, 0x34, 0xd1, 0x89, 0x1c, 0xb0,
-	0xe8, 0xff, 0x69, 0xee, 0x13, 0x45, 0xdb, 0x83, 0x6a, 0x82, 0x6f, 0x54, 0x03, 0x38, 0xef, 0xee,
-	0x77, 0xfa, 0x9d, 0x76, 0xbf, 0xb3, 0xaf, 0x2e, 0xa1, 0x55, 0xa8, 0x9c, 0x77, 0x0f, 0x3b, 0xad,
-	0xe3, 0xfe, 0xe1, 0x73, 0x55, 0x41, 0x55, 0x58, 0x8e, 0x0a, 0x39, 0xed, 0x1a, 0x10, 0x26, 0xa6,
-	0x77, 0x45, 0x02, 0x66, 0xc8, 0x52, 0xab, 0xe8, 0x1d, 0x58, 0xa6, 0x46, 0x78, 0xa9, 0xdb, 0x96,
-	0xe4, 0xb9, 0xc4, 0x8a, 0x47, 0x16, 0x3a, 0x82, 0xd2, 0xd0, 0x70, 0xad, 0xd1, 0x9b, 0xf9, 0x4e,
-	0x8b, 0x9a, 0x81, 0x1f, 0xf2, 0x8e, 0x58, 0x02, 0x30, 0xeb, 0x4e, 0x8d, 0x2c, 0x14, 0xa0, 0x3d,
-	0x07, 0xb5, 0x47, 0x8d, 0x80, 0x26, 0xd9, 0xe9, 0x40, 0x81, 0x8d, 0x2f, 0x2d, 0x7a, 0x9e, 0x31,
-	0xc5, 0xca, 0xc4, 0xbc, 0xbb, 0xf6, 0xff, 0x39, 0x58, 0x4f, 0x60, 0x4b, 0x4b, 0x7d, 0x06, 0xa5,
-	0x80, 0x84, 0xe3, 0x11, 0xe5, 0xf0, 0xb5, 0xdd, 0xc7, 0x19, 0xe1, 0xa7, 0x90, 0x9a, 0x98, 0xc3,
-	0x60, 0x09, 0x87, 0xb6, 0x41, 0x15, 0x3d, 0x74, 0x12, 0x04, 0x5e, 0xa0, 0x3b, 0xe1, 0x80, 0x4b,
-	0xad, 0x82, 0x6b, 0x82, 0xde, 0x61, 0xe4, 0x93, 0x70, 0x90, 0x90, 0x6a, 0xfe, 0x8e, 0x52, 0x45,
-	0x06, 0xa8, 0x2e, 0xa1, 0xaf, 0xbc, 0xe0, 0x52, 0x67, 0xa2, 0x0d, 0x6c, 0x8b, 0xd4, 0x0b, 0x1c,
-	0xf4, 0xe3, 0x8c, 0xa0, 0x5d, 0xd1, 0xfd, 0x54, 0xf6, 0xc6, 0x6b, 0x6e, 0x9a, 0xa0, 0xfd, 0x10,
-	0x4a, 0x62, 0xa6, 0xcc, 0x92, 0x7a, 0xe7, 0xed, 0x76, 0xa7, 0xd7, 0x53, 0x97, 0x50, 0x05, 0x8a,
-	0xb8, 0xd3, 0xc7, 0xcc, 0xc2, 0x2a, 0x50, 0x7c, 0xd2, 0xea, 0xb7, 0x8e, 0xd5, 0x9c, 0xf6, 0x03,
-	0x58, 0x7b, 0x66, 0xd8, 0x34, 0x8b, 0x71, 0x69, 0x1e, 0xa8, 0x93, 0xb6, 0x52, 0x3b, 0x47, 0x29,
-	0xed, 0x64, 0x17, 0x4d, 0xe7, 0xda, 0xa6, 0xb7, 0xf4, 0xa1, 0x42, 0x9e, 0x04, 0x81, 0x54, 0x01,
-	0xfb, 0xd5, 0x5e, 0xc1, 0x5a, 0x8f, 0x7a, 0x7e, 0x26, 0xcb, 0xff, 0x11, 0x2c, 0x33, 0x1f, 0xe5,
-	0x8d, 0xa9, 0x34, 0xfd, 0xfb, 0x4d, 0xe1, 0xc3, 0x9a, 0x91, 0x0f, 0x6b, 0xee, 0x4b, 0x1f, 0x87,
-	0xa3, 0x96, 0xe8, 0x1e, 0x94, 0x42, 0x7b, 0xe0, 0x1a, 0x23, 0xb9, 0x5b, 0xc8, 0x92, 0x86, 0x98,
-	0x91, 0x47, 0x03, 0x4b, 0xc3, 0x6f, 0x03, 0xda, 0x27, 0x21, 0x0d, 0xbc, 0x9b, 0x4c, 0xfc, 0x6c,
-	0x42, 0xf1, 0x85, 0x17, 0x98, 0x62, 0x21, 0x96, 0xb1, 0x28, 0xb0, 0x45, 0x95, 0x02, 0x91, 0xd8,
-	0x1f, 0x00, 0x3a, 0x72, 0x99, 0x4f, 0xc9, 0xa6, 0x88, 0xbf, 0xcf, 0xc1, 0x46, 0xaa, 0xbd, 0x54,
-	0xc6, 0xe2, 0xeb, 0x90, 0x6d, 0x4c, 0xe3, 0x50, 0xac, 0x43, 0x74, 0x0a, 0x25, 0xd1, 0x42, 0x4a,
-	0xf2, 0xe1, 0x1c, 0x40, 0xc2, 0x4d, 0x49, 0x38, 0x09, 0x33, 0xd3, 0xe8, 0xf3, 0x6f, 0xd7, 0xe8,
-	0x5f, 0x81, 0x1a, 0xcd, 0x23, 0x7c, 0xa3, 0x6e, 0xbe, 0x84, 0x0d, 0xd3, 0x1b, 0x8d, 0x88, 0xc9,
-	0xac, 0x41, 0xb7, 0x5d, 0x4a, 0x82, 0x2b, 0x63, 0xf4, 0x66, 0xbb, 0x41, 0x93, 0x5e, 0x47, 0xb2,
-	0x93, 0xf6, 0x15, 0xac, 0x27, 0x06, 0x96, 0x8a, 0x78, 0x02, 0xc5, 0x90, 0x11, 0xa4, 0x26, 0x3e,
-	0x9c, 0x53, 0x13, 0x21, 0x16, 0xdd, 0xb5, 0x0d, 0x01, 0xde, 0xb9, 0x22, 0x6e, 0x3c, 0x2d, 0x6d,
-	0x1f, 0xd6, 0x7b, 0xdc, 0x4c, 0x33, 0xd9, 0xe1, 0xc4, 0xc4, 0x73, 0x29, 0x13, 0xdf, 0x04, 0x94,
-	0x44, 0x91, 0x86, 0x78, 0x03, 0x6b, 0x9d, 0x6b, 0x62, 0x66, 0x42, 0xae, 0xc3, 0xb2, 0xe9, 0x39,
-	0x8e, 0xe1, 0x5a, 0xf5, 0xdc, 0x83, 0xfc, 0x76, 0x05, 0x47, 0xc5, 0xe4, 0x5a, 0xcc, 0x67, 0x5d,
-	0x8b, 0xda, 0xdf, 0x2a, 0xa0, 0x4e, 0xc6, 0x96, 0x82, 0x64, 0xdc, 0x53, 0x8b, 0x01, 0xb1, 0xb1,
-	0x57, 0xb0, 0x2c, 0x49, 0x7a, 0xb4, 0x5d, 0x08, 0x3a, 0x09, 0x82, 0xc4, 0x76, 0x94, 0xbf, 0xe3,
-	0x76, 0xa4, 0x1d, 0xc2, 0xef, 0x44, 0xec, 0xf4, 0x68, 0x40, 0x0c, 0xc7, 0x76, 0x07, 0x47, 0xa7,
-	0xa7, 0x3e, 0x11, 0x8c, 0x23, 0x04, 0x05, 0xcb, 0xa0, 0x86, 0x64, 0x8c, 0xff, 0xb3, 0x45, 0x6f,
-	0x8e, 0xbc, 0x30, 0x5e, 0xf4, 0xbc, 0xa0, 0xfd, 0x67, 0x1e, 0xea, 0x53, 0x50, 0x91, 0x78, 0xbf,
-	0x82, 0x62, 0x48, 0xe8, 0xd8, 0x97, 0xa6, 0xd2, 0xc9, 0xcc, 0xf0, 0x6c, 0xbc, 0x66, 0x8f, 0x81,
-	0x61, 0x81, 0x89, 0x06, 0x50, 0xa6, 0xf4, 0x46, 0x0f, 0xed, 0x9f, 0x47, 0x01, 0xc1, 0xf1, 0x5d,
-	0xf1, 0xfb, 0x24, 0x70, 0x6c, 0xd7, 0x18, 0xf5, 0xec, 0x9f, 0x13, 0xbc, 0x4c, 0xe9, 0x0d, 0xfb,
-	0x41, 0xcf, 0x99, 0xc1, 0x5b, 0xb6, 0x2b, 0xc5, 0xde, 0x5e, 0x74, 0x94, 0x84, 0x80, 0xb1, 0x40,
-	0x6c, 0x1c, 0x43, 0x91, 0xcf, 0x69, 0x11, 0x43, 0x54, 0x21, 0x4f, 0xe9, 0x0d, 0x67, 0xaa, 0x8c,
-	0xd9, 0x6f, 0xe3, 0x11, 0xac, 0x24, 0x67, 0xc0, 0x0c, 0x69, 0x48, 0xec, 0xc1, 0x50, 0x18, 0x58,
-	0x11, 0xcb, 0x12, 0xd3, 0xe4, 0x2b, 0xdb, 0x92, 0x21, 0x6b, 0x11, 0x8b, 0x82, 0xf6, 0x6f, 0x39,
-	0xb8, 0x3f, 0x43, 0x32, 0xd2, 0x58, 0xbf, 0x4a, 0x19, 0xeb, 0x5b, 0x92, 0x42, 0x64, 0xf1, 0x5f,
-	0xa5, 0x2c, 0xfe, 0x2d, 0x82, 0xb3, 0x65, 0x73, 0x0f, 0x4a, 0xe4, 0xda, 0xa6, 0xc4, 0x92, 0xa2,
-	0x92, 0xa5, 0xc4, 0x72, 0x2a, 0xdc, 0x75, 0x39, 0x7d, 0x04, 0x9b, 0xed, 0x80, 0x18, 0x94, 0xc8,
-	0xad, 0x3c, 0xb2, 0xff, 0xfb, 0x50, 0x36, 0x46, 0x23, 0xcf, 0x9c, 0xa8, 0x75, 0x99, 0x97, 0x8f,
-	0x2c, 0xed, 0x5b, 0x05, 0xb6, 0x6e, 0xf5, 0x91, 0x92, 0xbe, 0x80, 0x9a, 0x1d, 0x7a, 0x23, 0x3e,
-	0x09, 0x3d, 0x71, 0x8a, 0xfb, 0xf1, 0x7c, 0xee, 0xe4, 0x28, 0xc2, 0xe0, 0x87, 0xba, 0x55, 0x3b,
-	0x59, 0xe4, 0x56, 0xc5, 0x07, 0xb7, 0xe4, 0x6a, 0x8e, 0x8a, 0xda, 0x3f, 0x28, 0xb0, 0x25, 0xbd,
-	0x78, 0xe6, 0xc9, 0xcc, 0x60, 0x39, 0xf7, 0xb6, 0x59, 0xd6, 0xea, 0x70, 0xef, 0x36, 0x5f, 0x72,
-	0x5f, 0xff, 0xa7, 0x3c, 0xa0, 0xe9, 0x13, 0x24, 0xfa, 0x1e, 0xac, 0x84, 0xc4, 0xb5, 0x74, 0xe1,
-	0x13, 0x84, 0xbb, 0x2a, 0xe3, 0x2a, 0xa3, 0x09, 0xe7, 0x10, 0xb2, 0x6d, 0x8e, 0x5c, 0x4b, 0x6e,
-	0xcb, 0x98, 0xff, 0xa3, 0x21, 0xac, 0xbc, 0x08, 0xf5, 0x78, 0x6c, 0x6e, 0x34, 0xb5, 0xcc, 0x5b,
-	0xd7, 0x34, 0x1f, 0xcd, 0x27, 0xbd, 0x78, 0x5e, 0xb8, 0xfa, 0x22, 0x8c, 0x0b, 0xe8, 0x97, 0x0a,
-	0xbc, 0x13, 0x85, 0x0e, 0x13, 0xf1, 0x39, 0x9e, 0x45, 0xc2, 0x7a, 0xe1, 0x41, 0x7e, 0xbb, 0xb6,
-	0x7b, 0x76, 0x07, 0xf9, 0x4d, 0x11, 0x4f, 0x3c, 0x8b, 0xe0, 0x2d, 0x77, 0x06, 0x35, 0x44, 0x4d,
-	0xd8, 0x70, 0xc6, 0x21, 0xd5, 0x85, 0x15, 0xe8, 0xb2, 0x51, 0xbd, 0xc8, 0xe5, 0xb2, 0xce, 0xaa,
-	0x52, 0xb6, 0xaa, 0x35, 0xa1, 0x9a, 0x98, 0x16, 0x2a, 0x43, 0xa1, 0x7b, 0xda, 0xed, 0xa8, 0x4b,
-	0x08, 0xa0, 0xd4, 0x3e, 0xc4, 0xa7, 0xa7, 0x7d, 0x11, 0x89, 0x1f, 0x9d, 0xb4, 0x0e, 0x3a, 0x6a,
-	0x4e, 0xfb, 0xbf, 0x1c, 0x6c, 0xce, 0x62, 0x12, 0x59, 0x50, 0x60, 0x13, 0x96, 0xc7, 0x9f, 0xb7,
-	0x3f, 0x5f, 0x8e, 0xce, 0xf4, 0xec, 0x1b, 0x72, 0xbf, 0xab, 0x60, 0xfe, 0x8f, 0x74, 0x28, 0x8d,
-	0x8c, 0x0b, 0x32, 0x0a, 0xeb, 0x79, 0x7e, 0x41, 0x70, 0x70, 0x97, 0xb1, 0x8f, 0x39, 0x92, 0xb8,
-	0x1d, 0x90, 0xb0, 0x8d, 0x3d, 0xa8, 0x26, 0xc8, 0x33, 0x8e, 0xe1, 0x9b, 0xc9, 0x63, 0x78, 0x25,
-	0x79, 0xa6, 0x7e, 0x3c, 0x2d, 0x2d, 0x36, 0x1b, 0x26, 0xe7, 0xc3, 0xd3, 0x5e, 0x5f, 0x1c, 0x78,
-	0x0e, 0xf0, 0xe9, 0xf9, 0x99, 0xaa, 0x30, 0x62, 0xbf, 0xd5, 0x7b, 0xaa, 0xe6, 0x62, 0x35, 0xe4,
-	0xb5, 0x7f, 0x5d, 0x06, 0x98, 0x1c, 0x41, 0x51, 0x0d, 0x72, 0xf1, 0xa2, 0xcd, 0xd9, 0x16, 0x93,
-	0x87, 0x6b, 0x38, 0xd1, 0xc0, 0xfc, 0x1f, 0xed, 0xc2, 0x96, 0x13, 0x0e, 0x7c, 0xc3, 0xbc, 0xd4,
-	0xe5, 0xc9, 0xd1, 0xe4, 0x9d, 0xf9, 0x02, 0x58, 0xc1, 0x1b, 0xb2, 0x52, 0x1a, 0xb8, 0xc0, 0x3d,
-	0x86, 0x3c, 0x71, 0xaf, 0xb8, 0xb1, 0x56, 0x77, 0x3f, 0x9d, 0xfb, 0x68, 0xdc, 0xec, 0xb8, 0x57,
-	0x42, 0x66, 0x0c, 0x06, 0xe9, 0x00, 0x16, 0xb9, 0xb2, 0x4d, 0xa2, 0x33, 0xd0, 0x22, 0x07, 0xfd,
-	0x7c, 0x7e, 0xd0, 0x7d, 0x8e, 0x11, 0x43, 0x57, 0xac, 0xa8, 0x8c, 0xba, 0x50, 0x09, 0x48, 0xe8,
-	0x8d, 0x03, 0x93, 0x84, 0xf5, 0xd2, 0x5c, 0xd1, 0x2b, 0x8e, 0xfa, 0xe1, 0x09, 0x04, 0xda, 0x87,
-	0x92, 0xe3, 0x8d, 0x5d, 0x1a, 0xd6, 0x97, 0x39, 0xb3, 0xef, 0x67, 0x04, 0x3b, 0x61, 0x9d, 0xb0,
-	0xec, 0x8b, 0x0e, 0x60, 0x59, 0xb0, 0x18, 0xd6, 0xcb, 0x1c, 0xe6, 0x83, 0xac, 0x7b, 0x0d, 0xef,
-	0x85, 0xa3, 0xde, 0x4c, 0xab, 0xe3, 0x90, 0x04, 0xf5, 0x8a, 0xd0, 0x2a, 0xfb, 0x47, 0xef, 0x42,
-	0x45, 0x6c, 0xda, 0x96, 0x1d, 0xd4, 0x81, 0x57, 0x88, 0x5d, 0x7c, 0xdf, 0x0e, 0xd0, 0x7b, 0x50,
-	0x15, 0x0e, 0x58, 0xe7, 0xab, 0xa3, 0xca, 0xab, 0x41, 0x90, 0xce, 0xd8, 0x1a, 0x11, 0x0d, 0x48,
-	0x10, 0x88, 0x06, 0x2b, 0x71, 0x03, 0x12, 0x04, 0xbc, 0xc1, 0xef, 0xc3, 0x1a, 0x0f, 0x5b, 0x06,
-	0x81, 0x37, 0xf6, 0x75, 0x6e, 0x53, 0xab, 0xbc, 0xd1, 0x2a, 0x23, 0x1f, 0x30, 0x6a, 0x97, 0x19,
-	0xd7, 0x7d, 0x28, 0xbf, 0xf4, 0x2e, 0x44, 0x83, 0x9a, 0xf0, 0x1d, 0x2f, 0xbd, 0x8b, 0xa8, 0x2a,
-	0x76, 0x2b, 0x6b, 0x69, 0xb7, 0xf2, 0x0d, 0xdc, 0x9b, 0xde, 0x1f, 0xb9, 0x7b, 0x51, 0xef, 0xee,
-	0x5e, 0x36, 0xdd, 0x19, 0xd4, 0xc6, 0xc7, 0x50, 0x8e, 0x2c, 0x67, 0x9e, 0x15, 0xdb, 0x78, 0x04,
-	0xb5, 0xb4, 0xdd, 0xcd, 0xb5, 0xde, 0xff, 0x4b, 0x81, 0x4a, 0x6c, 0x61, 0xc8, 0x85, 0x0d, 0x2e,
-	0x01, 0xe6, 0x8f, 0xf5, 0x89, 0xc1, 0x8a, 0x28, 0xe0, 0xb3, 0x8c, 0x73, 0x6e, 0x45, 0x08, 0xf2,
-	0xc8, 0x21, 0xad, 0x17, 0xc5, 0xc8, 0x93, 0xf1, 0xbe, 0x86, 0xb5, 0x91, 0xed, 0x8e, 0xaf, 0x13,
-	0x63, 0x09, 0xf7, 0xfd, 0x07, 0x19, 0xc7, 0x3a, 0x66, 0xbd, 0x27, 0x63, 0xd4, 0x46, 0xa9, 0xb2,
-	0xf6, 0x6d, 0x0e, 0xee, 0xcd, 0x66, 0x07, 0x75, 0x21, 0x6f, 0xfa, 0x63, 0x39, 0xb5, 0x47, 0xf3,
-	0x4e, 0xad, 0xed, 0x8f, 0x27, 0xa3, 0x32, 0x20, 0xf4, 0x0c, 0x4a, 0x0e, 0x71, 0xbc, 0xe0, 0x46,
-	0xce, 0xe0, 0xf1, 0xbc, 0x90, 0x27, 0xbc, 0xf7, 0x04, 0x55, 0xc2, 0x21, 0x0c, 0x65, 0x69, 0x2f,
-	0xa1, 0xdc, 0x99, 0xe6, 0x3c, 0xdd, 0x47, 0x90, 0x38, 0xc6, 0xd1, 0x3e, 0x86, 0xad, 0x99, 0x53,
-	0x41, 0xbf, 0x0b, 0x60, 0xfa, 0x63, 0x9d, 0xdf, 0xbe, 0x0a, 0xbd, 0xe7, 0x71, 0xc5, 0xf4, 0xc7,
-	0x3d, 0x4e, 0xd0, 0x1e, 0x42, 0xfd, 0x75, 0xfc, 0xb2, 0xf5, 0x2e, 0x38, 0xd6, 0x9d, 0x0b, 0x2e,
-	0x83, 0x3c, 0x2e, 0x0b, 0xc2, 0xc9, 0x85, 0xf6, 0xab, 0x1c, 0xac, 0xdd, 0x62, 0x87, 0x45, 0xc7,
-	0x62, 0xff, 0x88, 0xce, 0x1d, 0xa2, 0xc4, 0x36, 0x13, 0xd3, 0xb6, 0xa2, 0x1b, 0x2b, 0xfe, 0xcf,
-	0xdd, 0x88, 0x2f, 0x6f, 0x93, 0x72, 0xb6, 0xcf, 0x0c, 0xda, 0xb9, 0xb0, 0x69, 0xc8, 0x03, 0xe8,
-	0x22, 0x16, 0x05, 0xf4, 0x1c, 0x6a, 0x01, 0x09, 0x49, 0x70, 0x45, 0x2c, 0xdd, 0xf7, 0x02, 0x1a,
-	0x09, 0x6c, 0x77, 0x3e, 0x81, 0x9d, 0x79, 0x01, 0xc5, 0xab, 0x11, 0x12, 0x2b, 0x85, 0xe8, 0x19,
-	0xac, 0x5a, 0x37, 0xae, 0xe1, 0xd8, 0xa6, 0x44, 0x2e, 0x2d, 0x8c, 0xbc, 0x22, 0x81, 0x38, 0xb0,
-	0xb6, 0x07, 0xd5, 0x44, 0x25, 0x9b, 0x18, 0x77, 0xe2, 0x52, 0x26, 0xa2, 0x90, 0x5e, 0xbf, 0x45,
-	0xb9, 0x7e, 0xb5, 0x7f, 0xce, 0x41, 0x2d, 0xbd, 0x00, 0x22, 0xfd, 0xf9, 0x24, 0xb0, 0x3d, 0x2b,
-	0xa1, 0xbf, 0x33, 0x4e, 0x60, 0x3a, 0x62, 0xd5, 0xdf, 0x8c, 0x3d, 0x6a, 0x44, 0x3a, 0x32, 0xfd,
-	0xf1, 0x1f, 0xb2, 0xf2, 0x2d, 0xdd, 0xe7, 0x6f, 0xe9, 0x1e, 0xbd, 0x0f, 0x48, 0xea, 0x77, 0x64,
-	0x3b, 0x36, 0xd5, 0x2f, 0x6e, 0x28, 0x11, 0xf2, 0xcf, 0x63, 0x55, 0xd4, 0x1c, 0xb3, 0x8a, 0x2f,
-	0x18, 0x1d, 0x69, 0xb0, 0xea, 0x79, 0x8e, 0x1e, 0x9a, 0x5e, 0x40, 0x74, 0xc3, 0x7a, 0xc9, 0x03,
-	0xba, 0x3c, 0xae, 0x7a, 0x9e, 0xd3, 0x63, 0xb4, 0x96, 0xf5, 0x92, 0xed, 0xf1, 0xa6, 0x3f, 0x0e,
-	0x09, 0xd5, 0xd9, 0x87, 0xbb, 0xc5, 0x0a, 0x06, 0x41, 0x6a, 0xfb, 0xe3, 0x30, 0xd1, 0xc0, 0x21,
-	0x0e, 0x73, 0x75, 0x89, 0x06, 0x27, 0xc4, 0x61, 0xa3, 0xac, 0x9c, 0x91, 0xc0, 0x24, 0x2e, 0xed,
-	0xdb, 0xe6, 0x25, 0xf3, 0x62, 0xca, 0xb6, 0x82, 0x53, 0x34, 0xed, 0x67, 0x50, 0xe4, 0x5e, 0x8f,
-	0x4d, 0x9e, 0x7b, 0x0c, 0xee, 0x50, 0x84, 0x78, 0xcb, 0x8c, 0xc0, 0xdd, 0xc9, 0xbb, 0x50, 0x19,
-	0x7a, 0xa1, 0x74, 0x47, 0xc2, 0xf2, 0xca, 0x8c, 0xc0, 0x2b, 0x1b, 0x50, 0x0e, 0x88, 0x61, 0x79,
-	0xee, 0x28, 0x3a, 0xf4, 0xc6, 0x65, 0xed, 0x1b, 0x28, 0x89, 0xed, 0xf7, 0x0e, 0xf8, 0x1f, 0x00,
-	0x32, 0x85, 0x1f, 0xf3, 0xd9, 0x21, 0x3a, 0x0c, 0x6d, 0xcf, 0x0d, 0xa3, 0x97, 0x16, 0x51, 0x73,
-	0x36, 0xa9, 0xd0, 0xfe, 0x5b, 0x11, 0x21, 0x96, 0xb8, 0x03, 0x67, 0x27, 0x2a, 0x66, 0x69, 0xec,
-	0xc4, 0x20, 0x0e, 0xdb, 0x51, 0x91, 0x9d, 0x33, 0x65, 0x24, 0x95, 0x5b, 0xf4, 0x09, 0x41, 0x02,
-	0x44, 0x57, 0x6f, 0x44, 0x1e, 0x4a, 0xe6, 0xbd, 0x7a, 0x23, 0xe2, 0xea, 0x8d, 0xb0, 0xa3, 0x91,
-	0x8c, 0xf1, 0x04, 0x5c, 0x81, 0x87, 0x78, 0x55, 0x2b, 0xbe, 0xdf, 0x24, 0xda, 0xff, 0x2a, 0xf1,
-	0x5e, 0x11, 0xdd, 0x43, 0xa2, 0xaf, 0xa1, 0xcc, 0x96, 0x9d, 0xee, 0x18, 0xbe, 0x7c, 0x55, 0x6b,
-	0x2f, 0x76, 0xc5, 0xd9, 0x64, 0xab, 0xec, 0xc4, 0xf0, 0x45, 0x84, 0xb6, 0xec, 0x8b, 0x12, 0xdb,
-	0x73, 0x0c, 0x6b, 0xb2, 0xe7, 0xb0, 0x7f, 0xf4, 0x7d, 0xa8, 0x19, 0x63, 0xea, 0xe9, 0x86, 0x75,
-	0x45, 0x02, 0x6a, 0x87, 0x44, 0xea, 0x7e, 0x95, 0x51, 0x5b, 0x11, 0xb1, 0xf1, 0x29, 0xac, 0x24,
-	0x31, 0xdf, 0xe4, 0x7d, 0x8b, 0x49, 0xef, 0xfb, 0x27, 0x00, 0x93, 0x33, 0x3d, 0xb3, 0x11, 0x72,
-	0x6d, 0x53, 0xdd, 0x8c, 0x8e, 0x25, 0x45, 0x5c, 0x66, 0x84, 0x36, 0x0b, 0xc0, 0xd3, 0x17, 0x8e,
-	0xc5, 0xe8, 0xc2, 0x91, 0xad, 0x5a, 0xb6, 0xd0, 0x2e, 0xed, 0xd1, 0x28, 0xbe, 0x67, 0xa8, 0x78,
-	0x9e, 0xf3, 0x94, 0x13, 0xb4, 0xdf, 0xe4, 0x84, 0xad, 0x88, 0xab, 0xe3, 0x4c, 0xe1, 0xf8, 0xdb,
-	0x52, 0xf5, 0x1e, 0x40, 0x48, 0x8d, 0x80, 0x85, 0x12, 0x46, 0x74, 0xd3, 0xd1, 0x98, 0xba, 0xb1,
-	0xec, 0x47, 0x2f, 0xe0, 0xb8, 0x22, 0x5b, 0xb7, 0x28, 0xfa, 0x0c, 0x56, 0x4c, 0xcf, 0xf1, 0x47,
-	0x44, 0x76, 0x2e, 0xbe, 0xb1, 0x73, 0x35, 0x6e, 0xdf, 0xa2, 0x89, 0xfb, 0x95, 0xd2, 0x5d, 0xef,
-	0x57, 0xfe, 0x5d, 0x11, 0x37, 0xe0, 0xc9, 0x0b, 0x78, 0x34, 0x98, 0xf1, 0xca, 0x7b, 0xb0, 0xe0,
-	0x6d, 0xfe, 0x77, 0x3d, 0xf1, 0x36, 0x3e, 0xcb, 0xf2, 0xa6, 0xfa, 0xfa, 0xe0, 0xee, 0x3f, 0xf2,
-	0x50, 0x89, 0x2f, 0xbf, 0xa7, 0x74, 0xff, 0x09, 0x54, 0xe2, 0xf4, 0x03, 0xb9, 0x41, 0x7c, 0xa7,
-	0x7a, 0xe2, 0xc6, 0xe8, 0x05, 0x20, 0x63, 0x30, 0x88, 0x83, 0x36, 0x7d, 0x1c, 0x1a, 0x83, 0xe8,
-	0xe9, 0xe1, 0x93, 0x39, 0xe4, 0x10, 0xf9, 0xad, 0x73, 0xd6, 0x1f, 0xab, 0xc6, 0x60, 0x90, 0xa2,
-	0xa0, 0x3f, 0x85, 0xad, 0xf4, 0x18, 0xfa, 0xc5, 0x8d, 0xee, 0xdb, 0x96, 0x3c, 0xf6, 0x1d, 0xce,
-	0x7b, 0xff, 0xdf, 0x4c, 0xc1, 0x7f, 0x71, 0x73, 0x66, 0x5b, 0x42, 0xe6, 0x28, 0x98, 0xaa, 0x68,
-	0xfc, 0x39, 0xbc, 0xf3, 0x9a, 0xe6, 0x33, 0x74, 0xd0, 0x4d, 0xbf, 0x6b, 0x2f, 0x2e, 0x84, 0x84,
-	0xf6, 0x7e, 0xad, 0x88, 0x67, 0x8a, 0xb4, 0x4c, 0x5a, 0xc9, 0xb8, 0x75, 0x27, 0xe3, 0x38, 0xed,
-	0xb3, 0x73, 0x01, 0xcf, 0x43, 0xd5, 0x2f, 0x6f, 0x85, 0xaa, 0x59, 0x83, 0x18, 0x11, 0xf1, 0x09,
-	0x20, 0x89, 0xa0, 0xfd, 0x4b, 0x1e, 0xca, 0x11, 0x3a, 0x3f, 0xb4, 0xdd, 0x84, 0x94, 0x38, 0x7a,
-	0x7c, 0xb3, 0xa2, 0x60, 0x10, 0x24, 0x7e, 0x8b, 0xf0, 0x2e, 0x54, 0xd8, 0xd9, 0x50, 0x54, 0xe7,
-	0x78, 0x75, 0x99, 0x11, 0x78, 0xe5, 0x7b, 0x50, 0xa5, 0x1e, 0x35, 0x46, 0x3a, 0xe5, 0xbe, 0x3c,
-	0x2f, 0x7a, 0x73, 0x12, 0xf7, 0xe4, 0xe8, 0x87, 0xb0, 0x4e, 0x87, 0x81, 0x47, 0xe9, 0x88, 0xc5,
-	0x77, 0x3c, 0xa2, 0x11, 0x01, 0x48, 0x01, 0xab, 0x71, 0x85, 0x88, 0x74, 0x42, 0xb6, 0x7b, 0x4f,
-	0x1a, 0x33, 0xd3, 0xe5, 0x9b, 0x48, 0x01, 0xaf, 0xc6, 0x54, 0x66, 0xda, 0xcc, 0x79, 0xfa, 0x22,
-	0x5a, 0xe0, 0x7b, 0x85, 0x82, 0xa3, 0x22, 0xd2, 0x61, 0xcd, 0x21, 0x46, 0x38, 0x0e, 0x88, 0xa5,
-	0xbf, 0xb0, 0xc9, 0xc8, 0x12, 0x67, 0xed, 0x5a, 0xe6, 0xf0, 0x3b, 0x12, 0x4b, 0xf3, 0x09, 0xef,
-	0x8d, 0x6b, 0x11, 0x9c, 0x28, 0xb3, 0xc8, 0x41, 0xfc, 0xa1, 0x35, 0xa8, 0xf6, 0x9e, 0xf7, 0xfa,
-	0x9d, 0x13, 0xfd, 0xe4, 0x74, 0xbf, 0x23, 0x53, 0x17, 0x7a, 0x1d, 0x2c, 0x8a, 0x0a, 0xab, 0xef,
-	0x9f, 0xf6, 0x5b, 0xc7, 0x7a, 0xff, 0xa8, 0xfd, 0xb4, 0xa7, 0xe6, 0xd0, 0x16, 0xac, 0xf7, 0x0f,
-	0xf1, 0x69, 0xbf, 0x7f, 0xdc, 0xd9, 0xd7, 0xcf, 0x3a, 0xf8, 0xe8, 0x74, 0xbf, 0xa7, 0xe6, 0x11,
-	0x82, 0xda, 0x84, 0xdc, 0x3f, 0x3a, 0xe9, 0xa8, 0x05, 0x54, 0x85, 0xe5, 0xb3, 0x0e, 0x6e, 0x77,
-	0xba, 0x7d, 0xb5, 0xa8, 0xfd, 0x2a, 0x0f, 0xd5, 0x84, 0x16, 0x99, 0x21, 0x07, 0xa1, 0x88, 0xf3,
-	0x0b, 0x98, 0xfd, 0xf2, 0xa7, 0x16, 0xc3, 0x1c, 0x0a, 0xed, 0x14, 0xb0, 0x28, 0xf0, 0xd8, 0xde,
-	0xb8, 0x4e, 0xac, 0xf3, 0x02, 0x2e, 0x3b, 0xc6, 0xb5, 0x00, 0xf9, 0x1e, 0xac, 0x5c, 0x92, 0xc0,
-	0x25, 0x23, 0x59, 0x2f, 0x34, 0x52, 0x15, 0x34, 0xd1, 0x64, 0x1b, 0x54, 0xd9, 0x64, 0x02, 0x23,
-	0xd4, 0x51, 0x13, 0xf4, 0x93, 0x08, 0x6c, 0x13, 0x8a, 0xa2, 0x7a, 0x59, 0x8c, 0xcf, 0x0b, 0xcc,
-	0x4d, 0x85, 0xaf, 0x0c, 0x9f, 0xc7, 0x77, 0x05, 0xcc, 0xff, 0xd1, 0xc5, 0xb4, 0x7e, 0x4a, 0x5c,
-	0x3f, 0x7b, 0xf3, 0x9b, 0xf3, 0xeb, 0x54, 0x34, 0x8c, 0x55, 0xb4, 0x0c, 0x79, 0x1c, 0xbd, 0xf7,
-	0xb7, 0x5b, 0xed, 0x43, 0xa6, 0x96, 0x55, 0xa8, 0x9c, 0xb4, 0x7e, 0xaa, 0x9f, 0xf7, 0xf8, 0x4d,
-	0x23, 0x52, 0x61, 0xe5, 0x69, 0x07, 0x77, 0x3b, 0xc7, 0x92, 0x92, 0x47, 0x9b, 0xa0, 0x4a, 0xca,
-	0xa4, 0x5d, 0x81, 0x21, 0x88, 0xdf, 0x22, 0x2a, 0x43, 0xa1, 0xf7, 0xac, 0x75, 0xa6, 0x96, 0xb4,
-	0xff, 0xc9, 0xc1, 0x9a, 0x70, 0x0b, 0xf1, 0xcb, 0xe4, 0xeb, 0x5f, 0x66, 0x92, 0x17, 0x17, 0xb9,
-	0xf4, 0xc5, 0x45, 0x14, 0x84, 0x72, 0xaf, 0x9e, 0x9f, 0x04, 0xa1, 0xfc, 0xc2, 0x23, 0xb5, 0xe3,
-	0x17, 0xe6, 0xd9, 0xf1, 0xeb, 0xb0, 0xec, 0x90, 0x30, 0xd6, 0x5b, 0x05, 0x47, 0x45, 0x64, 0x43,
-	0xd5, 0x70, 0x5d, 0x8f, 0xf2, 0x8b, 0x8c, 0xe8, 0x58, 0x74, 0x30, 0xd7, 0x9d, 0x75, 0x3c, 0xe3,
-	0x66, 0x6b, 0x82, 0x24, 0x36, 0xe6, 0x24, 0x76, 0xe3, 0x27, 0xa0, 0xde, 0x6e, 0x30, 0x8f, 0x3b,
-	0xfc, 0xc1, 0x47, 0x13, 0x6f, 0x48, 0xd8, 0xba, 0x38, 0xef, 0x3e, 0xed, 0x9e, 0x3e, 0xeb, 0xaa,
-	0x4b, 0xac, 0x80, 0xcf, 0xbb, 0xdd, 0xa3, 0xee, 0x81, 0xaa, 0x20, 0x80, 0x52, 0xe7, 0xa7, 0x47,
-	0xfd, 0xce, 0xbe, 0x9a, 0xdb, 0xfd, 0xf5, 0x3a, 0x94, 0x04, 0x93, 0xe8, 0x5b, 0x19, 0x09, 0x24,
-	0xb3, 0xde, 0xd0, 0x4f, 0xe6, 0x8e, 0xa8, 0x53, 0x99, 0x74, 0x8d, 0xc7, 0x0b, 0xf7, 0x97, 0x2f,
-	0x10, 0x4b, 0xe8, 0xaf, 0x15, 0x58, 0x49, 0xbd, 0x3e, 0x64, 0xbd, 0x0d, 0x9d, 0x91, 0x64, 0xd7,
-	0xf8, 0xf1, 0x42, 0x7d, 0x63, 0x5e, 0x7e, 0xa9, 0x40, 0x35, 0x91, 0x5e, 0x86, 0xf6, 0x16, 0x49,
-	0x49, 0x13, 0x9c, 0x7c, 0xba, 0x78, 0x36, 0x9b, 0xb6, 0xf4, 0xa1, 0x82, 0xfe, 0x4a, 0x81, 0x6a,
-	0x22, 0xd1, 0x2a, 0x33, 0x2b, 0xd3, 0x69, 0x61, 0x99, 0x59, 0x99, 0x95, 0xd7, 0xb5, 0x84, 0xfe,
-	0x42, 0x81, 0x4a, 0x9c, 0x34, 0x85, 0x1e, 0xce, 0x9f, 0x66, 0x25, 0x98, 0xf8, 0x64, 0xd1, 0xfc,
-	0x2c, 0x6d, 0x09, 0xfd, 0x19, 0x94, 0xa3, 0x0c, 0x23, 0x94, 0xd5, 0x7b, 0xdd, 0x4a, 0x5f, 0x6a,
-	0x3c, 0x9c, 0xbb, 0x5f, 0x72, 0xf8, 0x28, 0xed, 0x27, 0xf3, 0xf0, 0xb7, 0x12, 0x94, 0x1a, 0x0f,
-	0xe7, 0xee, 0x17, 0x0f, 0xcf, 0x2c, 0x21, 0x91, 0x1d, 0x94, 0xd9, 0x12, 0xa6, 0xd3, 0x92, 0x32,
-	0x5b, 0xc2, 0xac, 0x64, 0x24, 0xc1, 0x48, 0x22, 0xbf, 0x28, 0x33, 0x23, 0xd3, 0x39, 0x4c, 0x99,
-	0x19, 0x99, 0x91, 0xce, 0xa4, 0x2d, 0xa1, 0x5f, 0x28, 0xc9, 0x73, 0xc1, 0xc3, 0xb9, 0xd3, 0x68,
-	0xe6, 0x34, 0xc9, 0xa9, 0x44, 0x1e, 0xbe, 0x40, 0x7f, 0x21, 0x6f, 0x31, 0x44, 0x16, 0x0e, 0x9a,
-	0x07, 0x2c, 0x95, 0xb8, 0xd3, 0xf8, 0x78, 0x31, 0x67, 0xc3, 0x99, 0xf8, 0x4b, 0x05, 0x60, 0x92,
-	0xaf, 0x93, 0x99, 0x89, 0xa9, 0x44, 0xa1, 0xc6, 0xde, 0x02, 0x3d, 0x93, 0x0b, 0x24, 0xca, 0x27,
-	0xc8, 0xbc, 0x40, 0x6e, 0xe5, 0x13, 0x65, 0x5e, 0x20, 0xb7, 0x73, 0x81, 0xb4, 0x25, 0xf4, 0x8f,
-	0x0a, 0xac, 0x4f, 0xe5, 0x33, 0xa0, 0xc7, 0x77, 0x4c, 0x69, 0x69, 0x7c, 0xbe, 0x38, 0x40, 0xc4,
-	0xda, 0xb6, 0xf2, 0xa1, 0x82, 0xfe, 0x46, 0x81, 0xd5, 0xd4, 0x1b, 0x30, 0xca, 0xec, 0xa5, 0x66,
-	0x64, 0x46, 0x34, 0x1e, 0x2d, 0xd6, 0x39, 0x96, 0xd6, 0xdf, 0x29, 0x50, 0x4b, 0xa7, 0x03, 0xa0,
-	0x47, 0xf3, 0x6d, 0x0b, 0xb7, 0x18, 0xfa, 0x6c, 0xc1, 0xde, 0x11, 0x47, 0x5f, 0x2c, 0xff, 0x51,
-	0x51, 0x44, 0x6f, 0x25, 0xfe, 0xf9, 0xd1, 0x6f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x81, 0xc8, 0x42,
-	0x4f, 0xd2, 0x30, 0x00, 0x00,
+	// 3822 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0x4f, 0x6f, 0x1b, 0x49,
+	0x76, 0x77, 0xb3, 0xf9, 0xf7, 0x91, 0xa2, 0xa8, 0xb2, 0xec, 0xa1, 0x39, 0xd9, 0x8c, 0xb7, 0x81,
+	0x0d, 0x84, 0xdd, 0x1d, 0x7a, 0x46, 0x83, 0x8c, 0xc7, 0x5e, 0xcf, 0x7a, 0x68, 0x8a, 0xb6, 0x38,
+	0x96, 0x48, 0xa5, 0x48, 0xc1, 0xe3, 0x38, 0x3b, 0x9d, 0x56, 0x77, 0x99, 0x6a, 0x8b, 0xec, 0xee,
+	0xe9, 0x2e, 0xca, 0xd2, 0x06, 0x41, 0x82, 0x0d, 0x10, 0x6c, 0x80, 0x04, 0xc9, 0x65, 0xb2, 0x97,
+	0x1c, 0x82, 0xe4, 0x18, 0xe4, 0x94, 0x4b, 0x90, 0x60, 0x0f, 0x39, 0xe5, 0x43, 0x24, 0x97, 0xdc,
+	0x72, 0x09, 0x90, 0x7c, 0x83, 0xa0, 0xfe, 0x35, 0xbb, 0x45, 0x79, 0x4c, 0x52, 0x3e, 0x91, 0xef,
+	0x55, 0xbd, 0x5f, 0xbd, 0x7a, 0xef, 0x55, 0xd5, 0xab, 0xd7, 0x05, 0x46, 0x30, 0x9e, 0x8e, 0x5c,
+	0x2f, 0xba, 0xe3, 0x84, 0xee, 0x29, 0x09, 0xa3, 0x3b, 0x41, 0xe8, 0x53, 0x5f, 0x52, 0x4d, 0x4e,
+	0xa0, 0x1f, 0x1c, 0x5b, 0xd1, 0xb1, 0x6b, 0xfb, 0x61, 0xd0, 0xf4, 0xfc, 0x89, 0xe5, 0x34, 0xa5,
+	0x4c, 0x53, 0xca, 0x88, 0x6e, 0x8d, 0xdf, 0x1c, 0xf9, 0xfe, 0x68, 0x4c, 0x04, 0xc2, 0xd1, 0xf4,
+	0xe5, 0x1d, 0x67, 0x1a, 0x5a, 0xd4, 0xf5, 0x3d, 0xd9, 0xfe, 0xc1, 0xc5, 0x76, 0xea, 0x4e, 0x48,
+	0x44, 0xad, 0x49, 0x20, 0x3b, 0x7c, 0x31, 0x72, 0xe9, 0xf1, 0xf4, 0xa8, 0x69, 0xfb, 0x93, 0x3b,
+	0xf1, 0x90, 0x77, 0xf8, 0x90, 0x77, 0x94, 0x9a, 0xd1, 0xb1, 0x15, 0x12, 0xe7, 0xce, 0xb1, 0x3d,
+	0x8e, 0x02, 0x62, 0xb3, 0x5f, 0x93, 0xfd, 0x91, 0x08, 0x4f, 0x16, 0x47, 0x88, 0x68, 0x38, 0xb5,
+	0xa9, 0x9a, 0xaf, 0x45, 0x69, 0xe8, 0x1e, 0x4d, 0x29, 0x11, 0x40, 0xc6, 0x2d, 0x78, 0x6f, 0x68,
+	0x45, 0x27, 0x6d, 0xdf, 0x7b, 0xe9, 0x8e, 0x06, 0xf6, 0x31, 0x99, 0x58, 0x98, 0x7c, 0x33, 0x25,
+	0x11, 0x35, 0x7e, 0x0f, 0xea, 0xf3, 0x4d, 0x51, 0xe0, 0x7b, 0x11, 0x41, 0x5f, 0x40, 0x96, 0x69,
+	0x53, 0xd7, 0x6e, 0x6b, 0x5b, 0xe5, 0xed, 0x1f, 0x37, 0xdf, 0x64, 0x38, 0xa1, 0x43, 0x53, 0xce,
+	0xa2, 0x39, 0x08, 0x88, 0x8d, 0xb9, 0xa4, 0x71, 0x03, 0xae, 0xb7, 0xad, 0xc0, 0x3a, 0x72, 0xc7,
+	0x2e, 0x75, 0x49, 0xa4, 0x06, 0x9d, 0xc2, 0x66, 0x9a, 0x2d, 0x07, 0xfc, 0x19, 0x54, 0xec, 0x04,
+	0x5f, 0x0e, 0x7c, 0xaf, 0xb9, 0x90, 0xc7, 0x9a, 0x3b, 0x9c, 0x4a, 0x01, 0xa7, 0xe0, 0x8c, 0x4d,
+	0x40, 0x8f, 0x5d, 0x6f, 0x44, 0xc2, 0x20, 0x74, 0x3d, 0xaa, 0x94, 0xf9, 0xb5, 0x0e, 0xd7, 0x53,
+	0x6c, 0xa9, 0xcc, 0x2b, 0x80, 0xd8, 0x8e, 0x4c, 0x15, 0x7d, 0xab, 0xbc, 0xfd, 0xe5, 0x82, 0xaa,
+	0x5c, 0x82, 0xd7, 0x6c, 0xc5, 0x60, 0x1d, 0x8f, 0x86, 0xe7, 0x38, 0x81, 0x8e, 0xbe, 0x86, 0xfc,
+	0x31, 0xb1, 0xc6, 0xf4, 0xb8, 0x9e, 0xb9, 0xad, 0x6d, 0x55, 0xb7, 0x1f, 0x5f, 0x61, 0x9c, 0x5d,
+	0x0e, 0x34, 0xa0, 0x16, 0x25, 0x58, 0xa2, 0xa2, 0x0f, 0x01, 0x89, 0x7f, 0xa6, 0x43, 0x22, 0x3b,
+	0x74, 0x03, 0x16, 0xc8, 0x75, 0xfd, 0xb6, 0xb6, 0x55, 0xc2, 0x1b, 0xa2, 0x65, 0x67, 0xd6, 0xd0,
+	0x08, 0x60, 0xfd, 0x82, 0xb6, 0xa8, 0x06, 0xfa, 0x09, 0x39, 0xe7, 0x1e, 0x29, 0x61, 0xf6, 0x17,
+	0x3d, 0x81, 0xdc, 0xa9, 0x35, 0x9e, 0x12, 0xae, 0x72, 0x79, 0xfb, 0xe3, 0xb7, 0x85, 0x87, 0x0c,
+	0xd1, 0x99, 0x1d, 0xb0, 0x90, 0xbf, 0x9f, 0xf9, 0x4c, 0x33, 0xee, 0x41, 0x39, 0xa1, 0x37, 0xaa,
+	0x02, 0x1c, 0xf6, 0x76, 0x3a, 0xc3, 0x4e, 0x7b, 0xd8, 0xd9, 0xa9, 0x5d, 0x43, 0x6b, 0x50, 0x3a,
+	0xec, 0xed, 0x76, 0x5a, 0x7b, 0xc3, 0xdd, 0xe7, 0x35, 0x0d, 0x95, 0xa1, 0xa0, 0x88, 0x8c, 0x71,
+	0x06, 0x08, 0x13, 0xdb, 0x3f, 0x25, 0x21, 0x0b, 0x64, 0xe9, 0x55, 0xf4, 0x1e, 0x14, 0xa8, 0x15,
+	0x9d, 0x98, 0xae, 0x23, 0x75, 0xce, 0x33, 0xb2, 0xeb, 0xa0, 0x2e, 0xe4, 0x8f, 0x2d, 0xcf, 0x19,
+	0xbf, 0x5d, 0xef, 0xb4, 0xa9, 0x19, 0xf8, 0x2e, 0x17, 0xc4, 0x12, 0x80, 0x45, 0x77, 0x6a, 0x64,
+	0xe1, 0x00, 0xe3, 0x39, 0xd4, 0x06, 0xd4, 0x0a, 0x69, 0x52, 0x9d, 0x0e, 0x64, 0xd9, 0xf8, 0x75,
+	0x6d, 0xe9, 0x31, 0xc5, 0xca, 0xc4, 0x5c, 0xdc, 0xf8, 0xbf, 0x0c, 0x6c, 0x24, 0xb0, 0x65, 0xa4,
+	0x3e, 0x83, 0x7c, 0x48, 0xa2, 0xe9, 0x98, 0x72, 0xf8, 0xea, 0xf6, 0xc3, 0x05, 0xe1, 0xe7, 0x90,
+	0x9a, 0x98, 0xc3, 0x60, 0x09, 0x87, 0xb6, 0xa0, 0x26, 0x24, 0x4c, 0x12, 0x86, 0x7e, 0x68, 0x4e,
+	0xa2, 0x11, 0xb7, 0x5a, 0x09, 0x57, 0x05, 0xbf, 0xc3, 0xd8, 0xfb, 0xd1, 0x28, 0x61, 0x55, 0xfd,
+	0x8a, 0x56, 0x45, 0x16, 0xd4, 0x3c, 0x42, 0x5f, 0xfb, 0xe1, 0x89, 0xc9, 0x4c, 0x1b, 0xba, 0x0e,
+	0xa9, 0x67, 0x39, 0xe8, 0xa7, 0x0b, 0x82, 0xf6, 0x84, 0x78, 0x5f, 0x4a, 0xe3, 0x75, 0x2f, 0xcd,
+	0x30, 0x7e, 0x04, 0x79, 0x31, 0x53, 0x16, 0x49, 0x83, 0xc3, 0x76, 0xbb, 0x33, 0x18, 0xd4, 0xae,
+	0xa1, 0x12, 0xe4, 0x70, 0x67, 0x88, 0x59, 0x84, 0x95, 0x20, 0xf7, 0xb8, 0x35, 0x6c, 0xed, 0xd5,
+	0x32, 0xc6, 0x0f, 0x61, 0xfd, 0x99, 0xe5, 0xd2, 0x45, 0x82, 0xcb, 0xf0, 0xa1, 0x36, 0xeb, 0x2b,
+	0xbd, 0xd3, 0x4d, 0x79, 0x67, 0x71, 0xd3, 0x74, 0xce, 0x5c, 0x7a, 0xc1, 0x1f, 0x35, 0xd0, 0x49,
+	0x18, 0x4a, 0x17, 0xb0, 0xbf, 0xc6, 0x6b, 0x58, 0x1f, 0x50, 0x3f, 0x58, 0x28, 0xf2, 0x3f, 0x81,
+	0x02, 0x3b, 0xa3, 0xfc, 0x29, 0x95, 0xa1, 0x7f, 0xab, 0x29, 0xce, 0xb0, 0xa6, 0x3a, 0xc3, 0x9a,
+	0x3b, 0xf2, 0x8c, 0xc3, 0xaa, 0x27, 0xba, 0x09, 0xf9, 0xc8, 0x1d, 0x79, 0xd6, 0x58, 0xee, 0x16,
+	0x92, 0x32, 0x10, 0xd4, 0x66, 0x03, 0xcb, 0xc0, 0x6f, 0x03, 0xda, 0x21, 0x11, 0x0d, 0xfd, 0xf3,
+	0x85, 0xf4, 0xd9, 0x84, 0xdc, 0x4b, 0x3f, 0xb4, 0xc5, 0x42, 0x2c, 0x62, 0x41, 0xb0, 0x45, 0x95,
+	0x02, 0x91, 0xd8, 0x1f, 0x02, 0xea, 0x7a, 0xec, 0x4c, 0x59, 0xcc, 0x11, 0x7f, 0x95, 0x81, 0xeb,
+	0xa9, 0xfe, 0xd2, 0x19, 0xab, 0xaf, 0x43, 0xb6, 0x31, 0x4d, 0x23, 0xb1, 0x0e, 0x51, 0x1f, 0xf2,
+	0xa2, 0x87, 0xb4, 0xe4, 0xdd, 0x25, 0x80, 0xc4, 0x31, 0x25, 0xe1, 0x24, 0xcc, 0xa5, 0x41, 0xaf,
+	0xbf, 0xdb, 0xa0, 0x7f, 0x0d, 0x35, 0x35, 0x8f, 0xe8, 0xad, 0xbe, 0xf9, 0x12, 0xae, 0xdb, 0xfe,
+	0x78, 0x4c, 0x6c, 0x16, 0x0d, 0xa6, 0xeb, 0x51, 0x12, 0x9e, 0x5a, 0xe3, 0xb7, 0xc7, 0x0d, 0x9a,
+	0x49, 0x75, 0xa5, 0x90, 0xf1, 0x02, 0x36, 0x12, 0x03, 0x4b, 0x47, 0x3c, 0x86, 0x5c, 0xc4, 0x18,
+	0xd2, 0x13, 0x1f, 0x2d, 0xe9, 0x89, 0x08, 0x0b, 0x71, 0xe3, 0xba, 0x00, 0xef, 0x9c, 0x12, 0x2f,
+	0x9e, 0x96, 0xb1, 0x03, 0x1b, 0x03, 0x1e, 0xa6, 0x0b, 0xc5, 0xe1, 0x2c, 0xc4, 0x33, 0xa9, 0x10,
+	0xdf, 0x04, 0x94, 0x44, 0x91, 0x81, 0x78, 0x0e, 0xeb, 0x9d, 0x33, 0x62, 0x2f, 0x84, 0x5c, 0x87,
+	0x82, 0xed, 0x4f, 0x26, 0x96, 0xe7, 0xd4, 0x33, 0xb7, 0xf5, 0xad, 0x12, 0x56, 0x64, 0x72, 0x2d,
+	0xea, 0x8b, 0xae, 0x45, 0xe3, 0x2f, 0x34, 0xa8, 0xcd, 0xc6, 0x96, 0x86, 0x64, 0xda, 0x53, 0x87,
+	0x01, 0xb1, 0xb1, 0x2b, 0x58, 0x52, 0x92, 0xaf, 0xb6, 0x0b, 0xc1, 0x27, 0x61, 0x98, 0xd8, 0x8e,
+	0xf4, 0x2b, 0x6e, 0x47, 0xc6, 0x2e, 0xfc, 0x86, 0x52, 0x67, 0x40, 0x43, 0x62, 0x4d, 0x5c, 0x6f,
+	0xd4, 0xed, 0xf7, 0x03, 0x22, 0x14, 0x47, 0x08, 0xb2, 0x8e, 0x45, 0x2d, 0xa9, 0x18, 0xff, 0xcf,
+	0x16, 0xbd, 0x3d, 0xf6, 0xa3, 0x78, 0xd1, 0x73, 0xc2, 0xf8, 0x77, 0x1d, 0xea, 0x73, 0x50, 0xca,
+	0xbc, 0x2f, 0x20, 0x17, 0x11, 0x3a, 0x0d, 0x64, 0xa8, 0x74, 0x16, 0x56, 0xf8, 0x72, 0xbc, 0xe6,
+	0x80, 0x81, 0x61, 0x81, 0x89, 0x46, 0x50, 0xa4, 0xf4, 0xdc, 0x8c, 0xdc, 0x9f, 0xab, 0x84, 0x60,
+	0xef, 0xaa, 0xf8, 0x43, 0x12, 0x4e, 0x5c, 0xcf, 0x1a, 0x0f, 0xdc, 0x9f, 0x13, 0x5c, 0xa0, 0xf4,
+	0x9c, 0xfd, 0x41, 0xcf, 0x59, 0xc0, 0x3b, 0xae, 0x27, 0xcd, 0xde, 0x5e, 0x75, 0x94, 0x84, 0x81,
+	0xb1, 0x40, 0x6c, 0xec, 0x41, 0x8e, 0xcf, 0x69, 0x95, 0x40, 0xac, 0x81, 0x4e, 0xe9, 0x39, 0x57,
+	0xaa, 0x88, 0xd9, 0xdf, 0xc6, 0x03, 0xa8, 0x24, 0x67, 0xc0, 0x02, 0xe9, 0x98, 0xb8, 0xa3, 0x63,
+	0x11, 0x60, 0x39, 0x2c, 0x29, 0xe6, 0xc9, 0xd7, 0xae, 0x23, 0x53, 0xd6, 0x1c, 0x16, 0x84, 0xf1,
+	0xcf, 0x19, 0xb8, 0x75, 0x89, 0x65, 0x64, 0xb0, 0xbe, 0x48, 0x05, 0xeb, 0x3b, 0xb2, 0x82, 0x8a,
+	0xf8, 0x17, 0xa9, 0x88, 0x7f, 0x87, 0xe0, 0x6c, 0xd9, 0xdc, 0x84, 0x3c, 0x39, 0x73, 0x29, 0x71,
+	0xa4, 0xa9, 0x24, 0x95, 0x58, 0x4e, 0xd9, 0xab, 0x2e, 0xa7, 0x8f, 0x61, 0xb3, 0x1d, 0x12, 0x8b,
+	0x12, 0xb9, 0x95, 0xab, 0xf8, 0xbf, 0x05, 0x45, 0x6b, 0x3c, 0xf6, 0xed, 0x99, 0x5b, 0x0b, 0x9c,
+	0xee, 0x3a, 0xc6, 0xb7, 0x1a, 0xdc, 0xb8, 0x20, 0x23, 0x2d, 0x7d, 0x04, 0x55, 0x37, 0xf2, 0xc7,
+	0x7c, 0x12, 0x66, 0xe2, 0x16, 0xf7, 0x93, 0xe5, 0x8e, 0x93, 0xae, 0xc2, 0xe0, 0x97, 0xba, 0x35,
+	0x37, 0x49, 0xf2, 0xa8, 0xe2, 0x83, 0x3b, 0x72, 0x35, 0x2b, 0xd2, 0xf8, 0x6b, 0x0d, 0x6e, 0xc8,
+	0x53, 0x7c, 0xe1, 0xc9, 0x5c, 0xa2, 0x72, 0xe6, 0x5d, 0xab, 0x6c, 0xd4, 0xe1, 0xe6, 0x45, 0xbd,
+	0xe4, 0xbe, 0xfe, 0xb7, 0x3a, 0xa0, 0xf9, 0x1b, 0x24, 0xfa, 0x3e, 0x54, 0x22, 0xe2, 0x39, 0xa6,
+	0x38, 0x13, 0xc4, 0x71, 0x55, 0xc4, 0x65, 0xc6, 0x13, 0x87, 0x43, 0xc4, 0xb6, 0x39, 0x72, 0x26,
+	0xb5, 0x2d, 0x62, 0xfe, 0x1f, 0x1d, 0x43, 0xe5, 0x65, 0x64, 0xc6, 0x63, 0xf3, 0xa0, 0xa9, 0x2e,
+	0xbc, 0x75, 0xcd, 0xeb, 0xd1, 0x7c, 0x3c, 0x88, 0xe7, 0x85, 0xcb, 0x2f, 0xa3, 0x98, 0x40, 0xbf,
+	0xd4, 0xe0, 0x3d, 0x95, 0x3a, 0xcc, 0xcc, 0x37, 0xf1, 0x1d, 0x12, 0xd5, 0xb3, 0xb7, 0xf5, 0xad,
+	0xea, 0xf6, 0xc1, 0x15, 0xec, 0x37, 0xc7, 0xdc, 0xf7, 0x1d, 0x82, 0x6f, 0x78, 0x97, 0x70, 0x23,
+	0xd4, 0x84, 0xeb, 0x93, 0x69, 0x44, 0x4d, 0x11, 0x05, 0xa6, 0xec, 0x54, 0xcf, 0x71, 0xbb, 0x6c,
+	0xb0, 0xa6, 0x54, 0xac, 0x1a, 0x4d, 0x28, 0x27, 0xa6, 0x85, 0x8a, 0x90, 0xed, 0xf5, 0x7b, 0x9d,
+	0xda, 0x35, 0x04, 0x90, 0x6f, 0xef, 0xe2, 0x7e, 0x7f, 0x28, 0x32, 0xf1, 0xee, 0x7e, 0xeb, 0x49,
+	0xa7, 0x96, 0x31, 0xfe, 0x27, 0x03, 0x9b, 0x97, 0x29, 0x89, 0x1c, 0xc8, 0xb2, 0x09, 0xcb, 0xeb,
+	0xcf, 0xbb, 0x9f, 0x2f, 0x47, 0x67, 0x7e, 0x0e, 0x2c, 0xb9, 0xdf, 0x95, 0x30, 0xff, 0x8f, 0x4c,
+	0xc8, 0x8f, 0xad, 0x23, 0x32, 0x8e, 0xea, 0x3a, 0x2f, 0x10, 0x3c, 0xb9, 0xca, 0xd8, 0x7b, 0x1c,
+	0x49, 0x54, 0x07, 0x24, 0x6c, 0xe3, 0x1e, 0x94, 0x13, 0xec, 0x4b, 0xae, 0xe1, 0x9b, 0xc9, 0x6b,
+	0x78, 0x29, 0x79, 0xa7, 0x7e, 0x08, 0x9b, 0x97, 0xcd, 0x86, 0xd9, 0x79, 0xb7, 0x3f, 0x18, 0x8a,
+	0x0b, 0xcf, 0x13, 0xdc, 0x3f, 0x3c, 0xa8, 0x69, 0x8c, 0x39, 0x6c, 0x0d, 0x9e, 0xd6, 0x32, 0xb1,
+	0x1b, 0x74, 0xe3, 0x05, 0x94, 0x76, 0x7a, 0x03, 0x71, 0x01, 0x65, 0x8b, 0x3d, 0x22, 0x21, 0x9b,
+	0x02, 0xaf, 0x85, 0x94, 0xb0, 0x22, 0x51, 0x03, 0x8a, 0x11, 0xb1, 0x42, 0xfb, 0x98, 0x44, 0xf2,
+	0x74, 0x89, 0x69, 0x26, 0xe5, 0xf3, 0x9a, 0x82, 0x30, 0x50, 0x09, 0x2b, 0xd2, 0xf8, 0xdf, 0x02,
+	0xc0, 0xec, 0x7e, 0x8b, 0xaa, 0x90, 0x89, 0x77, 0x84, 0x8c, 0xeb, 0x30, 0x63, 0x7b, 0xd6, 0x44,
+	0xcd, 0x8a, 0xff, 0x47, 0xdb, 0x70, 0x63, 0x12, 0x8d, 0x02, 0xcb, 0x3e, 0x31, 0xe5, 0xb5, 0xd4,
+	0xe6, 0xc2, 0x7c, 0x75, 0x55, 0xf0, 0x75, 0xd9, 0x28, 0x57, 0x8f, 0xc0, 0xdd, 0x03, 0x9d, 0x78,
+	0xa7, 0x7c, 0x25, 0x94, 0xb7, 0xef, 0x2f, 0x7d, 0xef, 0x6e, 0x76, 0xbc, 0x53, 0xe1, 0x10, 0x06,
+	0x83, 0x4c, 0x00, 0x87, 0x9c, 0xba, 0x36, 0x31, 0x19, 0x68, 0x8e, 0x83, 0x7e, 0xb1, 0x3c, 0xe8,
+	0x0e, 0xc7, 0x88, 0xa1, 0x4b, 0x8e, 0xa2, 0x51, 0x0f, 0x4a, 0x21, 0x89, 0xfc, 0x69, 0x68, 0x93,
+	0xa8, 0x9e, 0x5f, 0x2a, 0x35, 0xc6, 0x4a, 0x0e, 0xcf, 0x20, 0xd0, 0x0e, 0xe4, 0x27, 0xfe, 0xd4,
+	0xa3, 0x51, 0xbd, 0x70, 0x5b, 0xff, 0xce, 0x22, 0x5e, 0x1a, 0x6c, 0x9f, 0x09, 0x61, 0x29, 0x8b,
+	0x9e, 0x40, 0x41, 0xa8, 0x18, 0xd5, 0x8b, 0x1c, 0xe6, 0xc3, 0x45, 0x37, 0x32, 0x2e, 0x85, 0x95,
+	0x34, 0xf3, 0xea, 0x34, 0x22, 0x61, 0xbd, 0x24, 0xbc, 0xca, 0xfe, 0xa3, 0xf7, 0xa1, 0x24, 0x4e,
+	0x04, 0xc7, 0x0d, 0xeb, 0xc0, 0x1b, 0xc4, 0x11, 0xb1, 0xe3, 0x86, 0xe8, 0x03, 0x28, 0x8b, 0xd3,
+	0xdd, 0xe4, 0x4b, 0xaf, 0xcc, 0x9b, 0x41, 0xb0, 0x0e, 0xd8, 0x02, 0x14, 0x1d, 0x48, 0x18, 0x8a,
+	0x0e, 0x95, 0xb8, 0x03, 0x09, 0x43, 0xde, 0xe1, 0xb7, 0x60, 0x9d, 0xe7, 0x44, 0xa3, 0xd0, 0x9f,
+	0x06, 0x26, 0x8f, 0xa9, 0x35, 0xde, 0x69, 0x8d, 0xb1, 0x9f, 0x30, 0x6e, 0x8f, 0x05, 0xd7, 0x2d,
+	0x28, 0xbe, 0xf2, 0x8f, 0x44, 0x87, 0xaa, 0x38, 0x98, 0x5e, 0xf9, 0x47, 0xaa, 0x29, 0x3e, 0xb3,
+	0xd6, 0xd3, 0x67, 0xd6, 0x37, 0x70, 0x73, 0x7e, 0xf3, 0xe5, 0x67, 0x57, 0xed, 0xea, 0x67, 0xd7,
+	0xa6, 0x77, 0x09, 0x17, 0x3d, 0x02, 0xdd, 0xf1, 0xa2, 0xfa, 0xc6, 0x52, 0xc1, 0x11, 0xaf, 0x63,
+	0xcc, 0x84, 0x1b, 0x9f, 0x42, 0x51, 0x45, 0xdf, 0x32, 0x5b, 0x4a, 0xe3, 0x01, 0x54, 0xd3, 0xb1,
+	0xbb, 0xd4, 0x86, 0xf4, 0x1f, 0x1a, 0x94, 0xe2, 0x28, 0x45, 0x1e, 0x5c, 0xe7, 0x56, 0xb4, 0x28,
+	0x71, 0xcc, 0x59, 0xd0, 0x8b, 0x34, 0xe5, 0xf3, 0x05, 0xe7, 0xd5, 0x52, 0x08, 0xf2, 0x4e, 0x24,
+	0x57, 0x00, 0x8a, 0x91, 0x67, 0xe3, 0x7d, 0x0d, 0xeb, 0x63, 0xd7, 0x9b, 0x9e, 0x25, 0xc6, 0x12,
+	0xf9, 0xc5, 0x6f, 0x2f, 0x38, 0xd6, 0x1e, 0x93, 0x9e, 0x8d, 0x51, 0x1d, 0xa7, 0x68, 0xe3, 0xdb,
+	0x0c, 0xdc, 0xbc, 0x5c, 0x1d, 0xd4, 0x03, 0xdd, 0x0e, 0xa6, 0x72, 0x6a, 0x0f, 0x96, 0x9d, 0x5a,
+	0x3b, 0x98, 0xce, 0x46, 0x65, 0x40, 0xac, 0xe0, 0x37, 0x21, 0x13, 0x3f, 0x3c, 0x97, 0x33, 0x78,
+	0xb8, 0x2c, 0xe4, 0x3e, 0x97, 0x9e, 0xa1, 0x4a, 0x38, 0x84, 0xa1, 0x28, 0x63, 0x2e, 0x92, 0xbb,
+	0xdb, 0x92, 0xe5, 0x07, 0x05, 0x89, 0x63, 0x1c, 0xe3, 0x53, 0xb8, 0x71, 0xe9, 0x54, 0xd0, 0xf7,
+	0x00, 0xec, 0x60, 0x6a, 0xf2, 0xf2, 0xb0, 0xf0, 0xbb, 0x8e, 0x4b, 0x76, 0x30, 0x1d, 0x70, 0x86,
+	0x71, 0x17, 0xea, 0x6f, 0xd2, 0x97, 0xed, 0x19, 0x42, 0x63, 0x73, 0x72, 0xc4, 0x6d, 0xa0, 0xe3,
+	0xa2, 0x60, 0xec, 0x1f, 0x19, 0xbf, 0xca, 0xc0, 0xfa, 0x05, 0x75, 0x58, 0xfa, 0x2e, 0xf6, 0x20,
+	0x75, 0x31, 0x12, 0x14, 0xdb, 0x90, 0x6c, 0xd7, 0x51, 0x25, 0x35, 0xfe, 0x9f, 0x1f, 0x45, 0x81,
+	0x2c, 0x77, 0x65, 0xdc, 0x80, 0x05, 0xf4, 0xe4, 0xc8, 0xa5, 0x11, 0xcf, 0xf0, 0x73, 0x58, 0x10,
+	0xe8, 0x39, 0x54, 0x43, 0xc2, 0x8f, 0x40, 0xc7, 0x0c, 0xfc, 0x90, 0x2a, 0x83, 0x6d, 0x2f, 0x67,
+	0xb0, 0x03, 0x3f, 0xa4, 0x78, 0x4d, 0x21, 0x31, 0x2a, 0x42, 0xcf, 0x60, 0xcd, 0x39, 0xf7, 0xac,
+	0x89, 0x6b, 0x4b, 0xe4, 0xfc, 0xca, 0xc8, 0x15, 0x09, 0xc4, 0x81, 0x0d, 0x0c, 0xe5, 0x44, 0x23,
+	0x9b, 0x18, 0xcf, 0x32, 0xa4, 0x4d, 0x04, 0x91, 0x5e, 0xbf, 0x39, 0xb9, 0x7e, 0xd9, 0xd5, 0xf2,
+	0xd8, 0x8f, 0xa8, 0x19, 0x5b, 0x26, 0xcf, 0xc8, 0x6e, 0x60, 0xfc, 0x7d, 0x06, 0xaa, 0xe9, 0x95,
+	0xa1, 0x1c, 0x1b, 0x90, 0xd0, 0xf5, 0x9d, 0x84, 0x63, 0x0f, 0x38, 0x83, 0x39, 0x8f, 0x35, 0x7f,
+	0x33, 0xf5, 0xa9, 0xa5, 0x9c, 0x67, 0x07, 0xd3, 0xdf, 0x61, 0xf4, 0x85, 0xa0, 0xd0, 0x2f, 0x04,
+	0x05, 0xfa, 0x31, 0x20, 0xe9, 0xf8, 0xb1, 0x3b, 0x71, 0xa9, 0x79, 0x74, 0x4e, 0x89, 0x70, 0x8c,
+	0x8e, 0x6b, 0xa2, 0x65, 0x8f, 0x35, 0x3c, 0x62, 0x7c, 0x64, 0xc0, 0x9a, 0xef, 0x4f, 0xcc, 0xc8,
+	0xf6, 0x43, 0x62, 0x5a, 0xce, 0x2b, 0x9e, 0x8a, 0xea, 0xb8, 0xec, 0xfb, 0x93, 0x01, 0xe3, 0xb5,
+	0x9c, 0x57, 0xec, 0x00, 0xb1, 0x83, 0x69, 0x44, 0xa8, 0xc9, 0x7e, 0xf8, 0x99, 0x5b, 0xc2, 0x20,
+	0x58, 0xed, 0x60, 0x1a, 0x25, 0x3a, 0x4c, 0xc8, 0x84, 0x9d, 0xa3, 0x89, 0x0e, 0xfb, 0x64, 0xc2,
+	0x46, 0xa9, 0x1c, 0x90, 0xd0, 0x26, 0x1e, 0x1d, 0xba, 0xf6, 0x09, 0x3b, 0x22, 0xb5, 0x2d, 0x0d,
+	0xa7, 0x78, 0xc6, 0xcf, 0x20, 0xc7, 0x8f, 0x54, 0x36, 0x79, 0x7e, 0x1c, 0xf1, 0xd3, 0x4a, 0xd8,
+	0xbd, 0xc8, 0x18, 0xfc, 0xac, 0x7a, 0x1f, 0x4a, 0xdc, 0xc8, 0x89, 0x34, 0xb3, 0xc8, 0x18, 0xbc,
+	0xb1, 0x01, 0xc5, 0x90, 0x58, 0x8e, 0xef, 0x8d, 0xd5, 0x75, 0x3d, 0xa6, 0x8d, 0x6f, 0x20, 0x2f,
+	0xf6, 0xe5, 0x2b, 0xe0, 0x7f, 0x08, 0xc8, 0x16, 0x87, 0x64, 0xc0, 0xae, 0xff, 0x51, 0x24, 0xb3,
+	0x36, 0xfe, 0x8d, 0x48, 0xb4, 0x1c, 0xcc, 0x1a, 0x8c, 0xff, 0xd4, 0x00, 0x66, 0xd5, 0x7b, 0x96,
+	0xe8, 0xb1, 0x10, 0x64, 0x77, 0x1d, 0x51, 0x26, 0x50, 0x24, 0xbb, 0x21, 0xcb, 0x34, 0x2d, 0xb3,
+	0xea, 0xc7, 0x0f, 0x09, 0xa0, 0x8a, 0x86, 0x44, 0x5e, 0xa7, 0x96, 0x2d, 0x1a, 0x12, 0x51, 0x34,
+	0x24, 0xec, 0x52, 0x27, 0x13, 0x48, 0x01, 0x97, 0xe5, 0xf9, 0x63, 0xd9, 0x89, 0x2b, 0xb3, 0xc4,
+	0xf8, 0x6f, 0x2d, 0xde, 0x44, 0x54, 0x05, 0x15, 0x7d, 0x0d, 0x45, 0xb6, 0x1e, 0xcd, 0x89, 0x15,
+	0xc8, 0xef, 0x81, 0xed, 0xd5, 0x8a, 0xb3, 0x4d, 0xb6, 0xfc, 0xf6, 0xad, 0x40, 0xa4, 0x7f, 0x85,
+	0x40, 0x50, 0x6c, 0x33, 0xb2, 0x9c, 0xd9, 0x66, 0xc4, 0xfe, 0xa3, 0x1f, 0x40, 0xd5, 0x9a, 0x52,
+	0xdf, 0xb4, 0x9c, 0x53, 0x12, 0x52, 0x37, 0x22, 0xd2, 0xf7, 0x6b, 0x8c, 0xdb, 0x52, 0xcc, 0xc6,
+	0x7d, 0xa8, 0x24, 0x31, 0xdf, 0x76, 0x2c, 0xe7, 0x92, 0xc7, 0xf2, 0xef, 0x03, 0xcc, 0xaa, 0x11,
+	0x2c, 0x46, 0x58, 0x69, 0xc3, 0xb4, 0xd5, 0x85, 0x2a, 0x87, 0x8b, 0x8c, 0xd1, 0x66, 0x57, 0x87,
+	0x74, 0xa9, 0x34, 0xa7, 0x4a, 0xa5, 0x6c, 0xd5, 0xb2, 0x85, 0x76, 0xe2, 0x8e, 0xc7, 0x71, 0x85,
+	0xa4, 0xe4, 0xfb, 0x93, 0xa7, 0x9c, 0x61, 0xfc, 0x3a, 0x23, 0x62, 0x45, 0x14, 0xbd, 0x17, 0xca,
+	0xf5, 0xdf, 0x95, 0xab, 0xef, 0x01, 0x44, 0xd4, 0x0a, 0x59, 0x8e, 0x61, 0xa9, 0x1a, 0x4d, 0x63,
+	0xae, 0xd6, 0x3a, 0x54, 0xdf, 0xee, 0x71, 0x49, 0xf6, 0x6e, 0x51, 0xf4, 0x39, 0x54, 0x6c, 0x7f,
+	0x12, 0x8c, 0x89, 0x14, 0xce, 0xbd, 0x55, 0xb8, 0x1c, 0xf7, 0x6f, 0xd1, 0x44, 0x65, 0x28, 0x7f,
+	0xd5, 0xca, 0xd0, 0xbf, 0x68, 0xa2, 0x76, 0x9f, 0xfc, 0x74, 0x80, 0x46, 0x97, 0x7c, 0x9f, 0x7e,
+	0xb2, 0xe2, 0x77, 0x88, 0xef, 0xfa, 0x38, 0xdd, 0xf8, 0x7c, 0x91, 0xaf, 0xc1, 0x6f, 0xce, 0xfa,
+	0xfe, 0x55, 0x87, 0x92, 0x72, 0xcb, 0xbc, 0xef, 0x3f, 0x83, 0x52, 0xfc, 0x70, 0xa2, 0x9e, 0x79,
+	0xab, 0x85, 0x67, 0x9d, 0xd1, 0x4b, 0x40, 0xd6, 0x68, 0x14, 0x67, 0x73, 0xe6, 0x34, 0xb2, 0x46,
+	0xea, 0xa3, 0xc9, 0x67, 0x4b, 0xd8, 0x41, 0x9d, 0x5b, 0x87, 0x4c, 0x1e, 0xd7, 0xac, 0xd1, 0x28,
+	0xc5, 0x41, 0x7f, 0x00, 0x37, 0xd2, 0x63, 0x98, 0x47, 0xe7, 0x66, 0xe0, 0x3a, 0xf2, 0x4e, 0xb9,
+	0xbb, 0xec, 0x97, 0x8b, 0x66, 0x0a, 0xfe, 0xd1, 0xf9, 0x81, 0xeb, 0x08, 0x9b, 0xa3, 0x70, 0xae,
+	0xa1, 0xf1, 0x47, 0xf0, 0xde, 0x1b, 0xba, 0x5f, 0xe2, 0x83, 0x5e, 0xfa, 0x8b, 0xfc, 0xea, 0x46,
+	0x48, 0x78, 0xef, 0xdf, 0x32, 0xb0, 0x31, 0xd7, 0x01, 0xb5, 0x92, 0x09, 0xed, 0x9d, 0x05, 0xc7,
+	0x69, 0x1f, 0x1c, 0x0a, 0x78, 0x26, 0x8b, 0xbe, 0xbc, 0x90, 0xc3, 0x2e, 0x9a, 0xdd, 0x88, 0x54,
+	0x50, 0x00, 0xa9, 0xb4, 0x75, 0x1f, 0x0a, 0xaa, 0xd8, 0x24, 0xfc, 0xff, 0xc9, 0x72, 0xfb, 0xb2,
+	0x40, 0x53, 0x18, 0xa8, 0x07, 0xc5, 0xa3, 0xb1, 0x6f, 0x9f, 0x98, 0xae, 0x5f, 0xcf, 0x2e, 0x85,
+	0xf7, 0x88, 0x89, 0x75, 0xfb, 0x12, 0x8f, 0x83, 0x74, 0x7d, 0xe3, 0x1f, 0x74, 0x28, 0xaa, 0xc9,
+	0xf3, 0x0b, 0xeb, 0x79, 0x44, 0xc9, 0xc4, 0x8c, 0x4b, 0x56, 0x1a, 0x06, 0xc1, 0xe2, 0xe5, 0x99,
+	0xf7, 0xa1, 0xc4, 0xee, 0xc5, 0xa2, 0x39, 0xc3, 0x9b, 0x8b, 0x8c, 0xc1, 0x1b, 0x3f, 0x80, 0x32,
+	0xf5, 0xa9, 0x35, 0x36, 0x29, 0x4f, 0x35, 0x74, 0x21, 0xcd, 0x59, 0x3c, 0xd1, 0x40, 0x3f, 0x82,
+	0x0d, 0x7a, 0x1c, 0xfa, 0x94, 0x8e, 0x59, 0x5e, 0xca, 0x13, 0x2e, 0x91, 0x1f, 0x65, 0x71, 0x2d,
+	0x6e, 0x10, 0x89, 0x58, 0xc4, 0x0e, 0x97, 0x59, 0x67, 0xb6, 0xb2, 0xf8, 0x1e, 0x97, 0xc5, 0x6b,
+	0x31, 0x97, 0xad, 0x3c, 0x76, 0xb6, 0x07, 0x22, 0x99, 0xe1, 0x5b, 0x99, 0x86, 0x15, 0x89, 0x4c,
+	0x58, 0x9f, 0x10, 0x2b, 0x9a, 0x86, 0xc4, 0x31, 0x5f, 0xba, 0x64, 0xec, 0x88, 0x3a, 0x43, 0x75,
+	0xe1, 0x6b, 0x83, 0x32, 0x4b, 0xf3, 0x31, 0x97, 0xc6, 0x55, 0x05, 0x27, 0x68, 0x96, 0xd8, 0x88,
+	0x7f, 0x68, 0x1d, 0xca, 0x83, 0xe7, 0x83, 0x61, 0x67, 0xdf, 0xdc, 0xef, 0xef, 0x74, 0xe4, 0x9b,
+	0x90, 0x41, 0x07, 0x0b, 0x52, 0x63, 0xed, 0xc3, 0xfe, 0xb0, 0xb5, 0x67, 0x0e, 0xbb, 0xed, 0xa7,
+	0x83, 0x5a, 0x06, 0xdd, 0x80, 0x8d, 0xe1, 0x2e, 0xee, 0x0f, 0x87, 0x7b, 0x9d, 0x1d, 0xf3, 0xa0,
+	0x83, 0xbb, 0xfd, 0x9d, 0x41, 0x4d, 0x47, 0x08, 0xaa, 0x33, 0xf6, 0xb0, 0xbb, 0xdf, 0xa9, 0x65,
+	0xd9, 0x2b, 0x80, 0x83, 0x0e, 0x6e, 0x77, 0x7a, 0xc3, 0x5a, 0xce, 0xf8, 0x95, 0x0e, 0xe5, 0x44,
+	0x90, 0xb1, 0x75, 0x16, 0x46, 0xe2, 0x7e, 0x92, 0xc5, 0xec, 0x2f, 0xff, 0x86, 0x65, 0xd9, 0xc7,
+	0xc2, 0x3b, 0x59, 0x2c, 0x08, 0x7e, 0x27, 0xb1, 0xce, 0x12, 0xdb, 0x50, 0x16, 0x17, 0x27, 0xd6,
+	0x99, 0x00, 0xf9, 0x3e, 0x54, 0x4e, 0x48, 0xe8, 0x91, 0xb1, 0x6c, 0x17, 0x1e, 0x29, 0x0b, 0x9e,
+	0xe8, 0xb2, 0x05, 0x35, 0xd9, 0x65, 0x06, 0x23, 0xdc, 0x51, 0x15, 0xfc, 0x7d, 0x05, 0xb6, 0x09,
+	0x39, 0xd1, 0x5c, 0x10, 0xe3, 0x73, 0x82, 0x9d, 0xa2, 0xd1, 0x6b, 0x2b, 0xe0, 0xe9, 0x67, 0x16,
+	0xf3, 0xff, 0xe8, 0x68, 0xde, 0x3f, 0x79, 0xee, 0x9f, 0x7b, 0xcb, 0xaf, 0xb6, 0x37, 0xb9, 0xe8,
+	0x38, 0x76, 0x51, 0x01, 0x74, 0xac, 0x1e, 0x52, 0xb4, 0x5b, 0xed, 0x5d, 0xe6, 0x96, 0x35, 0x28,
+	0xed, 0xb7, 0xbe, 0x32, 0x0f, 0x07, 0xbc, 0x84, 0x8b, 0x6a, 0x50, 0x79, 0xda, 0xc1, 0xbd, 0xce,
+	0x9e, 0xe4, 0xe8, 0x68, 0x13, 0x6a, 0x92, 0x33, 0xeb, 0x97, 0x65, 0x08, 0xe2, 0x6f, 0x8e, 0xd5,
+	0x23, 0x07, 0xcf, 0x5a, 0x07, 0xb5, 0xbc, 0xf1, 0x8f, 0x19, 0xa8, 0x24, 0x57, 0x2c, 0x2b, 0xcc,
+	0x84, 0x67, 0xf2, 0x0e, 0x20, 0xfc, 0x53, 0x08, 0xcf, 0x44, 0xea, 0x7f, 0x0b, 0x8a, 0x54, 0x35,
+	0x09, 0x37, 0x15, 0xa8, 0x6c, 0xfa, 0x1e, 0x40, 0x78, 0x66, 0xb2, 0x4a, 0x21, 0xa1, 0x91, 0xf4,
+	0x54, 0x29, 0x3c, 0x3b, 0x10, 0x0c, 0xd6, 0x4c, 0x67, 0xcd, 0xc2, 0x51, 0x25, 0x1a, 0x37, 0xdb,
+	0xf3, 0x26, 0xcd, 0x71, 0x93, 0xde, 0x5f, 0x61, 0xcf, 0x79, 0x93, 0x4d, 0x77, 0x62, 0x9b, 0x56,
+	0xa0, 0x88, 0xbf, 0x32, 0x1f, 0x3d, 0x1f, 0x76, 0x98, 0x61, 0x2b, 0x50, 0x1c, 0x2a, 0x4a, 0x63,
+	0xaf, 0xa4, 0xf0, 0x57, 0xe6, 0x41, 0xab, 0xfd, 0xb4, 0x33, 0x64, 0x11, 0x5f, 0x05, 0x18, 0xce,
+	0x68, 0xdd, 0xf8, 0xa7, 0x0c, 0x54, 0x92, 0x3b, 0x12, 0x9f, 0x39, 0xb1, 0x9c, 0x94, 0xc5, 0x4a,
+	0x8c, 0x23, 0x0c, 0xf3, 0x01, 0x94, 0x5f, 0x87, 0x2e, 0x25, 0x29, 0xb3, 0x01, 0x67, 0xc5, 0x46,
+	0xe5, 0xf2, 0x7e, 0xa0, 0xec, 0x56, 0x60, 0x74, 0x3f, 0xe0, 0x37, 0x72, 0x21, 0xeb, 0x07, 0xca,
+	0x68, 0x45, 0xce, 0xe8, 0x07, 0xef, 0xc0, 0x66, 0xc9, 0x59, 0xbc, 0xc9, 0x66, 0x8f, 0x63, 0x9b,
+	0x31, 0xbb, 0x74, 0x5a, 0x3b, 0xb1, 0xd5, 0xd6, 0xa1, 0xfc, 0x0c, 0x77, 0x87, 0x9d, 0xd8, 0x70,
+	0xcc, 0xa8, 0xac, 0x43, 0xff, 0x80, 0x99, 0x6d, 0x0d, 0x4a, 0xa2, 0x99, 0x91, 0xba, 0xf1, 0x5f,
+	0x19, 0x58, 0x17, 0xb9, 0x51, 0xfc, 0xb0, 0xe0, 0xcd, 0x1f, 0x56, 0x93, 0xa5, 0xc1, 0x4c, 0xba,
+	0x34, 0xa8, 0x6e, 0x62, 0x3c, 0xb5, 0xd5, 0x67, 0x37, 0x31, 0x5e, 0x52, 0x4c, 0xa5, 0x3d, 0xd9,
+	0x65, 0xd2, 0x9e, 0x3a, 0x14, 0x26, 0x24, 0x8a, 0x77, 0x87, 0x12, 0x56, 0x24, 0x72, 0xa1, 0x6c,
+	0x79, 0x9e, 0x4f, 0x2d, 0x51, 0x6f, 0xcf, 0x2f, 0x95, 0x11, 0x5e, 0x98, 0x71, 0xb3, 0x35, 0x43,
+	0x12, 0xd9, 0x49, 0x12, 0xbb, 0xf1, 0x53, 0xa8, 0x5d, 0xec, 0xb0, 0x4c, 0x4e, 0xf8, 0xc3, 0x8f,
+	0x67, 0x29, 0x21, 0x61, 0xbb, 0xef, 0x61, 0xef, 0x69, 0xaf, 0xff, 0xac, 0x57, 0xbb, 0xc6, 0x08,
+	0x7c, 0xd8, 0xeb, 0x75, 0x7b, 0x4f, 0x6a, 0x1a, 0xfb, 0x0e, 0xd4, 0xf9, 0xaa, 0xcb, 0x9e, 0x00,
+	0x66, 0xb6, 0xff, 0x6e, 0x03, 0xf2, 0x42, 0x49, 0xf4, 0xad, 0x4c, 0x87, 0x93, 0x8f, 0x56, 0xd1,
+	0x4f, 0x97, 0xbe, 0x56, 0xa6, 0x1e, 0xc2, 0x36, 0x1e, 0xae, 0x2c, 0x2f, 0x3f, 0x20, 0x5e, 0x43,
+	0x7f, 0xa6, 0x41, 0x25, 0xf5, 0xf1, 0x70, 0xd1, 0xf0, 0xbe, 0xe4, 0x8d, 0x6c, 0xe3, 0x27, 0x2b,
+	0xc9, 0xc6, 0xba, 0xfc, 0x52, 0x83, 0x72, 0xe2, 0x75, 0x28, 0xba, 0xb7, 0xca, 0x8b, 0x52, 0xa1,
+	0xc9, 0xfd, 0xd5, 0x1f, 0xa3, 0x1a, 0xd7, 0x3e, 0xd2, 0xd0, 0x9f, 0x6a, 0x50, 0x4e, 0xbc, 0x93,
+	0x5c, 0x58, 0x95, 0xf9, 0x57, 0x9d, 0x8d, 0xfb, 0xab, 0x88, 0xc6, 0x36, 0xf9, 0x63, 0x0d, 0x4a,
+	0xf1, 0x9b, 0x47, 0x74, 0x77, 0xf9, 0x57, 0x92, 0x42, 0x89, 0xcf, 0x56, 0x7d, 0x5e, 0x69, 0x5c,
+	0x43, 0x7f, 0x08, 0x45, 0xf5, 0x40, 0x10, 0x2d, 0x9a, 0x23, 0x5d, 0x78, 0x7d, 0xd8, 0xb8, 0xbb,
+	0xb4, 0x5c, 0x72, 0x78, 0xf5, 0x6a, 0x6f, 0xe1, 0xe1, 0x2f, 0xbc, 0x2f, 0x6c, 0xdc, 0x5d, 0x5a,
+	0x2e, 0x1e, 0x9e, 0x45, 0x42, 0xe2, 0x71, 0xdf, 0xc2, 0x91, 0x30, 0xff, 0xaa, 0xb0, 0x71, 0x7f,
+	0x15, 0xd1, 0x94, 0x22, 0x89, 0xe7, 0x81, 0x0b, 0x2b, 0x32, 0xff, 0x04, 0xb1, 0x71, 0x7f, 0x15,
+	0xd1, 0x58, 0x91, 0x5f, 0x68, 0xc9, 0xcb, 0xf1, 0xdd, 0xa5, 0x5f, 0xc1, 0x2d, 0x19, 0x92, 0x73,
+	0xef, 0xf0, 0xf8, 0x02, 0xfd, 0x85, 0x2c, 0xe5, 0x89, 0x47, 0x74, 0x68, 0x19, 0xb0, 0xd4, 0xbb,
+	0xbb, 0xc6, 0xa7, 0xab, 0x1d, 0x36, 0x5c, 0x89, 0x3f, 0xd1, 0x00, 0x66, 0xcf, 0xed, 0x16, 0x56,
+	0x62, 0xee, 0x9d, 0x5f, 0xe3, 0xde, 0x0a, 0x92, 0xc9, 0x05, 0xa2, 0x9e, 0x03, 0x2d, 0xbc, 0x40,
+	0x2e, 0x3c, 0x07, 0x6c, 0xdc, 0x5d, 0x5a, 0x2e, 0x1e, 0xfe, 0x6f, 0x34, 0xd8, 0x98, 0x7b, 0x8e,
+	0x84, 0x1e, 0x5e, 0xf1, 0x45, 0x5a, 0xe3, 0x8b, 0xd5, 0x01, 0x94, 0x6a, 0x5b, 0xda, 0x47, 0x1a,
+	0xfa, 0x73, 0x0d, 0xd6, 0x52, 0x4f, 0x38, 0xd0, 0xc2, 0xa7, 0xd4, 0x25, 0x0f, 0x9b, 0x1a, 0x0f,
+	0x56, 0x13, 0x8e, 0xad, 0xf5, 0x97, 0x1a, 0x54, 0xe5, 0xfa, 0x56, 0xfa, 0x3c, 0x58, 0x6e, 0x5b,
+	0xb8, 0xa0, 0xd0, 0xe7, 0x2b, 0x4a, 0x2b, 0x8d, 0x1e, 0x15, 0x7e, 0x37, 0x27, 0xb2, 0xb7, 0x3c,
+	0xff, 0xf9, 0xe4, 0xff, 0x07, 0x00, 0x51, 0x9d, 0x6c, 0x56, 0x91, 0x34, 0x00, 0x00,
 }
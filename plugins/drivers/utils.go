@@ -65,6 +65,7 @@ func taskConfigFromProto(pb *proto.TaskConfig) *TaskConfig {
 		StderrPath:       pb.StderrPath,
 		AllocID:          pb.AllocId,
 		NetworkIsolation: NetworkIsolationSpecFromProto(pb.NetworkIsolationSpec),
+		DNS:              dnsConfigFromProto(pb.Dns),
 	}
 }
 
@@ -89,6 +90,7 @@ func taskConfigToProto(cfg *TaskConfig) *proto.TaskConfig {
 		StderrPath:           cfg.StderrPath,
 		AllocId:              cfg.AllocID,
 		NetworkIsolationSpec: NetworkIsolationSpecToProto(cfg.NetworkIsolation),
+		Dns:                  dnsConfigToProto(cfg.DNS),
 	}
 	return pb
 }
@@ -118,14 +120,16 @@ func ResourcesFromProto(pb *proto.Resources) *Resources {
 			n.MBits = int(network.Mbits)
 			for _, port := range network.ReservedPorts {
 				n.ReservedPorts = append(n.ReservedPorts, structs.Port{
-					Label: port.Label,
-					Value: int(port.Value),
+					Label:  port.Label,
+					Value:  int(port.Value),
+					HostIP: port.HostIp,
 				})
 			}
 			for _, port := range network.DynamicPorts {
 				n.DynamicPorts = append(n.DynamicPorts, structs.Port{
-					Label: port.Label,
-					Value: int(port.Value),
+					Label:  port.Label,
+					Value:  int(port.Value),
+					HostIP: port.HostIp,
 				})
 			}
 			r.NomadResources.Networks = append(r.NomadResources.Networks, &n)
@@ -174,14 +178,16 @@ func ResourcesToProto(r *Resources) *proto.Resources {
 			n.ReservedPorts = []*proto.NetworkPort{}
 			for _, port := range network.ReservedPorts {
 				n.ReservedPorts = append(n.ReservedPorts, &proto.NetworkPort{
-					Label: port.Label,
-					Value: int32(port.Value),
+					Label:  port.Label,
+					Value:  int32(port.Value),
+					HostIp: port.HostIP,
 				})
 			}
 			for _, port := range network.DynamicPorts {
 				n.DynamicPorts = append(n.DynamicPorts, &proto.NetworkPort{
-					Label: port.Label,
-					Value: int32(port.Value),
+					Label:  port.Label,
+					Value:  int32(port.Value),
+					HostIp: port.HostIP,
 				})
 			}
 			pb.AllocatedResources.Networks[i] = &n
@@ -444,10 +450,32 @@ func resourceUsageToProto(ru *ResourceUsage) *proto.TaskResourceUsage {
 		KernelMaxUsage: ru.MemoryStats.KernelMaxUsage,
 	}
 
-	return &proto.TaskResourceUsage{
+	pb := &proto.TaskResourceUsage{
 		Cpu:    cpu,
 		Memory: memory,
 	}
+
+	if ru.NetworkStats != nil {
+		pb.Network = &proto.NetworkUsage{
+			MeasuredFields: networkUsageMeasuredFieldsToProto(ru.NetworkStats.Measured),
+			RxBytes:        ru.NetworkStats.RxBytes,
+			TxBytes:        ru.NetworkStats.TxBytes,
+			RxPackets:      ru.NetworkStats.RxPackets,
+			TxPackets:      ru.NetworkStats.TxPackets,
+		}
+	}
+
+	if ru.BlockIOStats != nil {
+		pb.BlockIo = &proto.BlockIOUsage{
+			MeasuredFields: blockIOUsageMeasuredFieldsToProto(ru.BlockIOStats.Measured),
+			ReadBytes:      ru.BlockIOStats.ReadBytes,
+			WriteBytes:     ru.BlockIOStats.WriteBytes,
+			ReadOps:        ru.BlockIOStats.ReadOps,
+			WriteOps:       ru.BlockIOStats.WriteOps,
+		}
+	}
+
+	return pb
 }
 
 func resourceUsageFromProto(pb *proto.TaskResourceUsage) *ResourceUsage {
@@ -478,10 +506,32 @@ func resourceUsageFromProto(pb *proto.TaskResourceUsage) *ResourceUsage {
 		}
 	}
 
-	return &ResourceUsage{
+	ru := &ResourceUsage{
 		CpuStats:    &cpu,
 		MemoryStats: &memory,
 	}
+
+	if pb.Network != nil {
+		ru.NetworkStats = &NetworkStats{
+			Measured:  networkUsageMeasuredFieldsFromProto(pb.Network.MeasuredFields),
+			RxBytes:   pb.Network.RxBytes,
+			TxBytes:   pb.Network.TxBytes,
+			RxPackets: pb.Network.RxPackets,
+			TxPackets: pb.Network.TxPackets,
+		}
+	}
+
+	if pb.BlockIo != nil {
+		ru.BlockIOStats = &BlockIOStats{
+			Measured:   blockIOUsageMeasuredFieldsFromProto(pb.BlockIo.MeasuredFields),
+			ReadBytes:  pb.BlockIo.ReadBytes,
+			WriteBytes: pb.BlockIo.WriteBytes,
+			ReadOps:    pb.BlockIo.ReadOps,
+			WriteOps:   pb.BlockIo.WriteOps,
+		}
+	}
+
+	return ru
 }
 
 func BytesToMB(bytes int64) int64 {
@@ -574,6 +624,82 @@ func memoryUsageMeasuredFieldsFromProto(fields []proto.MemoryUsage_Fields) []str
 	return r
 }
 
+var networkUsageMeasuredFieldToProtoMap = map[string]proto.NetworkUsage_Fields{
+	"Rx Bytes":   proto.NetworkUsage_RX_BYTES,
+	"Tx Bytes":   proto.NetworkUsage_TX_BYTES,
+	"Rx Packets": proto.NetworkUsage_RX_PACKETS,
+	"Tx Packets": proto.NetworkUsage_TX_PACKETS,
+}
+
+var networkUsageMeasuredFieldFromProtoMap = map[proto.NetworkUsage_Fields]string{
+	proto.NetworkUsage_RX_BYTES:   "Rx Bytes",
+	proto.NetworkUsage_TX_BYTES:   "Tx Bytes",
+	proto.NetworkUsage_RX_PACKETS: "Rx Packets",
+	proto.NetworkUsage_TX_PACKETS: "Tx Packets",
+}
+
+func networkUsageMeasuredFieldsToProto(fields []string) []proto.NetworkUsage_Fields {
+	r := make([]proto.NetworkUsage_Fields, 0, len(fields))
+
+	for _, f := range fields {
+		if v, ok := networkUsageMeasuredFieldToProtoMap[f]; ok {
+			r = append(r, v)
+		}
+	}
+
+	return r
+}
+
+func networkUsageMeasuredFieldsFromProto(fields []proto.NetworkUsage_Fields) []string {
+	r := make([]string, 0, len(fields))
+
+	for _, f := range fields {
+		if v, ok := networkUsageMeasuredFieldFromProtoMap[f]; ok {
+			r = append(r, v)
+		}
+	}
+
+	return r
+}
+
+var blockIOUsageMeasuredFieldToProtoMap = map[string]proto.BlockIOUsage_Fields{
+	"Read Bytes":  proto.BlockIOUsage_READ_BYTES,
+	"Write Bytes": proto.BlockIOUsage_WRITE_BYTES,
+	"Read Ops":    proto.BlockIOUsage_READ_OPS,
+	"Write Ops":   proto.BlockIOUsage_WRITE_OPS,
+}
+
+var blockIOUsageMeasuredFieldFromProtoMap = map[proto.BlockIOUsage_Fields]string{
+	proto.BlockIOUsage_READ_BYTES:  "Read Bytes",
+	proto.BlockIOUsage_WRITE_BYTES: "Write Bytes",
+	proto.BlockIOUsage_READ_OPS:    "Read Ops",
+	proto.BlockIOUsage_WRITE_OPS:   "Write Ops",
+}
+
+func blockIOUsageMeasuredFieldsToProto(fields []string) []proto.BlockIOUsage_Fields {
+	r := make([]proto.BlockIOUsage_Fields, 0, len(fields))
+
+	for _, f := range fields {
+		if v, ok := blockIOUsageMeasuredFieldToProtoMap[f]; ok {
+			r = append(r, v)
+		}
+	}
+
+	return r
+}
+
+func blockIOUsageMeasuredFieldsFromProto(fields []proto.BlockIOUsage_Fields) []string {
+	r := make([]string, 0, len(fields))
+
+	for _, f := range fields {
+		if v, ok := blockIOUsageMeasuredFieldFromProtoMap[f]; ok {
+			r = append(r, v)
+		}
+	}
+
+	return r
+}
+
 func netIsolationModeToProto(mode NetIsolationMode) proto.NetworkIsolationSpec_NetworkIsolationMode {
 	switch mode {
 	case NetIsolationModeHost:
@@ -625,3 +751,25 @@ func NetworkIsolationSpecFromProto(pb *proto.NetworkIsolationSpec) *NetworkIsola
 		Mode:   netIsolationModeFromProto(pb.Mode),
 	}
 }
+
+func dnsConfigToProto(dns *DNSConfig) *proto.DNSConfig {
+	if dns == nil {
+		return nil
+	}
+	return &proto.DNSConfig{
+		Servers:  dns.Servers,
+		Searches: dns.Searches,
+		Options:  dns.Options,
+	}
+}
+
+func dnsConfigFromProto(pb *proto.DNSConfig) *DNSConfig {
+	if pb == nil {
+		return nil
+	}
+	return &DNSConfig{
+		Servers:  pb.Servers,
+		Searches: pb.Searches,
+		Options:  pb.Options,
+	}
+}
@@ -3,6 +3,9 @@ package drivers
 import (
 	"testing"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/nomad/nomad/structs"
+	dproto "github.com/hashicorp/nomad/plugins/drivers/proto"
 	"github.com/stretchr/testify/require"
 )
 
@@ -32,3 +35,90 @@ func TestResourceUsageRoundTrip(t *testing.T) {
 
 	require.EqualValues(t, parsed, input)
 }
+
+func TestResourceUsageRoundTrip_NetworkBlockIO(t *testing.T) {
+	input := &ResourceUsage{
+		CpuStats: &CpuStats{
+			Percent:  1.5,
+			Measured: []string{"Percent"},
+		},
+		MemoryStats: &MemoryStats{
+			RSS:      25681920,
+			Measured: []string{"RSS"},
+		},
+		NetworkStats: &NetworkStats{
+			RxBytes:   1024,
+			TxBytes:   2048,
+			RxPackets: 10,
+			TxPackets: 20,
+			Measured:  []string{"Rx Bytes", "Tx Bytes", "Rx Packets", "Tx Packets"},
+		},
+		BlockIOStats: &BlockIOStats{
+			ReadBytes:  4096,
+			WriteBytes: 8192,
+			ReadOps:    1,
+			WriteOps:   2,
+			Measured:   []string{"Read Bytes", "Write Bytes", "Read Ops", "Write Ops"},
+		},
+	}
+
+	// Encode the message to ensure the new fields survive the wire
+	buf, err := proto.Marshal(resourceUsageToProto(input))
+	require.NoError(t, err)
+
+	var pb dproto.TaskResourceUsage
+	require.NoError(t, proto.Unmarshal(buf, &pb))
+
+	parsed := resourceUsageFromProto(&pb)
+	require.EqualValues(t, input, parsed)
+}
+
+func TestTaskConfigRoundTrip_DNS(t *testing.T) {
+	input := &TaskConfig{
+		ID:   "abc",
+		Name: "web",
+		DNS: &DNSConfig{
+			Servers:  []string{"10.0.0.1"},
+			Searches: []string{"service.consul"},
+			Options:  []string{"ndots:2"},
+		},
+	}
+
+	buf, err := proto.Marshal(taskConfigToProto(input))
+	require.NoError(t, err)
+
+	var pb dproto.TaskConfig
+	require.NoError(t, proto.Unmarshal(buf, &pb))
+
+	parsed := taskConfigFromProto(&pb)
+	require.Equal(t, input.DNS, parsed.DNS)
+
+	// Tasks without a DNS configuration keep using the driver's default
+	parsed = taskConfigFromProto(taskConfigToProto(&TaskConfig{ID: "abc"}))
+	require.Nil(t, parsed.DNS)
+}
+
+func TestResourcesRoundTrip_HostIP(t *testing.T) {
+	input := &Resources{
+		NomadResources: &structs.AllocatedTaskResources{
+			Networks: []*structs.NetworkResource{
+				{
+					IP:            "192.168.0.100",
+					ReservedPorts: []structs.Port{{Label: "admin", Value: 9000}},
+					DynamicPorts:  []structs.Port{{Label: "http", Value: 25000, HostIP: "10.0.0.5"}},
+				},
+			},
+		},
+	}
+
+	buf, err := proto.Marshal(ResourcesToProto(input))
+	require.NoError(t, err)
+
+	var pb dproto.Resources
+	require.NoError(t, proto.Unmarshal(buf, &pb))
+
+	parsed := ResourcesFromProto(&pb)
+	network := parsed.NomadResources.Networks[0]
+	require.Empty(t, network.ReservedPorts[0].HostIP)
+	require.Equal(t, "10.0.0.5", network.DynamicPorts[0].HostIP)
+}
@@ -177,6 +177,74 @@ func (h *HostVolumeChecker) hasVolumes(n *structs.Node) bool {
 	return true
 }
 
+// HostNetworkChecker is a FeasibilityChecker which returns whether a node has
+// the host networks requested by the ports of a task group.
+type HostNetworkChecker struct {
+	ctx Context
+
+	// networks is the set of host network names requested by the task group
+	networks map[string]struct{}
+}
+
+// NewHostNetworkChecker creates a HostNetworkChecker
+func NewHostNetworkChecker(ctx Context) *HostNetworkChecker {
+	return &HostNetworkChecker{
+		ctx: ctx,
+	}
+}
+
+// SetTaskGroup collects the host networks requested by the group and task
+// network ports.
+func (h *HostNetworkChecker) SetTaskGroup(tg *structs.TaskGroup) {
+	networks := make(map[string]struct{})
+	add := func(nets []*structs.NetworkResource) {
+		for _, n := range nets {
+			for _, ports := range [][]structs.Port{n.ReservedPorts, n.DynamicPorts} {
+				for _, port := range ports {
+					if port.HostNetwork != "" {
+						networks[port.HostNetwork] = struct{}{}
+					}
+				}
+			}
+		}
+	}
+
+	add(tg.Networks)
+	for _, task := range tg.Tasks {
+		if task.Resources != nil {
+			add(task.Resources.Networks)
+		}
+	}
+	h.networks = networks
+}
+
+func (h *HostNetworkChecker) Feasible(candidate *structs.Node) bool {
+	if h.hasNetworks(candidate) {
+		return true
+	}
+
+	h.ctx.Metrics().FilterNode(candidate, "missing host network")
+	return false
+}
+
+func (h *HostNetworkChecker) hasNetworks(n *structs.Node) bool {
+	// Fast path: Requested no host networks
+	if len(h.networks) == 0 {
+		return true
+	}
+
+	if n.NodeResources == nil {
+		return false
+	}
+
+	for name := range h.networks {
+		if n.NodeResources.HostNetworks.Lookup(name) == nil {
+			return false
+		}
+	}
+	return true
+}
+
 // DriverChecker is a FeasibilityChecker which returns whether a node has the
 // drivers necessary to scheduler a task group.
 type DriverChecker struct {
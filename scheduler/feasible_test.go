@@ -163,6 +163,77 @@ func TestHostVolumeChecker(t *testing.T) {
 	}
 }
 
+func TestHostNetworkChecker(t *testing.T) {
+	_, ctx := testContext(t)
+	nodes := []*structs.Node{
+		mock.Node(),
+		mock.Node(),
+		mock.Node(),
+	}
+	nodes[1].NodeResources.HostNetworks = []*structs.NodeHostNetwork{
+		{Name: "public", Device: "eth1", IP: "10.0.0.5"},
+	}
+	nodes[2].NodeResources.HostNetworks = []*structs.NodeHostNetwork{
+		{Name: "public", Device: "eth1", IP: "10.0.0.5"},
+		{Name: "internal", Device: "eth2", IP: "172.16.0.5"},
+	}
+
+	noNetworks := mock.Job().TaskGroups[0]
+
+	publicGroup := mock.Job().TaskGroups[0]
+	publicGroup.Networks = []*structs.NetworkResource{
+		{
+			DynamicPorts: []structs.Port{{Label: "http", HostNetwork: "public"}},
+		},
+	}
+
+	bothGroup := mock.Job().TaskGroups[0]
+	bothGroup.Networks = publicGroup.Networks
+	bothGroup.Tasks[0].Resources.Networks[0].ReservedPorts = []structs.Port{
+		{Label: "admin", Value: 8080, HostNetwork: "internal"},
+	}
+
+	checker := NewHostNetworkChecker(ctx)
+	cases := []struct {
+		Node      *structs.Node
+		TaskGroup *structs.TaskGroup
+		Result    bool
+	}{
+		{ // No host networks requested or available
+			Node:      nodes[0],
+			TaskGroup: noNetworks,
+			Result:    true,
+		},
+		{ // Host network requested, none available
+			Node:      nodes[0],
+			TaskGroup: publicGroup,
+			Result:    false,
+		},
+		{ // Happy path
+			Node:      nodes[1],
+			TaskGroup: publicGroup,
+			Result:    true,
+		},
+		{ // Task requests a host network the node lacks
+			Node:      nodes[1],
+			TaskGroup: bothGroup,
+			Result:    false,
+		},
+		{ // Group and task host networks available
+			Node:      nodes[2],
+			TaskGroup: bothGroup,
+			Result:    true,
+		},
+	}
+
+	for i, c := range cases {
+		checker.SetTaskGroup(c.TaskGroup)
+		if act := checker.Feasible(c.Node); act != c.Result {
+			t.Fatalf("case(%d) failed: got %v; want %v", i, act, c.Result)
+		}
+	}
+}
+
 func TestHostVolumeChecker_ReadOnly(t *testing.T) {
 	_, ctx := testContext(t)
 	nodes := []*structs.Node{
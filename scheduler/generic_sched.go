@@ -2,6 +2,7 @@ package scheduler
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	log "github.com/hashicorp/go-hclog"
@@ -50,6 +51,14 @@ const (
 	// that are a result of failing to place all allocations.
 	blockedEvalFailedPlacements = "created to place remaining allocations"
 
+	// blockedEvalDependencies is the description used for blocked evals that
+	// are waiting on the jobs the job depends on.
+	blockedEvalDependencies = "waiting on job dependencies"
+
+	// blockedEvalDependenciesMissing is appended to the description of blocked
+	// evals waiting on jobs that don't exist.
+	blockedEvalDependenciesMissing = "jobs not found"
+
 	// reschedulingFollowupEvalDesc is the description used when creating follow
 	// up evals for delayed rescheduling
 	reschedulingFollowupEvalDesc = "created for delayed rescheduling"
@@ -134,7 +143,8 @@ func (s *GenericScheduler) Process(eval *structs.Evaluation) error {
 		structs.EvalTriggerRollingUpdate, structs.EvalTriggerQueuedAllocs,
 		structs.EvalTriggerPeriodicJob, structs.EvalTriggerMaxPlans,
 		structs.EvalTriggerDeploymentWatcher, structs.EvalTriggerRetryFailedAlloc,
-		structs.EvalTriggerFailedFollowUp, structs.EvalTriggerPreemption:
+		structs.EvalTriggerFailedFollowUp, structs.EvalTriggerPreemption,
+		structs.EvalTriggerArrayAllocDone:
 	default:
 		desc := fmt.Sprintf("scheduler cannot handle '%s' evaluation reason",
 			eval.TriggeredBy)
@@ -143,6 +153,11 @@ func (s *GenericScheduler) Process(eval *structs.Evaluation) error {
 			s.deployment.GetID())
 	}
 
+	// Hold the evaluation until the job's dependencies are met
+	if held, err := s.holdForDependencies(); held || err != nil {
+		return err
+	}
+
 	// Retry up to the maxScheduleAttempts and reset if progress is made.
 	progress := func() bool { return progressMade(s.planResult) }
 	limit := maxServiceScheduleAttempts
@@ -175,6 +190,7 @@ func (s *GenericScheduler) Process(eval *structs.Evaluation) error {
 		newEval.EscapedComputedClass = e.HasEscaped()
 		newEval.ClassEligibility = e.GetClasses()
 		newEval.QuotaLimitReached = e.QuotaLimitReached()
+		newEval.WaitingOnJobs = nil
 		return s.planner.ReblockEval(newEval)
 	}
 
@@ -207,6 +223,41 @@ func (s *GenericScheduler) createBlockedEval(planFailure bool) error {
 	return s.planner.CreateEval(s.blocked)
 }
 
+// holdForDependencies creates a blocked eval waiting on the jobs the job
+// depends on if any of their conditions isn't met, and completes the current
+// eval. It returns whether the eval was held.
+func (s *GenericScheduler) holdForDependencies() (bool, error) {
+	job, err := s.state.JobByID(nil, s.eval.Namespace, s.eval.JobID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get job %q: %v", s.eval.JobID, err)
+	}
+	if job == nil || job.Stopped() || len(job.DependsOn) == 0 {
+		return false, nil
+	}
+
+	unmet, missing, err := unmetDependencies(s.state, job)
+	if err != nil || len(unmet) == 0 {
+		return false, err
+	}
+
+	// Jobs that were garbage collected or never registered keep the eval
+	// blocked until a job with the same ID meets the condition
+	desc := fmt.Sprintf("%s: %s", blockedEvalDependencies, strings.Join(unmet, ", "))
+	if len(missing) != 0 {
+		desc = fmt.Sprintf("%s (%s: %s)", desc, blockedEvalDependenciesMissing, strings.Join(missing, ", "))
+	}
+	s.blocked = s.eval.CreateBlockedEval(nil, false, "")
+	s.blocked.WaitingOnJobs = unmet
+	s.blocked.StatusDescription = desc
+	if err := s.planner.CreateEval(s.blocked); err != nil {
+		return false, err
+	}
+	s.logger.Debug("job dependencies not met, blocked eval created", "blocked_eval_id", s.blocked.ID, "waiting_on", unmet)
+
+	return true, setStatus(s.logger, s.planner, s.eval, nil, s.blocked, nil,
+		structs.EvalStatusComplete, desc, nil, "")
+}
+
 // process is wrapped in retryMax to iteratively run the handler until we have no
 // further work or we've made the maximum number of attempts.
 func (s *GenericScheduler) process() (bool, error) {
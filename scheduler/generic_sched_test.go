@@ -3558,6 +3558,176 @@ func TestBatchSched_Run_CompleteAlloc(t *testing.T) {
 	h.AssertEvalStatus(t, structs.EvalStatusComplete)
 }
 
+func TestBatchSched_DependsOn(t *testing.T) {
+	h := NewHarness(t)
+	require := require.New(t)
+
+	// Create a node
+	node := mock.Node()
+	require.NoError(h.State.UpsertNode(h.NextIndex(), node))
+
+	// Create the job depended on with a running alloc
+	dep := mock.Job()
+	dep.ID = "extract"
+	dep.Type = structs.JobTypeBatch
+	dep.TaskGroups[0].Count = 1
+	require.NoError(h.State.UpsertJob(h.NextIndex(), dep))
+
+	depAlloc := mock.Alloc()
+	depAlloc.Job = dep
+	depAlloc.JobID = dep.ID
+	depAlloc.NodeID = node.ID
+	depAlloc.ClientStatus = structs.AllocClientStatusRunning
+	require.NoError(h.State.UpsertAllocs(h.NextIndex(), []*structs.Allocation{depAlloc}))
+
+	// Create the dependent job
+	job := mock.Job()
+	job.Type = structs.JobTypeBatch
+	job.TaskGroups[0].Count = 1
+	job.DependsOn = []*structs.JobDependency{{JobID: dep.ID, Condition: structs.JobDependencyConditionSuccess}}
+	require.NoError(h.State.UpsertJob(h.NextIndex(), job))
+
+	eval := &structs.Evaluation{
+		Namespace:   structs.DefaultNamespace,
+		ID:          uuid.Generate(),
+		Priority:    job.Priority,
+		TriggeredBy: structs.EvalTriggerJobRegister,
+		JobID:       job.ID,
+		Status:      structs.EvalStatusPending,
+	}
+	require.NoError(h.State.UpsertEvals(h.NextIndex(), []*structs.Evaluation{eval}))
+
+	// The evaluation is held while the job depended on runs
+	require.NoError(h.Process(NewBatchScheduler, eval))
+	require.Empty(h.Plans)
+	require.Len(h.CreateEvals, 1)
+	blocked := h.CreateEvals[0]
+	require.Equal(structs.EvalStatusBlocked, blocked.Status)
+	require.Equal([]string{dep.ID}, blocked.WaitingOnJobs)
+	require.Equal(blocked.ID, h.Evals[0].BlockedEval)
+	h.AssertEvalStatus(t, structs.EvalStatusComplete)
+
+	// A failed job doesn't meet the success condition
+	failed := depAlloc.Copy()
+	failed.ClientStatus = structs.AllocClientStatusFailed
+	require.NoError(h.State.UpdateAllocsFromClient(h.NextIndex(), []*structs.Allocation{failed}))
+
+	require.NoError(h.State.UpsertEvals(h.NextIndex(), []*structs.Evaluation{blocked}))
+	h = NewHarnessWithState(t, h.State)
+	require.NoError(h.Process(NewBatchScheduler, blocked))
+	require.Empty(h.Plans)
+	require.Len(h.CreateEvals, 1)
+
+	// The job depended on succeeds once its alloc is rescheduled and completes
+	complete := mock.Alloc()
+	complete.Job = dep
+	complete.JobID = dep.ID
+	complete.NodeID = node.ID
+	complete.ClientStatus = structs.AllocClientStatusComplete
+	failed = failed.Copy()
+	failed.NextAllocation = complete.ID
+	require.NoError(h.State.UpsertAllocs(h.NextIndex(), []*structs.Allocation{failed, complete}))
+
+	blocked = h.CreateEvals[0]
+	require.NoError(h.State.UpsertEvals(h.NextIndex(), []*structs.Evaluation{blocked}))
+	h = NewHarnessWithState(t, h.State)
+	require.NoError(h.Process(NewBatchScheduler, blocked))
+	require.Len(h.Plans, 1)
+	require.Empty(h.CreateEvals)
+	h.AssertEvalStatus(t, structs.EvalStatusComplete)
+}
+
+func TestBatchSched_DependsOn_Missing(t *testing.T) {
+	h := NewHarness(t)
+	require := require.New(t)
+
+	node := mock.Node()
+	require.NoError(h.State.UpsertNode(h.NextIndex(), node))
+
+	// Create a dependent job whose dependency was garbage collected
+	job := mock.Job()
+	job.Type = structs.JobTypeBatch
+	job.TaskGroups[0].Count = 1
+	job.DependsOn = []*structs.JobDependency{{JobID: "extract", Condition: structs.JobDependencyConditionSuccess}}
+	require.NoError(h.State.UpsertJob(h.NextIndex(), job))
+
+	eval := &structs.Evaluation{
+		Namespace:   structs.DefaultNamespace,
+		ID:          uuid.Generate(),
+		Priority:    job.Priority,
+		TriggeredBy: structs.EvalTriggerJobRegister,
+		JobID:       job.ID,
+		Status:      structs.EvalStatusPending,
+	}
+	require.NoError(h.State.UpsertEvals(h.NextIndex(), []*structs.Evaluation{eval}))
+
+	// The evaluation is held and says the job depended on is missing
+	require.NoError(h.Process(NewBatchScheduler, eval))
+	require.Empty(h.Plans)
+	require.Len(h.CreateEvals, 1)
+	blocked := h.CreateEvals[0]
+	require.Equal([]string{"extract"}, blocked.WaitingOnJobs)
+	require.Equal("waiting on job dependencies: extract (jobs not found: extract)", blocked.StatusDescription)
+	require.Equal(blocked.StatusDescription, h.Evals[0].StatusDescription)
+}
+
+func TestBatchSched_DependsOn_Parameterized(t *testing.T) {
+	h := NewHarness(t)
+	require := require.New(t)
+
+	node := mock.Node()
+	require.NoError(h.State.UpsertNode(h.NextIndex(), node))
+
+	// Create a parameterized job depended on
+	parent := mock.Job()
+	parent.ID = "extract"
+	parent.Type = structs.JobTypeBatch
+	parent.ParameterizedJob = &structs.ParameterizedJobConfig{}
+	require.NoError(h.State.UpsertJob(h.NextIndex(), parent))
+
+	job := mock.Job()
+	job.Type = structs.JobTypeBatch
+	job.TaskGroups[0].Count = 1
+	job.DependsOn = []*structs.JobDependency{{JobID: parent.ID, Condition: structs.JobDependencyConditionComplete}}
+	require.NoError(h.State.UpsertJob(h.NextIndex(), job))
+
+	eval := &structs.Evaluation{
+		Namespace:   structs.DefaultNamespace,
+		ID:          uuid.Generate(),
+		Priority:    job.Priority,
+		TriggeredBy: structs.EvalTriggerJobRegister,
+		JobID:       job.ID,
+		Status:      structs.EvalStatusPending,
+	}
+	require.NoError(h.State.UpsertEvals(h.NextIndex(), []*structs.Evaluation{eval}))
+
+	// The evaluation is held until a child is dispatched
+	require.NoError(h.Process(NewBatchScheduler, eval))
+	require.Empty(h.Plans)
+	require.Len(h.CreateEvals, 1)
+
+	// Dispatch a child that completes
+	child := parent.Copy()
+	child.ID = structs.DispatchedID(parent.ID, time.Now())
+	child.ParentID = parent.ID
+	child.Dispatched = true
+	require.NoError(h.State.UpsertJob(h.NextIndex(), child))
+
+	alloc := mock.Alloc()
+	alloc.Job = child
+	alloc.JobID = child.ID
+	alloc.NodeID = node.ID
+	alloc.ClientStatus = structs.AllocClientStatusComplete
+	require.NoError(h.State.UpsertAllocs(h.NextIndex(), []*structs.Allocation{alloc}))
+
+	blocked := h.CreateEvals[0]
+	require.NoError(h.State.UpsertEvals(h.NextIndex(), []*structs.Evaluation{blocked}))
+	h = NewHarnessWithState(t, h.State)
+	require.NoError(h.Process(NewBatchScheduler, blocked))
+	require.Len(h.Plans, 1)
+	require.Empty(h.CreateEvals)
+}
+
 func TestBatchSched_Run_FailedAlloc(t *testing.T) {
 	h := NewHarness(t)
 
@@ -414,6 +414,12 @@ func (a *allocReconciler) computeGroup(group string, all allocSet) bool {
 	// * Not placing any canaries
 	// * If there are any canaries that they have been promoted
 	place := a.computePlacements(tg, nameIndex, untainted, migrate, rescheduleNow)
+
+	// Throttle the placements of an array to its max_parallel. Failed
+	// allocations are only rescheduled if their replacement is placed.
+	if tg.Array != nil && tg.Array.MaxParallel > 0 {
+		place, rescheduleNow = a.computeArrayPlacements(tg, untainted.union(migrate), place)
+	}
 	if !existingDeployment {
 		dstate.DesiredTotal += len(place)
 	}
@@ -693,6 +699,45 @@ func (a *allocReconciler) computePlacements(group *structs.TaskGroup,
 	return place
 }
 
+// computeArrayPlacements limits the placements of an array task group such that
+// at most MaxParallel of its allocations are running at once. Failed indices
+// being rescheduled are placed before new indices. The returned reschedule set
+// contains the allocations whose replacement is placed.
+func (a *allocReconciler) computeArrayPlacements(group *structs.TaskGroup,
+	existing allocSet, place []allocPlaceResult) ([]allocPlaceResult, allocSet) {
+
+	running := 0
+	for _, alloc := range existing {
+		if !alloc.TerminalStatus() {
+			running++
+		}
+	}
+
+	// Order the rescheduled indices first and in index order
+	sort.SliceStable(place, func(i, j int) bool {
+		pi, pj := place[i].PreviousAllocation(), place[j].PreviousAllocation()
+		ri, rj := place[i].IsRescheduling(), place[j].IsRescheduling()
+		if ri != rj {
+			return ri
+		}
+		return ri && pi.Index() < pj.Index()
+	})
+
+	allowed := helper.IntMax(group.Array.MaxParallel-running, 0)
+	if allowed < len(place) {
+		place = place[:allowed]
+	}
+
+	reschedule := make(map[string]*structs.Allocation)
+	for _, p := range place {
+		if p.IsRescheduling() {
+			prev := p.PreviousAllocation()
+			reschedule[prev.ID] = prev
+		}
+	}
+	return place, reschedule
+}
+
 // computeStop returns the set of allocations that are marked for stopping given
 // the group definition, the set of allocations in various states and whether we
 // are canarying.
@@ -4382,6 +4382,95 @@ func TestReconciler_Batch_Rerun(t *testing.T) {
 	assertNamesHaveIndexes(t, intRange(0, 9), placeResultsToNames(r.place))
 }
 
+// Tests that the placements of an array are limited to its max_parallel
+func TestReconciler_Batch_Array_MaxParallel(t *testing.T) {
+	job := mock.Job()
+	job.Type = structs.JobTypeBatch
+	job.TaskGroups[0].Update = nil
+	job.TaskGroups[0].Count = 10
+	job.TaskGroups[0].Array = &structs.ArrayConfig{Size: 10, MaxParallel: 3}
+
+	// Create 2 running and 1 complete allocations
+	var allocs []*structs.Allocation
+	for i := 0; i < 3; i++ {
+		alloc := mock.Alloc()
+		alloc.Job = job
+		alloc.JobID = job.ID
+		alloc.NodeID = uuid.Generate()
+		alloc.Name = structs.AllocName(job.ID, job.TaskGroups[0].Name, uint(i))
+		alloc.TaskGroup = job.TaskGroups[0].Name
+		alloc.ClientStatus = structs.AllocClientStatusRunning
+		allocs = append(allocs, alloc)
+	}
+	allocs[0].ClientStatus = structs.AllocClientStatusComplete
+
+	reconciler := NewAllocReconciler(testlog.HCLogger(t), allocUpdateFnIgnore, true, job.ID, job, nil, allocs, nil, "")
+	r := reconciler.Compute()
+
+	// Only one more allocation may run
+	assertResults(t, r, &resultExpectation{
+		createDeployment:  nil,
+		deploymentUpdates: nil,
+		place:             1,
+		desiredTGUpdates: map[string]*structs.DesiredUpdates{
+			job.TaskGroups[0].Name: {
+				Place:  1,
+				Ignore: 3,
+			},
+		},
+	})
+
+	assertNamesHaveIndexes(t, intRange(3, 3), placeResultsToNames(r.place))
+}
+
+// Tests that failed indices of an array are retried before new indices are
+// placed and that failed allocations are only stopped once replaced
+func TestReconciler_Batch_Array_RescheduleFirst(t *testing.T) {
+	job := mock.Job()
+	job.Type = structs.JobTypeBatch
+	job.TaskGroups[0].Update = nil
+	job.TaskGroups[0].Count = 10
+	job.TaskGroups[0].Array = &structs.ArrayConfig{Size: 10, MaxParallel: 2}
+	job.TaskGroups[0].ReschedulePolicy = &structs.ReschedulePolicy{Attempts: 1, Interval: 24 * time.Hour}
+
+	// Create 1 running and 2 failed allocations
+	var allocs []*structs.Allocation
+	for i := 0; i < 3; i++ {
+		alloc := mock.Alloc()
+		alloc.Job = job
+		alloc.JobID = job.ID
+		alloc.NodeID = uuid.Generate()
+		alloc.Name = structs.AllocName(job.ID, job.TaskGroups[0].Name, uint(i))
+		alloc.TaskGroup = job.TaskGroups[0].Name
+		alloc.ClientStatus = structs.AllocClientStatusFailed
+		allocs = append(allocs, alloc)
+	}
+	allocs[0].ClientStatus = structs.AllocClientStatusRunning
+
+	reconciler := NewAllocReconciler(testlog.HCLogger(t), allocUpdateFnIgnore, true, job.ID, job, nil, allocs, nil, "")
+	r := reconciler.Compute()
+
+	// Only the first failed index is retried
+	assertResults(t, r, &resultExpectation{
+		createDeployment:  nil,
+		deploymentUpdates: nil,
+		place:             1,
+		stop:              1,
+		desiredTGUpdates: map[string]*structs.DesiredUpdates{
+			job.TaskGroups[0].Name: {
+				Place:  1,
+				Stop:   1,
+				Ignore: 1,
+			},
+		},
+	})
+
+	assertNamesHaveIndexes(t, intRange(1, 1), placeResultsToNames(r.place))
+	assertPlaceResultsHavePreviousAllocs(t, 1, r.place)
+	assertPlacementsAreRescheduled(t, 1, r.place)
+	require.Equal(t, allocs[1].ID, r.stop[0].alloc.ID)
+}
+
 // Test that a failed deployment will not result in rescheduling failed allocations
 func TestReconciler_FailedDeployment_DontReschedule(t *testing.T) {
 	job := mock.Job()
@@ -316,7 +316,7 @@ func TestServiceStack_Select_BinPack_Overflow(t *testing.T) {
 
 func TestSystemStack_SetNodes(t *testing.T) {
 	_, ctx := testContext(t)
-	stack := NewSystemStack(ctx)
+	stack := NewSystemStack(false, ctx)
 
 	nodes := []*structs.Node{
 		mock.Node(),
@@ -338,7 +338,7 @@ func TestSystemStack_SetNodes(t *testing.T) {
 
 func TestSystemStack_SetJob(t *testing.T) {
 	_, ctx := testContext(t)
-	stack := NewSystemStack(ctx)
+	stack := NewSystemStack(false, ctx)
 
 	job := mock.Job()
 	stack.SetJob(job)
@@ -354,7 +354,7 @@ func TestSystemStack_SetJob(t *testing.T) {
 func TestSystemStack_Select_Size(t *testing.T) {
 	_, ctx := testContext(t)
 	nodes := []*structs.Node{mock.Node()}
-	stack := NewSystemStack(ctx)
+	stack := NewSystemStack(false, ctx)
 	stack.SetNodes(nodes)
 
 	job := mock.Job()
@@ -382,7 +382,7 @@ func TestSystemStack_Select_MetricsReset(t *testing.T) {
 		mock.Node(),
 		mock.Node(),
 	}
-	stack := NewSystemStack(ctx)
+	stack := NewSystemStack(false, ctx)
 	stack.SetNodes(nodes)
 
 	job := mock.Job()
@@ -418,7 +418,7 @@ func TestSystemStack_Select_DriverFilter(t *testing.T) {
 	zero := nodes[0]
 	zero.Attributes["driver.foo"] = "1"
 
-	stack := NewSystemStack(ctx)
+	stack := NewSystemStack(false, ctx)
 	stack.SetNodes(nodes)
 
 	job := mock.Job()
@@ -440,7 +440,7 @@ func TestSystemStack_Select_DriverFilter(t *testing.T) {
 		t.Fatalf("ComputedClass() failed: %v", err)
 	}
 
-	stack = NewSystemStack(ctx)
+	stack = NewSystemStack(false, ctx)
 	stack.SetNodes(nodes)
 	stack.SetJob(job)
 	node = stack.Select(job.TaskGroups[0], selectOptions)
@@ -461,7 +461,7 @@ func TestSystemStack_Select_ConstraintFilter(t *testing.T) {
 		t.Fatalf("ComputedClass() failed: %v", err)
 	}
 
-	stack := NewSystemStack(ctx)
+	stack := NewSystemStack(false, ctx)
 	stack.SetNodes(nodes)
 
 	job := mock.Job()
@@ -504,7 +504,7 @@ func TestSystemStack_Select_BinPack_Overflow(t *testing.T) {
 	}
 	one := nodes[1]
 
-	stack := NewSystemStack(ctx)
+	stack := NewSystemStack(false, ctx)
 	stack.SetNodes(nodes)
 
 	job := mock.Job()
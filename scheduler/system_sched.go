@@ -5,6 +5,7 @@ import (
 
 	log "github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/hashicorp/nomad/nomad/structs"
 )
@@ -16,13 +17,15 @@ const (
 	maxSystemScheduleAttempts = 5
 )
 
-// SystemScheduler is used for 'system' jobs. This scheduler is
-// designed for services that should be run on every client.
+// SystemScheduler is used for 'system' and 'sysbatch' jobs. This scheduler
+// is designed for services that should be run on every client, and for
+// batch work that should run to completion once on every client.
 // One for each job, containing an allocation for each node
 type SystemScheduler struct {
-	logger  log.Logger
-	state   State
-	planner Planner
+	logger   log.Logger
+	state    State
+	planner  Planner
+	sysbatch bool
 
 	eval       *structs.Evaluation
 	job        *structs.Job
@@ -32,6 +35,11 @@ type SystemScheduler struct {
 	stack      *SystemStack
 	nodes      []*structs.Node
 	nodesByDC  map[string]int
+	deployment *structs.Deployment
+
+	// canaries is the set of placements that are canaries of a new
+	// deployment
+	canaries map[nodeAllocName]struct{}
 
 	limitReached bool
 	nextEval     *structs.Evaluation
@@ -50,6 +58,17 @@ func NewSystemScheduler(logger log.Logger, state State, planner Planner) Schedul
 	}
 }
 
+// NewSysBatchScheduler is a factory function to instantiate a new sysbatch
+// scheduler.
+func NewSysBatchScheduler(logger log.Logger, state State, planner Planner) Scheduler {
+	return &SystemScheduler{
+		logger:   logger.Named("sysbatch_sched"),
+		state:    state,
+		planner:  planner,
+		sysbatch: true,
+	}
+}
+
 // Process is used to handle a single evaluation.
 func (s *SystemScheduler) Process(eval *structs.Evaluation) error {
 	// Store the evaluation
@@ -63,12 +82,12 @@ func (s *SystemScheduler) Process(eval *structs.Evaluation) error {
 	case structs.EvalTriggerJobRegister, structs.EvalTriggerNodeUpdate, structs.EvalTriggerFailedFollowUp,
 		structs.EvalTriggerJobDeregister, structs.EvalTriggerRollingUpdate, structs.EvalTriggerPreemption,
 		structs.EvalTriggerDeploymentWatcher, structs.EvalTriggerNodeDrain, structs.EvalTriggerAllocStop,
-		structs.EvalTriggerQueuedAllocs:
+		structs.EvalTriggerQueuedAllocs, structs.EvalTriggerPeriodicJob:
 	default:
 		desc := fmt.Sprintf("scheduler cannot handle '%s' evaluation reason",
 			eval.TriggeredBy)
 		return setStatus(s.logger, s.planner, s.eval, s.nextEval, nil, s.failedTGAllocs, structs.EvalStatusFailed, desc,
-			s.queuedAllocs, "")
+			s.queuedAllocs, s.deployment.GetID())
 	}
 
 	// Retry up to the maxSystemScheduleAttempts and reset if progress is made.
@@ -76,14 +95,14 @@ func (s *SystemScheduler) Process(eval *structs.Evaluation) error {
 	if err := retryMax(maxSystemScheduleAttempts, s.process, progress); err != nil {
 		if statusErr, ok := err.(*SetStatusError); ok {
 			return setStatus(s.logger, s.planner, s.eval, s.nextEval, nil, s.failedTGAllocs, statusErr.EvalStatus, err.Error(),
-				s.queuedAllocs, "")
+				s.queuedAllocs, s.deployment.GetID())
 		}
 		return err
 	}
 
 	// Update the status to complete
 	return setStatus(s.logger, s.planner, s.eval, s.nextEval, nil, s.failedTGAllocs, structs.EvalStatusComplete, "",
-		s.queuedAllocs, "")
+		s.queuedAllocs, s.deployment.GetID())
 }
 
 // process is wrapped in retryMax to iteratively run the handler until we have no
@@ -114,14 +133,23 @@ func (s *SystemScheduler) process() (bool, error) {
 	// Create a plan
 	s.plan = s.eval.MakePlan(s.job)
 
-	// Reset the failed allocations
+	if !s.sysbatch {
+		// Get any existing deployment
+		s.deployment, err = s.state.LatestDeploymentByJobID(ws, s.eval.Namespace, s.eval.JobID)
+		if err != nil {
+			return false, fmt.Errorf("failed to get job deployment %q: %v", s.eval.JobID, err)
+		}
+	}
+
+	// Reset the failed allocations and canaries
 	s.failedTGAllocs = nil
+	s.canaries = nil
 
 	// Create an evaluation context
 	s.ctx = NewEvalContext(s.state, s.plan, s.logger)
 
 	// Construct the placement stack
-	s.stack = NewSystemStack(s.ctx)
+	s.stack = NewSystemStack(s.sysbatch, s.ctx)
 	if !s.job.Stopped() {
 		s.stack.SetJob(s.job)
 	}
@@ -200,11 +228,37 @@ func (s *SystemScheduler) computeJobAllocs() error {
 	// nodes to lost
 	updateNonTerminalAllocsToLost(s.plan, tainted, allocs)
 
+	// Index the sysbatch allocations that already ran to completion before
+	// the terminal allocations are filtered out
+	var completed map[nodeAllocName]*structs.Allocation
+	if s.sysbatch {
+		completed = completedSysBatchAllocs(s.job, allocs)
+	}
+
 	// Filter out the allocations in a terminal state
 	allocs, terminalAllocs := structs.FilterTerminalAllocs(allocs)
 
 	// Diff the required and existing allocations
 	diff := diffSystemAllocs(s.job, s.nodes, tainted, allocs, terminalAllocs)
+
+	// Sysbatch allocations that ran to completion are not placed again
+	// until the job is updated
+	if s.sysbatch {
+		place := diff.place[:0]
+		for _, missing := range diff.place {
+			key := nodeAllocName{nodeID: missing.Alloc.NodeID, name: missing.Name}
+			if alloc, ok := completed[key]; ok {
+				diff.ignore = append(diff.ignore, allocTuple{
+					Name:      missing.Name,
+					TaskGroup: missing.TaskGroup,
+					Alloc:     alloc,
+				})
+				continue
+			}
+			place = append(place, missing)
+		}
+		diff.place = place
+	}
 	s.logger.Debug("reconciled current state with desired state",
 		"place", len(diff.place), "update", len(diff.update),
 		"migrate", len(diff.migrate), "stop", len(diff.stop),
@@ -236,14 +290,45 @@ func (s *SystemScheduler) computeJobAllocs() error {
 		}
 	}
 
+	// Cancel the deployments that are no longer needed
+	s.cancelDeployments()
+
+	// The updates of groups with an update block are rolled out by a
+	// deployment, the others are staggered by the job's update block
+	var staggered []allocTuple
+	deploying := make(map[string][]allocTuple)
+	for _, update := range diff.update {
+		if s.sysbatch || update.TaskGroup.Update.IsEmpty() {
+			staggered = append(staggered, update)
+			continue
+		}
+		deploying[update.TaskGroup.Name] = append(deploying[update.TaskGroup.Name], update)
+	}
+
 	// Check if a rolling upgrade strategy is being used
-	limit := len(diff.update)
+	limit := len(staggered)
 	if !s.job.Stopped() && s.job.Update.Rolling() {
 		limit = s.job.Update.MaxParallel
 	}
 
 	// Treat non in-place updates as an eviction and new placement.
-	s.limitReached = evictAndPlace(s.ctx, diff, diff.update, allocUpdating, &limit)
+	s.limitReached = evictAndPlace(s.ctx, diff, staggered, allocUpdating, &limit)
+
+	// Do the destructive updates allowed by the deployment of each group
+	remaining := make(map[string]int, len(deploying))
+	if !s.job.Stopped() {
+		for _, tg := range s.job.TaskGroups {
+			updates, ok := deploying[tg.Name]
+			if !ok {
+				continue
+			}
+			limit := s.computeDeploymentLimit(updates, allocs)
+			if n := len(updates) - limit; n > 0 {
+				remaining[tg.Name] = n
+			}
+			evictAndPlace(s.ctx, diff, updates, allocUpdating, &limit)
+		}
+	}
 
 	// Nothing remaining to do if placement is not required
 	if len(diff.place) == 0 {
@@ -252,6 +337,7 @@ func (s *SystemScheduler) computeJobAllocs() error {
 				s.queuedAllocs[tg.Name] = 0
 			}
 		}
+		s.computeDeployment(allocs, deploying, inplaceUpdates, remaining)
 		return nil
 	}
 
@@ -261,7 +347,12 @@ func (s *SystemScheduler) computeJobAllocs() error {
 	}
 
 	// Compute the placements
-	return s.computePlacements(diff.place)
+	if err := s.computePlacements(diff.place); err != nil {
+		return err
+	}
+
+	s.computeDeployment(allocs, deploying, inplaceUpdates, remaining)
+	return nil
 }
 
 // computePlacements computes placements for allocations
@@ -401,6 +492,257 @@ func (s *SystemScheduler) computePlacements(place []allocTuple) error {
 	return nil
 }
 
+// cancelDeployments cancels the deployment of the job if the job is stopped
+// or the deployment is for an older version of the job.
+func (s *SystemScheduler) cancelDeployments() {
+	d := s.deployment
+	if d == nil {
+		return
+	}
+
+	// If the job is stopped and there is a non-terminal deployment, cancel it
+	if s.job.Stopped() {
+		if d.Active() {
+			s.plan.DeploymentUpdates = append(s.plan.DeploymentUpdates, &structs.DeploymentStatusUpdate{
+				DeploymentID:      d.ID,
+				Status:            structs.DeploymentStatusCancelled,
+				StatusDescription: structs.DeploymentStatusDescriptionStoppedJob,
+			})
+		}
+		s.deployment = nil
+		return
+	}
+
+	// Check if the deployment is active and referencing an older job and cancel it
+	if d.JobCreateIndex != s.job.CreateIndex || d.JobVersion != s.job.Version {
+		if d.Active() {
+			s.plan.DeploymentUpdates = append(s.plan.DeploymentUpdates, &structs.DeploymentStatusUpdate{
+				DeploymentID:      d.ID,
+				Status:            structs.DeploymentStatusCancelled,
+				StatusDescription: structs.DeploymentStatusDescriptionNewerJob,
+			})
+		}
+		s.deployment = nil
+		return
+	}
+
+	// Clear it as the current deployment if it is successful
+	if d.Status == structs.DeploymentStatusSuccessful {
+		s.deployment = nil
+	}
+}
+
+// computeDeploymentLimit returns the number of destructive updates of a
+// group that its deployment allows to be made. The updates are all for the
+// same group. The canaries of a new deployment are the first updates.
+func (s *SystemScheduler) computeDeploymentLimit(updates []allocTuple, allocs []*structs.Allocation) int {
+	tg := updates[0].TaskGroup
+	strategy := tg.Update
+
+	// A new deployment starts by updating its canaries, or as many
+	// allocations as allowed by max_parallel
+	d := s.deployment
+	if d == nil {
+		if strategy.Canary == 0 {
+			return strategy.MaxParallel
+		}
+
+		n := helper.IntMin(strategy.Canary, len(updates))
+		if s.canaries == nil {
+			s.canaries = make(map[nodeAllocName]struct{}, n)
+		}
+		for _, update := range updates[:n] {
+			s.canaries[nodeAllocName{nodeID: update.Alloc.NodeID, name: update.Name}] = struct{}{}
+		}
+		return n
+	}
+
+	// The group isn't part of the deployment of the job version
+	dstate, ok := d.TaskGroups[tg.Name]
+	if !ok {
+		return strategy.MaxParallel
+	}
+
+	// If the deployment is paused or failed, or its canaries have not been
+	// promoted, do not update anything else
+	if d.Status == structs.DeploymentStatusPaused || d.Status == structs.DeploymentStatusFailed {
+		return 0
+	}
+	if dstate.DesiredCanaries != 0 && !dstate.Promoted {
+		return 0
+	}
+
+	// The limit is the configured max_parallel minus any outstanding
+	// non-healthy allocation of the deployment
+	limit := strategy.MaxParallel
+	for _, alloc := range allocs {
+		if alloc.DeploymentID != d.ID || alloc.TaskGroup != tg.Name {
+			continue
+		}
+
+		// An unhealthy allocation means nothing else should be happen.
+		if alloc.DeploymentStatus.IsUnhealthy() {
+			return 0
+		}
+
+		if !alloc.DeploymentStatus.IsHealthy() {
+			limit--
+		}
+	}
+
+	if limit < 0 {
+		return 0
+	}
+	return limit
+}
+
+// computeDeployment creates a deployment for the groups with an update block
+// whose allocations are updated or placed for the first time, attaches the
+// allocations of the plan to the active deployment and marks the deployment
+// successful once all of its allocations are healthy. The allocations are
+// the non-terminal allocations of the job, deploying holds the destructive
+// updates of each group with an update block and remaining the number of
+// updates left for a later evaluation.
+func (s *SystemScheduler) computeDeployment(allocs []*structs.Allocation,
+	deploying map[string][]allocTuple, inplace []allocTuple, remaining map[string]int) {
+
+	if s.sysbatch || s.job.Stopped() {
+		return
+	}
+
+	// Count the placements and updates of each group that are either in
+	// the plan, failed or left for later
+	pending := make(map[string]int, len(s.job.TaskGroups))
+	for name, n := range remaining {
+		pending[name] += n
+	}
+	for _, nodeAllocs := range s.plan.NodeAllocation {
+		for _, alloc := range nodeAllocs {
+			pending[alloc.TaskGroup]++
+		}
+	}
+	for name, metric := range s.failedTGAllocs {
+		pending[name] += 1 + metric.CoalescedFailures
+	}
+
+	// Create a new deployment if there is no deployment for the job version
+	if s.deployment == nil {
+		updating := make(map[string]bool, len(deploying))
+		for name := range deploying {
+			updating[name] = true
+		}
+		for _, update := range inplace {
+			updating[update.TaskGroup.Name] = true
+		}
+		hadRunning := make(map[string]bool)
+		for _, alloc := range allocs {
+			if alloc.Job.Version == s.job.Version && alloc.Job.CreateIndex == s.job.CreateIndex {
+				hadRunning[alloc.TaskGroup] = true
+			}
+		}
+
+		for _, tg := range s.job.TaskGroups {
+			if tg.Update.IsEmpty() || pending[tg.Name] == 0 {
+				continue
+			}
+			if !updating[tg.Name] && hadRunning[tg.Name] {
+				continue
+			}
+
+			// A previous group may have made the deployment already
+			if s.deployment == nil {
+				s.deployment = structs.NewDeployment(s.job)
+				s.plan.Deployment = s.deployment
+			}
+
+			dstate := &structs.DeploymentState{
+				AutoRevert:       tg.Update.AutoRevert,
+				AutoPromote:      tg.Update.AutoPromote,
+				ProgressDeadline: tg.Update.ProgressDeadline,
+				DesiredTotal:     pending[tg.Name],
+			}
+			if updates := deploying[tg.Name]; tg.Update.Canary != 0 && len(updates) != 0 {
+				dstate.DesiredCanaries = helper.IntMin(tg.Update.Canary, len(updates))
+			}
+			s.deployment.TaskGroups[tg.Name] = dstate
+		}
+	}
+
+	d := s.deployment
+	if d == nil || !d.Active() {
+		return
+	}
+
+	// Attach the placed and in-place updated allocations to the deployment
+	for _, nodeAllocs := range s.plan.NodeAllocation {
+		for _, alloc := range nodeAllocs {
+			if alloc.DeploymentID != d.ID {
+				alloc.DeploymentID = d.ID
+				alloc.DeploymentStatus = nil
+			}
+
+			// Mark the canaries and add them to the deployment state
+			if _, ok := s.canaries[nodeAllocName{nodeID: alloc.NodeID, name: alloc.Name}]; ok {
+				alloc.DeploymentStatus = &structs.AllocDeploymentStatus{
+					Canary: true,
+				}
+				if dstate, ok := d.TaskGroups[alloc.TaskGroup]; ok {
+					dstate.PlacedCanaries = append(dstate.PlacedCanaries, alloc.ID)
+				}
+			}
+		}
+	}
+
+	// Set the description of a created deployment
+	if s.plan.Deployment != nil {
+		if d.RequiresPromotion() {
+			if d.HasAutoPromote() {
+				d.StatusDescription = structs.DeploymentStatusDescriptionRunningAutoPromotion
+			} else {
+				d.StatusDescription = structs.DeploymentStatusDescriptionRunningNeedsPromotion
+			}
+		}
+		return
+	}
+
+	// Mark the deployment as complete if nothing is left to do and all of
+	// its allocations are healthy and promoted
+	for name, dstate := range d.TaskGroups {
+		if pending[name] != 0 ||
+			dstate.HealthyAllocs < helper.IntMax(dstate.DesiredTotal, dstate.DesiredCanaries) ||
+			(dstate.DesiredCanaries > 0 && !dstate.Promoted) {
+			return
+		}
+	}
+	s.plan.DeploymentUpdates = append(s.plan.DeploymentUpdates, &structs.DeploymentStatusUpdate{
+		DeploymentID:      d.ID,
+		Status:            structs.DeploymentStatusSuccessful,
+		StatusDescription: structs.DeploymentStatusDescriptionSuccessful,
+	})
+}
+
+// nodeAllocName identifies the allocation of a task group on a node
+type nodeAllocName struct {
+	nodeID string
+	name   string
+}
+
+// completedSysBatchAllocs indexes the allocations of the current version of
+// the sysbatch job that ran successfully by node and name.
+func completedSysBatchAllocs(job *structs.Job, allocs []*structs.Allocation) map[nodeAllocName]*structs.Allocation {
+	completed := make(map[nodeAllocName]*structs.Allocation)
+	for _, alloc := range allocs {
+		if alloc.Job == nil || alloc.Job.JobModifyIndex != job.JobModifyIndex {
+			continue
+		}
+		if alloc.ClientStatus != structs.AllocClientStatusComplete || !alloc.RanSuccessfully() {
+			continue
+		}
+		completed[nodeAllocName{nodeID: alloc.NodeID, name: alloc.Name}] = alloc
+	}
+	return completed
+}
+
 // addBlocked creates a new blocked eval for this job on this node
 // and submit to the planner (worker.go), which keeps the eval for execution later
 func (s *SystemScheduler) addBlocked(node *structs.Node) error {
@@ -1955,3 +1955,415 @@ func TestSystemSched_Preemption(t *testing.T) {
 	h.AssertEvalStatus(t, structs.EvalStatusComplete)
 
 }
+
+func TestSysBatchSched_JobRegister(t *testing.T) {
+	h := NewHarness(t)
+	require := require.New(t)
+
+	// Create some nodes
+	for i := 0; i < 10; i++ {
+		node := mock.Node()
+		require.NoError(h.State.UpsertNode(h.NextIndex(), node))
+	}
+
+	// Create a job
+	job := mock.SysBatchJob()
+	require.NoError(h.State.UpsertJob(h.NextIndex(), job))
+
+	// Create a mock evaluation to register the job
+	eval := &structs.Evaluation{
+		Namespace:   structs.DefaultNamespace,
+		ID:          uuid.Generate(),
+		Priority:    job.Priority,
+		TriggeredBy: structs.EvalTriggerJobRegister,
+		JobID:       job.ID,
+		Status:      structs.EvalStatusPending,
+	}
+	require.NoError(h.State.UpsertEvals(h.NextIndex(), []*structs.Evaluation{eval}))
+
+	// Process the evaluation
+	require.NoError(h.Process(NewSysBatchScheduler, eval))
+
+	// Ensure a single plan allocating on every node
+	require.Len(h.Plans, 1)
+	plan := h.Plans[0]
+	require.Len(plan.NodeAllocation, 10)
+
+	ws := memdb.NewWatchSet()
+	out, err := h.State.AllocsByJob(ws, job.Namespace, job.ID, false)
+	require.NoError(err)
+	require.Len(out, 10)
+
+	h.AssertEvalStatus(t, structs.EvalStatusComplete)
+}
+
+func TestSysBatchSched_Completed(t *testing.T) {
+	h := NewHarness(t)
+	require := require.New(t)
+
+	// Create some nodes
+	var nodes []*structs.Node
+	for i := 0; i < 3; i++ {
+		node := mock.Node()
+		nodes = append(nodes, node)
+		require.NoError(h.State.UpsertNode(h.NextIndex(), node))
+	}
+
+	// Create a job
+	job := mock.SysBatchJob()
+	require.NoError(h.State.UpsertJob(h.NextIndex(), job))
+
+	// Create allocations that ran to completion on the first two nodes, and
+	// one that failed on the last node
+	var allocs []*structs.Allocation
+	for i, node := range nodes {
+		alloc := mock.Alloc()
+		alloc.Job = job
+		alloc.JobID = job.ID
+		alloc.NodeID = node.ID
+		alloc.Name = "my-job.web[0]"
+		alloc.DesiredStatus = structs.AllocDesiredStatusRun
+		alloc.ClientStatus = structs.AllocClientStatusComplete
+		alloc.TaskStates = map[string]*structs.TaskState{
+			"web": {
+				State:  structs.TaskStateDead,
+				Failed: false,
+				Events: []*structs.TaskEvent{
+					structs.NewTaskEvent(structs.TaskTerminated).SetExitCode(0),
+				},
+			},
+		}
+		if i == 2 {
+			alloc.ClientStatus = structs.AllocClientStatusFailed
+			alloc.TaskStates["web"].Failed = true
+		}
+		allocs = append(allocs, alloc)
+	}
+	require.NoError(h.State.UpsertAllocs(h.NextIndex(), allocs))
+
+	// Create a mock evaluation for the job
+	eval := &structs.Evaluation{
+		Namespace:   structs.DefaultNamespace,
+		ID:          uuid.Generate(),
+		Priority:    job.Priority,
+		TriggeredBy: structs.EvalTriggerNodeUpdate,
+		JobID:       job.ID,
+		Status:      structs.EvalStatusPending,
+	}
+	require.NoError(h.State.UpsertEvals(h.NextIndex(), []*structs.Evaluation{eval}))
+
+	// Process the evaluation
+	require.NoError(h.Process(NewSysBatchScheduler, eval))
+
+	// Ensure only the failed allocation is replaced
+	require.Len(h.Plans, 1)
+	plan := h.Plans[0]
+	require.Len(plan.NodeAllocation, 1)
+	require.Len(plan.NodeAllocation[nodes[2].ID], 1)
+
+	// Update the job and ensure it runs on every node again
+	job2 := job.Copy()
+	job2.TaskGroups[0].Tasks[0].Config["command"] = "/bin/other"
+	require.NoError(h.State.UpsertJob(h.NextIndex(), job2))
+
+	eval = &structs.Evaluation{
+		Namespace:   structs.DefaultNamespace,
+		ID:          uuid.Generate(),
+		Priority:    job.Priority,
+		TriggeredBy: structs.EvalTriggerJobRegister,
+		JobID:       job.ID,
+		Status:      structs.EvalStatusPending,
+	}
+	require.NoError(h.State.UpsertEvals(h.NextIndex(), []*structs.Evaluation{eval}))
+
+	h.Plans = nil
+	require.NoError(h.Process(NewSysBatchScheduler, eval))
+	require.Len(h.Plans, 1)
+	require.Len(h.Plans[0].NodeAllocation, 3)
+}
+
+// systemDeploymentJob returns a system job with allocations on the given
+// nodes and a destructive update of the job using the update strategy.
+func systemDeploymentJob(t *testing.T, h *Harness, nodes []*structs.Node, update *structs.UpdateStrategy) *structs.Job {
+	job := mock.SystemJob()
+	require.NoError(t, h.State.UpsertJob(h.NextIndex(), job))
+
+	var allocs []*structs.Allocation
+	for _, node := range nodes {
+		alloc := mock.Alloc()
+		alloc.Job = job
+		alloc.JobID = job.ID
+		alloc.NodeID = node.ID
+		alloc.Name = "my-job.web[0]"
+		allocs = append(allocs, alloc)
+	}
+	require.NoError(t, h.State.UpsertAllocs(h.NextIndex(), allocs))
+
+	job2 := job.Copy()
+	job2.TaskGroups[0].Update = update
+	job2.TaskGroups[0].Tasks[0].Config["command"] = "/bin/other"
+	require.NoError(t, h.State.UpsertJob(h.NextIndex(), job2))
+	return job2
+}
+
+func TestSystemSched_JobModify_Deployment(t *testing.T) {
+	h := NewHarness(t)
+	require := require.New(t)
+
+	// Create some nodes
+	var nodes []*structs.Node
+	for i := 0; i < 10; i++ {
+		node := mock.Node()
+		nodes = append(nodes, node)
+		require.NoError(h.State.UpsertNode(h.NextIndex(), node))
+	}
+
+	job := systemDeploymentJob(t, h, nodes, &structs.UpdateStrategy{
+		MaxParallel:     3,
+		HealthCheck:     structs.UpdateStrategyHealthCheck_Checks,
+		MinHealthyTime:  10 * time.Second,
+		HealthyDeadline: 10 * time.Minute,
+		AutoRevert:      true,
+	})
+
+	// Create a mock evaluation to handle the update
+	eval := &structs.Evaluation{
+		Namespace:   structs.DefaultNamespace,
+		ID:          uuid.Generate(),
+		Priority:    job.Priority,
+		TriggeredBy: structs.EvalTriggerJobRegister,
+		JobID:       job.ID,
+		Status:      structs.EvalStatusPending,
+	}
+	require.NoError(h.State.UpsertEvals(h.NextIndex(), []*structs.Evaluation{eval}))
+	require.NoError(h.Process(NewSystemScheduler, eval))
+
+	// Ensure a single plan that creates a deployment and updates as many
+	// allocations as allowed by max_parallel
+	require.Len(h.Plans, 1)
+	plan := h.Plans[0]
+	d := plan.Deployment
+	require.NotNil(d)
+	require.Equal(job.Version, d.JobVersion)
+	dstate := d.TaskGroups["web"]
+	require.NotNil(dstate)
+	require.Equal(10, dstate.DesiredTotal)
+	require.True(dstate.AutoRevert)
+
+	var planned []*structs.Allocation
+	for _, allocList := range plan.NodeAllocation {
+		planned = append(planned, allocList...)
+	}
+	require.Len(planned, 3)
+	for _, alloc := range planned {
+		require.Equal(d.ID, alloc.DeploymentID)
+	}
+
+	// No rolling eval is created, the deployment drives the update
+	require.Empty(h.CreateEvals)
+	require.Equal(d.ID, h.Evals[0].DeploymentID)
+
+	// While the placed allocations are not healthy nothing else is updated
+	h.Plans = nil
+	eval2 := eval.Copy()
+	eval2.ID = uuid.Generate()
+	eval2.TriggeredBy = structs.EvalTriggerDeploymentWatcher
+	require.NoError(h.State.UpsertEvals(h.NextIndex(), []*structs.Evaluation{eval2}))
+	require.NoError(h.Process(NewSystemScheduler, eval2))
+	require.Empty(h.Plans)
+
+	// Mark two of them healthy and ensure two more allocations are updated
+	ws := memdb.NewWatchSet()
+	out, err := h.State.AllocsByDeployment(ws, d.ID)
+	require.NoError(err)
+	require.Len(out, 3)
+	var healthy []*structs.Allocation
+	for _, alloc := range out[:2] {
+		alloc = alloc.Copy()
+		alloc.DeploymentStatus = &structs.AllocDeploymentStatus{
+			Healthy:   helper.BoolToPtr(true),
+			Timestamp: time.Now(),
+		}
+		healthy = append(healthy, alloc)
+	}
+	require.NoError(h.State.UpsertAllocs(h.NextIndex(), healthy))
+
+	eval3 := eval2.Copy()
+	eval3.ID = uuid.Generate()
+	require.NoError(h.State.UpsertEvals(h.NextIndex(), []*structs.Evaluation{eval3}))
+	require.NoError(h.Process(NewSystemScheduler, eval3))
+	require.Len(h.Plans, 1)
+	require.Nil(h.Plans[0].Deployment)
+	planned = nil
+	for _, allocList := range h.Plans[0].NodeAllocation {
+		planned = append(planned, allocList...)
+	}
+	require.Len(planned, 2)
+	for _, alloc := range planned {
+		require.Equal(d.ID, alloc.DeploymentID)
+	}
+}
+
+func TestSystemSched_JobModify_Deployment_Complete(t *testing.T) {
+	h := NewHarness(t)
+	require := require.New(t)
+
+	// Create some nodes
+	var nodes []*structs.Node
+	for i := 0; i < 2; i++ {
+		node := mock.Node()
+		nodes = append(nodes, node)
+		require.NoError(h.State.UpsertNode(h.NextIndex(), node))
+	}
+
+	job := mock.SystemJob()
+	job.TaskGroups[0].Update = &structs.UpdateStrategy{
+		MaxParallel:     2,
+		HealthCheck:     structs.UpdateStrategyHealthCheck_Checks,
+		MinHealthyTime:  10 * time.Second,
+		HealthyDeadline: 10 * time.Minute,
+	}
+	require.NoError(h.State.UpsertJob(h.NextIndex(), job))
+
+	// Create a deployment whose allocations are all healthy
+	d := structs.NewDeployment(job)
+	d.TaskGroups["web"] = &structs.DeploymentState{
+		DesiredTotal:  2,
+		HealthyAllocs: 2,
+	}
+	require.NoError(h.State.UpsertDeployment(h.NextIndex(), d))
+
+	var allocs []*structs.Allocation
+	for _, node := range nodes {
+		alloc := mock.Alloc()
+		alloc.Job = job
+		alloc.JobID = job.ID
+		alloc.NodeID = node.ID
+		alloc.Name = "my-job.web[0]"
+		alloc.DeploymentID = d.ID
+		alloc.DeploymentStatus = &structs.AllocDeploymentStatus{
+			Healthy: helper.BoolToPtr(true),
+		}
+		allocs = append(allocs, alloc)
+	}
+	require.NoError(h.State.UpsertAllocs(h.NextIndex(), allocs))
+
+	eval := &structs.Evaluation{
+		Namespace:    structs.DefaultNamespace,
+		ID:           uuid.Generate(),
+		Priority:     job.Priority,
+		TriggeredBy:  structs.EvalTriggerDeploymentWatcher,
+		JobID:        job.ID,
+		DeploymentID: d.ID,
+		Status:       structs.EvalStatusPending,
+	}
+	require.NoError(h.State.UpsertEvals(h.NextIndex(), []*structs.Evaluation{eval}))
+	require.NoError(h.Process(NewSystemScheduler, eval))
+
+	// Ensure the deployment is marked successful
+	require.Len(h.Plans, 1)
+	plan := h.Plans[0]
+	require.Empty(plan.NodeAllocation)
+	require.Len(plan.DeploymentUpdates, 1)
+	require.Equal(d.ID, plan.DeploymentUpdates[0].DeploymentID)
+	require.Equal(structs.DeploymentStatusSuccessful, plan.DeploymentUpdates[0].Status)
+}
+
+func TestSystemSched_JobModify_Deployment_Canaries(t *testing.T) {
+	h := NewHarness(t)
+	require := require.New(t)
+
+	// Create some nodes
+	var nodes []*structs.Node
+	for i := 0; i < 5; i++ {
+		node := mock.Node()
+		nodes = append(nodes, node)
+		require.NoError(h.State.UpsertNode(h.NextIndex(), node))
+	}
+
+	job := systemDeploymentJob(t, h, nodes, &structs.UpdateStrategy{
+		MaxParallel:     2,
+		Canary:          1,
+		HealthCheck:     structs.UpdateStrategyHealthCheck_Checks,
+		MinHealthyTime:  10 * time.Second,
+		HealthyDeadline: 10 * time.Minute,
+	})
+
+	eval := &structs.Evaluation{
+		Namespace:   structs.DefaultNamespace,
+		ID:          uuid.Generate(),
+		Priority:    job.Priority,
+		TriggeredBy: structs.EvalTriggerJobRegister,
+		JobID:       job.ID,
+		Status:      structs.EvalStatusPending,
+	}
+	require.NoError(h.State.UpsertEvals(h.NextIndex(), []*structs.Evaluation{eval}))
+	require.NoError(h.Process(NewSystemScheduler, eval))
+
+	// Ensure a single canary is placed and the deployment needs promotion
+	require.Len(h.Plans, 1)
+	plan := h.Plans[0]
+	d := plan.Deployment
+	require.NotNil(d)
+	require.Equal(structs.DeploymentStatusDescriptionRunningNeedsPromotion, d.StatusDescription)
+	dstate := d.TaskGroups["web"]
+	require.Equal(5, dstate.DesiredTotal)
+	require.Equal(1, dstate.DesiredCanaries)
+
+	var planned []*structs.Allocation
+	for _, allocList := range plan.NodeAllocation {
+		planned = append(planned, allocList...)
+	}
+	require.Len(planned, 1)
+	require.True(planned[0].DeploymentStatus.IsCanary())
+	require.Equal([]string{planned[0].ID}, dstate.PlacedCanaries)
+
+	// Nothing else is updated until the canary is promoted
+	h.Plans = nil
+	eval2 := eval.Copy()
+	eval2.ID = uuid.Generate()
+	eval2.TriggeredBy = structs.EvalTriggerDeploymentWatcher
+	require.NoError(h.State.UpsertEvals(h.NextIndex(), []*structs.Evaluation{eval2}))
+	require.NoError(h.Process(NewSystemScheduler, eval2))
+	require.Empty(h.Plans)
+}
+
+func TestSystemSched_JobModify_Deployment_CancelOlder(t *testing.T) {
+	h := NewHarness(t)
+	require := require.New(t)
+
+	node := mock.Node()
+	require.NoError(h.State.UpsertNode(h.NextIndex(), node))
+
+	job := systemDeploymentJob(t, h, []*structs.Node{node}, &structs.UpdateStrategy{
+		MaxParallel:     1,
+		HealthCheck:     structs.UpdateStrategyHealthCheck_Checks,
+		MinHealthyTime:  10 * time.Second,
+		HealthyDeadline: 10 * time.Minute,
+	})
+
+	// Create a running deployment for an older version of the job
+	old := structs.NewDeployment(job)
+	old.JobVersion = job.Version - 1
+	require.NoError(h.State.UpsertDeployment(h.NextIndex(), old))
+
+	eval := &structs.Evaluation{
+		Namespace:   structs.DefaultNamespace,
+		ID:          uuid.Generate(),
+		Priority:    job.Priority,
+		TriggeredBy: structs.EvalTriggerJobRegister,
+		JobID:       job.ID,
+		Status:      structs.EvalStatusPending,
+	}
+	require.NoError(h.State.UpsertEvals(h.NextIndex(), []*structs.Evaluation{eval}))
+	require.NoError(h.Process(NewSystemScheduler, eval))
+
+	// Ensure the older deployment is cancelled and a new one is created
+	require.Len(h.Plans, 1)
+	plan := h.Plans[0]
+	require.Len(plan.DeploymentUpdates, 1)
+	require.Equal(old.ID, plan.DeploymentUpdates[0].DeploymentID)
+	require.Equal(structs.DeploymentStatusCancelled, plan.DeploymentUpdates[0].Status)
+	require.NotNil(plan.Deployment)
+	require.NotEqual(old.ID, plan.Deployment.ID)
+}
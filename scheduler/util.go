@@ -109,7 +109,8 @@ func diffAllocs(job *structs.Job, taintedNodes map[string]*structs.Node,
 			// lost as the work was already successfully finished. However for
 			// service/system jobs, tasks should never complete. The check of
 			// batch type, defends against client bugs.
-			if exist.Job.Type == structs.JobTypeBatch && exist.RanSuccessfully() {
+			batch := exist.Job.Type == structs.JobTypeBatch || exist.Job.Type == structs.JobTypeSysBatch
+			if batch && exist.RanSuccessfully() {
 				goto IGNORE
 			}
 
@@ -392,6 +393,9 @@ func tasksUpdated(jobA, jobB *structs.Job, taskGroup string) bool {
 		if !reflect.DeepEqual(at.Vault, bt.Vault) {
 			return true
 		}
+		if !reflect.DeepEqual(at.Identity, bt.Identity) {
+			return true
+		}
 		if !reflect.DeepEqual(at.Templates, bt.Templates) {
 			return true
 		}
@@ -929,3 +933,87 @@ func genericAllocUpdateFn(ctx Context, stack Stack, evalID string) allocUpdateTy
 		return false, false, newAlloc
 	}
 }
+
+// unmetDependencies returns the IDs of the jobs the given job depends on whose
+// dependency condition isn't met, and the subset of them that don't exist.
+func unmetDependencies(state State, job *structs.Job) (unmet, missing []string, err error) {
+	for _, dep := range job.DependsOn {
+		met, exists, err := dependencyMet(state, job.Namespace, dep)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !met {
+			unmet = append(unmet, dep.JobID)
+		}
+		if !exists {
+			missing = append(missing, dep.JobID)
+		}
+	}
+	return unmet, missing, nil
+}
+
+// dependencyMet returns whether the job referenced by the dependency meets its
+// condition and whether it exists. Periodic and parameterized jobs meet the
+// condition once they have launched children and all of them meet it.
+func dependencyMet(state State, namespace string, dep *structs.JobDependency) (met, exists bool, err error) {
+	job, err := state.JobByID(nil, namespace, dep.JobID)
+	if err != nil {
+		return false, false, fmt.Errorf("failed to get job %q: %v", dep.JobID, err)
+	}
+	if job == nil {
+		return false, false, nil
+	}
+
+	if !job.IsPeriodic() && !job.IsParameterized() {
+		met, err := jobMeetsCondition(state, job, dep.Condition)
+		return met, true, err
+	}
+
+	iter, err := state.JobsByIDPrefix(nil, namespace, job.ID+"/")
+	if err != nil {
+		return false, true, fmt.Errorf("failed to get children of job %q: %v", dep.JobID, err)
+	}
+
+	children := 0
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		child := raw.(*structs.Job)
+		if child.ParentID != job.ID {
+			continue
+		}
+
+		children++
+		if met, err := jobMeetsCondition(state, child, dep.Condition); err != nil || !met {
+			return false, true, err
+		}
+	}
+	return children != 0, true, nil
+}
+
+// jobMeetsCondition returns whether the job is dead and, for the success
+// condition, whether it wasn't stopped and the latest allocation of every index
+// completed.
+func jobMeetsCondition(state State, job *structs.Job, condition string) (bool, error) {
+	if job.Status != structs.JobStatusDead {
+		return false, nil
+	}
+	if condition != structs.JobDependencyConditionSuccess {
+		return true, nil
+	}
+	if job.Stopped() {
+		return false, nil
+	}
+
+	allocs, err := state.AllocsByJob(nil, job.Namespace, job.ID, false)
+	if err != nil {
+		return false, fmt.Errorf("failed to get allocs for job %q: %v", job.ID, err)
+	}
+	if len(allocs) == 0 {
+		return false, nil
+	}
+	for _, alloc := range allocs {
+		if alloc.NextAllocation == "" && alloc.ClientStatus != structs.AllocClientStatusComplete {
+			return false, nil
+		}
+	}
+	return true, nil
+}
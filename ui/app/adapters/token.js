@@ -7,13 +7,41 @@ export default ApplicationAdapter.extend({
   namespace: namespace + '/acl',
 
   findSelf() {
-    return this.ajax(`${this.buildURL()}/token/self`, 'GET').then(token => {
-      const store = this.store;
-      store.pushPayload('token', {
-        tokens: [token],
-      });
+    return this.ajax(`${this.buildURL()}/token/self`, 'GET').then(token => this.pushToken(token));
+  },
+
+  findAuthMethods() {
+    return this.ajax(`${this.buildURL()}/auth-methods`, 'GET');
+  },
 
-      return store.peekRecord('token', store.normalize('token', token).data.id);
+  oidcAuthURL({ authMethodName, redirectURI, clientNonce }) {
+    return this.ajax(`${this.buildURL()}/oidc/auth-url`, 'POST', {
+      data: {
+        AuthMethodName: authMethodName,
+        RedirectURI: redirectURI,
+        ClientNonce: clientNonce,
+      },
     });
   },
+
+  oidcCompleteAuth({ authMethodName, redirectURI, clientNonce, state, code }) {
+    return this.ajax(`${this.buildURL()}/oidc/complete-auth`, 'POST', {
+      data: {
+        AuthMethodName: authMethodName,
+        RedirectURI: redirectURI,
+        ClientNonce: clientNonce,
+        State: state,
+        Code: code,
+      },
+    }).then(token => this.pushToken(token));
+  },
+
+  pushToken(token) {
+    const store = this.store;
+    store.pushPayload('token', {
+      tokens: [token],
+    });
+
+    return store.peekRecord('token', store.normalize('token', token).data.id);
+  },
 });
@@ -1,23 +1,65 @@
 import { inject as service } from '@ember/service';
 import { reads } from '@ember/object/computed';
+import { computed } from '@ember/object';
 import Controller from '@ember/controller';
 import { getOwner } from '@ember/application';
+import messageFromAdapterError from 'nomad-ui/utils/message-from-adapter-error';
 
 export default Controller.extend({
   token: service(),
   system: service(),
   store: service(),
+  router: service(),
+
+  queryParams: ['code', 'state'],
+
+  // Set by the OIDC provider when it redirects back after a sign in
+  code: null,
+  state: null,
 
   secret: reads('token.secret'),
+  authMethods: reads('model'),
 
   tokenIsValid: false,
   tokenIsInvalid: false,
   tokenRecord: null,
+  oidcError: null,
+
+  // The auth method must list this URI in its allowed redirect URIs
+  oidcRedirectURI: computed(function() {
+    return `${window.location.origin}${this.router.urlFor('settings.tokens')}`;
+  }),
 
   resetStore() {
     this.store.unloadAll();
   },
 
+  // Leaves the UI for the OIDC provider's sign in page. Tests replace this
+  // to stay on the page.
+  redirectTo(url) {
+    window.location.assign(url);
+  },
+
+  tokenVerified(token) {
+    // Capture the token ID before clearing the store
+    const tokenId = token.get('id');
+
+    // Clear out all data to ensure only data the new token is privileged to
+    // see is shown
+    this.system.reset();
+    this.resetStore();
+
+    // Immediately refetch the token now that the store is empty
+    const newToken = this.store.findRecord('token', tokenId);
+
+    this.setProperties({
+      tokenIsValid: true,
+      tokenIsInvalid: false,
+      tokenRecord: newToken,
+      oidcError: null,
+    });
+  },
+
   actions: {
     clearTokenProperties() {
       this.token.setProperties({
@@ -27,6 +69,7 @@ export default Controller.extend({
         tokenIsValid: false,
         tokenIsInvalid: false,
         tokenRecord: null,
+        oidcError: null,
       });
       this.resetStore();
     },
@@ -39,22 +82,7 @@ export default Controller.extend({
 
       TokenAdapter.findSelf().then(
         token => {
-          // Capture the token ID before clearing the store
-          const tokenId = token.get('id');
-
-          // Clear out all data to ensure only data the new token is privileged to
-          // see is shown
-          this.system.reset();
-          this.resetStore();
-
-          // Immediately refetch the token now that the store is empty
-          const newToken = this.store.findRecord('token', tokenId);
-
-          this.setProperties({
-            tokenIsValid: true,
-            tokenIsInvalid: false,
-            tokenRecord: newToken,
-          });
+          this.tokenVerified(token);
         },
         () => {
           this.set('token.secret', undefined);
@@ -66,5 +94,81 @@ export default Controller.extend({
         }
       );
     },
+
+    signInWithOIDC(method) {
+      const TokenAdapter = getOwner(this).lookup('adapter:token');
+      const clientNonce = generateNonce();
+
+      // The nonce and method are needed again to complete the sign in once
+      // the provider redirects back to this page
+      window.sessionStorage.nomadOIDCNonce = clientNonce;
+      window.sessionStorage.nomadOIDCAuthMethod = method.Name;
+
+      this.set('oidcError', null);
+
+      TokenAdapter.oidcAuthURL({
+        authMethodName: method.Name,
+        redirectURI: this.oidcRedirectURI,
+        clientNonce,
+      }).then(
+        ({ AuthURL }) => {
+          this.redirectTo(AuthURL);
+        },
+        error => {
+          this.set('oidcError', messageFromAdapterError(error) || 'Could not start the sign in');
+        }
+      );
+    },
+
+    completeOIDCAuth() {
+      const { code, state } = this;
+      const clientNonce = window.sessionStorage.nomadOIDCNonce;
+      const authMethodName = window.sessionStorage.nomadOIDCAuthMethod;
+      const TokenAdapter = getOwner(this).lookup('adapter:token');
+
+      // The code is only good for one attempt, so don't leave it in the URL
+      window.sessionStorage.removeItem('nomadOIDCNonce');
+      window.sessionStorage.removeItem('nomadOIDCAuthMethod');
+      this.setProperties({ code: null, state: null });
+
+      if (!clientNonce || !authMethodName) {
+        this.set('oidcError', 'The sign in was not started from this browser session');
+        return;
+      }
+
+      TokenAdapter.oidcCompleteAuth({
+        authMethodName,
+        redirectURI: this.oidcRedirectURI,
+        clientNonce,
+        state,
+        code,
+      }).then(
+        token => {
+          this.set('token.secret', token.get('secret'));
+          this.tokenVerified(token);
+        },
+        error => {
+          this.setProperties({
+            tokenIsValid: false,
+            tokenRecord: null,
+            oidcError: messageFromAdapterError(error) || 'Could not complete the sign in',
+          });
+        }
+      );
+    },
   },
 });
+
+// Returns a random hex string to tie the provider's redirect back to the
+// browser session that started the sign in.
+function generateNonce() {
+  const crypto = window.crypto || window.msCrypto;
+  const bytes = new Uint8Array(16);
+  crypto.getRandomValues(bytes);
+
+  let nonce = '';
+  for (let i = 0; i < bytes.length; i++) {
+    nonce += ('0' + bytes[i].toString(16)).slice(-2);
+  }
+  return nonce;
+}
@@ -0,0 +1,24 @@
+import Route from '@ember/routing/route';
+import { getOwner } from '@ember/application';
+
+export default Route.extend({
+  // The auth methods are listed so the user can sign in with one of them.
+  // Listing fails when ACLs are disabled, in which case there is nothing
+  // to sign in with.
+  model() {
+    const TokenAdapter = getOwner(this).lookup('adapter:token');
+    return TokenAdapter.findAuthMethods().then(
+      methods => methods.filterBy('Type', 'OIDC'),
+      () => []
+    );
+  },
+
+  // The OIDC provider redirects back to this page with a code and state
+  // once the user has signed in.
+  setupController(controller) {
+    this._super(...arguments);
+    if (controller.code && controller.state) {
+      controller.send('completeOIDCAuth');
+    }
+  },
+});
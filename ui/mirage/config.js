@@ -292,6 +292,31 @@ export default function() {
     return new Response(403, {}, null);
   });
 
+  this.get('/acl/auth-methods', function({ authMethods }) {
+    return this.serialize(authMethods.all());
+  });
+
+  this.post('/acl/oidc/auth-url', function({ authMethods }, { requestBody }) {
+    const { AuthMethodName, RedirectURI, ClientNonce } = JSON.parse(requestBody);
+    if (!authMethods.find(AuthMethodName) || !RedirectURI || !ClientNonce) {
+      return new Response(400, {}, null);
+    }
+
+    return {
+      AuthURL: `https://oidc.example.com/authorize?state=${ClientNonce}&redirect_uri=${RedirectURI}`,
+    };
+  });
+
+  // The mock provider signs every user in as the first token
+  this.post('/acl/oidc/complete-auth', function({ authMethods, tokens }, { requestBody }) {
+    const { AuthMethodName, ClientNonce, State, Code } = JSON.parse(requestBody);
+    if (!authMethods.find(AuthMethodName) || !Code || State !== ClientNonce) {
+      return new Response(403, {}, null);
+    }
+
+    return this.serialize(tokens.first());
+  });
+
   this.get('/regions', function({ regions }) {
     return this.serialize(regions.all());
   });
@@ -0,0 +1,11 @@
+import { Factory } from 'ember-cli-mirage';
+import faker from 'nomad-ui/mirage/faker';
+
+export default Factory.extend({
+  id() {
+    return this.name;
+  },
+  name: () => faker.hacker.noun(),
+  type: 'OIDC',
+  default: false,
+});
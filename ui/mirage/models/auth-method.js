@@ -0,0 +1,3 @@
+import { Model } from 'ember-cli-mirage';
+
+export default Model.extend();
@@ -121,6 +121,7 @@ function emptyCluster(server) {
 
 function createTokens(server) {
   server.createList('token', 3);
+  server.create('auth-method', { name: 'example' });
   logTokens(server);
 }
 
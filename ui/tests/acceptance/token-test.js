@@ -1,4 +1,4 @@
-import { find } from '@ember/test-helpers';
+import { currentURL, find } from '@ember/test-helpers';
 import { module, skip, test } from 'qunit';
 import { setupApplicationTest } from 'ember-qunit';
 import { setupMirage } from 'ember-cli-mirage/test-support';
@@ -141,6 +141,74 @@ module('Acceptance | tokens', function(hooks) {
     assert.notOk(find('[data-test-job-row]'), 'No jobs found');
   });
 
+  test('each OIDC auth method has a sign in button', async function(assert) {
+    server.create('auth-method', { name: 'okta' });
+
+    await Tokens.visit();
+
+    assert.equal(Tokens.oidcSignIn.length, 1, 'One sign in button');
+    assert.equal(Tokens.oidcSignIn.objectAt(0).name, 'Sign in with okta');
+  });
+
+  test('signing in with an auth method redirects to the provider', async function(assert) {
+    server.create('auth-method', { name: 'okta' });
+
+    await Tokens.visit();
+
+    let redirectedTo;
+    this.owner.lookup('controller:settings/tokens').set('redirectTo', url => {
+      redirectedTo = url;
+    });
+
+    await Tokens.oidcSignIn.objectAt(0).click();
+
+    const request = server.pretender.handledRequests.findBy('url', '/v1/acl/oidc/auth-url');
+    const body = JSON.parse(request.requestBody);
+    assert.equal(body.AuthMethodName, 'okta');
+    assert.equal(body.RedirectURI, `${window.location.origin}/ui/settings/tokens`);
+    assert.equal(body.ClientNonce, window.sessionStorage.nomadOIDCNonce, 'Nonce is kept');
+    assert.ok(redirectedTo.startsWith('https://oidc.example.com/authorize'), 'Redirected');
+  });
+
+  test('returning from the provider completes the sign in and sets the token', async function(assert) {
+    server.create('auth-method', { name: 'okta' });
+    window.sessionStorage.nomadOIDCNonce = 'nonce';
+    window.sessionStorage.nomadOIDCAuthMethod = 'okta';
+
+    await Tokens.visit({ code: 'code', state: 'nonce' });
+
+    assert.equal(
+      window.localStorage.nomadTokenSecret,
+      managementToken.secretId,
+      'Token secret was set'
+    );
+    assert.ok(Tokens.successMessage, 'Token success message is shown');
+    assert.notOk(Tokens.oidcErrorMessage, 'Sign in error message is not shown');
+    assert.ok(window.sessionStorage.nomadOIDCNonce == null, 'Nonce is discarded');
+    assert.equal(currentURL(), '/settings/tokens', 'Code and state are removed from the URL');
+  });
+
+  test('an error message is shown when completing the sign in fails', async function(assert) {
+    server.create('auth-method', { name: 'okta' });
+    window.sessionStorage.nomadOIDCNonce = 'nonce';
+    window.sessionStorage.nomadOIDCAuthMethod = 'okta';
+
+    await Tokens.visit({ code: 'code', state: 'not-the-nonce' });
+
+    assert.ok(window.localStorage.nomadTokenSecret == null, 'No token secret set');
+    assert.ok(Tokens.oidcErrorMessage, 'Sign in error message is shown');
+    assert.notOk(Tokens.successMessage, 'Token success message is not shown');
+  });
+
+  test('returning from the provider without a started sign in shows an error', async function(assert) {
+    server.create('auth-method', { name: 'okta' });
+
+    await Tokens.visit({ code: 'code', state: 'nonce' });
+
+    assert.ok(window.localStorage.nomadTokenSecret == null, 'No token secret set');
+    assert.ok(Tokens.oidcErrorMessage, 'Sign in error message is shown');
+  });
+
   function getHeader({ requestHeaders }, name) {
     // Headers are case-insensitive, but object property look up is not
     return (
@@ -14,6 +14,12 @@ export default create({
   secret: fillable('[data-test-token-secret]'),
   submit: clickable('[data-test-token-submit]'),
 
+  oidcSignIn: collection('[data-test-oidc-sign-in]', {
+    name: text(),
+    click: clickable(),
+  }),
+  oidcErrorMessage: isVisible('[data-test-oidc-error]'),
+
   errorMessage: isVisible('[data-test-token-error]'),
   successMessage: isVisible('[data-test-token-success]'),
   managementMessage: isVisible('[data-test-token-management-message]'),